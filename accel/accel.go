@@ -0,0 +1,183 @@
+// Package accel accelerates fixed-point iterations x ← g(x), such as the
+// successive substitution of K-values in flash and phase equilibrium
+// calculations.
+//
+// Successive substitution converges linearly: the error shrinks by the dominant
+// eigenvalue λ of the Jacobian of g at every iteration. Near critical points and
+// azeotropes λ approaches 1 and convergence stalls. The dominant eigenvalue
+// methods of Crowe and Nishio (1975) and Michelsen (1982) estimate the
+// eigenvalues from successive steps and extrapolate to the limit of the
+// sequence.
+package accel
+
+import "fmt"
+
+// Method selects the extrapolation of an Accelerator.
+type Method int
+
+const (
+	// DEM extrapolates with one dominant eigenvalue, λ = Δₖ·Δₖ / Δₖ₋₁·Δₖ, to
+	// xₖ + Δₖ/(1 - λ). It is the vector form of Aitken's Δ² process and needs
+	// two steps.
+	DEM Method = iota
+	// GDEM extrapolates with the two dominant eigenvalues, fitted by least
+	// squares to the last three steps. It also accelerates sequences whose
+	// eigenvalues are complex or of similar magnitude.
+	GDEM
+)
+
+// String implements fmt.Stringer for Method.
+func (m Method) String() string {
+	switch m {
+	case DEM:
+		return "DEM"
+	case GDEM:
+		return "GDEM"
+	default:
+		return fmt.Sprintf("Method(%d)", int(m))
+	}
+}
+
+// steps returns the number of steps the method extrapolates from.
+func (m Method) steps() int {
+	if m == GDEM {
+		return 3
+	}
+	return 2
+}
+
+// defaultEvery is the default number of iterations between accelerated steps.
+const defaultEvery = 5
+
+// Accelerator accelerates a successive substitution loop. It records the steps
+// Δₖ = g(xₖ) - xₖ passed to Next and replaces every Every-th step with an
+// extrapolation to the limit of the iteration. The zero value is a DEM
+// accelerator ready for use.
+//
+// An Accelerator is not safe for concurrent use.
+type Accelerator struct {
+	Method Method // Extrapolation method. Defaults to DEM.
+	// Every is the number of iterations between accelerated steps. The plain
+	// steps in between let the iteration settle into its dominant eigenvalues.
+	// Defaults to 5.
+	Every int
+
+	n     int         // Steps since the last accelerated step
+	steps [][]float64 // Last steps, most recent first
+}
+
+// Next returns the next iterate of the iteration from the current iterate x and
+// the substituted gx = g(x): gx itself, or an extrapolated point on accelerated
+// iterations. An extrapolation that is undefined, or would move away from the
+// direction of convergence, is skipped.
+func (a *Accelerator) Next(x, gx []float64) []float64 {
+	step := make([]float64, len(x))
+	for i := range x {
+		step[i] = gx[i] - x[i]
+	}
+	if len(a.steps) > 0 && len(a.steps[0]) != len(step) {
+		a.Reset()
+	}
+	keep := a.Method.steps()
+	a.steps = append([][]float64{step}, a.steps...)
+	if len(a.steps) > keep {
+		a.steps = a.steps[:keep]
+	}
+	a.n++
+
+	every := a.Every
+	if every <= 0 {
+		every = defaultEvery
+	}
+	out := append([]float64(nil), gx...)
+	if a.n < every || len(a.steps) < keep {
+		return out
+	}
+	a.n = 0
+	var ok bool
+	switch a.Method {
+	case GDEM:
+		ok = gdem(out, x, a.steps)
+	default:
+		ok = dem(out, x, a.steps)
+	}
+	if ok {
+		// The extrapolated point breaks the sequence of steps; start afresh.
+		a.steps = a.steps[:0]
+	}
+	return out
+}
+
+// Reset discards the recorded steps, e.g. when the iteration is restarted from
+// a new point.
+func (a *Accelerator) Reset() {
+	a.n = 0
+	a.steps = a.steps[:0]
+}
+
+// dem writes the one-eigenvalue extrapolation from x into out, if defined.
+func dem(out, x []float64, steps [][]float64) bool {
+	d0, d1 := steps[0], steps[1]
+	lambda := dot(d0, d0) / dot(d0, d1)
+	if !(lambda > 0 && lambda < 1) {
+		return false
+	}
+	for i := range out {
+		out[i] = x[i] + d0[i]/(1-lambda)
+	}
+	return true
+}
+
+// gdem writes the two-eigenvalue extrapolation from x into out, if defined. The
+// steps are taken to follow Δₖ + μ₁Δₖ₋₁ + μ₂Δₖ₋₂ = 0, whose remaining terms sum
+// to the limit
+//
+//	x = xₖ + (Δₖ - μ₂Δₖ₋₁) / (1 + μ₁ + μ₂)
+func gdem(out, x []float64, steps [][]float64) bool {
+	d0, d1, d2 := steps[0], steps[1], steps[2]
+	b01, b02 := dot(d0, d1), dot(d0, d2)
+	b11, b12, b22 := dot(d1, d1), dot(d1, d2), dot(d2, d2)
+	det := b11*b22 - b12*b12
+	if !(det > 1e-12*b11*b22) {
+		// The last steps are parallel; extrapolate with one eigenvalue.
+		return dem(out, x, steps)
+	}
+	mu1 := (b02*b12 - b01*b22) / det
+	mu2 := (b01*b12 - b02*b11) / det
+	den := 1 + mu1 + mu2
+	// The eigenvalues are the roots of λ² + μ₁λ + μ₂; den > 0 when both lie
+	// below 1, as for a convergent iteration.
+	if !(den > 0) {
+		return false
+	}
+	for i := range out {
+		out[i] = x[i] + (d0[i]-mu2*d1[i])/den
+	}
+	return true
+}
+
+// Aitken returns the Aitken Δ² extrapolation of three successive iterates x0,
+// x1 and x2 of a scalar fixed-point iteration,
+//
+//	x = x2 - (x2 - x1)² / ((x2 - x1) - (x1 - x0))
+//
+// or x2 if the iterates are not converging geometrically.
+func Aitken(x0, x1, x2 float64) float64 {
+	d1, d2 := x1-x0, x2-x1
+	den := d2 - d1
+	if den == 0 || d1 == 0 {
+		return x2
+	}
+	if r := d2 / d1; !(r > -1 && r < 1) {
+		return x2
+	}
+	return x2 - d2*d2/den
+}
+
+func dot(a, b []float64) float64 {
+	var s float64
+	for i := range a {
+		s += a[i] * b[i]
+	}
+	return s
+}
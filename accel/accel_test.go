@@ -0,0 +1,100 @@
+package accel_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/accel"
+)
+
+// linear is the fixed-point iteration x ← Ax + c with A = diag(lambda), whose
+// limit is cᵢ/(1 - λᵢ).
+type linear struct {
+	lambda, c []float64
+}
+
+func (l linear) g(x []float64) []float64 {
+	out := make([]float64, len(x))
+	for i := range x {
+		out[i] = l.lambda[i]*x[i] + l.c[i]
+	}
+	return out
+}
+
+func (l linear) error(x []float64) float64 {
+	var e float64
+	for i := range x {
+		e = math.Max(e, math.Abs(x[i]-l.c[i]/(1-l.lambda[i])))
+	}
+	return e
+}
+
+// iterations returns the number of iterations until x is within tol of the limit.
+func (l linear) iterations(next func(x, gx []float64) []float64, tol float64) int {
+	x := make([]float64, len(l.c))
+	for n := 1; n <= 10000; n++ {
+		x = next(x, l.g(x))
+		if l.error(x) < tol {
+			return n
+		}
+	}
+	return math.MaxInt
+}
+
+func TestAccelerator(t *testing.T) {
+	const tol = 1e-8
+	plain := func(_, gx []float64) []float64 { return gx }
+	tests := []struct {
+		name    string
+		l       linear
+		method  accel.Method
+		maxIter int // Iterations needed with acceleration
+	}{
+		// One eigenvalue: the first DEM step lands on the limit.
+		{"DEM one eigenvalue", linear{[]float64{0.99, 0.99}, []float64{1, 2}}, accel.DEM, 5},
+		// Successive substitution needs about 2000 iterations.
+		{"DEM two eigenvalues", linear{[]float64{0.99, 0.9}, []float64{1, 2}}, accel.DEM, 500},
+		// Two eigenvalues in two dimensions: the first GDEM step is exact.
+		{"GDEM two eigenvalues", linear{[]float64{0.99, 0.9}, []float64{1, 2}}, accel.GDEM, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &accel.Accelerator{Method: tt.method}
+			got := tt.l.iterations(a.Next, tol)
+			if got > tt.maxIter {
+				t.Errorf("Accelerator converged in %d iterations, want at most %d", got, tt.maxIter)
+			}
+			if base := tt.l.iterations(plain, tol); got >= base {
+				t.Errorf("Accelerator converged in %d iterations, want fewer than successive substitution (%d)", got, base)
+			}
+		})
+	}
+}
+
+func TestAcceleratorDiverging(t *testing.T) {
+	// Steps of alternating sign give λ < 0, which is not extrapolated.
+	l := linear{[]float64{-0.5}, []float64{1}}
+	a := &accel.Accelerator{Every: 2}
+	x := []float64{0}
+	for range 4 {
+		gx := l.g(x)
+		if next := a.Next(x, gx); next[0] != gx[0] {
+			t.Errorf("Next() = %v, want the substituted %v", next, gx)
+		}
+		x = gx
+	}
+}
+
+func TestAitken(t *testing.T) {
+	// x ← 0.9x + 1 converges to 10; Aitken's Δ² is exact for a linear iteration.
+	x0 := 0.0
+	x1 := 0.9*x0 + 1
+	x2 := 0.9*x1 + 1
+	if got := accel.Aitken(x0, x1, x2); math.Abs(got-10) > 1e-12 {
+		t.Errorf("Aitken() = %v, want 10", got)
+	}
+	// Diverging iterates are returned unchanged.
+	if got := accel.Aitken(0, 1, 3); got != 3 {
+		t.Errorf("Aitken() of diverging iterates = %v, want 3", got)
+	}
+}
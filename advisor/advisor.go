@@ -0,0 +1,255 @@
+// Package advisor recommends methods for the compressibility factor and residual
+// properties of a substance at a given state, encoding the usual selection
+// heuristics: the region of the reduced temperature and pressure, the phase,
+// the data available for the substance and whether it is polar.
+//
+// The scores are a ranking aid for the methods of this module, not a measure of
+// accuracy.
+package advisor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Recommendation is a method applicable at the requested state.
+type Recommendation struct {
+	Method string // Name of the method, e.g. "Lee-Kesler"
+	// Score ranks the recommendations, higher first, on a 0-100 scale.
+	Score   int
+	Reasons []string // Why the method applies, and any caveats
+	// Provider evaluates the method with substance.PropertiesAt. It is nil for
+	// methods that are not available as a provider (three-term virial, Lydersen).
+	Provider substance.Provider
+}
+
+// polarZc is the critical compressibility factor below which a substance without
+// a dipole moment is flagged as likely polar or associating. Normal fluids have Zc
+// of 0.26 to 0.29, while water (0.229), ammonia (0.242) and the alcohols fall
+// below 0.25.
+const polarZc = 0.25
+
+// state is the requested state with the derived quantities the rules use.
+type state struct {
+	s      *substance.Substance
+	T, P   float64
+	Tr, Pr float64
+	phase  substance.Phase
+	polar  bool // Polar or associating (substance.Substance.Polar)
+	// lowZc is set for substances without polarity data whose Zc suggests that
+	// they are polar.
+	lowZc bool
+}
+
+// rule decides whether a method applies at a state, and scores it.
+type rule func(st *state) (*Recommendation, bool)
+
+var rules = []rule{idealGas, twoTermVirial, threeTermVirial, leeKesler, pengRobinson, soaveRK, redlichKwong, rkpr, lydersen}
+
+// Recommend returns the methods applicable to s at temperature T (K) and pressure
+// P (bar), ranked from the most to the least suitable.
+//
+// The phase is classified with the Lee-Kesler vapor pressure if s has a normal
+// boiling point and with the Peng-Robinson vapor pressure otherwise.
+func Recommend(s *substance.Substance, T, P float64) ([]Recommendation, error) {
+	if s == nil {
+		return nil, errors.New("substance cannot be nil")
+	}
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	tr, pr, err := s.Reduced(T, P)
+	if err != nil {
+		return nil, err
+	}
+	st := &state{s: s, T: T, P: P, Tr: tr, Pr: pr, phase: classify(s, T, P)}
+	st.polar = s.Polar()
+	st.lowZc = !st.polar && s.Dipole == 0 && s.Has(substance.PropZc) && s.Critical.Zc < polarZc
+
+	var recs []Recommendation
+	for _, r := range rules {
+		rec, ok := r(st)
+		if !ok {
+			continue
+		}
+		if _, ideal := rec.Provider.(substance.IdealGasProvider); rec.Provider != nil && !ideal {
+			switch {
+			case st.polar:
+				rec.Score -= 20
+				rec.Reasons = append(rec.Reasons, polarReason(s)+", for which generalized EOS parameters are less reliable")
+			case st.lowZc:
+				rec.Score -= 20
+				rec.Reasons = append(rec.Reasons, fmt.Sprintf("Zc = %.3f suggests a polar or associating fluid, for which corresponding-states methods are less reliable", s.Critical.Zc))
+			}
+		}
+		recs = append(recs, *rec)
+	}
+	sort.SliceStable(recs, func(i, j int) bool { return recs[i].Score > recs[j].Score })
+	return recs, nil
+}
+
+// classify returns the phase of s at T and P.
+func classify(s *substance.Substance, T, P float64) substance.Phase {
+	if ph := s.PhaseAt(T, P); ph != substance.UnknownPhase {
+		return ph
+	}
+	cfg := s.CubicConfig(&cubic.PR{}, zfactor.Args{T: T, P: P, R: zfactor.RSI * 10})
+	pSat, err := cubic.SaturationPressure(cfg, T)
+	if err != nil {
+		return substance.UnknownPhase
+	}
+	if P > pSat {
+		return substance.Liquid
+	}
+	return substance.Vapor
+}
+
+// polarReason describes why s is polar.
+func polarReason(s *substance.Substance) string {
+	if s.Associating {
+		return "associating fluid"
+	}
+	return fmt.Sprintf("polar fluid (μr = %.3g)", s.ReducedDipole())
+}
+
+// gas reports whether the state is a vapor or supercritical fluid.
+func (st *state) gas() bool {
+	return st.phase == substance.Vapor || st.phase == substance.Supercritical
+}
+
+// missing returns the properties of props that s does not define.
+func (st *state) missing(props ...substance.Property) []substance.Property {
+	var out []substance.Property
+	for _, p := range props {
+		if !st.s.Has(p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func idealGas(st *state) (*Recommendation, bool) {
+	if !st.gas() || st.Pr > 0.05 {
+		return nil, false
+	}
+	return &Recommendation{
+		Method:   "Ideal gas",
+		Score:    60,
+		Reasons:  []string{fmt.Sprintf("Pr = %.3g is low enough for Z to be within a few percent of 1", st.Pr)},
+		Provider: substance.IdealGasProvider{},
+	}, true
+}
+
+func twoTermVirial(st *state) (*Recommendation, bool) {
+	// Above the line Tr = 0.686 + 0.439 Pr (Vr >= 2) the two-term virial equation
+	// represents Z within the accuracy of the generalized coefficients.
+	// The Pitzer correlation for B is for nonpolar fluids.
+	if !st.gas() || st.polar || st.P > 15 || st.Tr <= 0.686+0.439*st.Pr || st.missing(substance.PropAcentric) != nil {
+		return nil, false
+	}
+	return &Recommendation{
+		Method: "Two-term virial (Abbott)",
+		Score:  85,
+		Reasons: []string{
+			fmt.Sprintf("Tr = %.3g is above 0.686 + 0.439 Pr = %.3g, where Z is linear in P", st.Tr, 0.686+0.439*st.Pr),
+			"gas at P <= 15 bar",
+		},
+		Provider: substance.AbbottProvider{},
+	}, true
+}
+
+func threeTermVirial(st *state) (*Recommendation, bool) {
+	if !st.gas() || st.Pr > 0.5 {
+		return nil, false
+	}
+	return &Recommendation{
+		Method: "Three-term virial",
+		Score:  50,
+		Reasons: []string{
+			fmt.Sprintf("gas at moderate pressure (Pr = %.3g)", st.Pr),
+			"needs measured B and C; there is no generalized correlation for C",
+		},
+	}, true
+}
+
+// lkPrMax is the highest reduced pressure of the Lee-Kesler tables.
+var lkPrMax = leekesler.Z0Table.Pr[len(leekesler.Z0Table.Pr)-1]
+
+func leeKesler(st *state) (*Recommendation, bool) {
+	// The simple and reference fluids of Lee-Kesler are nonpolar.
+	if st.polar || st.Tr < 0.3 || st.Tr > 4 || st.Pr > lkPrMax || st.missing(substance.PropAcentric) != nil {
+		return nil, false
+	}
+	rec := &Recommendation{
+		Method:   "Lee-Kesler",
+		Score:    90,
+		Reasons:  []string{fmt.Sprintf("Tr = %.3g and Pr = %.3g are within the tables (0.3 <= Tr <= 4, Pr <= %g)", st.Tr, st.Pr, lkPrMax)},
+		Provider: substance.LeeKeslerProvider{},
+	}
+	if !st.gas() {
+		rec.Score = 70
+		rec.Reasons = append(rec.Reasons, "liquid Z is less accurate than for gases")
+	}
+	return rec, true
+}
+
+// cubicRecommendation returns the recommendation of a cubic EOS that needs the
+// properties req.
+func cubicRecommendation(st *state, eos cubic.EOSType, method string, score int, reason string, req ...substance.Property) (*Recommendation, bool) {
+	if st.missing(req...) != nil {
+		return nil, false
+	}
+	return &Recommendation{
+		Method:   method,
+		Score:    score,
+		Reasons:  []string{reason},
+		Provider: substance.CubicProvider{EOS: eos},
+	}, true
+}
+
+func pengRobinson(st *state) (*Recommendation, bool) {
+	score, reason := 80, "applies to vapor and liquid"
+	if !st.gas() {
+		score, reason = 85, "applies to vapor and liquid; PR liquid volumes are better than SRK's"
+	}
+	return cubicRecommendation(st, &cubic.PR{}, "Peng-Robinson", score, reason, substance.PropAcentric)
+}
+
+func soaveRK(st *state) (*Recommendation, bool) {
+	return cubicRecommendation(st, &cubic.SRK{}, "Soave-Redlich-Kwong", 75, "applies to vapor and liquid; good vapor pressures for hydrocarbons", substance.PropAcentric)
+}
+
+func redlichKwong(st *state) (*Recommendation, bool) {
+	if !st.gas() {
+		return nil, false
+	}
+	return cubicRecommendation(st, &cubic.RK{}, "Redlich-Kwong", 55, "needs only Tc and Pc; adequate for gases, poor for liquids")
+}
+
+func rkpr(st *state) (*Recommendation, bool) {
+	score := 70
+	if !st.gas() {
+		score = 80
+	}
+	return cubicRecommendation(st, &cubic.RKPR{}, "RK-PR", score, "three-parameter EOS fitted to Zc, with improved liquid densities", substance.PropAcentric, substance.PropZc)
+}
+
+func lydersen(st *state) (*Recommendation, bool) {
+	if st.phase != substance.Liquid || st.Tr < 0.3 {
+		return nil, false
+	}
+	return &Recommendation{
+		Method: "Lydersen chart",
+		Score:  75,
+		Reasons: []string{
+			"liquid below Tc, where the generalized density chart applies",
+			"most accurate from a measured reference density (Substance.LiquidDensity)",
+		},
+	}, true
+}
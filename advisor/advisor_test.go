@@ -0,0 +1,109 @@
+package advisor_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/advisor"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestRecommend(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       *substance.Substance
+		T, P    float64
+		want    string   // Top-ranked method
+		include []string // Methods that must be recommended
+		exclude []string // Methods that must not be recommended
+	}{
+		{"gas", substance.Methane, 300, 10, "Lee-Kesler", []string{"Two-term virial (Abbott)", "Peng-Robinson", "Redlich-Kwong"}, []string{"Lydersen chart"}},
+		{"liquid", substance.Propane, 250, 20, "Peng-Robinson", []string{"Lydersen chart", "Soave-Redlich-Kwong"}, []string{"Two-term virial (Abbott)", "Redlich-Kwong", "Ideal gas"}},
+		{"high pressure", substance.Methane, 300, 200, "Lee-Kesler", nil, []string{"Two-term virial (Abbott)", "Three-term virial", "Ideal gas"}},
+		{"low pressure", substance.Methane, 300, 0.5, "Lee-Kesler", []string{"Ideal gas"}, nil},
+		{"extended tables", substance.Methane, 300, 550, "Lee-Kesler", nil, []string{"Ideal gas"}}, // Pr ≈ 12
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs, err := advisor.Recommend(tt.s, tt.T, tt.P)
+			if err != nil {
+				t.Fatalf("Recommend() error = %v", err)
+			}
+			if len(recs) == 0 {
+				t.Fatal("Recommend() returned no methods")
+			}
+			if recs[0].Method != tt.want {
+				t.Errorf("Recommend()[0].Method = %q, want %q", recs[0].Method, tt.want)
+			}
+			methods := map[string]bool{}
+			for i, r := range recs {
+				methods[r.Method] = true
+				if len(r.Reasons) == 0 {
+					t.Errorf("Recommend()[%d] (%s) has no reasons", i, r.Method)
+				}
+				if i > 0 && r.Score > recs[i-1].Score {
+					t.Errorf("Recommend() not sorted: %s (%d) after %s (%d)", r.Method, r.Score, recs[i-1].Method, recs[i-1].Score)
+				}
+			}
+			for _, m := range tt.include {
+				if !methods[m] {
+					t.Errorf("Recommend() missing %q", m)
+				}
+			}
+			for _, m := range tt.exclude {
+				if methods[m] {
+					t.Errorf("Recommend() includes %q", m)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendPolar(t *testing.T) {
+	// Water vapor at Tr = 0.93 and 1 bar is otherwise in the range of the
+	// two-term virial equation and Lee-Kesler.
+	recs, err := advisor.Recommend(substance.Water, 600, 1)
+	if err != nil {
+		t.Fatalf("Recommend() error = %v", err)
+	}
+	flagged := false
+	for _, r := range recs {
+		switch r.Method {
+		case "Lee-Kesler", "Two-term virial (Abbott)":
+			t.Errorf("Recommend() includes nonpolar correlation %q", r.Method)
+		case "Peng-Robinson":
+			for _, reason := range r.Reasons {
+				flagged = flagged || strings.Contains(reason, "associating")
+			}
+		}
+	}
+	if !flagged {
+		t.Error("Recommend() Peng-Robinson reasons have no polarity flag")
+	}
+}
+
+func TestRecommendMissingData(t *testing.T) {
+	s := &substance.Substance{Name: "Unknown", Acentric: math.NaN(), Tn: 200, Critical: substance.CriticalProps{Tc: 300, Pc: 40}}
+	recs, err := advisor.Recommend(s, 400, 5)
+	if err != nil {
+		t.Fatalf("Recommend() error = %v", err)
+	}
+	for _, r := range recs {
+		switch r.Method {
+		case "Lee-Kesler", "Peng-Robinson", "Two-term virial (Abbott)":
+			t.Errorf("Recommend() includes %q without an acentric factor", r.Method)
+		}
+	}
+}
+
+func TestRecommendErrors(t *testing.T) {
+	if _, err := advisor.Recommend(substance.Methane, 300, 0); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("Recommend() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	if _, err := advisor.Recommend(nil, 300, 1); err == nil {
+		t.Error("Recommend(nil) error = nil, want error")
+	}
+}
@@ -53,6 +53,7 @@ type TempRange struct {
 // LnPSat calculates the natural logarithm of the saturation pressure (kPa) at temperature t (°C).
 // Returns an error if t is outside the valid range.
 func (a *Antoine) LnPSat(t float64) (float64, error) {
+	a.useData()
 	var err error
 	if !a.ValidateTempRange(t) {
 		err = &RangeError{
@@ -80,9 +81,74 @@ func (a *Antoine) ValidateTempRange(t float64) bool {
 // Temperature calculates the saturation temperature (°C) at a pressure p (kPa).
 // Returns an error if p is irregular.
 func (a *Antoine) Temperature(p float64) (float64, error) {
+	a.useData()
 	if p <= 0 {
 		return 0, zfactor.ErrPressure
 	}
 
 	return a.B/(a.A-math.Log(p)) - a.C, nil
 }
+
+// DPdT calculates the slope of the vapor-pressure curve dP/dT (kPa/K) at
+// temperature t (°C),
+//
+//	dP/dT = P B/(t + C)²
+//
+// Returns an error if t is outside the valid range.
+func (a *Antoine) DPdT(t float64) (float64, error) {
+	p, err := a.Pressure(t)
+	return p * a.B / ((t + a.C) * (t + a.C)), err
+}
+
+// HeatOfVaporization calculates the latent heat of vaporization (kJ/mol) at
+// temperature t (°C) from the Clausius-Clapeyron equation,
+//
+//	ΔHlv = ΔZ R T² d(ln P)/dT = ΔZ R T² B/(t + C)²
+//
+// with T in K and ΔZ = Zv - Zl the compressibility change of vaporization. ΔZ
+// = 1 gives the ideal-gas form, which holds at low pressures where the liquid
+// volume is negligible and the vapor is ideal; DeltaZ estimates ΔZ at higher
+// pressures. The result at Tn can be checked against the stored H.
+//
+// Returns an error if dZ is not in (0, 1] or t is outside the valid range.
+func (a *Antoine) HeatOfVaporization(t, dZ float64) (float64, error) {
+	if dZ <= 0 || dZ > 1 {
+		return 0, fmt.Errorf("compressibility change of vaporization (ΔZ) must be in (0, 1], got %g", dZ)
+	}
+	a.useData()
+	var err error
+	if !a.ValidateTempRange(t) {
+		err = &RangeError{
+			T:    t,
+			Low:  a.Range.Low,
+			High: a.Range.High,
+		}
+	}
+	T := t + 273.15
+	const R = zfactor.RSI * 1e-3 // kJ/(mol*K)
+	return dZ * R * T * T * a.B / ((t + a.C) * (t + a.C)), err
+}
+
+// DeltaZ estimates the compressibility change of vaporization ΔZ = Zv - Zl at
+// reduced temperature Tr and reduced pressure Pr on the saturation curve with
+// the equation of Haggenmacher (1946),
+//
+//	ΔZ = √(1 - Pr/Tr³)
+//
+// for HeatOfVaporization. ΔZ falls from 1 at low pressures to 0 at the critical
+// point.
+//
+// Returns an error if Tr <= 0, Pr <= 0 or Pr >= Tr³.
+func DeltaZ(Tr, Pr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Pr <= 0 {
+		return 0, zfactor.ErrInvalidPr
+	}
+	x := 1 - Pr/(Tr*Tr*Tr)
+	if x <= 0 {
+		return 0, fmt.Errorf("no vapor-liquid equilibrium at Tr = %g, Pr = %g", Tr, Pr)
+	}
+	return math.Sqrt(x), nil
+}
@@ -0,0 +1,157 @@
+package antoine_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/antoine"
+)
+
+func TestDPdT(t *testing.T) {
+	a := antoine.Benzene
+	for _, tc := range []float64{20, 50, 80} {
+		const h = 1e-4
+		lo, _ := a.Pressure(tc - h)
+		hi, _ := a.Pressure(tc + h)
+		got, err := a.DPdT(tc)
+		if err != nil {
+			t.Fatalf("DPdT(%v) unexpected error: %v", tc, err)
+		}
+		if want := (hi - lo) / (2 * h); math.Abs(got-want) > 1e-6*want {
+			t.Errorf("DPdT(%v) = %v, want %v", tc, got, want)
+		}
+	}
+	var rangeErr *antoine.RangeError
+	if _, err := a.DPdT(a.Range.High + 10); !errors.As(err, &rangeErr) {
+		t.Errorf("DPdT() error = %v, want *RangeError", err)
+	}
+}
+
+func TestHeatOfVaporization(t *testing.T) {
+	// Acetone at its normal boiling point, Tc = 508.2 K and Pc = 47.01 bar.
+	a := antoine.Acetone
+	dZ, err := antoine.DeltaZ((a.Tn+273.15)/508.2, 1.01325/47.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		dZ, want float64
+	}{
+		{"ideal gas", 1, 30.76},
+		{"Haggenmacher", dZ, 29.52},
+	}
+	for _, tt := range tests {
+		got, err := a.HeatOfVaporization(a.Tn, tt.dZ)
+		if err != nil {
+			t.Fatalf("%s: HeatOfVaporization() unexpected error: %v", tt.name, err)
+		}
+		if math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("%s: HeatOfVaporization() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	// The corrected value is within 2% of the stored latent heat.
+	got, _ := a.HeatOfVaporization(a.Tn, dZ)
+	if math.Abs(got-a.H) > 0.02*a.H {
+		t.Errorf("HeatOfVaporization() = %v, want about H = %v", got, a.H)
+	}
+
+	for _, dZ := range []float64{0, -0.5, 1.5} {
+		if _, err := a.HeatOfVaporization(a.Tn, dZ); err == nil {
+			t.Errorf("HeatOfVaporization(ΔZ = %v) expected error, got nil", dZ)
+		}
+	}
+}
+
+func TestDeltaZ(t *testing.T) {
+	tests := []struct {
+		Tr, Pr, want float64
+	}{
+		{0.7, 0.0343, math.Sqrt(1 - 0.0343/0.343)},
+		{1, 1e-12, 1},
+	}
+	for _, tt := range tests {
+		got, err := antoine.DeltaZ(tt.Tr, tt.Pr)
+		if err != nil || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("DeltaZ(%v, %v) = %v, %v, want %v", tt.Tr, tt.Pr, got, err, tt.want)
+		}
+	}
+	for _, in := range [][2]float64{{0, 0.1}, {0.7, 0}, {0.7, 0.5}} {
+		if _, err := antoine.DeltaZ(in[0], in[1]); err == nil {
+			t.Errorf("DeltaZ(%v, %v) expected error, got nil", in[0], in[1])
+		}
+	}
+}
+
+// dipprWater are the DIPPR equation 101 constants of water, in Pa.
+var dipprWater = &antoine.ExtendedAntoine{
+	Name:   "Water",
+	A:      73.649,
+	B:      -7258.2,
+	E:      -7.3037,
+	F:      4.1653e-6,
+	G:      2,
+	PScale: 1e-3,
+	Range:  antoine.TempRange{Low: 0.01, High: 373.9},
+}
+
+func TestExtendedAntoine(t *testing.T) {
+	var m antoine.Model = dipprWater
+	tests := []struct {
+		t, want float64 // °C, kPa
+	}{
+		{25, 3.17},
+		{100, 101.325},
+		{200, 1554.9},
+	}
+	for _, tt := range tests {
+		got, err := m.Pressure(tt.t)
+		if err != nil {
+			t.Fatalf("Pressure(%v) unexpected error: %v", tt.t, err)
+		}
+		if math.Abs(got-tt.want) > 0.005*tt.want {
+			t.Errorf("Pressure(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+		tsat, err := m.Temperature(got)
+		if err != nil || math.Abs(tsat-tt.t) > 1e-6 {
+			t.Errorf("Temperature(%v) = %v, %v, want %v", got, tsat, err, tt.t)
+		}
+	}
+
+	var rangeErr *antoine.RangeError
+	if _, err := m.Pressure(400); !errors.As(err, &rangeErr) {
+		t.Errorf("Pressure(400) error = %v, want *RangeError", err)
+	}
+	if _, err := m.Temperature(0); err == nil {
+		t.Errorf("Temperature(0) expected error, got nil")
+	}
+
+	// The latent heat at the normal boiling point, 40.66 kJ/mol, with the
+	// Haggenmacher ΔZ and Tc = 647.1 K, Pc = 220.55 bar.
+	dZ, _ := antoine.DeltaZ(373.15/647.1, 1.01325/220.55)
+	h, err := dipprWater.HeatOfVaporization(100, dZ)
+	if err != nil || math.Abs(h-40.66) > 0.03*40.66 {
+		t.Errorf("HeatOfVaporization(100) = %v, %v, want about 40.66", h, err)
+	}
+}
+
+func TestAntoineExtended(t *testing.T) {
+	a := antoine.Benzene
+	x := a.Extended()
+	for _, tc := range []float64{10, 50, 90} {
+		want, _ := a.Pressure(tc)
+		got, err := x.Pressure(tc)
+		if err != nil || math.Abs(got-want) > 1e-9*want {
+			t.Errorf("Extended().Pressure(%v) = %v, %v, want %v", tc, got, err, want)
+		}
+		wantSlope, _ := a.DPdT(tc)
+		if slope, _ := x.DPdT(tc); math.Abs(slope-wantSlope) > 1e-9*wantSlope {
+			t.Errorf("Extended().DPdT(%v) = %v, want %v", tc, slope, wantSlope)
+		}
+	}
+	want, _ := a.Temperature(50)
+	if got, err := x.Temperature(50); err != nil || math.Abs(got-want) > 1e-6 {
+		t.Errorf("Extended().Temperature(50) = %v, %v, want %v", got, err, want)
+	}
+}
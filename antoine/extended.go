@@ -0,0 +1,171 @@
+package antoine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// ExtendedAntoine holds the constants of the extended Antoine equation
+//
+//	ln(P) = A + B/(T + C) + D·T + E·ln(T) + F·T^G
+//
+// with T in K, the form of the PLXANT vapor pressures of Aspen Plus. The
+// DIPPR equation 101, ln(P) = A + B/T + C·ln(T) + D·T^E, is the special case
+// with C = 0 and its C, D and E as E, F and G here.
+//
+// Like Antoine, ExtendedAntoine implements Model, with temperatures in °C and
+// pressures in kPa; PScale converts the pressure unit of the constants.
+type ExtendedAntoine struct {
+	Name    string
+	Formula string
+	A       float64
+	B       float64
+	C       float64
+	D       float64
+	E       float64
+	F       float64
+	G       float64
+	// PScale is the pressure unit of the constants in kPa, e.g. 1e-3 for
+	// constants in Pa (DIPPR) or 100 for bar. Defaults to 1 (kPa) if 0.
+	PScale float64
+	Range  TempRange // Valid temperature range (°C)
+}
+
+// Extended returns the constants of a as an ExtendedAntoine, which evaluates
+// the same vapor pressures.
+func (a *Antoine) Extended() *ExtendedAntoine {
+	a.useData()
+	return &ExtendedAntoine{
+		Name:    a.Name,
+		Formula: a.Formula,
+		A:       a.A,
+		B:       -a.B,
+		C:       a.C - 273.15,
+		Range:   a.Range,
+	}
+}
+
+// lnScale returns the natural logarithm of PScale.
+func (x *ExtendedAntoine) lnScale() float64 {
+	if x.PScale <= 0 {
+		return 0
+	}
+	return math.Log(x.PScale)
+}
+
+// lnP evaluates ln(P) in the unit of the constants and its derivative with
+// respect to T at T (K).
+func (x *ExtendedAntoine) lnP(T float64) (lnP, dlnP float64) {
+	lnP = x.A + x.B/(T+x.C) + x.D*T
+	dlnP = -x.B/((T+x.C)*(T+x.C)) + x.D
+	if x.E != 0 {
+		lnP += x.E * math.Log(T)
+		dlnP += x.E / T
+	}
+	if x.F != 0 {
+		lnP += x.F * math.Pow(T, x.G)
+		dlnP += x.F * x.G * math.Pow(T, x.G-1)
+	}
+	return lnP, dlnP
+}
+
+// rangeErr returns a *RangeError if t is outside the valid range, or nil.
+func (x *ExtendedAntoine) rangeErr(t float64) error {
+	if x.ValidateTempRange(t) {
+		return nil
+	}
+	return &RangeError{
+		T:    t,
+		Low:  x.Range.Low,
+		High: x.Range.High,
+	}
+}
+
+// LnPSat calculates the natural logarithm of the saturation pressure (kPa) at temperature t (°C).
+// Returns an error if t is outside the valid range.
+func (x *ExtendedAntoine) LnPSat(t float64) (float64, error) {
+	lnP, _ := x.lnP(t + 273.15)
+	return lnP + x.lnScale(), x.rangeErr(t)
+}
+
+// Pressure calculates the saturation pressure (kPa) at temperature t (°C).
+// Returns an error if t is outside the valid range.
+func (x *ExtendedAntoine) Pressure(t float64) (float64, error) {
+	lnP, err := x.LnPSat(t)
+
+	return math.Exp(lnP), err
+}
+
+// ValidateTempRange reports whether t lies within the valid temperature range.
+func (x *ExtendedAntoine) ValidateTempRange(t float64) bool {
+	return t >= x.Range.Low && t <= x.Range.High
+}
+
+// errNoConvergence is returned by ExtendedAntoine.Temperature when Newton's
+// method does not converge.
+var errNoConvergence = errors.New("antoine: saturation temperature did not converge")
+
+// Temperature calculates the saturation temperature (°C) at a pressure p (kPa)
+// by Newton's method on ln(P), starting from the middle of the valid range.
+// Returns an error if p is irregular or the iteration does not converge.
+func (x *ExtendedAntoine) Temperature(p float64) (float64, error) {
+	if p <= 0 {
+		return 0, zfactor.ErrPressure
+	}
+	target := math.Log(p) - x.lnScale()
+
+	T := (x.Range.Low+x.Range.High)/2 + 273.15
+	if T <= 0 {
+		T = 300
+	}
+	for range 100 {
+		lnP, dlnP := x.lnP(T)
+		if dlnP == 0 || math.IsNaN(lnP) {
+			break
+		}
+		step := (lnP - target) / dlnP
+		// Keep T positive, where ln(T) and T^G are defined.
+		for T-step <= 0 {
+			step /= 2
+		}
+		T -= step
+		if math.Abs(step) < 1e-10*T {
+			return T - 273.15, nil
+		}
+	}
+	return 0, fmt.Errorf("%w at p = %g kPa", errNoConvergence, p)
+}
+
+// DPdT calculates the slope of the vapor-pressure curve dP/dT (kPa/K) at
+// temperature t (°C),
+//
+//	dP/dT = P d(ln P)/dT
+//
+// Returns an error if t is outside the valid range.
+func (x *ExtendedAntoine) DPdT(t float64) (float64, error) {
+	p, err := x.Pressure(t)
+	_, dlnP := x.lnP(t + 273.15)
+	return p * dlnP, err
+}
+
+// HeatOfVaporization calculates the latent heat of vaporization (kJ/mol) at
+// temperature t (°C) from the Clausius-Clapeyron equation,
+//
+//	ΔHlv = ΔZ R T² d(ln P)/dT
+//
+// with T in K and ΔZ = Zv - Zl the compressibility change of vaporization, as
+// in Antoine.HeatOfVaporization.
+//
+// Returns an error if dZ is not in (0, 1] or t is outside the valid range.
+func (x *ExtendedAntoine) HeatOfVaporization(t, dZ float64) (float64, error) {
+	if dZ <= 0 || dZ > 1 {
+		return 0, fmt.Errorf("compressibility change of vaporization (ΔZ) must be in (0, 1], got %g", dZ)
+	}
+	T := t + 273.15
+	_, dlnP := x.lnP(T)
+	const R = zfactor.RSI * 1e-3 // kJ/(mol*K)
+	return dZ * R * T * T * dlnP, x.rangeErr(t)
+}
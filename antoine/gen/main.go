@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/rickykimani/zfactor/internal/dataversion"
 )
 
 type antoineData struct {
@@ -46,8 +48,15 @@ func main() {
 	fmt.Fprintln(f)
 	fmt.Fprintln(f, "package antoine")
 	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// DataVersion is the version of the built-in Antoine sets, from data/versions.json.")
+	version, err := dataversion.Read("../data", "antoine")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(f, "const DataVersion = %d\n\n", version)
 
 	var count int
+	var ids []string
 
 	fmt.Println("#------------------------------------------------------#")
 
@@ -70,8 +79,17 @@ func main() {
 		fmt.Fprintf(f, "\tTn: %.5f,\n", s.Tn)
 		fmt.Fprintf(f, "}\n\n")
 
+		ids = append(ids, id)
 		count++
 	}
+
+	// Emit the built-in registry
+	fmt.Fprintln(f, "// builtins holds the generated Antoine sets, in the order of the data file.")
+	fmt.Fprintln(f, "var builtins = []*Antoine{")
+	for _, id := range ids {
+		fmt.Fprintf(f, "\t%s,\n", id)
+	}
+	fmt.Fprintf(f, "}\n")
 	fmt.Printf("Processed %d substances(Antoine)\n", count)
 }
 
@@ -0,0 +1,60 @@
+package antoine
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rickykimani/zfactor"
+)
+
+func init() {
+	zfactor.RegisterDataset(zfactor.DatasetAntoine, DataVersion)
+}
+
+// useData records that a result is computed from a, if it is a built-in set
+// (see zfactor.UseDataset).
+func (a *Antoine) useData() {
+	if zfactor.DataPinned() && slices.Contains(builtins, a) {
+		zfactor.UseDataset(zfactor.DatasetAntoine)
+	}
+}
+
+// registry holds the Antoine sets added at run time, keyed by lowercase name,
+// over the built-in table.
+var registry = struct {
+	sync.RWMutex
+	sets map[string]*Antoine
+}{sets: map[string]*Antoine{}}
+
+// Lookup returns the Antoine set of the substance named name, compared
+// case-insensitively. A set added with Register takes precedence over the
+// built-in set of the same name.
+func Lookup(name string) (*Antoine, bool) {
+	registry.RLock()
+	a, ok := registry.sets[strings.ToLower(name)]
+	registry.RUnlock()
+	if ok {
+		return a, true
+	}
+	for _, a := range builtins {
+		if strings.EqualFold(a.Name, name) {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// Register adds a to the sets found by Lookup, replacing a registered or
+// built-in set of the same name. The package variables of the built-in sets
+// are not changed.
+func Register(a *Antoine) error {
+	if a == nil || strings.TrimSpace(a.Name) == "" {
+		return errors.New("antoine: set must have a name")
+	}
+	registry.Lock()
+	registry.sets[strings.ToLower(a.Name)] = a
+	registry.Unlock()
+	return nil
+}
@@ -2,6 +2,9 @@
 
 package antoine
 
+// DataVersion is the version of the built-in Antoine sets, from data/versions.json.
+const DataVersion = 1
+
 var Acetone = &Antoine{
 	Name:    "Acetone",
 	Formula: "C3H6O",
@@ -589,3 +592,49 @@ var PXylene = &Antoine{
 	},
 	Tn: 138.30000,
 }
+
+// builtins holds the generated Antoine sets, in the order of the data file.
+var builtins = []*Antoine{
+	Acetone,
+	AceticAcid,
+	Acetonitrile,
+	Benzene,
+	IsoButane,
+	NButane,
+	OneButanol,
+	TwoButanol,
+	IsoButanol,
+	TertButanol,
+	CarbonTetrachloride,
+	Chlorobenzene,
+	OneChlorobutane,
+	Chloroform,
+	Cyclohexane,
+	Cyclopentane,
+	NDecane,
+	Dichloromethane,
+	DiethylEther,
+	One4Dioxane,
+	NEicosane,
+	Ethanol,
+	Ethylbenzene,
+	EthyleneGlycol,
+	NHeptane,
+	NHexane,
+	Methanol,
+	MethylAcetate,
+	MethylEthylKetone,
+	Nitromethane,
+	NNonane,
+	IsoOctane,
+	NOctane,
+	NPentane,
+	Phenol,
+	OnePropanol,
+	TwoPropanol,
+	Toluene,
+	Water,
+	OXylene,
+	MXylene,
+	PXylene,
+}
@@ -0,0 +1,75 @@
+package zfactor
+
+// Basis selects whether extensive properties are expressed per mole or per unit
+// mass. The molar mass MW (g/mol) converts between the two.
+//
+//	Property  MolarBasis     MassBasis
+//	Volume    cm³/mol        m³/kg
+//	Enthalpy  J/mol          kJ/kg
+//	Entropy   J/(mol·K)      kJ/(kg·K)
+type Basis int
+
+const (
+	MolarBasis Basis = iota // Per mole, the units used throughout the package
+	MassBasis               // Per unit mass
+)
+
+// String implements fmt.Stringer for Basis.
+func (b Basis) String() string {
+	if b == MassBasis {
+		return "mass"
+	}
+	return "molar"
+}
+
+// Volume converts a molar volume v (cm³/mol) to the basis.
+func (b Basis) Volume(v, MW float64) (float64, error) {
+	if b != MassBasis {
+		return v, nil
+	}
+	if MW <= 0 {
+		return 0, ErrMolarMass
+	}
+	return v / MW * 1e-3, nil // cm³/g -> m³/kg
+}
+
+// Energy converts a molar enthalpy h (J/mol) to the basis. It applies equally to
+// internal energy, Gibbs and Helmholtz energies and residual enthalpies.
+func (b Basis) Energy(h, MW float64) (float64, error) {
+	if b != MassBasis {
+		return h, nil
+	}
+	if MW <= 0 {
+		return 0, ErrMolarMass
+	}
+	return h / MW, nil // J/g = kJ/kg
+}
+
+// Entropy converts a molar entropy or heat capacity s (J/(mol·K)) to the basis.
+func (b Basis) Entropy(s, MW float64) (float64, error) {
+	return b.Energy(s, MW) // J/(g·K) = kJ/(kg·K)
+}
+
+// VolumeUnit returns the unit of volumes in the basis.
+func (b Basis) VolumeUnit() string {
+	if b == MassBasis {
+		return "m³/kg"
+	}
+	return "cm³/mol"
+}
+
+// EnergyUnit returns the unit of enthalpies in the basis.
+func (b Basis) EnergyUnit() string {
+	if b == MassBasis {
+		return "kJ/kg"
+	}
+	return "J/mol"
+}
+
+// EntropyUnit returns the unit of entropies in the basis.
+func (b Basis) EntropyUnit() string {
+	if b == MassBasis {
+		return "kJ/(kg·K)"
+	}
+	return "J/(mol·K)"
+}
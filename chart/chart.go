@@ -0,0 +1,205 @@
+// Package chart holds generalized charts digitized as isolines, such as the
+// Lydersen reduced density chart or Nelson-Obert and Katz compressibility charts,
+// and interpolates them.
+//
+// A chart gives y as a function of x along isolines of a parameter, e.g. Z versus
+// Pr along isotherms of Tr. Charts can be imported from CSV, so proprietary or
+// legacy charts digitized with common tools can be queried like the built-in ones.
+package chart
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Point is a digitized point of an isoline.
+type Point struct {
+	X, Y float64
+}
+
+// Isoline is a curve of the chart at a constant value of the parameter, with
+// points sorted by increasing X.
+type Isoline struct {
+	Param  float64
+	Points []Point
+}
+
+// Chart is a set of isolines sorted by increasing parameter.
+type Chart struct {
+	Name string
+	// Names of the parameter, abscissa and ordinate, e.g. "Tr", "Pr" and "Z".
+	Param, X, Y string
+	Isolines    []Isoline
+}
+
+// At returns y at the given parameter value and abscissa x, interpolating
+// linearly along the isolines and between the two isolines that bracket param.
+// It returns an error outside the range covered by the chart.
+func (c *Chart) At(param, x float64) (float64, error) {
+	if len(c.Isolines) == 0 {
+		return 0, fmt.Errorf("%s chart is empty", c.name())
+	}
+	lines := c.Isolines
+	idx := sort.Search(len(lines), func(i int) bool { return lines[i].Param >= param })
+	if idx == len(lines) {
+		return 0, fmt.Errorf("%s %g is above the maximum (%g) of the %s chart", c.Param, param, lines[len(lines)-1].Param, c.name())
+	}
+	if lines[idx].Param == param {
+		return c.along(lines[idx], x)
+	}
+	if idx == 0 {
+		return 0, fmt.Errorf("%s %g is below the minimum (%g) of the %s chart", c.Param, param, lines[0].Param, c.name())
+	}
+
+	lo, hi := lines[idx-1], lines[idx]
+	yLo, err := c.along(lo, x)
+	if err != nil {
+		return 0, err
+	}
+	yHi, err := c.along(hi, x)
+	if err != nil {
+		return 0, err
+	}
+	frac := (param - lo.Param) / (hi.Param - lo.Param)
+	return yLo + frac*(yHi-yLo), nil
+}
+
+// along interpolates y at x along one isoline.
+func (c *Chart) along(line Isoline, x float64) (float64, error) {
+	pts := line.Points
+	idx := sort.Search(len(pts), func(i int) bool { return pts[i].X >= x })
+	switch {
+	case idx == len(pts):
+		return 0, fmt.Errorf("%s %g is above the maximum (%g) of the %s = %g isoline", c.X, x, pts[len(pts)-1].X, c.Param, line.Param)
+	case pts[idx].X == x:
+		return pts[idx].Y, nil
+	case idx == 0:
+		return 0, fmt.Errorf("%s %g is below the minimum (%g) of the %s = %g isoline", c.X, x, pts[0].X, c.Param, line.Param)
+	}
+	lo, hi := pts[idx-1], pts[idx]
+	frac := (x - lo.X) / (hi.X - lo.X)
+	return lo.Y + frac*(hi.Y-lo.Y), nil
+}
+
+// name returns the name of the chart for error messages.
+func (c *Chart) name() string {
+	if c.Name == "" {
+		return "unnamed"
+	}
+	return c.Name
+}
+
+// Validate checks that the chart is usable by At: at least one isoline, isolines
+// sorted by strictly increasing parameter, each with at least two points sorted
+// by strictly increasing X.
+func (c *Chart) Validate() error {
+	if len(c.Isolines) == 0 {
+		return errors.New("chart has no isolines")
+	}
+	for i, line := range c.Isolines {
+		if i > 0 && line.Param <= c.Isolines[i-1].Param {
+			return fmt.Errorf("isolines are not sorted by increasing %s at %g", c.Param, line.Param)
+		}
+		if len(line.Points) < 2 {
+			return fmt.Errorf("isoline %s = %g has fewer than 2 points", c.Param, line.Param)
+		}
+		for j := 1; j < len(line.Points); j++ {
+			if line.Points[j].X <= line.Points[j-1].X {
+				return fmt.Errorf("isoline %s = %g is not strictly increasing in %s at %g", c.Param, line.Param, c.X, line.Points[j].X)
+			}
+		}
+	}
+	return nil
+}
+
+// ReadCSV reads a chart from CSV records of the form param,x,y, with one record
+// per digitized point. The first record is a header naming the three columns,
+// e.g. "Tr,Pr,Z". Points may be in any order; they are grouped into isolines by
+// parameter value and sorted. Blank lines and lines starting with # are ignored.
+func ReadCSV(r io.Reader, name string) (*Chart, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 3
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	c := &Chart{
+		Name:  name,
+		Param: strings.TrimSpace(header[0]),
+		X:     strings.TrimSpace(header[1]),
+		Y:     strings.TrimSpace(header[2]),
+	}
+
+	byParam := map[float64][]Point{}
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var v [3]float64
+		for i, field := range rec {
+			if v[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		byParam[v[0]] = append(byParam[v[0]], Point{X: v[1], Y: v[2]})
+	}
+
+	for param, pts := range byParam {
+		sort.Slice(pts, func(i, j int) bool { return pts[i].X < pts[j].X })
+		c.Isolines = append(c.Isolines, Isoline{Param: param, Points: pts})
+	}
+	sort.Slice(c.Isolines, func(i, j int) bool { return c.Isolines[i].Param < c.Isolines[j].Param })
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadCSV reads a chart from a CSV file, see ReadCSV. The chart is named after
+// the file.
+func LoadCSV(path string) (*Chart, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	name := strings.TrimSuffix(filepath.Base(path), ".csv")
+	c, err := ReadCSV(f, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+// WriteCSV writes the chart in the format read by ReadCSV.
+func (c *Chart) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{c.Param, c.X, c.Y}); err != nil {
+		return err
+	}
+	format := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+	for _, line := range c.Isolines {
+		for _, p := range line.Points {
+			if err := cw.Write([]string{format(line.Param), format(p.X), format(p.Y)}); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
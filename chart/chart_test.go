@@ -0,0 +1,111 @@
+package chart_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor/chart"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/liquids"
+)
+
+// digitized is a small compressibility chart in the CSV format, with points out
+// of order as they come from a digitizer.
+const digitized = `# Z versus Pr, digitized
+Tr, Pr, Z
+1.5, 0.0, 1.0
+1.5, 2.0, 0.82
+1.2, 2.0, 0.58
+1.2, 0.0, 1.0
+1.2, 1.0, 0.83
+1.5, 1.0, 0.92
+`
+
+func TestReadCSV(t *testing.T) {
+	c, err := chart.ReadCSV(strings.NewReader(digitized), "test")
+	if err != nil {
+		t.Fatalf("ReadCSV() unexpected error: %v", err)
+	}
+	if c.Param != "Tr" || c.X != "Pr" || c.Y != "Z" {
+		t.Errorf("ReadCSV() names = %q, %q, %q, want Tr, Pr, Z", c.Param, c.X, c.Y)
+	}
+	if len(c.Isolines) != 2 || c.Isolines[0].Param != 1.2 || c.Isolines[0].Points[1].X != 1 {
+		t.Fatalf("ReadCSV() isolines = %+v, want sorted Tr 1.2 and 1.5", c.Isolines)
+	}
+
+	tests := []struct {
+		tr, pr  float64
+		want    float64
+		wantErr bool
+	}{
+		{1.2, 1.0, 0.83, false},
+		{1.2, 1.5, 0.705, false},
+		{1.35, 1.0, 0.875, false},
+		{1.35, 1.5, 0.7875, false},
+		{1.1, 1.0, 0, true},
+		{1.2, 2.5, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := c.At(tt.tr, tt.pr)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("At(%v, %v) error = %v, wantErr %v", tt.tr, tt.pr, err, tt.wantErr)
+		}
+		if math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("At(%v, %v) = %v, want %v", tt.tr, tt.pr, got, tt.want)
+		}
+	}
+
+	var b strings.Builder
+	if err := c.WriteCSV(&b); err != nil {
+		t.Fatalf("WriteCSV() unexpected error: %v", err)
+	}
+	again, err := chart.ReadCSV(strings.NewReader(b.String()), "test")
+	if err != nil {
+		t.Fatalf("ReadCSV(WriteCSV()) unexpected error: %v", err)
+	}
+	if got, _ := again.At(1.35, 1.5); math.Abs(got-0.7875) > 1e-12 {
+		t.Errorf("At() after round trip = %v, want 0.7875", got)
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	tests := []struct {
+		name, csv string
+	}{
+		{"empty", ""},
+		{"no points", "Tr,Pr,Z\n"},
+		{"single point", "Tr,Pr,Z\n1.2,1,0.8\n"},
+		{"duplicate x", "Tr,Pr,Z\n1.2,1,0.8\n1.2,1,0.81\n"},
+		{"not a number", "Tr,Pr,Z\n1.2,1,abc\n1.2,2,0.6\n"},
+		{"missing column", "Tr,Pr,Z\n1.2,1\n"},
+	}
+	for _, tt := range tests {
+		if _, err := chart.ReadCSV(strings.NewReader(tt.csv), tt.name); err == nil {
+			t.Errorf("ReadCSV(%s) want error", tt.name)
+		}
+	}
+}
+
+func TestBuiltinCharts(t *testing.T) {
+	z0 := leekesler.Z0Table.Chart("Z0")
+	if err := z0.Validate(); err != nil {
+		t.Fatalf("Z0Table.Chart() invalid: %v", err)
+	}
+	for _, pt := range [][2]float64{{1.1, 1.3}, {0.75, 0.05}, {2.5, 7}} {
+		want, _ := leekesler.Z0Table.At(pt[0], pt[1])
+		got, err := z0.At(pt[0], pt[1])
+		if err != nil || math.Abs(got-want) > 1e-12 {
+			t.Errorf("Z0 chart At(%v, %v) = %v, %v, want %v", pt[0], pt[1], got, err, want)
+		}
+	}
+
+	lyd := liquids.LydersenChart()
+	if err := lyd.Validate(); err != nil {
+		t.Fatalf("LydersenChart() invalid: %v", err)
+	}
+	want, _ := liquids.ReducedDensity(0.85, 2)
+	if got, err := lyd.At(0.85, 2); err != nil || math.Abs(got-want) > 1e-12 {
+		t.Errorf("Lydersen chart At(0.85, 2) = %v, %v, want %v", got, err, want)
+	}
+}
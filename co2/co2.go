@@ -0,0 +1,140 @@
+// Package co2 implements the reference equation of state for carbon dioxide of
+// Span and Wagner (1996), for carbon capture and storage work that needs
+// accurate densities and phase boundaries of CO2, in particular near its
+// critical point, where generalized cubic equations of state err by 5-10% in
+// density.
+//
+// The equation gives the residual Helmholtz energy as a sum of 42 terms, fitted
+// to the experimental data from the triple point to 1100 K and 800 MPa; the
+// Gaussian and non-analytic terms reproduce the shape of the critical region.
+// Densities, Z, residual enthalpy and entropy and the saturation state follow
+// from αʳ(δ, τ) and its first derivatives. The ancillary equations of the same
+// paper give the vapor pressure, saturated densities and the melting and
+// sublimation pressures directly.
+//
+// Provider evaluates the equation for substance.CarbonDioxide through the
+// substance.Provider interface, so the rest of the library (flowsheet, meter,
+// pipeline, relief) can use it in place of a cubic EOS.
+//
+// Units: T in K, P in bar and densities in kg/m³, unless stated.
+package co2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// Critical and triple point constants of Span and Wagner (1996).
+const (
+	Tc   = 304.1282 // Critical temperature (K)
+	Pc   = 73.773   // Critical pressure (bar)
+	RhoC = 467.6    // Critical density (kg/m³)
+	Tt   = 216.592  // Triple point temperature (K)
+	Pt   = 5.1795   // Triple point pressure (bar)
+	MW   = 44.0098  // Molar mass (g/mol)
+)
+
+// r is the specific gas constant of the equation (J/(kg·K)).
+const r = 188.9241
+
+// Saturation correlations and melting and sublimation pressures are restricted
+// to the temperature ranges they were fitted over.
+var (
+	// ErrSaturationRange is returned for temperatures outside [Tt, Tc].
+	ErrSaturationRange = errors.New("co2: temperature outside the vapor-liquid saturation range [216.592, 304.1282] K")
+)
+
+// ancillary evaluates Σ aᵢ (1 - T/Tc)^tᵢ.
+func ancillary(T float64, a, t []float64) float64 {
+	x := 1 - T/Tc
+	var s float64
+	for i := range a {
+		s += a[i] * math.Pow(x, t[i])
+	}
+	return s
+}
+
+// checkSaturation checks that T is in the saturation range.
+func checkSaturation(T float64) error {
+	if T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if T < Tt || T > Tc {
+		return ErrSaturationRange
+	}
+	return nil
+}
+
+// VaporPressure returns the vapor pressure (bar) at temperature T (K) from the
+// ancillary equation of Span and Wagner,
+//
+//	ln(Ps/Pc) = (Tc/T) Σ aᵢ (1 - T/Tc)^tᵢ
+func VaporPressure(T float64) (float64, error) {
+	if err := checkSaturation(T); err != nil {
+		return 0, err
+	}
+	s := ancillary(T, []float64{-7.0602087, 1.9391218, -1.6463597, -3.2995634}, []float64{1, 1.5, 2, 4})
+	return Pc * math.Exp(Tc/T*s), nil
+}
+
+// SaturatedLiquidDensity returns the density (kg/m³) of the saturated liquid at
+// temperature T (K) from the ancillary equation of Span and Wagner.
+func SaturatedLiquidDensity(T float64) (float64, error) {
+	if err := checkSaturation(T); err != nil {
+		return 0, err
+	}
+	s := ancillary(T, []float64{1.9245108, -0.62385555, -0.32731127, 0.39245142}, []float64{0.34, 0.5, 10.0 / 6, 11.0 / 6})
+	return RhoC * math.Exp(s), nil
+}
+
+// SaturatedVaporDensity returns the density (kg/m³) of the saturated vapor at
+// temperature T (K) from the ancillary equation of Span and Wagner.
+func SaturatedVaporDensity(T float64) (float64, error) {
+	if err := checkSaturation(T); err != nil {
+		return 0, err
+	}
+	s := ancillary(T, []float64{-1.7074879, -0.8227467, -4.6008549, -10.111178, -29.742252}, []float64{0.34, 0.5, 1, 7.0 / 3, 14.0 / 3})
+	return RhoC * math.Exp(s), nil
+}
+
+// MeltingPressure returns the pressure (bar) of the solid-liquid boundary at
+// temperature T (K) ≥ Tt,
+//
+//	Pm/Pt = 1 + 1955.5390 (T/Tt - 1) + 2055.4593 (T/Tt - 1)²
+func MeltingPressure(T float64) (float64, error) {
+	if T < Tt {
+		return 0, fmt.Errorf("co2: melting pressure requires T ≥ %g K, got %g K", Tt, T)
+	}
+	return meltingPressure(T), nil
+}
+
+// SublimationPressure returns the pressure (bar) of the solid-vapor boundary at
+// temperature T (K) ≤ Tt,
+//
+//	ln(Psub/Pt) = (Tt/T) [-14.740846 (1 - T/Tt) + 2.4327015 (1 - T/Tt)^1.9 - 5.3061778 (1 - T/Tt)^2.9]
+func SublimationPressure(T float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if T > Tt {
+		return 0, fmt.Errorf("co2: sublimation pressure requires T ≤ %g K, got %g K", Tt, T)
+	}
+	x := 1 - T/Tt
+	return Pt * math.Exp(Tt/T*(-14.740846*x+2.4327015*math.Pow(x, 1.9)-5.3061778*math.Pow(x, 2.9))), nil
+}
+
+// Pressure returns the pressure (bar) of CO2 at temperature T (K) and density
+// rho (kg/m³) from the equation of state, P = ρRT(1 + δ αʳ_δ).
+func Pressure(T, rho float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if rho <= 0 {
+		return 0, errors.New("density must be greater than 0")
+	}
+	delta := rho / RhoC
+	return rho * r * T * residual(delta, Tc/T).z(delta) / 1e5, nil
+}
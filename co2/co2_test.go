@@ -0,0 +1,154 @@
+package co2_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/co2"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestPressureCriticalPoint(t *testing.T) {
+	got, err := co2.Pressure(co2.Tc, co2.RhoC)
+	if err != nil {
+		t.Fatalf("Pressure() unexpected error: %v", err)
+	}
+	if math.Abs(got-co2.Pc) > 1e-3 {
+		t.Errorf("Pressure(Tc, RhoC) = %v, want %v", got, co2.Pc)
+	}
+}
+
+func TestAncillaries(t *testing.T) {
+	if got, _ := co2.VaporPressure(co2.Tt); math.Abs(got-co2.Pt) > 1e-3 {
+		t.Errorf("VaporPressure(Tt) = %v, want %v", got, co2.Pt)
+	}
+	if got, _ := co2.VaporPressure(co2.Tc); math.Abs(got-co2.Pc) > 1e-9 {
+		t.Errorf("VaporPressure(Tc) = %v, want %v", got, co2.Pc)
+	}
+	if got, _ := co2.MeltingPressure(co2.Tt); math.Abs(got-co2.Pt) > 1e-9 {
+		t.Errorf("MeltingPressure(Tt) = %v, want %v", got, co2.Pt)
+	}
+	if got, _ := co2.SublimationPressure(co2.Tt); math.Abs(got-co2.Pt) > 1e-9 {
+		t.Errorf("SublimationPressure(Tt) = %v, want %v", got, co2.Pt)
+	}
+
+	for _, T := range []float64{200, 310} {
+		if _, err := co2.VaporPressure(T); !errors.Is(err, co2.ErrSaturationRange) {
+			t.Errorf("VaporPressure(%v) error = %v, want %v", T, err, co2.ErrSaturationRange)
+		}
+	}
+	if _, err := co2.MeltingPressure(200); err == nil {
+		t.Error("MeltingPressure(200) expected error, got nil")
+	}
+	if _, err := co2.SublimationPressure(250); err == nil {
+		t.Error("SublimationPressure(250) expected error, got nil")
+	}
+}
+
+func TestSaturationAt(t *testing.T) {
+	for _, T := range []float64{220, 250, 273.15, 300} {
+		s, err := co2.SaturationAt(T)
+		if err != nil {
+			t.Fatalf("SaturationAt(%v) unexpected error: %v", T, err)
+		}
+		// The ancillary equations reproduce the equation of state within 0.2%
+		// away from the critical point.
+		ps, _ := co2.VaporPressure(T)
+		rl, _ := co2.SaturatedLiquidDensity(T)
+		rv, _ := co2.SaturatedVaporDensity(T)
+		if math.Abs(s.P-ps) > 2e-3*ps {
+			t.Errorf("SaturationAt(%v).P = %v, want %v", T, s.P, ps)
+		}
+		if math.Abs(s.Liquid.Rho-rl) > 2e-3*rl {
+			t.Errorf("SaturationAt(%v).Liquid.Rho = %v, want %v", T, s.Liquid.Rho, rl)
+		}
+		if math.Abs(s.Vapor.Rho-rv) > 2e-3*rv {
+			t.Errorf("SaturationAt(%v).Vapor.Rho = %v, want %v", T, s.Vapor.Rho, rv)
+		}
+		if math.Abs(s.Liquid.LnPhi-s.Vapor.LnPhi) > 1e-8 {
+			t.Errorf("SaturationAt(%v) ln φ = %v and %v, want equal", T, s.Liquid.LnPhi, s.Vapor.LnPhi)
+		}
+	}
+	if _, err := co2.SaturationAt(320); !errors.Is(err, co2.ErrSaturationRange) {
+		t.Errorf("SaturationAt(320) error = %v, want %v", err, co2.ErrSaturationRange)
+	}
+}
+
+func TestDensity(t *testing.T) {
+	tests := []struct {
+		T, P float64
+		want float64 // kg/m³
+	}{
+		{300, 1, 1.773},
+		{300, 50, 128.4},
+		{250, 50, 1058.9},
+		{280, 100, 938.2},   // compressed liquid
+		{310, 100, 685.8},   // supercritical, near the critical point
+		{350, 200, 614.2},   // dense supercritical
+		{400, 5000, 1316.1}, // high pressure
+	}
+	for _, tt := range tests {
+		got, err := co2.Density(tt.T, tt.P)
+		if err != nil {
+			t.Fatalf("Density(%v, %v) unexpected error: %v", tt.T, tt.P, err)
+		}
+		if math.Abs(got-tt.want) > 1e-3*tt.want {
+			t.Errorf("Density(%v, %v) = %v, want %v", tt.T, tt.P, got, tt.want)
+		}
+	}
+	if _, err := co2.Density(230, 2000); err == nil {
+		t.Error("Density() of the solid expected error, got nil")
+	}
+}
+
+func TestPhaseAt(t *testing.T) {
+	tests := []struct {
+		T, P float64
+		want phase.Phase
+	}{
+		{250, 10, phase.Vapor},
+		{250, 50, phase.Liquid},
+		{210, 1, phase.Vapor},
+		{210, 10, phase.Solid},
+		{230, 2000, phase.Solid},
+		{310, 100, phase.Supercritical},
+	}
+	for _, tt := range tests {
+		if got := co2.PhaseAt(tt.T, tt.P); got != tt.want {
+			t.Errorf("PhaseAt(%v, %v) = %v, want %v", tt.T, tt.P, got, tt.want)
+		}
+	}
+}
+
+func TestProvider(t *testing.T) {
+	p := co2.Provider{}
+	z, err := p.Z(substance.CarbonDioxide, 310, 100)
+	if err != nil {
+		t.Fatalf("Z() unexpected error: %v", err)
+	}
+	s, _ := co2.Solve(310, 100, phase.Unknown)
+	if z != s.Z {
+		t.Errorf("Z() = %v, want %v", z, s.Z)
+	}
+	hr, sr, err := p.Residual(substance.CarbonDioxide, 310, 100)
+	if err != nil || hr != s.HR || sr != s.SR {
+		t.Errorf("Residual() = %v, %v, %v, want %v, %v", hr, sr, err, s.HR, s.SR)
+	}
+
+	// Residual enthalpy from the temperature derivative of ln φ,
+	// H^R = -RT² ∂ln φ/∂T at constant P.
+	const dT = 1e-3
+	lo, _ := co2.Solve(310-dT, 100, phase.Supercritical)
+	hi, _ := co2.Solve(310+dT, 100, phase.Supercritical)
+	want := -zfactor.RSI * 310 * 310 * (hi.LnPhi - lo.LnPhi) / (2 * dT)
+	if math.Abs(hr-want) > 1e-4*math.Abs(want) {
+		t.Errorf("Residual() H^R = %v, want %v", hr, want)
+	}
+
+	if _, err := p.Z(substance.Methane, 300, 10); err == nil {
+		t.Error("Z() of methane expected error, got nil")
+	}
+}
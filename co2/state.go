@@ -0,0 +1,239 @@
+package co2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// maxDelta is the highest reduced density scanned for roots, above the density
+// of the liquid at the melting line at 800 MPa.
+const maxDelta = 3.9
+
+// State is the solution of the equation of state at a temperature and pressure.
+type State struct {
+	Rho   float64 // Density (kg/m³)
+	V     float64 // Molar volume (cm³/mol)
+	Z     float64 // Compressibility factor
+	LnPhi float64 // ln φ, the fugacity coefficient
+	HR    float64 // Residual enthalpy H^R (J/mol)
+	SR    float64 // Residual entropy S^R (J/(mol·K))
+}
+
+// state evaluates the state at temperature T and reduced density delta.
+func state(T, delta float64) *State {
+	tau := Tc / T
+	h := residual(delta, tau)
+	z := h.z(delta)
+	rho := delta * RhoC
+	return &State{
+		Rho:   rho,
+		V:     MW / rho * 1e3,
+		Z:     z,
+		LnPhi: h.ar + z - 1 - math.Log(z),
+		HR:    zfactor.RSI * T * (tau*h.arTau + delta*h.arDelta),
+		SR:    zfactor.RSI * (tau*h.arTau - h.ar + math.Log(z)),
+	}
+}
+
+// roots returns the mechanically stable reduced densities at which the pressure
+// is P at T, in ascending order. The pressure is scanned over a geometric grid
+// of δ from well inside the ideal-gas region, and each crossing of P with a
+// rising pressure refined by bisection.
+func roots(T, P float64) []float64 {
+	const points = 400
+	f := func(delta float64) float64 {
+		rho := delta * RhoC
+		return rho*r*T*residual(delta, Tc/T).z(delta)/1e5 - P
+	}
+	lo := 1e-3 * P * 1e5 / (r * T) / RhoC
+	ratio := math.Pow(maxDelta/lo, 1.0/(points-1))
+
+	var out []float64
+	prevD := lo
+	prevF := f(prevD)
+	for i := 1; i < points; i++ {
+		delta := lo * math.Pow(ratio, float64(i))
+		F := f(delta)
+		if prevF < 0 && F >= 0 {
+			left, right := prevD, delta
+			for range 100 {
+				mid := (left + right) / 2
+				if f(mid) < 0 {
+					left = mid
+				} else {
+					right = mid
+				}
+			}
+			out = append(out, (left+right)/2)
+		}
+		prevD, prevF = delta, F
+	}
+	return out
+}
+
+// PhaseAt classifies CO2 at temperature T (K) and pressure P (bar) from the
+// ancillary vapor pressure, melting and sublimation curves.
+func PhaseAt(T, P float64) phase.Phase {
+	switch {
+	case T < Tt:
+		if pSub, _ := SublimationPressure(T); P > pSub {
+			return phase.Solid
+		}
+		return phase.Vapor
+	case P > meltingPressure(T):
+		return phase.Solid
+	case T >= Tc:
+		return phase.Supercritical
+	}
+	if pSat, _ := VaporPressure(T); P > pSat {
+		return phase.Liquid
+	}
+	return phase.Vapor
+}
+
+// meltingPressure is MeltingPressure without the check of T.
+func meltingPressure(T float64) float64 {
+	x := T/Tt - 1
+	return Pt * (1 + 1955.539*x + 2055.4593*x*x)
+}
+
+// Solve returns the state of CO2 at temperature T (K) and pressure P (bar) on
+// the density root of phase p: the densest for phase.Liquid, the least dense for
+// phase.Vapor, the one of lowest Gibbs energy for phase.Supercritical, or that of
+// the phase given by PhaseAt for phase.Unknown.
+//
+// The phase is not chosen by the lowest Gibbs energy among the roots: like other
+// multiparameter equations, the equation has spurious roots inside the
+// two-phase region, at which the Gibbs energy can be lower than that of the
+// stable phase. Solids are rejected.
+func Solve(T, P float64, p phase.Phase) (*State, error) {
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	if p == phase.Unknown {
+		p = PhaseAt(T, P)
+	}
+	deltas := roots(T, P)
+	if len(deltas) == 0 {
+		return nil, fmt.Errorf("co2: no density root found at %g K and %g bar", T, P)
+	}
+	switch p {
+	case phase.Liquid:
+		return state(T, deltas[len(deltas)-1]), nil
+	case phase.Vapor:
+		return state(T, deltas[0]), nil
+	case phase.Supercritical:
+		// Above Tc the pressure rises monotonically with density, but close to the
+		// critical point spurious roots remain; the stable one has the lowest
+		// Gibbs energy.
+		var best *State
+		for _, delta := range deltas {
+			if s := state(T, delta); best == nil || s.LnPhi < best.LnPhi {
+				best = s
+			}
+		}
+		return best, nil
+	case phase.Solid:
+		return nil, fmt.Errorf("co2: solid at %g K and %g bar", T, P)
+	default:
+		return nil, fmt.Errorf("co2: unsupported phase %v", p)
+	}
+}
+
+// Density returns the density (kg/m³) of the stable phase of CO2 at temperature
+// T (K) and pressure P (bar).
+func Density(T, P float64) (float64, error) {
+	s, err := Solve(T, P, phase.Unknown)
+	if err != nil {
+		return 0, err
+	}
+	return s.Rho, nil
+}
+
+// Saturation is a vapor-liquid saturation state of the equation of state.
+type Saturation struct {
+	T      float64 // Temperature (K)
+	P      float64 // Vapor pressure (bar)
+	Liquid *State  // Saturated liquid
+	Vapor  *State  // Saturated vapor
+}
+
+// saturationIter is the maximum number of iterations of SaturationAt.
+const saturationIter = 200
+
+// SaturationAt returns the saturation state of the equation of state at
+// temperature T (K), where the fugacities of the liquid and vapor are equal.
+// Starting from VaporPressure, the pressure is updated by P ← P φᴸ/φⱽ. Unlike
+// the ancillary equations, the result is consistent with Solve.
+func SaturationAt(T float64) (*Saturation, error) {
+	P, err := VaporPressure(T)
+	if err != nil {
+		return nil, err
+	}
+	for range saturationIter {
+		deltas := roots(T, P)
+		if len(deltas) < 2 {
+			return nil, fmt.Errorf("co2: saturation at %g K: a single density root at %g bar", T, P)
+		}
+		liq, vap := state(T, deltas[len(deltas)-1]), state(T, deltas[0])
+		if math.Abs(liq.Rho-vap.Rho) < 1e-6*liq.Rho {
+			return nil, errors.New("co2: saturation: the phases are identical, T is at the critical point")
+		}
+		d := liq.LnPhi - vap.LnPhi
+		if math.Abs(d) < 1e-10 {
+			return &Saturation{T: T, P: P, Liquid: liq, Vapor: vap}, nil
+		}
+		P *= math.Exp(d)
+	}
+	return nil, fmt.Errorf("co2: saturation at %g K did not converge in %d iterations", T, saturationIter)
+}
+
+// Provider evaluates Z and the residual properties of carbon dioxide with the
+// equation of state of Span and Wagner, in the phase given by PhaseAt. It
+// implements substance.Provider for substance.CarbonDioxide and rejects other
+// substances.
+type Provider struct{}
+
+func (Provider) Name() string { return "Span-Wagner" }
+
+// check rejects substances other than CO2.
+func check(s *substance.Substance) error {
+	if s == nil {
+		return errors.New("substance cannot be nil")
+	}
+	if !strings.EqualFold(s.Name, substance.CarbonDioxide.Name) {
+		return fmt.Errorf("co2: the Span-Wagner equation applies to carbon dioxide only, got %s", s.Name)
+	}
+	return nil
+}
+
+func (Provider) Z(s *substance.Substance, T, P float64) (float64, error) {
+	if err := check(s); err != nil {
+		return 0, err
+	}
+	st, err := Solve(T, P, phase.Unknown)
+	if err != nil {
+		return 0, err
+	}
+	return st.Z, nil
+}
+
+func (Provider) Residual(s *substance.Substance, T, P float64) (float64, float64, error) {
+	if err := check(s); err != nil {
+		return 0, 0, err
+	}
+	st, err := Solve(T, P, phase.Unknown)
+	if err != nil {
+		return 0, 0, err
+	}
+	return st.HR, st.SR, nil
+}
@@ -0,0 +1,113 @@
+package cpa
+
+import (
+	"errors"
+	"math"
+
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+const (
+	// assocTol bounds the largest relative change of the site fractions at
+	// convergence.
+	assocTol = 1e-12
+	// assocIter is the maximum number of Newton iterations for the site fractions.
+	assocIter = 100
+)
+
+// errAssociation is returned when the site fractions cannot be solved.
+var errAssociation = errors.New("association site fractions did not converge")
+
+// association holds the association sites of a mixture.
+type association struct {
+	comp []int // Component of each site
+	// strength is [exp(ε^kl/RT) - 1] bₖₗ β^kl of every pair of sites, or 0 if
+	// they do not bond. Multiplied by g(ρ), it is the association strength Δ^kl.
+	strength [][]float64
+}
+
+// newAssociation lists the sites of the components of cfg and their association
+// strengths at cfg.T.
+func newAssociation(cfg *Config) *association {
+	var kinds []site
+	as := &association{}
+	for i, c := range cfg.Components {
+		for _, s := range c.Scheme.sites() {
+			as.comp = append(as.comp, i)
+			kinds = append(kinds, s)
+		}
+	}
+	as.strength = make([][]float64, len(kinds))
+	for k := range kinds {
+		as.strength[k] = make([]float64, len(kinds))
+		for l := range kinds {
+			if !bonds(kinds[k], kinds[l]) {
+				continue
+			}
+			ci, cj := cfg.Components[as.comp[k]], cfg.Components[as.comp[l]]
+			eps := (ci.Epsilon + cj.Epsilon) / 2
+			beta := math.Sqrt(ci.Beta * cj.Beta)
+			as.strength[k][l] = math.Expm1(eps/cfg.T) * (ci.B + cj.B) / 2 * beta
+		}
+	}
+	return as
+}
+
+// fractions solves the monomer fractions X of the sites at molar density rho
+// (mol/cm³) and radial distribution function g for the mole fractions x. X holds
+// the initial estimate and is overwritten with the solution.
+//
+// The equations F_k = 1/X_k - 1 - ρ Σ_l x_l X_l Δ^kl = 0 are solved by Newton's
+// method, with steps that keep every X_k in (0, 1].
+func (as *association) fractions(x []float64, rho, g float64, X []float64) error {
+	n := len(as.comp)
+	if n == 0 {
+		return nil
+	}
+	w := make([]float64, n) // ρ x_l of every site
+	for l, i := range as.comp {
+		w[l] = rho * x[i]
+	}
+	jac := make([][]float64, n)
+	for k := range jac {
+		jac[k] = make([]float64, n)
+	}
+	f := make([]float64, n)
+	for range assocIter {
+		for k := range n {
+			var sum float64
+			for l := range n {
+				d := w[l] * g * as.strength[k][l]
+				sum += d * X[l]
+				jac[k][l] = -d
+			}
+			f[k] = 1/X[k] - 1 - sum
+			jac[k][k] -= 1 / (X[k] * X[k])
+		}
+		step, ok := numeric.SolveLinear(jac, f)
+		if !ok {
+			return errAssociation
+		}
+		// X ← X - step, shortened so that no fraction reaches 0. Fractions that
+		// would exceed 1 move halfway to it instead.
+		t := 1.0
+		for k := range n {
+			if X[k]-step[k] <= 0 {
+				t = math.Min(t, 0.9*X[k]/step[k])
+			}
+		}
+		var change float64
+		for k := range n {
+			next := X[k] - t*step[k]
+			if next > 1 {
+				next = (X[k] + 1) / 2
+			}
+			change = math.Max(change, math.Abs(next-X[k])/X[k])
+			X[k] = next
+		}
+		if change < assocTol {
+			return nil
+		}
+	}
+	return errAssociation
+}
@@ -0,0 +1,198 @@
+// Package cpa implements the Cubic-Plus-Association (CPA) equation of state of
+// Kontogeorgis et al. (1996) for fluids that form hydrogen bonds, such as water,
+// alcohols and glycols, where the cubic equations of state fail.
+//
+// CPA adds the association term of Wertheim's theory, as used in SAFT, to the
+// Soave-Redlich-Kwong equation:
+//
+//	Z = Z_SRK - (1/2)(1 + ρ ∂ln g/∂ρ) Σᵢ xᵢ Σ_A (1 - X_Ai)
+//
+// where X_Ai is the fraction of the sites A on molecules of component i that are
+// not bonded to other sites. It solves
+//
+//	X_Ai = 1 / (1 + ρ Σⱼ xⱼ Σ_B X_Bj Δ^AiBj)
+//
+// at every density, with the association strength
+//
+//	Δ^AiBj = g(ρ) [exp(ε^AiBj/RT) - 1] bᵢⱼ β^AiBj
+//
+// and the radial distribution function of the simplified CPA, g = 1/(1 - 1.9η)
+// with η = b/(4V). Cross-association between two self-associating components
+// follows the CR-1 combining rules, ε^AiBj = (εᵢ + εⱼ)/2 and β^AiBj = √(βᵢβⱼ),
+// with bᵢⱼ = (bᵢ + bⱼ)/2. Components without association sites reduce CPA to
+// SRK with the van der Waals mixing rules.
+//
+// Units: T in K, P in bar and V in cm³/mol.
+package cpa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+// R is the gas constant in the units of the package, bar·cm³/(mol·K).
+const R = zfactor.RSI * 10
+
+// Scheme is the association scheme of a molecule in the terminology of Huang and
+// Radosz (1990): the number of its association sites and the kind of each.
+type Scheme int
+
+const (
+	// None is a non-associating (inert) component.
+	None Scheme = iota
+	// Scheme1A is a single site that bonds to itself, e.g. in carboxylic acids.
+	Scheme1A
+	// Scheme2B is one proton donor and one proton acceptor, e.g. the hydroxyl
+	// group of alcohols.
+	Scheme2B
+	// Scheme3B is two proton donors and one acceptor, e.g. primary amines.
+	Scheme3B
+	// Scheme4C is two proton donors and two acceptors, e.g. water and glycols.
+	Scheme4C
+)
+
+// String implements fmt.Stringer for Scheme.
+func (s Scheme) String() string {
+	switch s {
+	case None:
+		return "none"
+	case Scheme1A:
+		return "1A"
+	case Scheme2B:
+		return "2B"
+	case Scheme3B:
+		return "3B"
+	case Scheme4C:
+		return "4C"
+	default:
+		return fmt.Sprintf("Scheme(%d)", int(s))
+	}
+}
+
+// site is the kind of an association site.
+type site int
+
+const (
+	donor      site = iota // Positive site, e.g. a hydrogen atom
+	acceptor               // Negative site, e.g. a lone pair of electrons
+	ambivalent             // Site that bonds with any other, as in 1A
+)
+
+// sites returns the kinds of the sites of a molecule with scheme s.
+func (s Scheme) sites() []site {
+	switch s {
+	case Scheme1A:
+		return []site{ambivalent}
+	case Scheme2B:
+		return []site{donor, acceptor}
+	case Scheme3B:
+		return []site{donor, donor, acceptor}
+	case Scheme4C:
+		return []site{donor, donor, acceptor, acceptor}
+	default:
+		return nil
+	}
+}
+
+// bonds reports whether sites of kinds a and b can bond.
+func bonds(a, b site) bool {
+	return a == ambivalent || b == ambivalent || a != b
+}
+
+// Component holds the CPA parameters of a substance. The physical parameters
+// A0, B and C1 are fitted together with the association parameters to vapor
+// pressures and liquid densities, so they differ from the SRK values derived
+// from Tc, Pc and ω.
+type Component struct {
+	Name    string
+	MW      float64 // Molar mass (g/mol)
+	Tc      float64 // Critical temperature (K), reducing the temperature of α
+	A0      float64 // Energy parameter a0 (bar·cm⁶/mol²)
+	B       float64 // Covolume b (cm³/mol)
+	C1      float64 // Slope of α(Tr) = [1 + c1(1 - √Tr)]²
+	Epsilon float64 // Association energy ε^AB/R (K)
+	Beta    float64 // Association volume β^AB - dimensionless
+	Scheme  Scheme  // Association scheme
+}
+
+// a returns the energy parameter a(T) = a0 [1 + c1(1 - √Tr)]².
+func (c *Component) a(T float64) float64 {
+	s := 1 + c.C1*(1-math.Sqrt(T/c.Tc))
+	return c.A0 * s * s
+}
+
+// validate checks the parameters of c.
+func (c *Component) validate() error {
+	if c.Tc <= 0 {
+		return zfactor.ErrCriticalProp
+	}
+	if c.A0 < 0 || c.B <= 0 {
+		return fmt.Errorf("%s: CPA parameters a0 and b must be positive", c.Name)
+	}
+	if c.Scheme != None && (c.Epsilon <= 0 || c.Beta <= 0) {
+		return fmt.Errorf("%s: association energy and volume of a %v scheme must be positive", c.Name, c.Scheme)
+	}
+	return nil
+}
+
+// Config holds a mixture, or a pure component, at a temperature and pressure.
+type Config struct {
+	Components []*Component
+	Y          []float64 // Mole fractions of the components
+	T          float64   // Temperature (K)
+	P          float64   // Pressure (bar)
+	// Kij is the symmetric matrix of binary interaction parameters of the cross
+	// energy parameters, aij = √(ai aj)(1 - kij). If nil, all kij are 0.
+	Kij [][]float64
+}
+
+// NewConfig creates a configuration for a mixture of comps with mole fractions y
+// at temperature T and pressure P.
+func NewConfig(T, P float64, y []float64, comps []*Component) *Config {
+	return &Config{Components: comps, Y: y, T: T, P: P}
+}
+
+// NewPureCfg creates a configuration for the pure component c at temperature T
+// and pressure P.
+func NewPureCfg(c *Component, T, P float64) *Config {
+	return NewConfig(T, P, []float64{1}, []*Component{c})
+}
+
+// validate checks the configuration.
+func (cfg *Config) validate() error {
+	if cfg == nil {
+		return errors.New("configuration error: config cannot be nil")
+	}
+	n := len(cfg.Components)
+	if n == 0 {
+		return errors.New("mixture must have at least one component")
+	}
+	if len(cfg.Y) != n {
+		return fmt.Errorf("got %d mole fractions for %d components", len(cfg.Y), n)
+	}
+	if err := numeric.MoleFractions(cfg.Y); err != nil {
+		return err
+	}
+	for _, c := range cfg.Components {
+		if c == nil {
+			return errors.New("component cannot be nil")
+		}
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	if cfg.T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if cfg.P <= 0 {
+		return zfactor.ErrPressure
+	}
+	if cfg.Kij != nil {
+		return numeric.SymmetricMatrix("Kij", cfg.Kij, n)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package cpa_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cpa"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestSaturationPressure(t *testing.T) {
+	// Normal boiling points.
+	tests := []struct {
+		c   *cpa.Component
+		T   float64
+		tol float64
+	}{
+		{cpa.Water, 373.15, 0.02},
+		{cpa.Methanol, 337.85, 0.02},
+		{cpa.Ethanol, 351.44, 0.03},
+		{cpa.EthyleneGlycol, 470.45, 0.03},
+	}
+	for _, tt := range tests {
+		got, err := cpa.SaturationPressure(tt.c, tt.T)
+		if err != nil {
+			t.Errorf("SaturationPressure(%s) unexpected error: %v", tt.c.Name, err)
+			continue
+		}
+		if math.Abs(got-zfactor.AtmBar)/zfactor.AtmBar > tt.tol {
+			t.Errorf("SaturationPressure(%s, %v) = %.4f bar, want %.4f bar within %v%%", tt.c.Name, tt.T, got, zfactor.AtmBar, 100*tt.tol)
+		}
+	}
+}
+
+func TestLiquidDensity(t *testing.T) {
+	s, err := cpa.Solve(cpa.NewPureCfg(cpa.Water, 298.15, 1), phase.Liquid)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	if rho := 1000 * cpa.Water.MW / s.V; math.Abs(rho-997)/997 > 0.015 {
+		t.Errorf("Solve() liquid density = %.1f kg/m³, want 997 kg/m³ within 1.5%%", rho)
+	}
+
+	// Four equivalent sites, most of them bonded in the liquid.
+	if len(s.X[0]) != 4 {
+		t.Fatalf("Solve() X = %v, want 4 sites", s.X)
+	}
+	for _, x := range s.X[0] {
+		if math.Abs(x-s.X[0][0]) > 1e-9 || !(x > 0 && x < 0.2) {
+			t.Errorf("Solve() X = %v, want four equal fractions below 0.2", s.X[0])
+			break
+		}
+	}
+}
+
+func TestComponentLogPhi(t *testing.T) {
+	comps := []*cpa.Component{cpa.Water, cpa.Methanol}
+	const T, P = 320.0, 1.0
+
+	// ln φ of the liquid from the residual Helmholtz energy, G^R/RT = A^r/RT + Z - 1 - ln Z.
+	nLnPhi := func(n []float64) float64 {
+		total := n[0] + n[1]
+		s, err := cpa.Solve(cpa.NewConfig(T, P, []float64{n[0] / total, n[1] / total}, comps), phase.Liquid)
+		if err != nil {
+			t.Fatalf("Solve() unexpected error: %v", err)
+		}
+		return total * (s.Ar + s.Z - 1 - math.Log(s.Z))
+	}
+
+	y := []float64{0.6, 0.4}
+	s, err := cpa.Solve(cpa.NewConfig(T, P, y, comps), phase.Liquid)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	// ln φ̂ᵢ is the partial molar derivative ∂(n ln φ)/∂nᵢ at constant T and P.
+	const h = 1e-5
+	for i := range y {
+		up, down := append([]float64(nil), y...), append([]float64(nil), y...)
+		up[i] += h
+		down[i] -= h
+		want := (nLnPhi(up) - nLnPhi(down)) / (2 * h)
+		if math.Abs(s.LnPhi[i]-want) > 1e-5 {
+			t.Errorf("LnPhi[%d] = %v, want ∂(n ln φ)/∂nᵢ = %v", i, s.LnPhi[i], want)
+		}
+	}
+}
+
+// srk is the Soave-Redlich-Kwong equation with the constants CPA uses for inert
+// components.
+type srk struct{}
+
+func (srk) Alpha(tr, w float64) float64 {
+	s := 1 + (0.480+1.574*w-0.176*w*w)*(1-math.Sqrt(tr))
+	return s * s
+}
+
+func (srk) Params() *cubic.Params {
+	return &cubic.Params{Sigma: 1, Epsilon: 0, Omega: 0.08664, Psi: 0.42748}
+}
+
+func TestForSubstance(t *testing.T) {
+	if c, err := cpa.ForSubstance(substance.Water); err != nil || c != cpa.Water {
+		t.Errorf("ForSubstance(Water) = %v, %v, want cpa.Water", c, err)
+	}
+	if _, err := cpa.ForSubstance(substance.AceticAcid); err == nil {
+		t.Error("ForSubstance(AceticAcid) expected an error for missing association parameters")
+	}
+
+	// Without association sites CPA is SRK.
+	c, err := cpa.ForSubstance(substance.Propane)
+	if err != nil {
+		t.Fatalf("ForSubstance(Propane) unexpected error: %v", err)
+	}
+	const T, P = 300.0, 5.0
+	s, err := cpa.Solve(cpa.NewPureCfg(c, T, P), phase.Vapor)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	cfg := substance.Propane.CubicConfig(srk{}, zfactor.Args{T: T, P: P, R: cpa.R})
+	vr, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	V, err := vr.Root(phase.Vapor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(s.V-V)/V > 1e-9 {
+		t.Errorf("Solve() V = %v, want SRK %v", s.V, V)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *cpa.Config
+	}{
+		{"nil config", nil},
+		{"zero temperature", cpa.NewPureCfg(cpa.Water, 0, 1)},
+		{"zero pressure", cpa.NewPureCfg(cpa.Water, 300, 0)},
+		{"mole fraction sum", cpa.NewConfig(300, 1, []float64{0.5, 0.4}, []*cpa.Component{cpa.Water, cpa.Methanol})},
+		{"missing association energy", cpa.NewPureCfg(&cpa.Component{Name: "X", Tc: 500, A0: 1e6, B: 30, Scheme: cpa.Scheme2B}, 300, 1)},
+	}
+	for _, tt := range tests {
+		if _, err := cpa.Solve(tt.cfg, phase.Liquid); err == nil {
+			t.Errorf("%s: Solve() expected error, got nil", tt.name)
+		}
+	}
+}
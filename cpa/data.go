@@ -0,0 +1,66 @@
+package cpa
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Parameters of Kontogeorgis et al. (2006), fitted to vapor pressures and
+// saturated liquid densities, converted from bar·L²/mol², L/mol and bar·L/mol.
+var (
+	Water = &Component{
+		Name: "Water", MW: 18.015, Tc: 647.29,
+		A0: 1.2277e6, B: 14.515, C1: 0.67359,
+		Epsilon: 166.55 / 0.08314, Beta: 0.0692, Scheme: Scheme4C,
+	}
+	Methanol = &Component{
+		Name: "Methanol", MW: 32.042, Tc: 512.64,
+		A0: 4.0531e6, B: 30.978, C1: 0.43102,
+		Epsilon: 245.91 / 0.08314, Beta: 0.0161, Scheme: Scheme2B,
+	}
+	Ethanol = &Component{
+		Name: "Ethanol", MW: 46.069, Tc: 513.92,
+		A0: 8.6716e6, B: 49.110, C1: 0.73690,
+		Epsilon: 215.32 / 0.08314, Beta: 0.0080, Scheme: Scheme2B,
+	}
+	EthyleneGlycol = &Component{
+		Name: "Ethylene glycol", MW: 62.068, Tc: 719.7,
+		A0: 10.819e6, B: 51.400, C1: 0.67440,
+		Epsilon: 197.52 / 0.08314, Beta: 0.0141, Scheme: Scheme4C,
+	}
+)
+
+// associating lists the parameters of associating substances by name.
+var associating = []*Component{Water, Methanol, Ethanol, EthyleneGlycol}
+
+// ForSubstance returns the CPA parameters of s. Associating substances take
+// their parameters from the package table, matched by name. Non-associating
+// substances use the SRK parameters from their critical properties and acentric
+// factor, for which CPA is SRK.
+func ForSubstance(s *substance.Substance) (*Component, error) {
+	if s == nil {
+		return nil, fmt.Errorf("substance cannot be nil")
+	}
+	for _, c := range associating {
+		if strings.EqualFold(c.Name, s.Name) {
+			return c, nil
+		}
+	}
+	if s.Associating {
+		return nil, fmt.Errorf("cpa: no association parameters for %s", s.Name)
+	}
+	if err := s.Require("CPA", substance.PropTc, substance.PropPc, substance.PropAcentric); err != nil {
+		return nil, err
+	}
+	tc, pc, w := s.Critical.Tc, s.Critical.Pc, s.Acentric
+	return &Component{
+		Name: s.Name,
+		MW:   s.MW,
+		Tc:   tc,
+		A0:   0.42748 * R * R * tc * tc / pc,
+		B:    0.08664 * R * tc / pc,
+		C1:   0.480 + 1.574*w - 0.176*w*w,
+	}, nil
+}
@@ -0,0 +1,248 @@
+package cpa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor/internal/numeric"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// model holds the parameters of a configuration at its temperature.
+type model struct {
+	cfg   *Config
+	aij   [][]float64 // Cross energy parameters √(ai aj)(1 - kij)
+	bi    []float64   // Covolumes of the components
+	a, b  float64     // Mixture parameters of the van der Waals mixing rules
+	assoc *association
+}
+
+// newModel validates cfg and evaluates its parameters at cfg.T.
+func newModel(cfg *Config) (*model, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	n := len(cfg.Components)
+	m := &model{cfg: cfg, aij: make([][]float64, n), bi: make([]float64, n), assoc: newAssociation(cfg)}
+	ai := make([]float64, n)
+	for i, c := range cfg.Components {
+		ai[i], m.bi[i] = c.a(cfg.T), c.B
+	}
+	for i := range n {
+		m.aij[i] = make([]float64, n)
+		for j := range n {
+			k := 0.0
+			if cfg.Kij != nil && i != j {
+				k = cfg.Kij[i][j]
+			}
+			m.aij[i][j] = math.Sqrt(ai[i]*ai[j]) * (1 - k)
+		}
+	}
+	for i, yi := range cfg.Y {
+		m.b += yi * m.bi[i]
+		for j, yj := range cfg.Y {
+			m.a += yi * yj * m.aij[i][j]
+		}
+	}
+	return m, nil
+}
+
+// rdf returns the radial distribution function g = 1/(1 - 1.9η) at molar volume
+// V and η d ln g/dη, with η = b/(4V).
+func (m *model) rdf(V float64) (g, etaDlnG float64) {
+	x := 1.9 * m.b / (4 * V)
+	return 1 / (1 - x), x / (1 - x)
+}
+
+// sites solves the site fractions at molar volume V, starting from X.
+func (m *model) sites(V float64, X []float64) error {
+	g, _ := m.rdf(V)
+	return m.assoc.fractions(m.cfg.Y, 1/V, g, X)
+}
+
+// unbonded returns h = Σᵢ xᵢ Σ_A (1 - X_Ai).
+func (m *model) unbonded(X []float64) float64 {
+	var h float64
+	for k, i := range m.assoc.comp {
+		h += m.cfg.Y[i] * (1 - X[k])
+	}
+	return h
+}
+
+// z returns the compressibility factor at molar volume V for the solved site
+// fractions X.
+func (m *model) z(V float64, X []float64) float64 {
+	RT := R * m.cfg.T
+	_, etaDlnG := m.rdf(V)
+	return V/(V-m.b) - m.a/(RT*(V+m.b)) - (1+etaDlnG)*m.unbonded(X)/2
+}
+
+// newSites returns the initial estimate of the site fractions.
+func (m *model) newSites() []float64 {
+	X := make([]float64, len(m.assoc.comp))
+	for k := range X {
+		X[k] = 0.5
+	}
+	return X
+}
+
+// roots returns the molar volumes at which the pressure equals m.cfg.P, in
+// ascending order. The pressure is scanned over a geometric grid of V - b and
+// its sign changes refined by bisection.
+func (m *model) roots() ([]float64, error) {
+	RT := R * m.cfg.T
+	P := m.cfg.P
+	// The site fractions of each point start from those of the previous one.
+	X := m.newSites()
+	f := func(x float64) (float64, error) {
+		V := m.b + x
+		if err := m.sites(V, X); err != nil {
+			return 0, err
+		}
+		return m.z(V, X)*RT/V - P, nil
+	}
+	roots, err := numeric.GeometricRoots(f, 1e-6*m.b, 2*RT/P+10*m.b, 300)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) == 0 {
+		return nil, errors.New("no volume root found")
+	}
+	for i := range roots {
+		roots[i] += m.b
+	}
+	return roots, nil
+}
+
+// State is the solution of CPA at the temperature and pressure of a Config.
+type State struct {
+	V float64 // Molar volume (cm³/mol)
+	Z float64 // Compressibility factor
+	// X holds, for every component, the fractions of its association sites that
+	// are not bonded, in the order of its Scheme. It is empty for components
+	// without sites.
+	X     [][]float64
+	LnPhi []float64 // ln φ̂ᵢ of the components
+	// Ar is the reduced residual Helmholtz energy A^r/(nRT) at T and V, of which
+	// AAssoc is the association contribution, Σᵢ xᵢ Σ_A (ln X_Ai - X_Ai/2 + 1/2).
+	Ar, AAssoc float64
+}
+
+// state evaluates the state at molar volume V.
+func (m *model) state(V float64) (*State, error) {
+	X := m.newSites()
+	if err := m.sites(V, X); err != nil {
+		return nil, err
+	}
+	cfg := m.cfg
+	RT := R * cfg.T
+	Z := m.z(V, X)
+	if Z <= 0 {
+		return nil, fmt.Errorf("non-physical root: Z = %v", Z)
+	}
+	s := &State{V: V, Z: Z, X: make([][]float64, len(cfg.Components)), LnPhi: make([]float64, len(cfg.Components))}
+
+	// SRK: A^r/(nRT) = -ln(1 - b/V) - a/(bRT) ln(1 + b/V).
+	lnRep, lnAtt := math.Log(1-m.b/V), math.Log(1+m.b/V)
+	s.Ar = -lnRep - m.a/(m.b*RT)*lnAtt
+
+	_, etaDlnG := m.rdf(V)
+	h := m.unbonded(X)
+	for k, i := range m.assoc.comp {
+		s.X[i] = append(s.X[i], X[k])
+		s.AAssoc += cfg.Y[i] * (math.Log(X[k]) - X[k]/2 + 0.5)
+	}
+	s.Ar += s.AAssoc
+
+	// ln φ̂ᵢ = ∂(nA^r/RT)/∂nᵢ at constant T and V, minus ln Z. The association
+	// part is Σ_A ln X_Ai - (h/2) ∂ln g/∂nᵢ (Michelsen and Hendriks, 2001).
+	for i, bi := range m.bi {
+		var sum float64
+		for j, yj := range cfg.Y {
+			sum += yj * m.aij[i][j]
+		}
+		mu := -lnRep + bi/(V-m.b) -
+			(2*sum/(m.b*RT)-m.a*bi/(m.b*m.b*RT))*lnAtt -
+			m.a*bi/(m.b*RT*(V+m.b))
+		for _, x := range s.X[i] {
+			mu += math.Log(x)
+		}
+		mu -= h / 2 * etaDlnG * bi / m.b
+		s.LnPhi[i] = mu - math.Log(Z)
+	}
+	return s, nil
+}
+
+// Solve returns the state of cfg on the volume root of phase p: the smallest for
+// phase.Liquid, the largest for phase.Vapor or phase.Supercritical, or the one
+// with the lowest Gibbs energy, Σ yᵢ ln φ̂ᵢ, for phase.Unknown.
+func Solve(cfg *Config, p phase.Phase) (*State, error) {
+	m, err := newModel(cfg)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := m.roots()
+	if err != nil {
+		return nil, err
+	}
+	if p != phase.Unknown {
+		V, err := phase.Root(roots, p)
+		if err != nil {
+			return nil, err
+		}
+		return m.state(V)
+	}
+	var best *State
+	bestG := math.Inf(1)
+	for _, V := range roots {
+		s, err := m.state(V)
+		if err != nil {
+			continue
+		}
+		var g float64
+		for i, y := range cfg.Y {
+			g += y * s.LnPhi[i]
+		}
+		if g < bestG {
+			best, bestG = s, g
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no physical volume root found")
+	}
+	return best, nil
+}
+
+// SaturationPressure returns the vapor pressure (bar) of the pure component c at
+// temperature T, where the fugacities of the liquid and vapor roots are equal.
+// The pressure is updated by P ← P φᴸ/φⱽ.
+func SaturationPressure(c *Component, T float64) (float64, error) {
+	return numeric.SaturationPressure(func(P float64) (liq, vap *numeric.PhaseRoot, err error) {
+		m, err := newModel(NewPureCfg(c, T, P))
+		if err != nil {
+			return nil, nil, err
+		}
+		roots, err := m.roots()
+		if err != nil {
+			return nil, nil, err
+		}
+		l, err := m.state(roots[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		liq = &numeric.PhaseRoot{V: l.V, LnPhi: l.LnPhi[0]}
+		// A single root is passed on as the liquid if it is dense.
+		if len(roots) == 1 {
+			if roots[0] < 4*c.B {
+				return liq, nil, nil
+			}
+			return nil, liq, nil
+		}
+		v, err := m.state(roots[len(roots)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+		return liq, &numeric.PhaseRoot{V: v.V, LnPhi: v.LnPhi[0]}, nil
+	})
+}
@@ -0,0 +1,153 @@
+package cubic
+
+import "math"
+
+// AlphaFunction is the temperature dependence α(Tr, ω) of the attraction
+// parameter a(T) = Ψ α R²Tc²/Pc of a cubic equation of state, with α(1) = 1.
+//
+// Every EOSType is an AlphaFunction, so the α of one equation can be combined
+// with the σ, ε, Ω and Ψ of another through CustomAlpha.
+type AlphaFunction interface {
+	Alpha(tr, w float64) float64 //α(Tr, ω)
+}
+
+// AlphaDerivative is implemented by alpha functions that evaluate the first and
+// second derivatives of α with respect to Tr analytically. The residual and
+// thermal properties (H^R, S^R, Cp, the speed of sound) depend on these
+// derivatives; for alpha functions that do not implement AlphaDerivative they
+// are evaluated by central differences (see AlphaDerivatives).
+//
+// All the alpha functions of this package implement AlphaDerivative.
+type AlphaDerivative interface {
+	AlphaDerivatives(tr, w float64) (a, da, d2a float64) //α, dα/dTr, d²α/dTr²
+}
+
+// AlphaZcDerivative is AlphaDerivative for the AlphaZc of a ThreeParameter
+// EOS.
+type AlphaZcDerivative interface {
+	AlphaZcDerivatives(tr, w, zc float64) (a, da, d2a float64) //α, dα/dTr, d²α/dTr²
+}
+
+// AlphaDerivatives returns α(Tr, ω) of f and its first and second derivatives
+// with respect to Tr, from f.AlphaDerivatives if f implements AlphaDerivative
+// and by central differences otherwise.
+func AlphaDerivatives(f AlphaFunction, tr, w float64) (a, da, d2a float64) {
+	if d, ok := f.(AlphaDerivative); ok {
+		return d.AlphaDerivatives(tr, w)
+	}
+	return centralDifferences(func(tr float64) float64 { return f.Alpha(tr, w) }, tr)
+}
+
+// centralDifferences returns alpha(tr) and its first and second derivatives by
+// central differences.
+func centralDifferences(alpha func(tr float64) float64, tr float64) (a, da, d2a float64) {
+	h := 1e-4 * tr
+	lo, a, hi := alpha(tr-h), alpha(tr), alpha(tr+h)
+	return a, (hi - lo) / (2 * h), (hi - 2*a + lo) / (h * h)
+}
+
+// soaveDerivatives returns the Soave alpha α = [1 + m(1 - √Tr)]² and its first
+// and second derivatives with respect to Tr.
+func soaveDerivatives(m, tr float64) (a, da, d2a float64) {
+	sq := math.Sqrt(tr)
+	c := 1 + m*(1-sq)
+	return c * c, -m * c / sq, m * (m + c/sq) / (2 * tr)
+}
+
+// Twu is the three-parameter alpha function of Twu et al. (1991),
+//
+//	α(Tr) = Tr^(N(M-1)) exp[L(1 - Tr^(NM))]
+//
+// L, M and N are fitted to the vapor pressures of each substance and replace
+// the acentric factor, which is ignored. Fitted constants reproduce vapor
+// pressures down to the triple point more closely than the Soave form, and the
+// constants differ between SRK and PR.
+type Twu struct {
+	L, M, N float64
+}
+
+// Alpha evaluates α(Tr) = Tr^(N(M-1)) exp[L(1 - Tr^(NM))]. ω is ignored.
+func (t *Twu) Alpha(tr, w float64) float64 {
+	return math.Pow(tr, t.N*(t.M-1)) * math.Exp(t.L*(1-math.Pow(tr, t.N*t.M)))
+}
+
+// AlphaDerivatives evaluates α and its derivatives from those of
+//
+//	ln α = N(M-1) ln Tr + L(1 - Tr^(NM))
+func (t *Twu) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	p, q := t.N*(t.M-1), t.N*t.M
+	tq := math.Pow(tr, q)
+	a = math.Pow(tr, p) * math.Exp(t.L*(1-tq))
+	g := (p - t.L*q*tq) / tr                // d ln α/dTr
+	dg := -(p + t.L*q*(q-1)*tq) / (tr * tr) // d² ln α/dTr²
+	return a, a * g, a * (g*g + dg)
+}
+
+// PRSV is the alpha function of Stryjek and Vera (1986) for Peng-Robinson,
+//
+//	α(Tr) = [1 + κ(1 - √Tr)]², κ = κ0 + κ1 (1 + √Tr)(0.7 - Tr)
+//	κ0 = 0.378893 + 1.4897153ω - 0.17131848ω² + 0.0196554ω³
+//
+// Kappa1 is fitted to the vapor pressures of each substance, which PRSV then
+// reproduces down to Tr = 0.3, including those of polar compounds. As Stryjek
+// and Vera recommend, κ1 is dropped above Tr = 0.7.
+type PRSV struct {
+	Kappa1 float64 //κ1
+}
+
+// Alpha evaluates α(Tr, ω) of PRSV.
+func (p *PRSV) Alpha(tr, w float64) float64 {
+	k := 0.378893 + w*(1.4897153+w*(-0.17131848+w*0.0196554))
+	sq := math.Sqrt(tr)
+	if tr < 0.7 {
+		k += p.Kappa1 * (1 + sq) * (0.7 - tr)
+	}
+	c := 1 + k*(1-sq)
+	return c * c
+}
+
+// AlphaDerivatives evaluates α(Tr, ω) of PRSV and its derivatives, which,
+// like κ, are continuous at Tr = 0.7 only in α itself.
+func (p *PRSV) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	k := 0.378893 + w*(1.4897153+w*(-0.17131848+w*0.0196554))
+	sq := math.Sqrt(tr)
+	var dk, d2k float64
+	if tr < 0.7 {
+		k += p.Kappa1 * (1 + sq) * (0.7 - tr)
+		dk = p.Kappa1 * ((0.7-tr)/(2*sq) - (1 + sq))
+		d2k = -p.Kappa1 * ((0.7-tr)/(4*tr*sq) + 1/sq)
+	}
+	c := 1 + k*(1-sq)
+	dc := dk*(1-sq) - k/(2*sq)
+	d2c := d2k*(1-sq) - dk/sq + k/(4*tr*sq)
+	return c * c, 2 * c * dc, 2 * (dc*dc + c*d2c)
+}
+
+// CustomAlpha is the equation of state Base with its alpha function replaced by
+// Func, e.g. Peng-Robinson with the Twu alpha:
+//
+//	eos := &cubic.CustomAlpha{Base: &cubic.PR{}, Func: &cubic.Twu{L: 0.164, M: 0.841, N: 2}}
+//
+// Base should be a two-parameter equation such as SRK or PR; CustomAlpha does
+// not implement ThreeParameter, so a three-parameter Base is evaluated at its
+// default Zc. In a MixtureCfg, Component.Alpha replaces Func for individual
+// components.
+type CustomAlpha struct {
+	Base EOSType       // Equation of state providing σ, ε, Ω and Ψ
+	Func AlphaFunction // Temperature dependence of a(T)
+}
+
+// Alpha evaluates Func.
+func (c *CustomAlpha) Alpha(tr, w float64) float64 {
+	return c.Func.Alpha(tr, w)
+}
+
+// AlphaDerivatives evaluates the derivatives of Func (see AlphaDerivatives).
+func (c *CustomAlpha) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return AlphaDerivatives(c.Func, tr, w)
+}
+
+// Params returns the parameters of Base.
+func (c *CustomAlpha) Params() *Params {
+	return c.Base.Params()
+}
@@ -0,0 +1,161 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// propaneTwu are Twu constants for propane with PR, fitted to the normal boiling
+// point and to the vapor pressure at Tr = 0.7 implied by ω.
+var propaneTwu = &cubic.Twu{L: 0.16394, M: 0.84095, N: 2}
+
+func TestTwuAlpha(t *testing.T) {
+	tests := []struct {
+		name string
+		twu  cubic.Twu
+		tr   float64
+		want float64
+	}{
+		{"critical point", *propaneTwu, 1, 1},
+		{"exponential", cubic.Twu{L: 0.5, M: 1, N: 1}, 0.5, math.Exp(0.25)},
+		{"power law", cubic.Twu{L: 0, M: 0.5, N: 2}, 0.25, 4},
+	}
+	for _, tt := range tests {
+		if got := tt.twu.Alpha(tt.tr, 0.3); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("%s: Alpha(%v) = %v, want %v", tt.name, tt.tr, got, tt.want)
+		}
+	}
+}
+
+func TestPRSVAlpha(t *testing.T) {
+	const w = 0.344 // Water
+	prsv := &cubic.PRSV{Kappa1: -0.06635}
+	if got := prsv.Alpha(1, w); math.Abs(got-1) > 1e-12 {
+		t.Errorf("Alpha(1) = %v, want 1", got)
+	}
+	k0 := 0.378893 + 1.4897153*w - 0.17131848*w*w + 0.0196554*w*w*w
+	tests := []struct {
+		tr, kappa float64
+	}{
+		{0.5, k0 - 0.06635*(1+math.Sqrt(0.5))*0.2},
+		{0.9, k0}, // κ1 is dropped above Tr = 0.7
+	}
+	for _, tt := range tests {
+		c := 1 + tt.kappa*(1-math.Sqrt(tt.tr))
+		if got := prsv.Alpha(tt.tr, w); math.Abs(got-c*c) > 1e-12 {
+			t.Errorf("Alpha(%v) = %v, want %v", tt.tr, got, c*c)
+		}
+	}
+}
+
+// powerAlpha is an alpha function without analytic derivatives.
+type powerAlpha struct{}
+
+func (powerAlpha) Alpha(tr, w float64) float64 { return math.Pow(tr, -2) }
+
+func TestAlphaDerivatives(t *testing.T) {
+	const w = 0.3
+	tests := []struct {
+		name string
+		f    cubic.AlphaFunction
+	}{
+		{"vdW", &cubic.VdW{}},
+		{"RK", &cubic.RK{}},
+		{"SRK", &cubic.SRK{}},
+		{"PR", &cubic.PR{}},
+		{"PatelTeja", &cubic.PatelTeja{}},
+		{"RKPR", &cubic.RKPR{}},
+		{"Twu", propaneTwu},
+		{"PRSV", &cubic.PRSV{Kappa1: -0.06635}},
+		{"CustomAlpha", &cubic.CustomAlpha{Base: &cubic.SRK{}, Func: powerAlpha{}}},
+		{"fallback", powerAlpha{}},
+	}
+	for _, tt := range tests {
+		for _, tr := range []float64{0.45, 0.8, 1.3} {
+			a, da, d2a := cubic.AlphaDerivatives(tt.f, tr, w)
+			h := 1e-3 * tr
+			lo, hi := tt.f.Alpha(tr-h, w), tt.f.Alpha(tr+h, w)
+			wantDa := (hi - lo) / (2 * h)
+			wantD2a := (hi - 2*a + lo) / (h * h)
+			if want := tt.f.Alpha(tr, w); a != want {
+				t.Errorf("%s: AlphaDerivatives(%v) α = %v, want %v", tt.name, tr, a, want)
+			}
+			if math.Abs(da-wantDa) > 1e-5*math.Max(1, math.Abs(wantDa)) {
+				t.Errorf("%s: AlphaDerivatives(%v) dα/dTr = %v, want %v", tt.name, tr, da, wantDa)
+			}
+			if math.Abs(d2a-wantD2a) > 1e-4*math.Max(1, math.Abs(wantD2a)) {
+				t.Errorf("%s: AlphaDerivatives(%v) d²α/dTr² = %v, want %v", tt.name, tr, d2a, wantD2a)
+			}
+		}
+	}
+}
+
+func TestAlphaZcDerivatives(t *testing.T) {
+	const w, zc = 0.152, 0.276
+	for _, eos := range []cubic.ThreeParameter{&cubic.PatelTeja{}, &cubic.RKPR{}} {
+		d := eos.(cubic.AlphaZcDerivative)
+		tr := 0.7
+		a, da, _ := d.AlphaZcDerivatives(tr, w, zc)
+		h := 1e-4
+		want := (eos.AlphaZc(tr+h, w, zc) - eos.AlphaZc(tr-h, w, zc)) / (2 * h)
+		if a != eos.AlphaZc(tr, w, zc) || math.Abs(da-want) > 1e-6 {
+			t.Errorf("%s: AlphaZcDerivatives(%v) = %v, %v, want %v, %v", cubic.Name(eos), tr, a, da, eos.AlphaZc(tr, w, zc), want)
+		}
+	}
+}
+
+func TestCustomAlphaSaturation(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T = 231.1 // Normal boiling point of propane
+	custom := &cubic.CustomAlpha{Base: &cubic.PR{}, Func: propaneTwu}
+	if got := cubic.Name(custom); got != "PR-Twu" {
+		t.Errorf("Name() = %q, want %q", got, "PR-Twu")
+	}
+
+	psat := func(eos cubic.EOSType) float64 {
+		cfg := &cubic.EOSCfg{Type: eos, T: T, P: 1, Tc: 369.8, Pc: 42.48, Acentric: 0.152, R: R}
+		p, err := cubic.SaturationPressure(cfg, T)
+		if err != nil {
+			t.Fatalf("SaturationPressure() unexpected error: %v", err)
+		}
+		return p
+	}
+	got, pr := psat(custom), psat(&cubic.PR{})
+	if math.Abs(got-zfactor.AtmBar)/zfactor.AtmBar > 1e-3 || math.Abs(got-zfactor.AtmBar) >= math.Abs(pr-zfactor.AtmBar) {
+		t.Errorf("SaturationPressure() = %.5f bar, want within 0.1%% of %.5f bar and closer than PR (%.5f)", got, zfactor.AtmBar, pr)
+	}
+
+	// The Soave alpha of PR through CustomAlpha is PR itself.
+	if got := psat(&cubic.CustomAlpha{Base: &cubic.PR{}, Func: &cubic.PR{}}); got != pr {
+		t.Errorf("SaturationPressure() = %v, want PR %v", got, pr)
+	}
+}
+
+func TestComponentAlpha(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T, P = 231.1, 1.0
+	propane := cubic.Component{Tc: 369.8, Pc: 42.48, Acentric: 0.152, Alpha: propaneTwu}
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, T, P, []float64{1}, []cubic.Component{propane}, R)
+	pure := &cubic.EOSCfg{
+		Type: &cubic.CustomAlpha{Base: &cubic.PR{}, Func: propaneTwu},
+		T:    T, P: P, Tc: propane.Tc, Pc: propane.Pc, Acentric: propane.Acentric, R: R,
+	}
+
+	got, err := cubic.SolveForVolume(mix)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	want, err := cubic.SolveForVolume(pure)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	vg, _ := got.Root(phase.Liquid)
+	vw, _ := want.Root(phase.Liquid)
+	if got.A != want.A || math.Abs(vg-vw) > 1e-9*vw {
+		t.Errorf("SolveForVolume() a = %v, V = %v, want a = %v, V = %v", got.A, vg, want.A, vw)
+	}
+}
@@ -0,0 +1,122 @@
+package cubic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Equilibrium is a vapor-liquid equilibrium state of a mixture.
+type Equilibrium struct {
+	T       float64   // Temperature
+	P       float64   // Pressure
+	X       []float64 // Liquid mole fractions
+	Y       []float64 // Vapor mole fractions
+	K       []float64 // Equilibrium ratios yᵢ/xᵢ
+	ZLiquid float64   // Compressibility factor of the liquid
+	ZVapor  float64   // Compressibility factor of the vapor
+}
+
+const (
+	bubbleTol  = 1e-10
+	bubbleIter = 200
+	// trivialTol is the relative difference of Z below which the liquid and vapor
+	// roots are taken to be the same phase.
+	trivialTol = 1e-6
+)
+
+// errTrivial is returned when the phases of an equilibrium iteration collapse
+// onto the same root of the EOS, e.g. above the critical point of the mixture.
+var errTrivial = errors.New("vapor and liquid converged to the same phase (trivial solution)")
+
+// BubblePointP calculates the bubble-point pressure of the liquid with the
+// composition cfg.Y at temperature T, together with the composition of the first
+// bubble of vapor. cfg.P is not used.
+//
+// Starting from Raoult's law with Wilson vapor pressures, the pressure and vapor
+// composition are updated from the equal-fugacity condition yᵢ φ̂ᵢⱽ = xᵢ φ̂ᵢᴸ,
+//
+//	Kᵢ = φ̂ᵢᴸ/φ̂ᵢⱽ,  P ← P Σ Kᵢxᵢ,  yᵢ = Kᵢxᵢ / Σ Kⱼxⱼ
+//
+// until Σ Kᵢxᵢ = 1.
+func BubblePointP(cfg *MixtureCfg, T float64) (*Equilibrium, error) {
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	x := cfg.Y
+	liq, vap := cfg.At(T, cfg.P), cfg.At(T, cfg.P)
+
+	// Raoult's law with Wilson vapor pressures: P = Σ xᵢ Psatᵢ.
+	P := 0.0
+	for i, c := range cfg.Components {
+		P += x[i] * wilsonK(c, T, 1)
+	}
+	y := make([]float64, len(x))
+	for i, c := range cfg.Components {
+		y[i] = x[i] * wilsonK(c, T, P)
+	}
+	normalize(y)
+
+	for range bubbleIter {
+		liq.P, vap.P = P, P
+		vap.Y = y
+		zl, phiL, err := PhaseLogPhi(liq, phase.Liquid)
+		if err != nil {
+			return nil, fmt.Errorf("liquid at P = %g: %w", P, err)
+		}
+		zv, phiV, err := PhaseLogPhi(vap, phase.Vapor)
+		if err != nil {
+			return nil, fmt.Errorf("vapor at P = %g: %w", P, err)
+		}
+
+		if math.Abs(zv-zl) < trivialTol*zv {
+			return nil, errTrivial
+		}
+
+		K := make([]float64, len(x))
+		sum := 0.0
+		for i := range x {
+			K[i] = math.Exp(phiL[i] - phiV[i])
+			sum += K[i] * x[i]
+		}
+
+		next := make([]float64, len(x))
+		change := 0.0
+		for i := range x {
+			next[i] = K[i] * x[i] / sum
+			change = math.Max(change, math.Abs(next[i]-y[i]))
+		}
+		if math.Abs(sum-1) < bubbleTol && change < bubbleTol {
+			return &Equilibrium{
+				T:       T,
+				P:       P,
+				X:       append([]float64(nil), x...),
+				Y:       next,
+				K:       K,
+				ZLiquid: zl,
+				ZVapor:  zv,
+			}, nil
+		}
+		P *= sum
+		y = next
+	}
+	return nil, fmt.Errorf("bubble-point iteration did not converge in %d iterations", bubbleIter)
+}
+
+// normalize scales v so that its elements sum to 1.
+func normalize(v []float64) {
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	for i := range v {
+		v[i] /= sum
+	}
+}
@@ -0,0 +1,70 @@
+package cubic_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+var (
+	propane = cubic.Component{Tc: 369.8, Pc: 42.48, Acentric: 0.152}
+	butane  = cubic.Component{Tc: 425.1, Pc: 37.96, Acentric: 0.200}
+)
+
+func TestBubblePointPPure(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, []float64{1}, []cubic.Component{propane}, R)
+	got, err := cubic.BubblePointP(mix, 300)
+	if err != nil {
+		t.Fatalf("BubblePointP() unexpected error: %v", err)
+	}
+	want, err := cubic.SaturationPressure(cubic.NewPRCfg(300, 1, propane.Tc, propane.Pc, propane.Acentric, R), 300)
+	if err != nil {
+		t.Fatalf("SaturationPressure() unexpected error: %v", err)
+	}
+	if math.Abs(got.P-want) > 1e-4*want {
+		t.Errorf("BubblePointP().P = %v, want Psat = %v", got.P, want)
+	}
+}
+
+func TestBubblePointPBinary(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T = 300.0
+	x := []float64{0.5, 0.5}
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, x, []cubic.Component{propane, butane}, R)
+	got, err := cubic.BubblePointP(mix, T)
+	if err != nil {
+		t.Fatalf("BubblePointP() unexpected error: %v", err)
+	}
+
+	var pSat [2]float64
+	for i, c := range mix.Components {
+		if pSat[i], err = cubic.SaturationPressure(cubic.NewPRCfg(T, 1, c.Tc, c.Pc, c.Acentric, R), T); err != nil {
+			t.Fatalf("SaturationPressure() unexpected error: %v", err)
+		}
+	}
+	// Propane and n-butane form a nearly ideal solution, so Raoult's law holds closely.
+	if raoult := x[0]*pSat[0] + x[1]*pSat[1]; math.Abs(got.P-raoult) > 0.05*raoult {
+		t.Errorf("BubblePointP().P = %v, want about %v", got.P, raoult)
+	}
+	if y := got.Y; math.Abs(y[0]+y[1]-1) > 1e-9 || y[0] <= x[0] {
+		t.Errorf("BubblePointP().Y = %v, want normalized and enriched in propane", y)
+	}
+	if !(got.ZLiquid < got.ZVapor) {
+		t.Errorf("BubblePointP() ZLiquid = %v, ZVapor = %v, want ZLiquid < ZVapor", got.ZLiquid, got.ZVapor)
+	}
+}
+
+func TestBubblePointPErrors(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, []float64{0.5, 0.5}, []cubic.Component{propane, butane}, R)
+	if _, err := cubic.BubblePointP(mix, 500); err == nil {
+		t.Error("BubblePointP() above the critical temperatures want error")
+	}
+	if _, err := cubic.BubblePointP(mix, 0); !errors.Is(err, zfactor.ErrTemp) {
+		t.Errorf("BubblePointP() error = %v, want %v", err, zfactor.ErrTemp)
+	}
+}
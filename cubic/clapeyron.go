@@ -0,0 +1,112 @@
+package cubic
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// errSupercritical is returned when a saturation property is requested at or above Tc.
+var errSupercritical = errors.New("no saturation state exists at or above the critical temperature")
+
+// SaturationResult contains the vapor-liquid equilibrium point computed from an EOS.
+type SaturationResult struct {
+	P  float64 // Saturation pressure
+	Vl float64 // Saturated liquid molar volume
+	Vv float64 // Saturated vapor molar volume
+}
+
+// Saturation computes the saturation pressure at temperature T together with the
+// saturated liquid and vapor molar volumes, all from the same EOS.
+//
+// Returns an error if T is at or above the critical temperature, where no
+// two-phase region exists.
+func Saturation(cfg *EOSCfg, T float64) (*SaturationResult, error) {
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if T >= cfg.Tc {
+		return nil, errSupercritical
+	}
+
+	pSat, err := SaturationPressure(cfg, T)
+	if err != nil {
+		return nil, err
+	}
+
+	satCfg := cfg.At(T, pSat)
+	volRes, err := SolveForVolume(satCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := volRes.Clean()
+	if len(roots) < 2 {
+		return nil, fmt.Errorf("expected liquid and vapor roots at T = %g, got %d real root(s)", T, len(roots))
+	}
+	vl, _ := phase.Root(roots, phase.Liquid)
+	vv, _ := phase.Root(roots, phase.Vapor)
+
+	return &SaturationResult{
+		P:  pSat,
+		Vl: vl,
+		Vv: vv,
+	}, nil
+}
+
+// DPsatDT calculates the slope of the saturation curve dPsat/dT at temperature T.
+//
+// The derivative is evaluated numerically from the EOS saturation pressure using a
+// fourth-order central difference:
+//
+//	dP/dT ≈ [P(T-2h) - 8P(T-h) + 8P(T+h) - P(T+2h)] / 12h
+//
+// The result has units of cfg pressure per Kelvin.
+func DPsatDT(cfg *EOSCfg, T float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+
+	// Keep the stencil inside the two-phase region.
+	h := 1e-3 * T
+	if T+2*h >= cfg.Tc {
+		h = (cfg.Tc - T) / 4
+	}
+	if h <= 0 {
+		return 0, errSupercritical
+	}
+
+	var p [4]float64
+	for i, dt := range [4]float64{-2 * h, -h, h, 2 * h} {
+		pSat, err := SaturationPressure(cfg, T+dt)
+		if err != nil {
+			return 0, err
+		}
+		p[i] = pSat
+	}
+
+	return (p[0] - 8*p[1] + 8*p[2] - p[3]) / (12 * h), nil
+}
+
+// HvapEOS calculates the latent heat of vaporization at temperature T using
+// the Clapeyron equation with the saturated volumes computed from the EOS:
+//
+//	ΔHlv = T * (Vv - Vl) * dPsat/dT
+//
+// The result has units of pressure × volume per mole in the units of cfg.R
+// (e.g. bar·cm³/mol when R = 83.14; multiply by 0.1 for J/mol).
+func HvapEOS(cfg *EOSCfg, T float64) (float64, error) {
+	sat, err := Saturation(cfg, T)
+	if err != nil {
+		return 0, err
+	}
+
+	dPdT, err := DPsatDT(cfg, T)
+	if err != nil {
+		return 0, err
+	}
+
+	return T * (sat.Vv - sat.Vl) * dPdT, nil
+}
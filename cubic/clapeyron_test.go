@@ -0,0 +1,49 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestHvapEOS(t *testing.T) {
+	// Propane (Tc = 369.8 K, Pc = 42.48 bar, ω = 0.152) at its normal boiling point.
+	// The experimental latent heat is about 19.04 kJ/mol.
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewPRCfg(231.1, 1, 369.8, 42.48, 0.152, R)
+
+	h, err := cubic.HvapEOS(cfg, 231.1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// bar·cm³/mol -> J/mol
+	got := h * 0.1
+	if math.Abs(got-19040)/19040 > 0.05 {
+		t.Errorf("HvapEOS() = %.1f J/mol, want within 5%% of 19040 J/mol", got)
+	}
+}
+
+func TestDPsatDT(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewSRKCfg(300, 1, 369.8, 42.48, 0.152, R)
+
+	got, err := cubic.DPsatDT(cfg, 300)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Compare against a coarse central difference.
+	p1, _ := cubic.SaturationPressure(cfg, 299)
+	p2, _ := cubic.SaturationPressure(cfg, 301)
+	want := (p2 - p1) / 2
+	if math.Abs(got-want)/want > 1e-3 {
+		t.Errorf("DPsatDT() = %g, want %g", got, want)
+	}
+
+	if _, err := cubic.DPsatDT(cfg, 400); err == nil {
+		t.Errorf("expected an error above Tc")
+	}
+}
@@ -0,0 +1,180 @@
+package cubic
+
+import (
+	"errors"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/liquids"
+)
+
+// ImpliedAcentric back-calculates the acentric factor implied by the EOS vapor
+// pressure at a reduced temperature of 0.7, following Pitzer's definition:
+//
+//	ω = -1 - log10(Psat(Tr = 0.7) / Pc)
+//
+// For an EOS whose alpha function is well fitted to the substance, the returned
+// value should reproduce cfg.Acentric closely. Large differences indicate that the
+// alpha function does not capture the vapor pressure curve of the substance.
+func ImpliedAcentric(cfg *EOSCfg) (float64, error) {
+	if cfg.Pc <= 0 || cfg.Tc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+
+	pSat, err := SaturationPressure(cfg, 0.7*cfg.Tc)
+	if err != nil {
+		return 0, err
+	}
+
+	return -1 - math.Log10(pSat/cfg.Pc), nil
+}
+
+// Fluid holds the pure-component data needed to check an EOS against a substance.
+//
+// Units follow the substance database: Tc and Tn in Kelvin, Pc in bar and
+// Vc in cm³/mol.
+type Fluid struct {
+	Name     string
+	Tc       float64 // Critical temperature (K)
+	Pc       float64 // Critical pressure (bar)
+	Vc       float64 // Critical volume (cm³/mol)
+	Zc       float64 // Critical compressibility factor
+	Acentric float64 // Acentric factor
+	Tn       float64 // Normal boiling point (K)
+}
+
+// VsatDeviation compares the EOS saturated liquid volume against the Rackett
+// equation at a single reduced temperature.
+type VsatDeviation struct {
+	Tr        float64 // Reduced temperature
+	VEOS      float64 // Saturated liquid volume from the EOS (cm³/mol)
+	VRackett  float64 // Saturated liquid volume from the Rackett equation (cm³/mol)
+	Deviation float64 // 100 * (VEOS - VRackett) / VRackett
+}
+
+// Consistency is the result of checking an EOS against the stored data of a fluid.
+//
+// Deviations are percentages relative to the stored data, except for
+// AcentricDeviation which is an absolute difference. Fields that cannot be
+// computed because the fluid lacks the required data are set to NaN.
+type Consistency struct {
+	Fluid string // Name of the checked fluid
+	EOS   string // Name of the EOS type
+
+	ZcEOS       float64 // Critical compressibility factor implied by the EOS
+	ZcDeviation float64 // 100 * (ZcEOS - Zc) / Zc
+
+	PsatTn          float64 // EOS saturation pressure at the normal boiling point (bar)
+	PsatTnDeviation float64 // 100 * (PsatTn - 1 atm) / 1 atm
+
+	AcentricEOS       float64 // Acentric factor implied by the EOS (see ImpliedAcentric)
+	AcentricDeviation float64 // AcentricEOS - Acentric
+
+	Vsat        []VsatDeviation // Saturated liquid volume comparison over a Tr range
+	MaxVsatDev  float64         // Largest absolute Vsat deviation (%)
+	MeanVsatDev float64         // Mean absolute Vsat deviation (%)
+}
+
+// consistencyTr is the reduced temperature grid used for the Vsat comparison.
+var consistencyTr = []float64{0.5, 0.55, 0.6, 0.65, 0.7, 0.75, 0.8, 0.85, 0.9, 0.95}
+
+// ConsistencyReport compares the properties implied by an EOS against the stored
+// data of a fluid, helping to choose an EOS per substance. It reports:
+//
+//   - The critical compressibility factor Zc implied by the EOS versus the stored Zc.
+//   - The EOS saturation pressure at the normal boiling point versus 1 atm.
+//   - The implied acentric factor versus the stored acentric factor.
+//   - The EOS saturated liquid volume versus the Rackett equation for 0.5 <= Tr <= 0.95.
+//
+// Grid points where the saturation calculation does not converge are omitted from Vsat.
+func ConsistencyReport(fluid Fluid, eos EOSType) (*Consistency, error) {
+	if eos == nil {
+		return nil, errors.New("eos type cannot be nil")
+	}
+	if fluid.Tc <= 0 || fluid.Pc <= 0 {
+		return nil, zfactor.ErrCriticalProp
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+
+	cfg := &EOSCfg{
+		Type:     eos,
+		T:        fluid.Tc,
+		P:        fluid.Pc,
+		Tc:       fluid.Tc,
+		Pc:       fluid.Pc,
+		Acentric: fluid.Acentric,
+		Zc:       fluid.Zc,
+		R:        R,
+	}
+
+	rep := &Consistency{
+		Fluid:             fluid.Name,
+		EOS:               Name(eos),
+		ZcEOS:             math.NaN(),
+		ZcDeviation:       math.NaN(),
+		PsatTn:            math.NaN(),
+		PsatTnDeviation:   math.NaN(),
+		AcentricEOS:       math.NaN(),
+		AcentricDeviation: math.NaN(),
+		MaxVsatDev:        math.NaN(),
+		MeanVsatDev:       math.NaN(),
+	}
+
+	// 1. Critical compressibility factor
+	// At the critical point the cubic in Z has a triple root, so matching the Z²
+	// coefficient gives 3Zc = 1 + Ω(1 - σ - ε).
+	params, err := cfg.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	rep.ZcEOS = (1 + params.Omega*(1-params.Sigma-params.Epsilon)) / 3
+	if fluid.Zc > 0 {
+		rep.ZcDeviation = 100 * (rep.ZcEOS - fluid.Zc) / fluid.Zc
+	}
+
+	// 2. Vapor pressure at the normal boiling point
+	if fluid.Tn > 0 && fluid.Tn < fluid.Tc {
+		pSat, err := SaturationPressure(cfg, fluid.Tn)
+		if err == nil {
+			rep.PsatTn = pSat
+			rep.PsatTnDeviation = 100 * (pSat - zfactor.AtmBar) / zfactor.AtmBar
+		}
+	}
+
+	// 3. Acentric factor
+	if w, err := ImpliedAcentric(cfg); err == nil {
+		rep.AcentricEOS = w
+		rep.AcentricDeviation = w - fluid.Acentric
+	}
+
+	// 4. Saturated liquid volume against Rackett
+	if fluid.Vc > 0 && fluid.Zc > 0 {
+		var sumAbs, maxAbs float64
+		for _, tr := range consistencyTr {
+			sat, err := Saturation(cfg, tr*fluid.Tc)
+			if err != nil {
+				continue
+			}
+			vr, err := liquids.Vsat(fluid.Vc, fluid.Zc, tr)
+			if err != nil {
+				continue
+			}
+			dev := 100 * (sat.Vl - vr) / vr
+			rep.Vsat = append(rep.Vsat, VsatDeviation{
+				Tr:        tr,
+				VEOS:      sat.Vl,
+				VRackett:  vr,
+				Deviation: dev,
+			})
+			sumAbs += math.Abs(dev)
+			maxAbs = max(maxAbs, math.Abs(dev))
+		}
+		if len(rep.Vsat) > 0 {
+			rep.MaxVsatDev = maxAbs
+			rep.MeanVsatDev = sumAbs / float64(len(rep.Vsat))
+		}
+	}
+
+	return rep, nil
+}
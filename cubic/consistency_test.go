@@ -0,0 +1,67 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestImpliedAcentric(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const w = 0.152
+	cfg := cubic.NewPRCfg(300, 1, 369.8, 42.48, w, R)
+
+	got, err := cubic.ImpliedAcentric(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The PR alpha function is fitted to reproduce ω, so the implied value
+	// should be close to the input.
+	if math.Abs(got-w) > 0.02 {
+		t.Errorf("ImpliedAcentric() = %.4f, want close to %.4f", got, w)
+	}
+}
+
+func TestConsistencyReport(t *testing.T) {
+	propane := cubic.Fluid{
+		Name:     "Propane",
+		Tc:       369.8,
+		Pc:       42.48,
+		Vc:       200.0,
+		Zc:       0.276,
+		Acentric: 0.152,
+		Tn:       231.1,
+	}
+
+	rep, err := cubic.ConsistencyReport(propane, &cubic.PR{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rep.EOS != "PR" {
+		t.Errorf("EOS = %q, want %q", rep.EOS, "PR")
+	}
+	// Peng-Robinson has a universal Zc of about 0.307.
+	if math.Abs(rep.ZcEOS-0.307) > 0.005 {
+		t.Errorf("ZcEOS = %.4f, want about 0.307", rep.ZcEOS)
+	}
+	if math.Abs(rep.PsatTnDeviation) > 5 {
+		t.Errorf("PsatTnDeviation = %.2f%%, want within 5%%", rep.PsatTnDeviation)
+	}
+	if len(rep.Vsat) == 0 {
+		t.Fatalf("expected Vsat comparison points")
+	}
+
+	// Missing Vc and Zc must not fail the report.
+	propane.Vc, propane.Zc = 0, 0
+	rep, err = cubic.ConsistencyReport(propane, &cubic.SRK{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !math.IsNaN(rep.ZcDeviation) || len(rep.Vsat) != 0 {
+		t.Errorf("expected undefined Zc and Vsat deviations for incomplete data")
+	}
+}
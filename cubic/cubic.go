@@ -6,17 +6,24 @@
 //   - Redlich-Kwong (RK)
 //   - Soave-Redlich-Kwong (SRK)
 //   - Peng-Robinson (PR)
+//   - Redlich-Kwong-Peng-Robinson (RKPR), a three-parameter EOS
+//   - Patel-Teja (PatelTeja), a three-parameter EOS
+//
+// The alpha function of an equation can be replaced with CustomAlpha, e.g. by the
+// Twu alpha with constants fitted to a substance.
 //
 // The core function SolveForVolume computes the roots of the cubic polynomial
 // for specific conditions (T, P) and substance parameters (Tc, Pc, omega).
 package cubic
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"slices"
 
 	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/phase"
 )
 
 // Params represents the substance agnostic variables in any
@@ -34,29 +41,86 @@ type EOSType interface {
 	Params() *Params
 }
 
+// ThreeParameter is implemented by three-parameter equations of state whose
+// σ, ε, Ω, Ψ and α depend on the critical compressibility factor in addition to
+// Tc, Pc and ω (e.g. RK-PR).
+//
+// When the Type of an EOSCfg implements ThreeParameter, the solvers call ParamsZc
+// and AlphaZc with EOSCfg.Zc in place of Params and Alpha.
+type ThreeParameter interface {
+	EOSType
+	ParamsZc(zc float64) *Params       // Parameters for a substance with critical compressibility zc
+	AlphaZc(tr, w, zc float64) float64 //α(Tr, ω, Zc)
+}
+
 // VolumeResult contains the results of solving the cubic equation of state for volume.
 type VolumeResult struct {
-	A       float64       // The a(T) parameter value
-	B       float64       // The b parameter value
-	Volumes [3]complex128 // The roots of the cubic equation (molar volumes)
+	A       float64           // The a(T) parameter value
+	B       float64           // The b parameter value
+	Volumes [3]complex128     // The roots of the cubic equation (molar volumes)
+	Class   zfactor.RootClass // Number and multiplicity of the real roots
 }
 
 // Clean returns the real roots of the volume equation, sorted in ascending order.
 // The smallest root corresponds to the liquid phase, and the largest to the vapor phase.
+//
+// If Class is set, it decides which roots are real; otherwise roots with a
+// negligible imaginary part are treated as real.
 func (vr *VolumeResult) Clean() []float64 {
 	res := make([]float64, 0, 3)
-	for _, value := range vr.Volumes {
-		if math.Abs(imag(value)) < 1e-9 {
+	switch vr.Class {
+	case zfactor.OneRealRoot:
+		res = append(res, real(vr.Volumes[0]))
+	case zfactor.ThreeRealRoots, zfactor.DoubleRoot, zfactor.TripleRoot:
+		for _, value := range vr.Volumes {
 			res = append(res, real(value))
 		}
+	default:
+		for _, value := range vr.Volumes {
+			if math.Abs(imag(value)) < 1e-9 {
+				res = append(res, real(value))
+			}
+		}
 	}
 	slices.Sort(res)
 	return res
 }
 
+// Root returns the real root of the volume equation for phase p: the smallest
+// for the liquid and the largest for the vapor or a supercritical fluid. Roots
+// at or below the covolume B, which have no physical meaning, are not
+// considered.
+func (vr *VolumeResult) Root(p phase.Phase) (float64, error) {
+	return phase.Root(vr.physical(), p)
+}
+
+// physical returns the real roots of Clean above the covolume B.
+func (vr *VolumeResult) physical() []float64 {
+	return slices.DeleteFunc(vr.Clean(), func(v float64) bool { return v <= vr.B })
+}
+
+// Stable returns the real root with the lowest fugacity at cfg.T and cfg.P,
+// i.e. the stable phase. cfg must be the configuration the roots were solved for.
+func (vr *VolumeResult) Stable(cfg *EOSCfg) (float64, error) {
+	best, bestPhi := 0.0, math.Inf(1)
+	for _, v := range vr.physical() {
+		h, err := ResidualHelmholtz(cfg, v)
+		if err != nil {
+			return 0, err
+		}
+		if lnPhi := h.LogFugacity(); lnPhi < bestPhi {
+			best, bestPhi = v, lnPhi
+		}
+	}
+	if math.IsInf(bestPhi, 1) {
+		return 0, errors.New("no real volume roots found")
+	}
+	return best, nil
+}
+
 // String implements fmt.Stringer for VolumeResult.
 func (vr *VolumeResult) String() string {
-	return fmt.Sprintf("VolumeResult{A: %g, B: %g, Volumes: %v}", vr.A, vr.B, vr.Volumes)
+	return fmt.Sprintf("VolumeResult{A: %g, B: %g, Volumes: %v, Class: %v}", vr.A, vr.B, vr.Volumes, vr.Class)
 }
 
 // PressureResult contains the calculated pressure and intermediate parameters.
@@ -72,6 +136,12 @@ func (pr *PressureResult) String() string {
 }
 
 // EOSCfg holds the configuration and state variables for an Equation of State calculation.
+//
+// The functions of this package only read a configuration: solvers that need
+// other states, such as SaturationPressure, evaluate copies made with At. One
+// configuration may therefore be shared by goroutines as long as none of them
+// modifies it; a goroutine that changes T or P should work on its own copy
+// from Clone or At.
 type EOSCfg struct {
 	Type     EOSType // The type of cubic equation of state (e.g., VdW, RK, SRK, PR)
 	T        float64 // Absolute temperature
@@ -79,9 +149,48 @@ type EOSCfg struct {
 	Tc       float64 // Critical temperature
 	Pc       float64 // Critical pressure
 	Acentric float64 // Acentric factor (ω) - dimensionless
+	Zc       float64 // Critical compressibility factor, used by ThreeParameter types
 	R        float64 // Universal gas constant in consistent units
 }
 
+// Clone returns a copy of cfg. The EOS type is shared, as the types of this
+// package are not modified by the calculations.
+func (cfg *EOSCfg) Clone() *EOSCfg {
+	c := *cfg
+	return &c
+}
+
+// At returns a copy of cfg at temperature T and pressure P.
+func (cfg *EOSCfg) At(T, P float64) *EOSCfg {
+	c := cfg.Clone()
+	c.T, c.P = T, P
+	return c
+}
+
+// Parameters returns the EOS parameters (σ, ε, Ω, Ψ) for the configured substance.
+// For ThreeParameter types these depend on cfg.Zc. It returns an error if cfg.Type
+// is not set.
+func (cfg *EOSCfg) Parameters() (*Params, error) {
+	if cfg.Type == nil {
+		return nil, errNoType
+	}
+	if tp, ok := cfg.Type.(ThreeParameter); ok {
+		return tp.ParamsZc(cfg.Zc), nil
+	}
+	return cfg.Type.Params(), nil
+}
+
+// alpha evaluates α at the reduced temperature tr for the configured substance.
+func (cfg *EOSCfg) alpha(tr float64) float64 {
+	if tp, ok := cfg.Type.(ThreeParameter); ok {
+		return tp.AlphaZc(tr, cfg.Acentric, cfg.Zc)
+	}
+	return cfg.Type.Alpha(tr, cfg.Acentric)
+}
+
+// errNoType is returned by the solvers when the EOS type of a configuration is not set.
+var errNoType = errors.New("EOS type is not set")
+
 // calculateB calculates the b parameter
 func calculateB(omega, r, tc, pc float64) float64 {
 	return omega * r * tc / pc
@@ -92,56 +201,95 @@ func calculateA(psi, alpha, r, tc, pc float64) float64 {
 	return psi * alpha * r * r * tc * tc / pc
 }
 
-// SolveForVolume solves the cubic equation of state for molar volume given the configuration.
-// It returns the calculated parameters a and b, and the three roots of the cubic equation.
-// Returns an error if input parameters are invalid (e.g. non-positive temperature).
-func SolveForVolume(cfg *EOSCfg) (*VolumeResult, error) {
+// Config is an equation of state at a given temperature and pressure that the
+// solvers can evaluate: a pure substance (*EOSCfg) or a mixture (*MixtureCfg).
+type Config interface {
+	// coefficients returns a(T), b and the EOS parameters at the configured state.
+	coefficients() (*coefficients, error)
+}
+
+// coefficients holds the parameters of the generic cubic at one state.
+type coefficients struct {
+	T, P, R float64
+	A, B    float64 // a(T) and b
+	AT      float64 // T da/dT
+	Params  *Params
+}
+
+// coefficients implements Config for a pure substance.
+func (cfg *EOSCfg) coefficients() (*coefficients, error) {
+	if cfg.Type == nil {
+		return nil, errNoType
+	}
 	if cfg.T <= 0 {
 		return nil, zfactor.ErrTemp
 	}
-	if cfg.P <= 0 {
-		return nil, zfactor.ErrPressure
-	}
-
 	if cfg.Pc <= 0 || cfg.Tc <= 0 {
 		return nil, zfactor.ErrCriticalProp
 	}
-
 	if cfg.R <= 0 {
 		return nil, zfactor.ErrUniversalConst
 	}
 
 	tr := cfg.T / cfg.Tc
+	alpha, da, _ := cfg.alphaDerivatives(tr)
+	params, err := cfg.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	a := calculateA(params.Psi, alpha, cfg.R, cfg.Tc, cfg.Pc)
+	return &coefficients{
+		T:      cfg.T,
+		P:      cfg.P,
+		R:      cfg.R,
+		A:      a,
+		B:      calculateB(params.Omega, cfg.R, cfg.Tc, cfg.Pc),
+		AT:     a * tr * da / alpha,
+		Params: params,
+	}, nil
+}
 
-	alpha := cfg.Type.Alpha(tr, cfg.Acentric)
-
-	sigma := cfg.Type.Params().Sigma
-	epsilon := cfg.Type.Params().Epsilon
-	omega := cfg.Type.Params().Omega
-	psi := cfg.Type.Params().Psi
+// SolveForVolume solves the cubic equation of state for molar volume given the configuration.
+// It returns the calculated parameters a and b, and the three roots of the cubic equation.
+// Returns an error if input parameters are invalid (e.g. non-positive temperature).
+func SolveForVolume(cfg Config) (*VolumeResult, error) {
+	c, err := cfg.coefficients()
+	if err != nil {
+		return nil, err
+	}
+	if c.P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
 
-	a := calculateA(psi, alpha, cfg.R, cfg.Tc, cfg.Pc)
-	b := calculateB(omega, cfg.R, cfg.Tc, cfg.Pc)
+	sigma := c.Params.Sigma
+	epsilon := c.Params.Epsilon
+	a, b := c.A, c.B
 
 	//eV^3 + fV^2 + gV + h = 0
 	x := epsilon + sigma
 	y := epsilon * sigma
-	v_ig := cfg.R * cfg.T / cfg.P //oops
+	v_ig := c.R * c.T / c.P //oops
 
 	e := 1.0
 	f := b*(x-1) - v_ig
-	g := b*((y-x)*b-(x*v_ig)) + a/cfg.P
-	h := -y*b*b*(b+v_ig) - a*b/cfg.P
+	g := b*((y-x)*b-(x*v_ig)) + a/c.P
+	h := -y*b*b*(b+v_ig) - a*b/c.P
 
 	solution, err := zfactor.SolveCubic(e, f, g, h)
 	if err != nil {
 		return nil, fmt.Errorf("failed to solve cubic: %w", err)
 	}
 
+	class, err := zfactor.ClassifyCubic(e, f, g, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify cubic: %w", err)
+	}
+
 	return &VolumeResult{
 		A:       a,
 		B:       b,
 		Volumes: solution,
+		Class:   class,
 	}, nil
 
 }
@@ -149,34 +297,16 @@ func SolveForVolume(cfg *EOSCfg) (*VolumeResult, error) {
 // Pressure calculates the pressure for a given molar volume and configuration.
 // It returns the calculated pressure and parameters a and b.
 // Returns an error if input parameters are invalid.
-func Pressure(cfg *EOSCfg, volume float64) (*PressureResult, error) {
-	if cfg.T <= 0 {
-		return nil, zfactor.ErrTemp
-	}
-	if cfg.Pc <= 0 || cfg.Tc <= 0 {
-		return nil, zfactor.ErrCriticalProp
-	}
-
-	if cfg.R <= 0 {
-		return nil, zfactor.ErrUniversalConst
+func Pressure(cfg Config, volume float64) (*PressureResult, error) {
+	c, err := cfg.coefficients()
+	if err != nil {
+		return nil, err
 	}
-	tr := cfg.T / cfg.Tc
-
-	alpha := cfg.Type.Alpha(tr, cfg.Acentric)
-
-	sigma := cfg.Type.Params().Sigma
-	epsilon := cfg.Type.Params().Epsilon
-	omega := cfg.Type.Params().Omega
-	psi := cfg.Type.Params().Psi
-
-	a := calculateA(psi, alpha, cfg.R, cfg.Tc, cfg.Pc)
-	b := calculateB(omega, cfg.R, cfg.Tc, cfg.Pc)
-	v := volume
+	a, b := c.A, c.B
 
-	first := cfg.R * cfg.T / (v - b)
-	second := a / ((v + epsilon*b) * (v + sigma*b))
-
-	p := first - second
+	// P = RT/V (1 - V ∂α^r/∂V); the temperature derivatives are not needed.
+	r := residual(b/volume, a/(b*c.R*c.T), 0, 0, c.Params.Sigma, c.Params.Epsilon)
+	p := c.R * c.T / volume * (1 - r.ArV)
 
 	return &PressureResult{
 		A: a,
@@ -192,6 +322,11 @@ func (*VdW) Alpha(tr, w float64) float64 {
 	return 1.0
 }
 
+// AlphaDerivatives returns α = 1, which does not depend on temperature.
+func (*VdW) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return 1, 0, 0
+}
+
 func (*VdW) Params() *Params {
 	return &Params{
 		Sigma:   0,
@@ -221,6 +356,12 @@ func (*RK) Alpha(tr, w float64) float64 {
 	return 1 / math.Sqrt(tr)
 }
 
+// AlphaDerivatives returns α = Tr^(-1/2) and its derivatives.
+func (*RK) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	a = 1 / math.Sqrt(tr)
+	return a, -a / (2 * tr), 3 * a / (4 * tr * tr)
+}
+
 func (*RK) Params() *Params {
 	return &Params{
 		Sigma:   1,
@@ -253,6 +394,11 @@ func (*SRK) Alpha(tr, w float64) float64 {
 	return c * c
 }
 
+// AlphaDerivatives returns α(Tr, ω) of SRK and its derivatives.
+func (*SRK) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return soaveDerivatives(0.480+1.574*w-0.716*w*w, tr)
+}
+
 func (*SRK) Params() *Params {
 	return &Params{
 		Sigma:   1,
@@ -285,6 +431,11 @@ func (*PR) Alpha(tr, w float64) float64 {
 	return c * c
 }
 
+// AlphaDerivatives returns α(Tr, ω) of PR and its derivatives.
+func (*PR) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return soaveDerivatives(0.37464+1.54226*w-0.26992*w*w, tr)
+}
+
 func (*PR) Params() *Params {
 	return &Params{
 		Sigma:   1 + math.Sqrt2,
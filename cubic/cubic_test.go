@@ -0,0 +1,81 @@
+package cubic_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+func TestEOSCfgClone(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewPRCfg(300, 10, 369.8, 42.48, 0.152, R)
+	c := cfg.At(250, 5)
+	if c.T != 250 || c.P != 5 || c.Tc != cfg.Tc || c.Type != cfg.Type {
+		t.Errorf("At() = %+v, want %+v at T = 250, P = 5", c, cfg)
+	}
+	c.Tc = 400
+	if cfg.T != 300 || cfg.P != 10 || cfg.Tc != 369.8 {
+		t.Errorf("modifying the copy changed the original: %+v", cfg)
+	}
+}
+
+func TestSharedCfgConcurrent(t *testing.T) {
+	// Propane with PR, evaluated by many goroutines from one configuration.
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewPRCfg(300, 10, 369.8, 42.48, 0.152, R)
+	temps := []float64{230, 260, 290, 320, 350}
+	want := make([]float64, len(temps))
+	for i, T := range temps {
+		p, err := cubic.SaturationPressure(cfg, T)
+		if err != nil {
+			t.Fatalf("SaturationPressure(%v) unexpected error: %v", T, err)
+		}
+		want[i] = p
+	}
+
+	var wg sync.WaitGroup
+	got := make([][]float64, 8)
+	for g := range got {
+		got[g] = make([]float64, len(temps))
+		wg.Go(func() {
+			for i, T := range temps {
+				got[g][i], _ = cubic.SaturationPressure(cfg, T)
+				if _, err := cubic.HvapEOS(cfg, T); err != nil {
+					t.Errorf("HvapEOS(%v) unexpected error: %v", T, err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	for g := range got {
+		for i := range temps {
+			if got[g][i] != want[i] {
+				t.Errorf("SaturationPressure(%v) in goroutine %d = %v, want %v", temps[i], g, got[g][i], want[i])
+			}
+		}
+	}
+	if cfg.T != 300 || cfg.P != 10 {
+		t.Errorf("solvers modified the shared configuration: T = %v, P = %v", cfg.T, cfg.P)
+	}
+}
+
+func TestVolumeResultRootCovolume(t *testing.T) {
+	// Roots at or below the covolume are not physical and are never selected.
+	vr := &cubic.VolumeResult{
+		B:       34.2,
+		Volumes: [3]complex128{-46.09, -0.95, 137.55},
+		Class:   zfactor.ThreeRealRoots,
+	}
+	for _, p := range []phase.Phase{phase.Liquid, phase.Vapor} {
+		if got, err := vr.Root(p); err != nil || got != 137.55 {
+			t.Errorf("Root(%v) = %v, %v, want 137.55", p, got, err)
+		}
+	}
+	vr.Volumes = [3]complex128{-46.09, -0.95, 20}
+	if _, err := vr.Root(phase.Liquid); err == nil {
+		t.Error("Root() without a root above the covolume expected an error")
+	}
+}
@@ -0,0 +1,72 @@
+package cubic
+
+import (
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// ResidualEnthalpy returns the enthalpy departure H^R = H - H^ig of the phase
+// with compressibility factor Z at cfg.T and cfg.P, in the units of R × K. Z is
+// usually a root of SolveForVolume; cfg may be a pure substance or a mixture.
+// For the generic cubic,
+//
+//	H^R/RT = Z - 1 + (T da/dT - a)/(bRT) · I
+//
+// with β = bP/(RT) and I = ln((Z + σβ)/(Z + εβ))/(σ - ε), or β/Z for van der
+// Waals. It equals Helmholtz.ResidualEnthalpy at the volume V = ZRT/P.
+func ResidualEnthalpy(cfg Config, Z float64) (float64, error) {
+	c, err := cfg.coefficients()
+	if err != nil {
+		return 0, err
+	}
+	_, I, err := c.departure(Z)
+	if err != nil {
+		return 0, err
+	}
+	RT := c.R * c.T
+	qT := (c.AT - c.A) / (c.B * RT) // T dq/dT with q = a/(bRT)
+	return RT * (Z - 1 + qT*I), nil
+}
+
+// ResidualEntropy returns the entropy departure S^R = S - S^ig of the phase with
+// compressibility factor Z at cfg.T and cfg.P, in the units of R. Z is usually a
+// root of SolveForVolume; cfg may be a pure substance or a mixture. For the
+// generic cubic,
+//
+//	S^R/R = ln(Z - β) + (T da/dT)/(bRT) · I
+//
+// with β and I as in ResidualEnthalpy. It equals Helmholtz.ResidualEntropy at the
+// volume V = ZRT/P.
+func ResidualEntropy(cfg Config, Z float64) (float64, error) {
+	c, err := cfg.coefficients()
+	if err != nil {
+		return 0, err
+	}
+	beta, I, err := c.departure(Z)
+	if err != nil {
+		return 0, err
+	}
+	return c.R * (math.Log(Z-beta) + c.AT/(c.B*c.R*c.T)*I), nil
+}
+
+// departure returns β = bP/(RT) and the integral I of the departure functions
+// for the phase with compressibility factor Z.
+func (c *coefficients) departure(Z float64) (beta, I float64, err error) {
+	if c.P <= 0 {
+		return 0, 0, zfactor.ErrPressure
+	}
+	beta = c.B * c.P / (c.R * c.T)
+	if Z <= beta {
+		return 0, 0, errCovolume
+	}
+	return beta, c.Params.integral(Z, beta), nil
+}
+
+// integral returns I = ln((Z + σβ)/(Z + εβ))/(σ - ε), or β/Z when σ = ε.
+func (p *Params) integral(Z, beta float64) float64 {
+	if diff := p.Sigma - p.Epsilon; math.Abs(diff) >= 1e-9 {
+		return math.Log((Z+p.Sigma*beta)/(Z+p.Epsilon*beta)) / diff
+	}
+	return beta / Z
+}
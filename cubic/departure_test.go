@@ -0,0 +1,131 @@
+package cubic_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+func TestDepartureFunctions(t *testing.T) {
+	// n-butane vapor at 350 K, 5 bar
+	cfgs := []*cubic.EOSCfg{
+		cubic.NewvdWCfg(350, 5, 425.1, 37.96, 83.14),
+		cubic.NewRKCfg(350, 5, 425.1, 37.96, 83.14),
+		cubic.NewSRKCfg(350, 5, 425.1, 37.96, 0.200, 83.14),
+		cubic.NewPRCfg(350, 5, 425.1, 37.96, 0.200, 83.14),
+		cubic.NewRKPRCfg(350, 5, 425.1, 37.96, 0.274, 0.200, 83.14),
+	}
+	for _, cfg := range cfgs {
+		h, _ := vaporState(t, cfg)
+		got, err := cubic.ResidualEnthalpy(cfg, h.Z())
+		if err != nil {
+			t.Fatalf("ResidualEnthalpy(%T) unexpected error: %v", cfg.Type, err)
+		}
+		if want := h.ResidualEnthalpy(); math.Abs(got-want) > 1e-6*math.Abs(want) {
+			t.Errorf("ResidualEnthalpy(%T) = %v, want %v", cfg.Type, got, want)
+		}
+
+		got, err = cubic.ResidualEntropy(cfg, h.Z())
+		if err != nil {
+			t.Fatalf("ResidualEntropy(%T) unexpected error: %v", cfg.Type, err)
+		}
+		if want := h.ResidualEntropy(); math.Abs(got-want) > 1e-6*math.Abs(want) {
+			t.Errorf("ResidualEntropy(%T) = %v, want %v", cfg.Type, got, want)
+		}
+	}
+}
+
+func TestDepartureFunctionsMixture(t *testing.T) {
+	k := cubic.TempKij{A: 0.01, B: 1e-4, C: -2}
+	tests := []struct {
+		name string
+		set  func(m *cubic.MixtureCfg)
+	}{
+		{"constant Kij", func(m *cubic.MixtureCfg) { m.Kij = [][]float64{{0, 0.01}, {0.01, 0}} }},
+		{"temperature-dependent KijT", func(m *cubic.MixtureCfg) { m.KijT = [][]cubic.TempKij{{{}, k}, {k, {}}} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) { testDepartureFunctionsMixture(t, tt.set) })
+	}
+}
+
+// testDepartureFunctionsMixture checks the departure functions of a
+// propane/n-butane vapor, with the interaction parameters set by set, against
+// the temperature derivative of the fugacity coefficients.
+func testDepartureFunctionsMixture(t *testing.T, set func(m *cubic.MixtureCfg)) {
+	const R = 10 * zfactor.RSI
+	const T, P = 350.0, 10.0
+	y := []float64{0.3, 0.7}
+	comps := []cubic.Component{propane, butane}
+
+	mixAt := func(T float64) *cubic.MixtureCfg {
+		m := cubic.NewMixtureCfg(&cubic.PR{}, T, P, y, comps, R)
+		set(m)
+		return m
+	}
+	// lnPhi returns ln φ = Σ yᵢ ln φ̂ᵢ and Z of the vapor at temperature T.
+	lnPhi := func(T float64) (float64, float64) {
+		m := mixAt(T)
+		volRes, err := cubic.SolveForVolume(m)
+		if err != nil {
+			t.Fatalf("SolveForVolume() unexpected error: %v", err)
+		}
+		V, err := volRes.Root(phase.Vapor)
+		if err != nil {
+			t.Fatalf("Root() unexpected error: %v", err)
+		}
+		Z := P * V / (R * T)
+		phi, err := cubic.ComponentLogPhi(m, Z)
+		if err != nil {
+			t.Fatalf("ComponentLogPhi() unexpected error: %v", err)
+		}
+		var sum float64
+		for i := range y {
+			sum += y[i] * phi[i]
+		}
+		return sum, Z
+	}
+
+	_, Z := lnPhi(T)
+	got, err := cubic.ResidualEnthalpy(mixAt(T), Z)
+	if err != nil {
+		t.Fatalf("ResidualEnthalpy() unexpected error: %v", err)
+	}
+
+	// H^R = -RT² (∂ ln φ/∂T) at constant P and composition.
+	const h = 1e-3
+	hi, _ := lnPhi(T + h)
+	lo, _ := lnPhi(T - h)
+	want := -R * T * T * (hi - lo) / (2 * h)
+	if math.Abs(got-want) > 1e-5*math.Abs(want) {
+		t.Errorf("ResidualEnthalpy() = %v, want %v", got, want)
+	}
+
+	// S^R = (H^R - G^R)/T with G^R = RT ln φ.
+	gotS, err := cubic.ResidualEntropy(mixAt(T), Z)
+	if err != nil {
+		t.Fatalf("ResidualEntropy() unexpected error: %v", err)
+	}
+	phi, _ := lnPhi(T)
+	if wantS := (got - R*T*phi) / T; math.Abs(gotS-wantS) > 1e-6*math.Abs(wantS) {
+		t.Errorf("ResidualEntropy() = %v, want %v", gotS, wantS)
+	}
+}
+
+func TestDepartureFunctionsErrors(t *testing.T) {
+	cfg := cubic.NewPRCfg(350, 5, 425.1, 37.96, 0.200, 83.14)
+	if _, err := cubic.ResidualEnthalpy(cfg, 1e-6); err == nil {
+		t.Error("ResidualEnthalpy() with Z below the covolume want error")
+	}
+	if _, err := cubic.ResidualEntropy(cfg, 1e-6); err == nil {
+		t.Error("ResidualEntropy() with Z below the covolume want error")
+	}
+	cfg.P = 0
+	if _, err := cubic.ResidualEnthalpy(cfg, 0.9); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("ResidualEnthalpy() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+}
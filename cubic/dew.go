@@ -0,0 +1,107 @@
+package cubic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// DewPointT calculates the dew-point temperature of the vapor with the
+// composition cfg.Y at pressure P, together with the composition of the first
+// drop of liquid. cfg.T is not used.
+//
+// The temperature is first estimated from Wilson K-values. The liquid composition
+// and temperature are then updated from the equal-fugacity condition,
+//
+//	Kᵢ = φ̂ᵢᴸ/φ̂ᵢⱽ,  xᵢ = (yᵢ/Kᵢ) / Σ yⱼ/Kⱼ
+//
+// with a Newton step on 1/T for ln Σ yᵢ/Kᵢ = 0, taking the temperature
+// dependence of each Kᵢ from the Wilson correlation, until Σ yᵢ/Kᵢ = 1.
+func DewPointT(cfg *MixtureCfg, P float64) (*Equilibrium, error) {
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	y := cfg.Y
+	liq, vap := cfg.At(cfg.T, P), cfg.At(cfg.T, P)
+
+	// slope returns d ln Σ yᵢ/Kᵢ / d(1/T) for the Wilson correlation, with the
+	// components weighted by their liquid mole fractions x.
+	slope := func(x []float64) float64 {
+		var s float64
+		for i, c := range cfg.Components {
+			s += x[i] * 5.373 * (1 + c.Acentric) * c.Tc
+		}
+		return s
+	}
+
+	// Wilson estimate, starting from the mole-fraction average of Tc.
+	T := 0.0
+	for i, c := range cfg.Components {
+		T += y[i] * c.Tc
+	}
+	x := make([]float64, len(y))
+	for range bubbleIter {
+		sum := 0.0
+		for i, c := range cfg.Components {
+			x[i] = y[i] / wilsonK(c, T, P)
+			sum += x[i]
+		}
+		normalize(x)
+		step := math.Log(sum) / slope(x)
+		T = 1 / (1/T - step)
+		if math.Abs(step*T) < 1e-10 {
+			break
+		}
+	}
+
+	for range bubbleIter {
+		liq.T, vap.T = T, T
+		liq.Y = x
+		zl, phiL, err := PhaseLogPhi(liq, phase.Liquid)
+		if err != nil {
+			return nil, fmt.Errorf("liquid at T = %g: %w", T, err)
+		}
+		zv, phiV, err := PhaseLogPhi(vap, phase.Vapor)
+		if err != nil {
+			return nil, fmt.Errorf("vapor at T = %g: %w", T, err)
+		}
+
+		if math.Abs(zv-zl) < trivialTol*zv {
+			return nil, errTrivial
+		}
+
+		K := make([]float64, len(y))
+		sum := 0.0
+		for i := range y {
+			K[i] = math.Exp(phiL[i] - phiV[i])
+			sum += y[i] / K[i]
+		}
+
+		next := make([]float64, len(y))
+		change := 0.0
+		for i := range y {
+			next[i] = y[i] / K[i] / sum
+			change = math.Max(change, math.Abs(next[i]-x[i]))
+		}
+		if math.Abs(sum-1) < bubbleTol && change < bubbleTol {
+			return &Equilibrium{
+				T:       T,
+				P:       P,
+				X:       next,
+				Y:       append([]float64(nil), y...),
+				K:       K,
+				ZLiquid: zl,
+				ZVapor:  zv,
+			}, nil
+		}
+		T = 1 / (1/T - math.Log(sum)/slope(next))
+		x = next
+	}
+	return nil, fmt.Errorf("dew-point iteration did not converge in %d iterations", bubbleIter)
+}
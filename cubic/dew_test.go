@@ -0,0 +1,67 @@
+package cubic_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestDewPointTPure(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T = 300.0
+	pSat, err := cubic.SaturationPressure(cubic.NewPRCfg(T, 1, propane.Tc, propane.Pc, propane.Acentric, R), T)
+	if err != nil {
+		t.Fatalf("SaturationPressure() unexpected error: %v", err)
+	}
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, []float64{1}, []cubic.Component{propane}, R)
+	got, err := cubic.DewPointT(mix, pSat)
+	if err != nil {
+		t.Fatalf("DewPointT() unexpected error: %v", err)
+	}
+	if math.Abs(got.T-T) > 1e-3 {
+		t.Errorf("DewPointT().T = %v, want Tsat = %v", got.T, T)
+	}
+}
+
+func TestDewPointTBinary(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const P = 5.0
+	y := []float64{0.5, 0.5}
+	comps := []cubic.Component{propane, butane}
+	got, err := cubic.DewPointT(cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, y, comps, R), P)
+	if err != nil {
+		t.Fatalf("DewPointT() unexpected error: %v", err)
+	}
+	if x := got.X; math.Abs(x[0]+x[1]-1) > 1e-9 || x[1] <= y[1] {
+		t.Errorf("DewPointT().X = %v, want normalized and enriched in n-butane", x)
+	}
+
+	// The dew liquid at the dew temperature must have its bubble point at P, in
+	// equilibrium with the original vapor.
+	bub, err := cubic.BubblePointP(cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, got.X, comps, R), got.T)
+	if err != nil {
+		t.Fatalf("BubblePointP() unexpected error: %v", err)
+	}
+	if math.Abs(bub.P-P) > 1e-6*P {
+		t.Errorf("BubblePointP(DewPointT().X).P = %v, want %v", bub.P, P)
+	}
+	for i := range y {
+		if math.Abs(bub.Y[i]-y[i]) > 1e-6 {
+			t.Errorf("BubblePointP(DewPointT().X).Y[%d] = %v, want %v", i, bub.Y[i], y[i])
+		}
+	}
+}
+
+func TestDewPointTErrors(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 0, 0, []float64{0.5, 0.5}, []cubic.Component{propane, butane}, R)
+	if _, err := cubic.DewPointT(mix, 0); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("DewPointT() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	if _, err := cubic.DewPointT(mix, 100); err == nil {
+		t.Error("DewPointT() above the critical pressures want error")
+	}
+}
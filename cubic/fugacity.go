@@ -0,0 +1,33 @@
+package cubic
+
+import (
+	"math"
+
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// FugacityCoefficient returns the fugacity coefficient φ = f/P of the pure
+// substance at cfg.T and cfg.P. The volume equation is solved and the root of
+// phase p is used: the smallest for phase.Liquid, the largest for phase.Vapor or
+// phase.Supercritical, and the stable root, with the lowest φ, for
+// phase.Unknown.
+func FugacityCoefficient(cfg *EOSCfg, p phase.Phase) (float64, error) {
+	volRes, err := SolveForVolume(cfg)
+	if err != nil {
+		return 0, err
+	}
+	var V float64
+	if p == phase.Unknown {
+		V, err = volRes.Stable(cfg)
+	} else {
+		V, err = volRes.Root(p)
+	}
+	if err != nil {
+		return 0, err
+	}
+	h, err := ResidualHelmholtz(cfg, V)
+	if err != nil {
+		return 0, err
+	}
+	return math.Exp(h.LogFugacity()), nil
+}
@@ -0,0 +1,61 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+func TestFugacityCoefficient(t *testing.T) {
+	// n-butane at 350 K, at and above its PR vapor pressure.
+	const T, R = 350.0, 83.14
+	cfg := cubic.NewPRCfg(T, 1, 425.1, 37.96, 0.200, R)
+	pSat, err := cubic.SaturationPressure(cfg, T)
+	if err != nil {
+		t.Fatalf("SaturationPressure() unexpected error: %v", err)
+	}
+
+	cfg.P = pSat
+	liq, err := cubic.FugacityCoefficient(cfg, phase.Liquid)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient(liquid) unexpected error: %v", err)
+	}
+	vap, err := cubic.FugacityCoefficient(cfg, phase.Vapor)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient(vapor) unexpected error: %v", err)
+	}
+	if math.Abs(liq-vap) > 1e-5*vap {
+		t.Errorf("FugacityCoefficient() at Psat: liquid %v, vapor %v, want equal", liq, vap)
+	}
+
+	// Above Psat the liquid is stable and has the lower φ.
+	cfg.P = 1.5 * pSat
+	liq, _ = cubic.FugacityCoefficient(cfg, phase.Liquid)
+	vap, _ = cubic.FugacityCoefficient(cfg, phase.Vapor)
+	stable, err := cubic.FugacityCoefficient(cfg, phase.Unknown)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient(unknown) unexpected error: %v", err)
+	}
+	if !(liq < vap) || stable != liq {
+		t.Errorf("FugacityCoefficient() above Psat: liquid %v, vapor %v, stable %v, want stable = liquid < vapor", liq, vap, stable)
+	}
+
+	// The vapor root agrees with LogFugacity.
+	volRes, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	V, _ := volRes.Root(phase.Vapor)
+	RT := R * T
+	Z := cfg.P * V / RT
+	want := math.Exp(cubic.LogFugacity(cfg, Z, volRes.A*cfg.P/(RT*RT), volRes.B*cfg.P/RT))
+	if math.Abs(vap-want) > 1e-9*want {
+		t.Errorf("FugacityCoefficient(vapor) = %v, want %v", vap, want)
+	}
+
+	if _, err := cubic.FugacityCoefficient(cfg, phase.TwoPhase); err == nil {
+		t.Error("FugacityCoefficient(two-phase) want error")
+	}
+}
@@ -0,0 +1,205 @@
+package cubic
+
+import (
+	"errors"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// Helmholtz is the reduced residual Helmholtz energy α^r = A^r/(RT) of a cubic
+// EOS at temperature T and molar volume V, together with its first and second
+// derivatives. Every residual and thermal property of the EOS follows from these
+// derivatives, so a property needs no derivation specific to an EOS.
+//
+// For the generic cubic P = RT/(V - b) - a(T)/((V + εb)(V + σb)),
+//
+//	α^r = -ln(1 - b/V) - a(T)/(bRT) · ln((V + σb)/(V + εb))/(σ - ε)
+//
+// with the limit -a(T)/(RT(V + εb)) when σ = ε (van der Waals).
+//
+// The derivatives are scaled to be dimensionless: ArT = T ∂α^r/∂T at constant V,
+// ArV = V ∂α^r/∂V at constant T, and so on.
+type Helmholtz struct {
+	T, V float64 // Temperature and molar volume
+	R    float64 // Universal gas constant, which sets the units of the properties
+
+	Ar   float64 // α^r
+	ArT  float64 // T ∂α^r/∂T
+	ArTT float64 // T² ∂²α^r/∂T²
+	ArV  float64 // V ∂α^r/∂V
+	ArVV float64 // V² ∂²α^r/∂V²
+	ArTV float64 // TV ∂²α^r/∂T∂V
+}
+
+// errCovolume is returned for molar volumes at or below the covolume b.
+var errCovolume = errors.New("molar volume must be greater than the EOS covolume b")
+
+// ResidualHelmholtz evaluates α^r and its derivatives at cfg.T and molar volume V.
+// V is usually a root returned by SolveForVolume; cfg.P is not used.
+//
+// The temperature derivatives of α(Tr) are analytic if the alpha function
+// implements AlphaDerivative (or AlphaZcDerivative) and are evaluated by central
+// differences otherwise.
+func ResidualHelmholtz(cfg *EOSCfg, V float64) (*Helmholtz, error) {
+	if cfg.T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if cfg.Pc <= 0 || cfg.Tc <= 0 {
+		return nil, zfactor.ErrCriticalProp
+	}
+	if cfg.R <= 0 {
+		return nil, zfactor.ErrUniversalConst
+	}
+
+	params, err := cfg.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	b := calculateB(params.Omega, cfg.R, cfg.Tc, cfg.Pc)
+	if V <= b {
+		return nil, errCovolume
+	}
+
+	// q = a(T)/(bRT) = Ψα/(Ω Tr)
+	tr := cfg.T / cfg.Tc
+	a, da, d2a := cfg.alphaDerivatives(tr)
+	k := params.Psi / params.Omega
+	q := k * a / tr
+	qT := k * (da - a/tr)               // T dq/dT
+	qTT := k * (tr*d2a - 2*da + 2*a/tr) // T² d²q/dT²
+
+	r := residual(b/V, q, qT, qTT, params.Sigma, params.Epsilon)
+	r.T, r.V, r.R = cfg.T, V, cfg.R
+	return r, nil
+}
+
+// residual evaluates α^r and its derivatives from the reduced density η = b/V,
+// q = a/(bRT) and its scaled temperature derivatives qT = T dq/dT and
+// qTT = T² d²q/dT².
+func residual(eta, q, qT, qTT, sigma, epsilon float64) *Helmholtz {
+	// α^r = F(η) - q G(η), with F the repulsive and G the attractive term.
+	f := -math.Log(1 - eta)
+	fV := -eta / (1 - eta)
+	fVV := 1/((1-eta)*(1-eta)) - 1
+
+	ds, de := 1+sigma*eta, 1+epsilon*eta
+	var g float64
+	if diff := sigma - epsilon; math.Abs(diff) < 1e-9 {
+		g = eta / de
+	} else {
+		g = math.Log(ds/de) / diff
+	}
+	gV := -eta / (ds * de)
+	gVV := eta * (2 + (sigma+epsilon)*eta) / (ds * ds * de * de)
+
+	return &Helmholtz{
+		Ar:   f - q*g,
+		ArT:  -qT * g,
+		ArTT: -qTT * g,
+		ArV:  fV - q*gV,
+		ArVV: fVV - q*gVV,
+		ArTV: -qT * gV,
+	}
+}
+
+// alphaDerivatives returns α and its first and second derivatives with respect
+// to the reduced temperature.
+func (cfg *EOSCfg) alphaDerivatives(tr float64) (a, da, d2a float64) {
+	if tp, ok := cfg.Type.(ThreeParameter); ok {
+		if d, ok := tp.(AlphaZcDerivative); ok {
+			return d.AlphaZcDerivatives(tr, cfg.Acentric, cfg.Zc)
+		}
+		return centralDifferences(func(tr float64) float64 { return tp.AlphaZc(tr, cfg.Acentric, cfg.Zc) }, tr)
+	}
+	return AlphaDerivatives(cfg.Type, tr, cfg.Acentric)
+}
+
+// Z returns the compressibility factor, Z = 1 - V ∂α^r/∂V.
+func (h *Helmholtz) Z() float64 {
+	return 1 - h.ArV
+}
+
+// Pressure returns the pressure, P = ZRT/V.
+func (h *Helmholtz) Pressure() float64 {
+	return h.Z() * h.R * h.T / h.V
+}
+
+// LogFugacity returns the natural logarithm of the fugacity coefficient,
+// ln φ = α^r + Z - 1 - ln Z.
+func (h *Helmholtz) LogFugacity() float64 {
+	z := h.Z()
+	return h.Ar + z - 1 - math.Log(z)
+}
+
+// ResidualEnthalpy returns H^R = RT(Z - 1 - T ∂α^r/∂T), in the units of R × K.
+func (h *Helmholtz) ResidualEnthalpy() float64 {
+	return h.R * h.T * (h.Z() - 1 - h.ArT)
+}
+
+// ResidualEntropy returns S^R = R(ln Z - α^r - T ∂α^r/∂T), in the units of R.
+func (h *Helmholtz) ResidualEntropy() float64 {
+	return h.R * (math.Log(h.Z()) - h.Ar - h.ArT)
+}
+
+// ResidualCv returns the residual isochoric heat capacity
+// Cv^R = -R(T²∂²α^r/∂T² + 2T ∂α^r/∂T), in the units of R.
+func (h *Helmholtz) ResidualCv() float64 {
+	return -h.R * (h.ArTT + 2*h.ArT)
+}
+
+// ResidualCp returns the residual isobaric heat capacity
+// Cp^R = Cv^R + T(∂P/∂T)²/(-∂P/∂V) - R, in the units of R.
+func (h *Helmholtz) ResidualCp() float64 {
+	return h.ResidualCv() + h.cpMinusCv() - h.R
+}
+
+// cpMinusCv returns Cp - Cv = T(∂P/∂T)²/(-∂P/∂V).
+func (h *Helmholtz) cpMinusCv() float64 {
+	x := 1 - h.ArV - h.ArTV
+	return h.R * x * x / (1 + h.ArVV)
+}
+
+// DPDT returns (∂P/∂T) at constant V.
+func (h *Helmholtz) DPDT() float64 {
+	return h.R / h.V * (1 - h.ArV - h.ArTV)
+}
+
+// DPDV returns (∂P/∂V) at constant T. It is negative on stable branches of the EOS.
+func (h *Helmholtz) DPDV() float64 {
+	return -h.R * h.T / (h.V * h.V) * (1 + h.ArVV)
+}
+
+// Cv returns the isochoric heat capacity for the ideal-gas heat capacity cpIdeal
+// at T, both in the units of R.
+func (h *Helmholtz) Cv(cpIdeal float64) float64 {
+	return cpIdeal - h.R + h.ResidualCv()
+}
+
+// Cp returns the isobaric heat capacity for the ideal-gas heat capacity cpIdeal
+// at T, both in the units of R.
+func (h *Helmholtz) Cp(cpIdeal float64) float64 {
+	return h.Cv(cpIdeal) + h.cpMinusCv()
+}
+
+// SoundSpeed returns the speed of sound (m/s),
+//
+//	w² = (Cp/Cv) · (-V² ∂P/∂V) / M = (Cp/Cv) · RT(1 + V²∂²α^r/∂V²) / M
+//
+// for the ideal-gas heat capacity cpIdeal at T, in the units of R, and the molar
+// mass MW (g/mol). The result does not depend on the units of R.
+func (h *Helmholtz) SoundSpeed(cpIdeal, MW float64) (float64, error) {
+	if MW <= 0 {
+		return 0, zfactor.ErrMolarMass
+	}
+	cv := h.Cv(cpIdeal)
+	if cv <= 0 {
+		return 0, errors.New("isochoric heat capacity must be positive")
+	}
+	stiff := 1 + h.ArVV
+	if stiff <= 0 {
+		return 0, errors.New("state is mechanically unstable (∂P/∂V ≥ 0)")
+	}
+	gamma := h.Cp(cpIdeal) / cv
+	return math.Sqrt(gamma * zfactor.RSI * h.T * stiff / (MW * 1e-3)), nil
+}
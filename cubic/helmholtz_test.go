@@ -0,0 +1,110 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+// vaporState solves cfg for its vapor root and evaluates the residual Helmholtz
+// energy there.
+func vaporState(t *testing.T, cfg *cubic.EOSCfg) (*cubic.Helmholtz, *cubic.VolumeResult) {
+	t.Helper()
+	volRes, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		t.Fatalf("SolveForVolume(%T) unexpected error: %v", cfg.Type, err)
+	}
+	roots := volRes.Clean()
+	h, err := cubic.ResidualHelmholtz(cfg, roots[len(roots)-1])
+	if err != nil {
+		t.Fatalf("ResidualHelmholtz(%T) unexpected error: %v", cfg.Type, err)
+	}
+	return h, volRes
+}
+
+func TestResidualHelmholtz(t *testing.T) {
+	// n-butane vapor at 350 K, 5 bar
+	cfgs := []*cubic.EOSCfg{
+		cubic.NewvdWCfg(350, 5, 425.1, 37.96, 83.14),
+		cubic.NewRKCfg(350, 5, 425.1, 37.96, 83.14),
+		cubic.NewSRKCfg(350, 5, 425.1, 37.96, 0.200, 83.14),
+		cubic.NewPRCfg(350, 5, 425.1, 37.96, 0.200, 83.14),
+		cubic.NewRKPRCfg(350, 5, 425.1, 37.96, 0.274, 0.200, 83.14),
+	}
+
+	for _, cfg := range cfgs {
+		h, volRes := vaporState(t, cfg)
+
+		if got := h.Pressure(); math.Abs(got-cfg.P) > 1e-8*cfg.P {
+			t.Errorf("%T: Pressure() = %v, want %v", cfg.Type, got, cfg.P)
+		}
+
+		RT := cfg.R * cfg.T
+		A := volRes.A * cfg.P / (RT * RT)
+		B := volRes.B * cfg.P / RT
+		if got, want := h.LogFugacity(), cubic.LogFugacity(cfg, h.Z(), A, B); math.Abs(got-want) > 1e-10 {
+			t.Errorf("%T: LogFugacity() = %v, want %v", cfg.Type, got, want)
+		}
+
+		// H^R/RT = -T (∂ln φ/∂T) and Cp^R = (∂H^R/∂T) at constant P.
+		dT := 1e-3 * cfg.T
+		var lnPhi, hr [2]float64
+		for i, T := range [2]float64{cfg.T - dT, cfg.T + dT} {
+			c := *cfg
+			c.T = T
+			hi, _ := vaporState(t, &c)
+			lnPhi[i] = hi.LogFugacity()
+			hr[i] = hi.ResidualEnthalpy()
+		}
+		wantHR := -RT * cfg.T * (lnPhi[1] - lnPhi[0]) / (2 * dT)
+		if got := h.ResidualEnthalpy(); math.Abs(got-wantHR) > 1e-4*math.Abs(wantHR) {
+			t.Errorf("%T: ResidualEnthalpy() = %v, want %v", cfg.Type, got, wantHR)
+		}
+		wantSR := (h.ResidualEnthalpy()/RT - h.LogFugacity()) * cfg.R
+		if got := h.ResidualEntropy(); math.Abs(got-wantSR) > 1e-10*math.Abs(wantSR) {
+			t.Errorf("%T: ResidualEntropy() = %v, want %v", cfg.Type, got, wantSR)
+		}
+		wantCpR := (hr[1] - hr[0]) / (2 * dT)
+		if got := h.ResidualCp(); math.Abs(got-wantCpR) > 1e-3*math.Abs(wantCpR) {
+			t.Errorf("%T: ResidualCp() = %v, want %v", cfg.Type, got, wantCpR)
+		}
+	}
+}
+
+func TestSoundSpeed(t *testing.T) {
+	// Nitrogen near the ideal-gas limit: w = sqrt(γRT/M) with γ = 1.4.
+	cfg := cubic.NewPRCfg(300, 1e-3, 126.2, 34.0, 0.038, 83.14)
+	h, _ := vaporState(t, cfg)
+	w, err := h.SoundSpeed(3.5*cfg.R, 28.014)
+	if err != nil {
+		t.Fatalf("SoundSpeed() unexpected error: %v", err)
+	}
+	want := math.Sqrt(1.4 * zfactor.RSI * 300 / 28.014e-3)
+	if math.Abs(w-want) > 1e-4*want {
+		t.Errorf("SoundSpeed() = %v, want %v", w, want)
+	}
+
+	// Dense gas at 100 bar: the residual terms make w differ from the ideal value.
+	cfg.P = 100
+	h, _ = vaporState(t, cfg)
+	w, err = h.SoundSpeed(3.5*cfg.R, 28.014)
+	if err != nil {
+		t.Fatalf("SoundSpeed() unexpected error: %v", err)
+	}
+	if math.Abs(w-want) < 1e-3*want {
+		t.Errorf("SoundSpeed() at 100 bar = %v, want a deviation from %v", w, want)
+	}
+
+	if _, err := h.SoundSpeed(3.5*cfg.R, 0); err != zfactor.ErrMolarMass {
+		t.Errorf("SoundSpeed() with MW = 0 error = %v, want %v", err, zfactor.ErrMolarMass)
+	}
+}
+
+func TestResidualHelmholtzCovolume(t *testing.T) {
+	cfg := cubic.NewPRCfg(300, 1, 126.2, 34.0, 0.038, 83.14)
+	if _, err := cubic.ResidualHelmholtz(cfg, 1); err == nil {
+		t.Error("ResidualHelmholtz() below the covolume expected an error")
+	}
+}
@@ -0,0 +1,355 @@
+package cubic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Component holds the properties of one component of a MixtureCfg.
+type Component struct {
+	Tc       float64 // Critical temperature
+	Pc       float64 // Critical pressure
+	Acentric float64 // Acentric factor (ω) - dimensionless
+	// Alpha, if set, replaces the alpha function of the EOS for this component,
+	// e.g. a Twu alpha with constants fitted to its vapor pressures.
+	Alpha AlphaFunction
+}
+
+// TempKij is a temperature-dependent binary interaction parameter,
+//
+//	kij(T) = A + B·T + C/T
+type TempKij struct {
+	A, B, C float64
+}
+
+// At returns kij at temperature T.
+func (k TempKij) At(T float64) float64 {
+	return k.A + k.B*T + k.C/T
+}
+
+// scaledDerivative returns T dkij/dT at temperature T.
+func (k TempKij) scaledDerivative(T float64) float64 {
+	return k.B*T - k.C/T
+}
+
+// MixtureCfg holds the configuration and state variables for an Equation of State
+// calculation on a mixture. It is accepted by SolveForVolume and Pressure in place
+// of an EOSCfg.
+//
+// The mixture is treated as a single pseudo-fluid with the van der Waals one-fluid
+// mixing rules
+//
+//	a = Σi Σj yi yj √(ai aj) (1 - kij)
+//	b = Σi yi bi
+//
+// where ai and bi are the pure-component parameters of the EOS at T, and kij are
+// constant (Kij) or functions of temperature (KijT).
+//
+// As for EOSCfg, the functions of this package only read a mixture
+// configuration, and one configuration may be shared by goroutines that do not
+// modify it. Clone copies the composition, components and interaction
+// parameters, so that a copy can be modified without affecting the original.
+type MixtureCfg struct {
+	Type       EOSType     // The type of cubic equation of state (e.g., VdW, RK, SRK, PR)
+	T          float64     // Absolute temperature
+	P          float64     // Pressure
+	Y          []float64   // Mole fractions of the components
+	Components []Component // Properties of the components, in the order of Y
+	// Kij is the symmetric matrix of binary interaction parameters. If nil, all
+	// kij are 0. The diagonal is not used.
+	Kij [][]float64
+	// KijT is the symmetric matrix of temperature-dependent binary interaction
+	// parameters, used instead of Kij for systems fitted over a wide temperature
+	// range. At most one of Kij and KijT may be set.
+	KijT [][]TempKij
+	R    float64 // Universal gas constant in consistent units
+}
+
+// NewMixtureCfg creates a mixture configuration for the given EOS with all binary
+// interaction parameters set to 0.
+func NewMixtureCfg(Type EOSType, T, P float64, y []float64, components []Component, R float64) *MixtureCfg {
+	return &MixtureCfg{
+		Type:       Type,
+		T:          T,
+		P:          P,
+		Y:          y,
+		Components: components,
+		R:          R,
+	}
+}
+
+// Clone returns a deep copy of m, with its own mole fractions, components and
+// interaction parameters. The EOS type and alpha functions are shared.
+func (m *MixtureCfg) Clone() *MixtureCfg {
+	c := *m
+	c.Y = slices.Clone(m.Y)
+	c.Components = slices.Clone(m.Components)
+	if m.Kij != nil {
+		c.Kij = make([][]float64, len(m.Kij))
+		for i, row := range m.Kij {
+			c.Kij[i] = slices.Clone(row)
+		}
+	}
+	if m.KijT != nil {
+		c.KijT = make([][]TempKij, len(m.KijT))
+		for i, row := range m.KijT {
+			c.KijT[i] = slices.Clone(row)
+		}
+	}
+	return &c
+}
+
+// At returns a copy of m at temperature T and pressure P (see Clone).
+func (m *MixtureCfg) At(T, P float64) *MixtureCfg {
+	c := m.Clone()
+	c.T, c.P = T, P
+	return c
+}
+
+// validate checks the composition and interaction parameters of the mixture.
+func (m *MixtureCfg) validate() error {
+	if m.Type == nil {
+		return errNoType
+	}
+	if _, ok := m.Type.(ThreeParameter); ok {
+		return fmt.Errorf("mixing rules are not implemented for three-parameter EOS %s", Name(m.Type))
+	}
+	n := len(m.Y)
+	if n == 0 {
+		return errors.New("mixture requires at least one component")
+	}
+	if len(m.Components) != n {
+		return fmt.Errorf("mixture has %d components, want one per mole fraction (%d)", len(m.Components), n)
+	}
+
+	if err := numeric.MoleFractions(m.Y); err != nil {
+		return err
+	}
+	for _, c := range m.Components {
+		if c.Pc <= 0 || c.Tc <= 0 {
+			return zfactor.ErrCriticalProp
+		}
+	}
+
+	switch {
+	case m.Kij != nil && m.KijT != nil:
+		return errors.New("only one of Kij and KijT may be set")
+	case m.Kij != nil:
+		return numeric.SymmetricMatrix("Kij", m.Kij, n)
+	case m.KijT != nil:
+		return numeric.SymmetricMatrix("KijT", m.KijT, n)
+	}
+	return nil
+}
+
+// kij returns the binary interaction parameter of components i and j at m.T.
+func (m *MixtureCfg) kij(i, j int) float64 {
+	switch {
+	case i == j:
+		return 0
+	case m.KijT != nil:
+		return m.KijT[i][j].At(m.T)
+	case m.Kij != nil:
+		return m.Kij[i][j]
+	}
+	return 0
+}
+
+// kijT returns T dkij/dT of components i and j at m.T.
+func (m *MixtureCfg) kijT(i, j int) float64 {
+	if i == j || m.KijT == nil {
+		return 0
+	}
+	return m.KijT[i][j].scaledDerivative(m.T)
+}
+
+// ComponentParams returns the cross attraction parameters
+//
+//	aij = √(ai aj) (1 - kij)
+//
+// with aii = ai, and the covolumes bi of the components at T. The mixture a and b
+// are a = Σi Σj yi yj aij and b = Σi yi bi.
+func (m *MixtureCfg) ComponentParams() (aij [][]float64, bi []float64, err error) {
+	if err := m.check(); err != nil {
+		return nil, nil, err
+	}
+	ai, _, bi := m.pureParams()
+	return m.crossParams(ai), bi, nil
+}
+
+// check validates the mixture together with the temperature and gas constant.
+func (m *MixtureCfg) check() error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	if m.T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if m.R <= 0 {
+		return zfactor.ErrUniversalConst
+	}
+	return nil
+}
+
+// pureParams returns the attraction parameters ai of the components at m.T,
+// their scaled temperature derivatives aiT = T dai/dT, and their covolumes bi.
+// The derivatives of α(Tr) are evaluated by AlphaDerivatives.
+func (m *MixtureCfg) pureParams() (ai, aiT, bi []float64) {
+	params := m.Type.Params()
+	n := len(m.Components)
+	ai, aiT, bi = make([]float64, n), make([]float64, n), make([]float64, n)
+	for i, c := range m.Components {
+		var f AlphaFunction = m.Type
+		if c.Alpha != nil {
+			f = c.Alpha
+		}
+		tr := m.T / c.Tc
+		alpha, da, _ := AlphaDerivatives(f, tr, c.Acentric)
+		ai[i] = calculateA(params.Psi, alpha, m.R, c.Tc, c.Pc)
+		aiT[i] = ai[i] * tr * da / alpha
+		bi[i] = calculateB(params.Omega, m.R, c.Tc, c.Pc)
+	}
+	return ai, aiT, bi
+}
+
+// crossParams returns the cross attraction parameters aij = √(ai aj) (1 - kij).
+func (m *MixtureCfg) crossParams(ai []float64) [][]float64 {
+	aij := make([][]float64, len(ai))
+	for i := range aij {
+		aij[i] = make([]float64, len(ai))
+		for j := range aij[i] {
+			aij[i][j] = math.Sqrt(ai[i]*ai[j]) * (1 - m.kij(i, j))
+		}
+	}
+	return aij
+}
+
+// mix applies the mixing rules to the component parameters.
+func (m *MixtureCfg) mix(aij [][]float64, bi []float64) (a, b float64) {
+	for i, yi := range m.Y {
+		b += yi * bi[i]
+		for j, yj := range m.Y {
+			a += yi * yj * aij[i][j]
+		}
+	}
+	return a, b
+}
+
+// coefficients implements Config for a mixture.
+func (m *MixtureCfg) coefficients() (*coefficients, error) {
+	if err := m.check(); err != nil {
+		return nil, err
+	}
+	ai, aiT, bi := m.pureParams()
+	aij := m.crossParams(ai)
+	a, b := m.mix(aij, bi)
+
+	// T daij/dT = aij (T dai/dT / ai + T daj/dT / aj) / 2 - √(ai aj) T dkij/dT
+	var aT float64
+	for i, yi := range m.Y {
+		for j, yj := range m.Y {
+			daij := aij[i][j]*(aiT[i]/ai[i]+aiT[j]/ai[j])/2 - math.Sqrt(ai[i]*ai[j])*m.kijT(i, j)
+			aT += yi * yj * daij
+		}
+	}
+	return &coefficients{
+		T:      m.T,
+		P:      m.P,
+		R:      m.R,
+		A:      a,
+		B:      b,
+		AT:     aT,
+		Params: m.Type.Params(),
+	}, nil
+}
+
+// ComponentLogPhi returns the logarithms of the fugacity coefficients φ̂ᵢ of the
+// components of the mixture in the phase with compressibility factor Z, usually
+// a root of SolveForVolume at m.T and m.P. For the van der Waals mixing rules,
+//
+//	ln φ̂ᵢ = (bᵢ/b)(Z - 1) - ln(Z - β) - q̄ᵢ I
+//	q̄ᵢ = q (2 Σⱼ yⱼ aᵢⱼ / a - bᵢ/b)
+//
+// with β = bP/(RT), q = a/(bRT) and I = ln((Z + σβ)/(Z + εβ))/(σ - ε), or β/Z
+// for van der Waals.
+func ComponentLogPhi(m *MixtureCfg, Z float64) ([]float64, error) {
+	if m.P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	aij, bi, err := m.ComponentParams()
+	if err != nil {
+		return nil, err
+	}
+	a, b := m.mix(aij, bi)
+
+	RT := m.R * m.T
+	beta := b * m.P / RT
+	if Z <= beta {
+		return nil, errCovolume
+	}
+	q := a / (b * RT)
+
+	I := m.Type.Params().integral(Z, beta)
+
+	lnPhi := make([]float64, len(bi))
+	for i := range bi {
+		var sum float64
+		for j, yj := range m.Y {
+			sum += yj * aij[i][j]
+		}
+		qi := q * (2*sum/a - bi[i]/b)
+		lnPhi[i] = bi[i]/b*(Z-1) - math.Log(Z-beta) - qi*I
+	}
+	return lnPhi, nil
+}
+
+// PhaseLogPhi solves m for volume and returns the compressibility factor of the
+// root for phase p together with ComponentLogPhi at that root. If p is
+// phase.Unknown, the root with the lowest Gibbs energy, Σ yᵢ ln φ̂ᵢ, is used,
+// i.e. the stable phase.
+func PhaseLogPhi(m *MixtureCfg, p phase.Phase) (float64, []float64, error) {
+	volRes, err := SolveForVolume(m)
+	if err != nil {
+		return 0, nil, err
+	}
+	RT := m.R * m.T
+	if p != phase.Unknown {
+		V, err := volRes.Root(p)
+		if err != nil {
+			return 0, nil, err
+		}
+		Z := m.P * V / RT
+		lnPhi, err := ComponentLogPhi(m, Z)
+		if err != nil {
+			return 0, nil, err
+		}
+		return Z, lnPhi, nil
+	}
+
+	var best []float64
+	bestZ, bestG := 0.0, math.Inf(1)
+	for _, V := range volRes.physical() {
+		Z := m.P * V / RT
+		lnPhi, err := ComponentLogPhi(m, Z)
+		if err != nil {
+			return 0, nil, err
+		}
+		var g float64
+		for i, y := range m.Y {
+			g += y * lnPhi[i]
+		}
+		if g < bestG {
+			best, bestZ, bestG = lnPhi, Z, g
+		}
+	}
+	if best == nil {
+		return 0, nil, errors.New("no real volume roots found")
+	}
+	return bestZ, best, nil
+}
@@ -0,0 +1,232 @@
+package cubic_test
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+var (
+	methane = cubic.Component{Tc: 190.6, Pc: 45.99, Acentric: 0.012}
+	ethane  = cubic.Component{Tc: 305.3, Pc: 48.72, Acentric: 0.100}
+)
+
+func TestMixtureSingleComponent(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 300, 50, []float64{1}, []cubic.Component{ethane}, R)
+	pure := cubic.NewPRCfg(300, 50, ethane.Tc, ethane.Pc, ethane.Acentric, R)
+
+	got, err := cubic.SolveForVolume(mix)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	want, err := cubic.SolveForVolume(pure)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	if got.A != want.A || got.B != want.B || got.Volumes != want.Volumes {
+		t.Errorf("SolveForVolume() = %v, want %v", got, want)
+	}
+}
+
+func TestMixtureParameters(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T, P, k = 250.0, 30.0, 0.03
+	y := []float64{0.7, 0.3}
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, T, P, y, []cubic.Component{methane, ethane}, R)
+	mix.Kij = [][]float64{{0, k}, {k, 0}}
+
+	var ai, bi [2]float64
+	for i, c := range mix.Components {
+		res, err := cubic.Pressure(cubic.NewPRCfg(T, P, c.Tc, c.Pc, c.Acentric, R), 100)
+		if err != nil {
+			t.Fatalf("Pressure() unexpected error: %v", err)
+		}
+		ai[i], bi[i] = res.A, res.B
+	}
+	wantA := y[0]*y[0]*ai[0] + y[1]*y[1]*ai[1] + 2*y[0]*y[1]*math.Sqrt(ai[0]*ai[1])*(1-k)
+	wantB := y[0]*bi[0] + y[1]*bi[1]
+
+	res, err := cubic.SolveForVolume(mix)
+	if err != nil {
+		t.Fatalf("SolveForVolume() unexpected error: %v", err)
+	}
+	if math.Abs(res.A-wantA) > 1e-9*wantA || math.Abs(res.B-wantB) > 1e-9*wantB {
+		t.Errorf("SolveForVolume() a, b = %g, %g, want %g, %g", res.A, res.B, wantA, wantB)
+	}
+
+	// The vapor root satisfies the EOS when evaluated back through Pressure.
+	v, err := res.Root(phase.Vapor)
+	if err != nil {
+		t.Fatalf("Root() unexpected error: %v", err)
+	}
+	pr, err := cubic.Pressure(mix, v)
+	if err != nil {
+		t.Fatalf("Pressure() unexpected error: %v", err)
+	}
+	if math.Abs(pr.P-P) > 1e-6*P {
+		t.Errorf("Pressure() = %g, want %g", pr.P, P)
+	}
+}
+
+func TestMixtureTempKij(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const P = 30.0
+	y := []float64{0.7, 0.3}
+	comps := []cubic.Component{methane, ethane}
+	kT := cubic.TempKij{A: 0.01, B: 1e-4, C: -2}
+
+	for _, T := range []float64{250, 400} {
+		// At each temperature, KijT gives the same mixture as a constant Kij of kij(T).
+		k := kT.At(T)
+		mixT := cubic.NewMixtureCfg(&cubic.PR{}, T, P, y, comps, R)
+		mixT.KijT = [][]cubic.TempKij{{{}, kT}, {kT, {}}}
+		mixK := cubic.NewMixtureCfg(&cubic.PR{}, T, P, y, comps, R)
+		mixK.Kij = [][]float64{{0, k}, {k, 0}}
+
+		got, err := cubic.SolveForVolume(mixT)
+		if err != nil {
+			t.Fatalf("SolveForVolume() unexpected error: %v", err)
+		}
+		want, err := cubic.SolveForVolume(mixK)
+		if err != nil {
+			t.Fatalf("SolveForVolume() unexpected error: %v", err)
+		}
+		if got.A != want.A || got.B != want.B {
+			t.Errorf("SolveForVolume(T = %v) a, b = %g, %g, want %g, %g", T, got.A, got.B, want.A, want.B)
+		}
+	}
+	if got, want := kT.At(250), 0.027; math.Abs(got-want) > 1e-12 {
+		t.Errorf("TempKij.At() = %v, want %v", got, want)
+	}
+}
+
+func TestMixtureErrors(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	comps := []cubic.Component{methane, ethane}
+	tests := []struct {
+		name    string
+		mix     *cubic.MixtureCfg
+		wantErr error
+	}{
+		{"fractions do not sum to 1", cubic.NewMixtureCfg(&cubic.PR{}, 300, 10, []float64{0.5, 0.4}, comps, R), zfactor.ErrMolFracSum},
+		{"negative fraction", cubic.NewMixtureCfg(&cubic.PR{}, 300, 10, []float64{1.5, -0.5}, comps, R), zfactor.ErrMolFracVal},
+		{"missing critical properties", cubic.NewMixtureCfg(&cubic.PR{}, 300, 10, []float64{0.5, 0.5}, []cubic.Component{methane, {}}, R), zfactor.ErrCriticalProp},
+		{"zero temperature", cubic.NewMixtureCfg(&cubic.PR{}, 0, 10, []float64{0.5, 0.5}, comps, R), zfactor.ErrTemp},
+		{"zero pressure", cubic.NewMixtureCfg(&cubic.PR{}, 300, 0, []float64{0.5, 0.5}, comps, R), zfactor.ErrPressure},
+		{"length mismatch", cubic.NewMixtureCfg(&cubic.PR{}, 300, 10, []float64{1}, comps, R), nil},
+		{"three-parameter EOS", cubic.NewMixtureCfg(&cubic.RKPR{}, 300, 10, []float64{0.5, 0.5}, comps, R), nil},
+		{"asymmetric kij", &cubic.MixtureCfg{Type: &cubic.PR{}, T: 300, P: 10, Y: []float64{0.5, 0.5}, Components: comps, Kij: [][]float64{{0, 0.1}, {0, 0}}, R: R}, nil},
+		{"asymmetric temperature-dependent kij", &cubic.MixtureCfg{Type: &cubic.PR{}, T: 300, P: 10, Y: []float64{0.5, 0.5}, Components: comps, KijT: [][]cubic.TempKij{{{}, {A: 0.1}}, {{}, {}}}, R: R}, nil},
+		{"both kij forms", &cubic.MixtureCfg{Type: &cubic.PR{}, T: 300, P: 10, Y: []float64{0.5, 0.5}, Components: comps, Kij: [][]float64{{0, 0}, {0, 0}}, KijT: [][]cubic.TempKij{{{}, {}}, {{}, {}}}, R: R}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cubic.SolveForVolume(tt.mix)
+			if err == nil {
+				t.Fatal("SolveForVolume() want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("SolveForVolume() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMixtureClone(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	m := cubic.NewMixtureCfg(&cubic.PR{}, 250, 20, []float64{0.4, 0.6}, []cubic.Component{methane, ethane}, R)
+	m.Kij = [][]float64{{0, 0.003}, {0.003, 0}}
+	c := m.At(300, 30)
+	if c.T != 300 || c.P != 30 || !slices.Equal(c.Y, m.Y) || c.Kij[0][1] != 0.003 {
+		t.Errorf("At() = %+v, want a copy of %+v at T = 300, P = 30", c, m)
+	}
+	c.Y[0], c.Components[0].Tc, c.Kij[0][1] = 0.5, 100, 0.1
+	if m.Y[0] != 0.4 || m.Components[0].Tc != methane.Tc || m.Kij[0][1] != 0.003 {
+		t.Errorf("modifying the clone changed the original: %+v", m)
+	}
+}
+
+func TestComponentLogPhi(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	const T, P = 250.0, 30.0
+	comps := []cubic.Component{methane, ethane}
+	kij := [][]float64{{0, 0.03}, {0.03, 0}}
+
+	// n ln φ of the mixture from the pure-fluid expression with the mixed a and b,
+	// ln φ = Z - 1 - ln(Z - β) - q I, for moles n of each component.
+	nLnPhi := func(n []float64) float64 {
+		total := n[0] + n[1]
+		mix := cubic.NewMixtureCfg(&cubic.PR{}, T, P, []float64{n[0] / total, n[1] / total}, comps, R)
+		mix.Kij = kij
+		vr, err := cubic.SolveForVolume(mix)
+		if err != nil {
+			t.Fatalf("SolveForVolume() unexpected error: %v", err)
+		}
+		V, err := vr.Root(phase.Vapor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		Z := P * V / (R * T)
+		beta := vr.B * P / (R * T)
+		q := vr.A / (vr.B * R * T)
+		I := math.Log((Z+(1+math.Sqrt2)*beta)/(Z+(1-math.Sqrt2)*beta)) / (2 * math.Sqrt2)
+		return total * (Z - 1 - math.Log(Z-beta) - q*I)
+	}
+
+	// ln φ̂ᵢ is the partial molar derivative ∂(n ln φ)/∂nᵢ at constant T and P.
+	y := []float64{0.7, 0.3}
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, T, P, y, comps, R)
+	mix.Kij = kij
+	_, got, err := cubic.PhaseLogPhi(mix, phase.Vapor)
+	if err != nil {
+		t.Fatalf("PhaseLogPhi() unexpected error: %v", err)
+	}
+	const h = 1e-5
+	for i := range y {
+		up, down := slices.Clone(y), slices.Clone(y)
+		up[i] += h
+		down[i] -= h
+		want := (nLnPhi(up) - nLnPhi(down)) / (2 * h)
+		if math.Abs(got[i]-want) > 1e-7 {
+			t.Errorf("ComponentLogPhi()[%d] = %v, want ∂(n ln φ)/∂nᵢ = %v", i, got[i], want)
+		}
+	}
+}
+
+func TestPhaseLogPhi(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	// An equimolar propane + n-butane mixture at 280 K is a vapor with three roots
+	// at 1 bar and a compressed liquid at 20 bar.
+	tests := []struct {
+		P    float64
+		want phase.Phase
+	}{
+		{1, phase.Vapor},
+		{20, phase.Liquid},
+	}
+	for _, tt := range tests {
+		mix := cubic.NewMixtureCfg(&cubic.PR{}, 280, tt.P, []float64{0.5, 0.5}, []cubic.Component{propane, butane}, R)
+		zw, phiW, err := cubic.PhaseLogPhi(mix, tt.want)
+		if err != nil {
+			t.Fatalf("PhaseLogPhi(%v) unexpected error: %v", tt.want, err)
+		}
+		z, phi, err := cubic.PhaseLogPhi(mix, phase.Unknown)
+		if err != nil {
+			t.Fatalf("PhaseLogPhi(Unknown) unexpected error: %v", err)
+		}
+		if z != zw || !slices.Equal(phi, phiW) {
+			t.Errorf("P = %v: PhaseLogPhi(Unknown) = %v, %v, want the %v root %v, %v", tt.P, z, phi, tt.want, zw, phiW)
+		}
+	}
+
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 280, 1, []float64{0.5, 0.5}, []cubic.Component{propane, butane}, R)
+	if _, _, err := cubic.PhaseLogPhi(mix, phase.TwoPhase); err == nil {
+		t.Error("PhaseLogPhi(TwoPhase) expected an error")
+	}
+}
@@ -0,0 +1,71 @@
+package cubic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+)
+
+func init() {
+	zfactor.RegisterEOSValidator(func(name string) error {
+		_, err := ByName(name)
+		return err
+	})
+}
+
+// ByName returns the built-in equation of state with the given name: "VdW", "RK",
+// "SRK", "PR", "RKPR", "PT" or "Auto". Names are case-insensitive; "RK-PR" and
+// "PatelTeja" are also accepted.
+func ByName(name string) (EOSType, error) {
+	switch strings.ToUpper(strings.ReplaceAll(name, "-", "")) {
+	case "VDW":
+		return &VdW{}, nil
+	case "RK":
+		return &RK{}, nil
+	case "SRK":
+		return &SRK{}, nil
+	case "PR":
+		return &PR{}, nil
+	case "RKPR":
+		return &RKPR{}, nil
+	case "PT", "PATELTEJA":
+		return &PatelTeja{}, nil
+	case "AUTO":
+		return &Auto{}, nil
+	default:
+		return nil, fmt.Errorf("unknown equation of state %q", name)
+	}
+}
+
+// Name returns the name of an equation of state as accepted by ByName, e.g. "PR"
+// for *cubic.PR. Types defined outside the package are named after their type,
+// and a CustomAlpha after its Base and Func, e.g. "PR-Twu".
+func Name(eos EOSType) string {
+	if c, ok := eos.(*CustomAlpha); ok && c.Base != nil && c.Func != nil {
+		return Name(c.Base) + "-" + typeName(c.Func)
+	}
+	return typeName(eos)
+}
+
+// typeName returns the name of the type of v without its package.
+func typeName(v any) string {
+	name := fmt.Sprintf("%T", v)
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// Auto selects the equation of state recommended for each substance, with its
+// tuned parameters (see substance.Substance.RecommendedEOS). It is resolved by
+// Substance.CubicConfig; where no substance is known, such as in a MixtureCfg,
+// it is evaluated as Peng-Robinson.
+type Auto struct {
+	PR
+}
+
+// DefaultEOS returns the equation of state named by zfactor.Defaults().EOS.
+func DefaultEOS() (EOSType, error) {
+	return ByName(zfactor.Defaults().EOS)
+}
@@ -0,0 +1,78 @@
+package cubic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestByName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    cubic.EOSType
+		wantErr bool
+	}{
+		{"PR", &cubic.PR{}, false},
+		{"srk", &cubic.SRK{}, false},
+		{"RK-PR", &cubic.RKPR{}, false},
+		{"vdw", &cubic.VdW{}, false},
+		{"PT", &cubic.PatelTeja{}, false},
+		{"Patel-Teja", &cubic.PatelTeja{}, false},
+		{"auto", &cubic.Auto{}, false},
+		{"BWR", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := cubic.ByName(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ByName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && fmt.Sprintf("%T", got) != fmt.Sprintf("%T", tt.want) {
+			t.Errorf("ByName(%q) = %T, want %T", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultEOS(t *testing.T) {
+	t.Cleanup(zfactor.ResetDefaults)
+
+	if eos, err := cubic.DefaultEOS(); err != nil {
+		t.Fatalf("DefaultEOS() unexpected error: %v", err)
+	} else if _, ok := eos.(*cubic.PR); !ok {
+		t.Errorf("DefaultEOS() = %T, want *cubic.PR", eos)
+	}
+
+	if err := zfactor.SetDefaults(zfactor.Config{EOS: "SRK"}); err != nil {
+		t.Fatal(err)
+	}
+	if eos, _ := cubic.DefaultEOS(); eos == nil {
+		t.Fatal("DefaultEOS() = nil, want *cubic.SRK")
+	} else if _, ok := eos.(*cubic.SRK); !ok {
+		t.Errorf("DefaultEOS() = %T, want *cubic.SRK", eos)
+	}
+
+	// Unknown names are rejected and leave the defaults unchanged.
+	if err := zfactor.SetDefaults(zfactor.Config{EOS: "PRR"}); err == nil {
+		t.Error("SetDefaults() with unknown EOS expected an error")
+	}
+	if got := zfactor.Defaults().EOS; got != "SRK" {
+		t.Errorf("Defaults().EOS = %q after a rejected SetDefaults, want SRK", got)
+	}
+
+	// A configuration without a type is rejected rather than dereferenced.
+	noType := &cubic.EOSCfg{T: 300, P: 1, Tc: 370, Pc: 42, R: 83.14}
+	if _, err := cubic.SolveForVolume(noType); err == nil {
+		t.Error("SolveForVolume() without a type expected an error")
+	}
+	if _, err := noType.Parameters(); err == nil {
+		t.Error("Parameters() without a type expected an error")
+	}
+	if _, err := cubic.ResidualHelmholtz(noType, 1000); err == nil {
+		t.Error("ResidualHelmholtz() without a type expected an error")
+	}
+	if _, err := cubic.VirialB(noType, 300); err == nil {
+		t.Error("VirialB() without a type expected an error")
+	}
+}
@@ -0,0 +1,104 @@
+package cubic
+
+import "math"
+
+// ptDefaultZc is the critical compressibility factor assumed by PatelTeja when
+// no Zc is supplied.
+const ptDefaultZc = 0.27
+
+// PatelTeja represents the three-parameter Patel-Teja equation of state (1982):
+//
+//	P = RT/(V - b) - a(T)/(V(V + b) + c(V - b))
+//
+// The third parameter c lets the critical compressibility factor of the EOS
+// vary between substances, which improves saturated liquid densities over SRK
+// and PR. It is the generic cubic with
+//
+//	σ, ε = ((1 + c/b) ± √((1 + c/b)² + 4c/b)) / 2
+//
+// with the generalization of Valderrama (1990), in which Ωa, Ωb, Ωc and the
+// slope F of the Soave-type
+//
+//	α(Tr) = [1 + F(1 - √Tr)]²
+//
+// are correlated with the experimental Zc and ω.
+//
+// PatelTeja implements ThreeParameter, so EOSCfg.Zc must be set (Substance.CubicConfig
+// does this). If Zc is not positive, a typical value of 0.27 is assumed.
+type PatelTeja struct{}
+
+// Alpha evaluates α assuming the default critical compressibility factor.
+func (pt *PatelTeja) Alpha(tr, w float64) float64 {
+	return pt.AlphaZc(tr, w, ptDefaultZc)
+}
+
+// Params returns the parameters for the default critical compressibility factor.
+func (pt *PatelTeja) Params() *Params {
+	return pt.ParamsZc(ptDefaultZc)
+}
+
+// AlphaZc evaluates α(Tr) = [1 + F(1 - √Tr)]² with
+//
+//	F = 0.46283 + 3.58230 ωZc + 8.19417 (ωZc)²
+func (*PatelTeja) AlphaZc(tr, w, zc float64) float64 {
+	s := 1 + ptSlope(w, zc)*(1-math.Sqrt(tr))
+	return s * s
+}
+
+// AlphaDerivatives evaluates α and its derivatives assuming the default critical
+// compressibility factor.
+func (pt *PatelTeja) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return pt.AlphaZcDerivatives(tr, w, ptDefaultZc)
+}
+
+// AlphaZcDerivatives evaluates α(Tr) of AlphaZc and its derivatives.
+func (*PatelTeja) AlphaZcDerivatives(tr, w, zc float64) (a, da, d2a float64) {
+	return soaveDerivatives(ptSlope(w, zc), tr)
+}
+
+// ptSlope returns the slope F of the Patel-Teja alpha function.
+func ptSlope(w, zc float64) float64 {
+	if zc <= 0 {
+		zc = ptDefaultZc
+	}
+	x := w * zc
+	return 0.46283 + 3.58230*x + 8.19417*x*x
+}
+
+// ParamsZc returns σ and ε for c/b = Ωc/Ωb, Ω = Ωb and Ψ = Ωa, with
+//
+//	Ωa = 0.66121 - 0.76105 Zc
+//	Ωb = 0.02207 + 0.20868 Zc
+//	Ωc = 0.57765 - 1.87080 Zc
+func (*PatelTeja) ParamsZc(zc float64) *Params {
+	if zc <= 0 {
+		zc = ptDefaultZc
+	}
+	omegaA := 0.66121 - 0.76105*zc
+	omegaB := 0.02207 + 0.20868*zc
+	omegaC := 0.57765 - 1.87080*zc
+
+	// The denominator V² + (b + c)V - bc is (V + σb)(V + εb).
+	k := omegaC / omegaB
+	d := math.Sqrt((1+k)*(1+k) + 4*k)
+	return &Params{
+		Sigma:   (1 + k + d) / 2,
+		Epsilon: (1 + k - d) / 2,
+		Omega:   omegaB,
+		Psi:     omegaA,
+	}
+}
+
+// NewPTCfg creates a configuration for the Patel-Teja cubic equation of state
+func NewPTCfg(T, P, Tc, Pc, Zc, W, R float64) *EOSCfg {
+	return &EOSCfg{
+		Type:     &PatelTeja{},
+		T:        T,
+		P:        P,
+		Tc:       Tc,
+		Pc:       Pc,
+		Acentric: W,
+		Zc:       Zc,
+		R:        R,
+	}
+}
@@ -0,0 +1,60 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+func TestPatelTejaParams(t *testing.T) {
+	pt := &cubic.PatelTeja{}
+	for _, zc := range []float64{0.23, 0.27, 0.29} {
+		p := pt.ParamsZc(zc)
+		// σ + ε = 1 + c/b and σε = -c/b, with c/b = Ωc/Ωb.
+		k := (0.57765 - 1.87080*zc) / p.Omega
+		if math.Abs(p.Sigma+p.Epsilon-1-k) > 1e-12 || math.Abs(p.Sigma*p.Epsilon+k) > 1e-12 {
+			t.Errorf("ParamsZc(%v) = %+v, want σ + ε = %v and σε = %v", zc, p, 1+k, -k)
+		}
+	}
+	if got := pt.AlphaZc(1, 0.152, 0.276); got != 1 {
+		t.Errorf("AlphaZc(1) = %v, want 1", got)
+	}
+}
+
+func TestPatelTejaSaturation(t *testing.T) {
+	// Propane at its normal boiling point, where the saturated liquid density is
+	// 581 kg/m³.
+	const R = 10 * zfactor.RSI
+	const T, mw, rhoL = 231.1, 44.097, 581.0
+	cfg := cubic.NewPTCfg(T, 1, 369.8, 42.48, 0.276, 0.152, R)
+
+	p, err := cubic.SaturationPressure(cfg, T)
+	if err != nil {
+		t.Fatalf("SaturationPressure() unexpected error: %v", err)
+	}
+	if math.Abs(p-zfactor.AtmBar)/zfactor.AtmBar > 0.02 {
+		t.Errorf("SaturationPressure() = %.4f bar, want about %.4f bar", p, zfactor.AtmBar)
+	}
+
+	// The liquid is denser than measured, but less so than with Peng-Robinson.
+	density := func(cfg *cubic.EOSCfg) float64 {
+		cfg.P = p
+		vr, err := cubic.SolveForVolume(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := vr.Root(phase.Liquid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return 1000 * mw / v
+	}
+	got := density(cfg)
+	pr := density(cubic.NewPRCfg(T, p, 369.8, 42.48, 0.152, R))
+	if math.Abs(got-rhoL) >= math.Abs(pr-rhoL) || math.Abs(got-rhoL)/rhoL > 0.05 {
+		t.Errorf("liquid density = %.1f kg/m³, want within 5%% of %.0f and closer than PR (%.1f)", got, rhoL, pr)
+	}
+}
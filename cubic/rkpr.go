@@ -0,0 +1,137 @@
+package cubic
+
+import "math"
+
+// rkprDefaultZc is the critical compressibility factor assumed by RKPR when
+// no Zc is supplied. It is typical of normal (non-polar) fluids.
+const rkprDefaultZc = 0.27
+
+// RKPR represents the three-parameter Redlich-Kwong-Peng-Robinson equation of state
+// of Cismondi and Mollerup (2005):
+//
+//	P = RT/(V - b) - a(T)/((V + δ1 b)(V + δ2 b)),   δ2 = (1 - δ1)/(1 + δ1)
+//
+// δ1 is correlated with the critical compressibility factor, which lets the EOS
+// reproduce liquid densities and vapor pressures simultaneously. δ1 = 1 recovers
+// the Redlich-Kwong family and δ1 = 1 + √2 the Peng-Robinson family.
+//
+// The temperature dependence of a is
+//
+//	α(Tr) = (3 / (2 + Tr))^k
+//
+// where k is correlated with ω and Zc.
+//
+// RKPR implements ThreeParameter, so EOSCfg.Zc must be set (Substance.CubicConfig
+// does this). If Zc is not positive, a typical value of 0.27 is assumed.
+type RKPR struct{}
+
+// Alpha evaluates α assuming the default critical compressibility factor.
+func (r *RKPR) Alpha(tr, w float64) float64 {
+	return r.AlphaZc(tr, w, rkprDefaultZc)
+}
+
+// Params returns the parameters for the default critical compressibility factor.
+func (r *RKPR) Params() *Params {
+	return r.ParamsZc(rkprDefaultZc)
+}
+
+// AlphaZc evaluates α(Tr) = (3/(2 + Tr))^k with
+//
+//	k = (A1 Zc' + A0) ω² + (B1 Zc' + B0) ω + (C1 Zc' + C0)
+//
+// where Zc' = 1.168 Zc is the critical compressibility factor of the EOS.
+func (*RKPR) AlphaZc(tr, w, zc float64) float64 {
+	return math.Pow(3/(2+tr), rkprExponent(w, zc))
+}
+
+// AlphaDerivatives evaluates α and its derivatives assuming the default critical
+// compressibility factor.
+func (r *RKPR) AlphaDerivatives(tr, w float64) (a, da, d2a float64) {
+	return r.AlphaZcDerivatives(tr, w, rkprDefaultZc)
+}
+
+// AlphaZcDerivatives evaluates α(Tr) of AlphaZc and its derivatives,
+//
+//	dα/dTr = -kα/(2 + Tr), d²α/dTr² = k(k + 1)α/(2 + Tr)²
+func (*RKPR) AlphaZcDerivatives(tr, w, zc float64) (a, da, d2a float64) {
+	k := rkprExponent(w, zc)
+	x := 2 + tr
+	a = math.Pow(3/x, k)
+	return a, -k * a / x, k * (k + 1) * a / (x * x)
+}
+
+// rkprExponent returns the exponent k of the RK-PR alpha function.
+func rkprExponent(w, zc float64) float64 {
+	if zc <= 0 {
+		zc = rkprDefaultZc
+	}
+	const (
+		a0, a1 = 0.0017, -2.4407
+		b0, b1 = 1.9681, 7.4513
+		c0, c1 = -2.7238, 12.504
+	)
+	zc *= 1.168
+	return (a1*zc+a0)*w*w + (b1*zc+b0)*w + (c1*zc + c0)
+}
+
+// ParamsZc returns σ = δ1, ε = δ2 and the Ω, Ψ that satisfy the critical
+// point conditions for the δ1 correlated with zc.
+func (r *RKPR) ParamsZc(zc float64) *Params {
+	if zc <= 0 {
+		zc = rkprDefaultZc
+	}
+	return rkprParams(RKPRDelta1(zc))
+}
+
+// RKPRDelta1 returns the δ1 parameter of the RK-PR EOS correlated with the
+// experimental critical compressibility factor:
+//
+//	δ1 = d1 + d2 (d3 - 1.168 Zc)^d4 + d5 (d3 - 1.168 Zc)^d6
+//
+// The factor 1.168 relates the experimental Zc to the Zc of the EOS. For
+// 1.168 Zc >= d3 the correlation is not defined and δ1 = d1 is returned.
+func RKPRDelta1(zc float64) float64 {
+	const (
+		d1 = 0.428363
+		d2 = 18.496215
+		d3 = 0.338426
+		d4 = 0.660000
+		d5 = 789.723105
+		d6 = 2.512392
+	)
+	x := d3 - 1.168*zc
+	if x <= 0 {
+		return d1
+	}
+	return d1 + d2*math.Pow(x, d4) + d5*math.Pow(x, d6)
+}
+
+// rkprParams computes the generic cubic parameters for a given δ1.
+func rkprParams(delta1 float64) *Params {
+	delta2 := (1 - delta1) / (1 + delta1)
+
+	d := (1 + delta1*delta1) / (1 + delta1)
+	y := 1 + math.Cbrt(2*(1+delta1)) + math.Cbrt(4/(1+delta1))
+	den := 3*y + d - 1
+
+	return &Params{
+		Sigma:   delta1,
+		Epsilon: delta2,
+		Omega:   1 / den,
+		Psi:     (3*y*y + 3*y*d + d*d + d - 1) / (den * den),
+	}
+}
+
+// NewRKPRCfg creates a configuration for the RK-PR cubic equation of state
+func NewRKPRCfg(T, P, Tc, Pc, Zc, W, R float64) *EOSCfg {
+	return &EOSCfg{
+		Type:     &RKPR{},
+		T:        T,
+		P:        P,
+		Tc:       Tc,
+		Pc:       Pc,
+		Acentric: W,
+		Zc:       Zc,
+		R:        R,
+	}
+}
@@ -0,0 +1,45 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestRKPRLimits(t *testing.T) {
+	// For δ1 = 1 + √2 the RK-PR parameters must reduce to Peng-Robinson.
+	rk := &cubic.RKPR{}
+	zc := 0.0
+	// Find the Zc giving δ1 = 1 + √2 by bisection on the monotone correlation.
+	lo, hi := 0.2, 0.2897
+	for range 100 {
+		zc = (lo + hi) / 2
+		if cubic.RKPRDelta1(zc) > 1+math.Sqrt2 {
+			lo = zc
+		} else {
+			hi = zc
+		}
+	}
+
+	got := rk.ParamsZc(zc)
+	want := (&cubic.PR{}).Params()
+	if math.Abs(got.Omega-want.Omega) > 1e-4 || math.Abs(got.Psi-want.Psi) > 1e-4 {
+		t.Errorf("ParamsZc(%.4f) = %+v, want PR parameters %+v", zc, got, want)
+	}
+}
+
+func TestRKPRSaturation(t *testing.T) {
+	// Propane at its normal boiling point should have Psat close to 1 atm.
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewRKPRCfg(231.1, 1, 369.8, 42.48, 0.276, 0.152, R)
+
+	p, err := cubic.SaturationPressure(cfg, 231.1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(p-zfactor.AtmBar)/zfactor.AtmBar > 0.02 {
+		t.Errorf("SaturationPressure() = %.4f bar, want about %.4f bar", p, zfactor.AtmBar)
+	}
+}
@@ -3,31 +3,25 @@ package cubic
 import (
 	"errors"
 	"math"
+
+	"github.com/rickykimani/zfactor/phase"
 )
 
 // LogFugacity calculates the natural logarithm of the fugacity coefficient.
 // Z is the compressibility factor (PV/RT).
 // A and B are the dimensionless EOS parameters: A = aP/(RT)^2, B = bP/RT.
+// It returns NaN if cfg.Type is not set.
 func LogFugacity(cfg *EOSCfg, Z, A, B float64) float64 {
-	sigma := cfg.Type.Params().Sigma
-	epsilon := cfg.Type.Params().Epsilon
-
-	// Generic cubic EOS fugacity coefficient
-	// ln(phi) = Z - 1 - ln(Z - B) + (A / (B * (epsilon - sigma))) * ln((Z + sigma*B) / (Z + epsilon*B))
-
-	term1 := Z - 1 - math.Log(Z-B)
-
-	var term2 float64
-	diff := epsilon - sigma
-	if math.Abs(diff) < 1e-9 {
-		// Degenerate case (e.g. VdW)
-		// For VdW, the integral term is -A/Z.
-		term2 = -A / Z
-	} else {
-		term2 = (A / (B * diff)) * math.Log((Z+sigma*B)/(Z+epsilon*B))
+	params, err := cfg.Parameters()
+	if err != nil {
+		return math.NaN()
 	}
 
-	return term1 + term2
+	// ln(phi) = α^r + Z - 1 - ln(Z), with α^r evaluated at b/V = B/Z and
+	// a/(bRT) = A/B. For the generic cubic this is
+	// ln(phi) = Z - 1 - ln(Z - B) + (A / (B * (epsilon - sigma))) * ln((Z + sigma*B) / (Z + epsilon*B))
+	r := residual(B/Z, A/B, 0, 0, params.Sigma, params.Epsilon)
+	return r.Ar + Z - 1 - math.Log(Z)
 }
 
 // SaturationPressure calculates the saturation pressure at a given temperature T.
@@ -38,29 +32,24 @@ func SaturationPressure(cfg *EOSCfg, T float64) (float64, error) {
 	}
 
 	// Initial guess using Wilson equation
-	Tr := T / cfg.Tc
-	P := cfg.Pc * math.Exp(5.373*(1+cfg.Acentric)*(1-1/Tr))
+	P := wilsonK(Component{Tc: cfg.Tc, Pc: cfg.Pc, Acentric: cfg.Acentric}, T, 1)
 
 	for range 100 {
-		// Update cfg with new P
-		iterCfg := *cfg
-		iterCfg.P = P
-		iterCfg.T = T
-
-		// Solve for volume
-		volRes, err := SolveForVolume(&iterCfg)
+		// Solve for volume at the new P
+		iterCfg := cfg.At(T, P)
+		volRes, err := SolveForVolume(iterCfg)
 		if err != nil {
 			return 0, err
 		}
 
 		roots := volRes.Clean()
+		if len(roots) == 0 {
+			return 0, errors.New("no real roots found")
+		}
 
-		// If we don't have 3 roots, we are likely outside the two-phase region (P is too high or too low).
-		// We need to adjust P to find the 3-root region.
-		if len(roots) < 3 {
-			if len(roots) == 0 {
-				return 0, errors.New("no real roots found")
-			}
+		// If we don't have distinct liquid and vapor roots, we are likely outside the two-phase
+		// region (P is too high or too low). We need to adjust P to find the 3-root region.
+		if volRes.Class.Distinct() < 2 {
 
 			// Heuristic: Check compressibility Z
 			// If Z is small (liquid-like), P is likely too high -> decrease P
@@ -86,8 +75,8 @@ func SaturationPressure(cfg *EOSCfg, T float64) (float64, error) {
 			continue
 		}
 
-		Vl := roots[0]
-		Vv := roots[len(roots)-1]
+		Vl, _ := phase.Root(roots, phase.Liquid)
+		Vv, _ := phase.Root(roots, phase.Vapor)
 
 		// Calculate dimensionless parameters
 		RT := cfg.R * T
@@ -105,8 +94,8 @@ func SaturationPressure(cfg *EOSCfg, T float64) (float64, error) {
 			continue
 		}
 
-		phil := LogFugacity(&iterCfg, Zl, Adim, Bdim)
-		phiv := LogFugacity(&iterCfg, Zv, Adim, Bdim)
+		phil := LogFugacity(iterCfg, Zl, Adim, Bdim)
+		phiv := LogFugacity(iterCfg, Zv, Adim, Bdim)
 
 		// Check convergence
 		if math.Abs(phil-phiv) < 1e-8 {
@@ -0,0 +1,35 @@
+package cubic
+
+import "github.com/rickykimani/zfactor"
+
+// VirialB calculates the second virial coefficient implied by the cubic EOS at
+// temperature T. Expanding the generic cubic in powers of 1/V gives
+//
+//	B(T) = b - a(T)/(RT)
+//
+// which describes the low-pressure limit of the EOS. It can be compared against
+// correlations such as abbott.B0/B1 to sanity-check an EOS parameterization.
+//
+// The result has units of molar volume consistent with cfg.R and cfg.Pc.
+func VirialB(cfg *EOSCfg, T float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if cfg.Pc <= 0 || cfg.Tc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	if cfg.R <= 0 {
+		return 0, zfactor.ErrUniversalConst
+	}
+
+	params, err := cfg.Parameters()
+	if err != nil {
+		return 0, err
+	}
+	alpha := cfg.alpha(T / cfg.Tc)
+
+	a := calculateA(params.Psi, alpha, cfg.R, cfg.Tc, cfg.Pc)
+	b := calculateB(params.Omega, cfg.R, cfg.Tc, cfg.Pc)
+
+	return b - a/(cfg.R*T), nil
+}
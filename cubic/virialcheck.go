@@ -0,0 +1,169 @@
+package cubic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// BoyleTemperature calculates the Boyle temperature of the EOS, where the
+// second virial coefficient B(T) = b - a(T)/(RT) vanishes. Below it B < 0 and
+// attraction lowers Z at low pressure; above it B > 0. For van der Waals the
+// Boyle temperature is a/(bR) = 27/8 Tc.
+//
+// The root is bracketed between 0.5 Tc and 20 Tc and refined by bisection.
+func BoyleTemperature(cfg *EOSCfg) (float64, error) {
+	f := func(T float64) (float64, error) { return VirialB(cfg, T) }
+
+	lo, hi := 0.5*cfg.Tc, 20*cfg.Tc
+	fLo, err := f(lo)
+	if err != nil {
+		return 0, err
+	}
+	fHi, err := f(hi)
+	if err != nil {
+		return 0, err
+	}
+	if math.Signbit(fLo) == math.Signbit(fHi) {
+		return 0, fmt.Errorf("B(T) does not change sign between %g and %g K", lo, hi)
+	}
+
+	for hi-lo > 1e-10*hi {
+		mid := (lo + hi) / 2
+		fm, err := f(mid)
+		if err != nil {
+			return 0, err
+		}
+		if math.Signbit(fm) == math.Signbit(fLo) {
+			lo, fLo = mid, fm
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
+
+// VirialCheck compares the full EOS with its truncation to the 2-term virial
+// equation Z = 1 + BP/RT, where B is the second virial coefficient implied by the
+// same EOS (see VirialB). The difference isolates the error of neglecting the
+// higher virial terms, i.e. of assuming Z - 1 linear in P.
+type VirialCheck struct {
+	T         float64 // Temperature
+	P         float64 // Pressure
+	ZEOS      float64 // Z of the stable root of the EOS
+	ZVirial   float64 // Z = 1 + BP/RT
+	Deviation float64 // 100 * (ZVirial - ZEOS) / ZEOS
+}
+
+// CheckVirial evaluates VirialCheck at every combination of the temperatures T
+// and pressures P, in the units of cfg. The results are ordered by temperature,
+// then pressure.
+//
+// Where the EOS has several real roots, the one with the lowest fugacity, i.e.
+// the stable phase, is compared. Deviations are therefore large for liquid
+// states, where the virial equation does not apply.
+func CheckVirial(cfg *EOSCfg, T, P []float64) ([]VirialCheck, error) {
+	checks := make([]VirialCheck, 0, len(T)*len(P))
+	for _, t := range T {
+		for _, p := range P {
+			c, err := checkVirial(cfg, t, p)
+			if err != nil {
+				return nil, fmt.Errorf("T = %g, P = %g: %w", t, p, err)
+			}
+			checks = append(checks, *c)
+		}
+	}
+	return checks, nil
+}
+
+// checkVirial evaluates VirialCheck at temperature T and pressure P.
+func checkVirial(cfg *EOSCfg, T, P float64) (*VirialCheck, error) {
+	c := cfg.At(T, P)
+
+	B, err := VirialB(c, T)
+	if err != nil {
+		return nil, err
+	}
+	volRes, err := SolveForVolume(c)
+	if err != nil {
+		return nil, err
+	}
+	v, err := volRes.Stable(c)
+	if err != nil {
+		return nil, err
+	}
+
+	RT := c.R * T
+	zEOS := P * v / RT
+	zVir := 1 + B*P/RT
+	return &VirialCheck{
+		T:         T,
+		P:         P,
+		ZEOS:      zEOS,
+		ZVirial:   zVir,
+		Deviation: 100 * (zVir - zEOS) / zEOS,
+	}, nil
+}
+
+// MaxVirialPressure returns the highest pressure, in the units of cfg, up to which
+// the 2-term virial equation reproduces the EOS Z at temperature T within tol
+// percent (see VirialCheck). Pressures are searched from 0.001 Pc up to 10 Pc.
+//
+// It gives an actionable bound on the virial approximation in place of a fixed
+// rule of thumb such as P < 15 bar.
+func MaxVirialPressure(cfg *EOSCfg, T, tol float64) (float64, error) {
+	if tol <= 0 {
+		return 0, errors.New("tolerance must be positive")
+	}
+	if cfg.Pc <= 0 || cfg.Tc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	within := func(P float64) (bool, error) {
+		c, err := checkVirial(cfg, T, P)
+		if err != nil {
+			return false, err
+		}
+		return math.Abs(c.Deviation) <= tol, nil
+	}
+
+	lo := 1e-3 * cfg.Pc
+	ok, err := within(lo)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("2-term virial deviates by more than %g%% already at P = %g", tol, lo)
+	}
+
+	hi := lo
+	for {
+		hi *= 2
+		if hi > 10*cfg.Pc {
+			return 0, fmt.Errorf("2-term virial is within %g%% up to 10 Pc", tol)
+		}
+		ok, err := within(hi)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		lo = hi
+	}
+
+	for hi-lo > 1e-6*hi {
+		mid := (lo + hi) / 2
+		ok, err := within(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
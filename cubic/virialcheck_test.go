@@ -0,0 +1,61 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestBoyleTemperature(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewvdWCfg(300, 1, 369.8, 42.48, R)
+
+	got, err := cubic.BoyleTemperature(cfg)
+	if err != nil {
+		t.Fatalf("BoyleTemperature() unexpected error: %v", err)
+	}
+	if want := 27.0 / 8.0 * 369.8; math.Abs(got-want) > 1e-6*want {
+		t.Errorf("BoyleTemperature() = %v, want %v", got, want)
+	}
+	if B, _ := cubic.VirialB(cfg, got); math.Abs(B) > 1e-6 {
+		t.Errorf("VirialB(Boyle temperature) = %v, want 0", B)
+	}
+}
+
+func TestCheckVirial(t *testing.T) {
+	// Propane vapor at 350 K, PR Psat ≈ 30 bar.
+	const R = 10 * zfactor.RSI
+	cfg := cubic.NewPRCfg(350, 1, 369.8, 42.48, 0.152, R)
+
+	checks, err := cubic.CheckVirial(cfg, []float64{350, 500}, []float64{0.01, 5, 20})
+	if err != nil {
+		t.Fatalf("CheckVirial() unexpected error: %v", err)
+	}
+	if len(checks) != 6 {
+		t.Fatalf("CheckVirial() returned %d checks, want 6", len(checks))
+	}
+	if d := checks[0].Deviation; math.Abs(d) > 1e-3 {
+		t.Errorf("Deviation at 0.01 bar = %v%%, want about 0", d)
+	}
+	// The truncation error grows with pressure and shrinks with temperature.
+	if math.Abs(checks[2].Deviation) <= math.Abs(checks[1].Deviation) {
+		t.Errorf("Deviation at 20 bar (%v%%) not larger than at 5 bar (%v%%)", checks[2].Deviation, checks[1].Deviation)
+	}
+	if math.Abs(checks[5].Deviation) >= math.Abs(checks[2].Deviation) {
+		t.Errorf("Deviation at 500 K (%v%%) not smaller than at 350 K (%v%%)", checks[5].Deviation, checks[2].Deviation)
+	}
+
+	pMax, err := cubic.MaxVirialPressure(cfg, 350, 1)
+	if err != nil {
+		t.Fatalf("MaxVirialPressure() unexpected error: %v", err)
+	}
+	c, err := cubic.CheckVirial(cfg, []float64{350}, []float64{pMax})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := math.Abs(c[0].Deviation); math.Abs(d-1) > 1e-3 {
+		t.Errorf("Deviation at MaxVirialPressure() = %v bar is %v%%, want 1%%", pMax, d)
+	}
+}
@@ -0,0 +1,60 @@
+package cubic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// WilsonK estimates the equilibrium ratio Kᵢ = yᵢ/xᵢ of a component at
+// temperature T and pressure P from the Wilson correlation:
+//
+//	K = (Pc/P) exp[5.373(1 + ω)(1 - Tc/T)]
+//
+// It assumes ideal liquid and vapor phases and is the usual initial estimate of
+// flash, bubble-point and dew-point iterations. P is in the units of c.Pc.
+func WilsonK(c Component, T, P float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return 0, zfactor.ErrPressure
+	}
+	if c.Tc <= 0 || c.Pc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	return wilsonK(c, T, P), nil
+}
+
+// WilsonKValues returns the Wilson K-values of the components of cfg at cfg.T and
+// cfg.P, in the order of cfg.Components, to initialize a flash or phase
+// equilibrium iteration on the mixture.
+func WilsonKValues(cfg *MixtureCfg) ([]float64, error) {
+	if cfg.T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if cfg.P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	K := make([]float64, len(cfg.Components))
+	for i, c := range cfg.Components {
+		k, err := WilsonK(c, cfg.T, cfg.P)
+		if err != nil {
+			return nil, fmt.Errorf("component %d: %w", i+1, err)
+		}
+		K[i] = k
+	}
+	return K, nil
+}
+
+// WilsonPressure returns the vapor pressure of a component at temperature T
+// estimated from the Wilson correlation, the pressure at which K = 1.
+func WilsonPressure(c Component, T float64) (float64, error) {
+	return WilsonK(c, T, 1)
+}
+
+// wilsonK is WilsonK without the input checks.
+func wilsonK(c Component, T, P float64) float64 {
+	return c.Pc / P * math.Exp(5.373*(1+c.Acentric)*(1-c.Tc/T))
+}
@@ -0,0 +1,67 @@
+package cubic_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestWilsonK(t *testing.T) {
+	// K = Pc/P at Tr = 0.7 when ω = 0, where the exponent is 5.373(1 - 1/0.7).
+	c := cubic.Component{Tc: 300, Pc: 40}
+	want := 40.0 / 2 * math.Exp(5.373*(1-1/0.7))
+	got, err := cubic.WilsonK(c, 210, 2)
+	if err != nil {
+		t.Fatalf("WilsonK() unexpected error: %v", err)
+	}
+	if math.Abs(got-want) > 1e-12*want {
+		t.Errorf("WilsonK() = %v, want %v", got, want)
+	}
+
+	// K = 1 at the Wilson vapor pressure.
+	pSat, err := cubic.WilsonPressure(propane, 250)
+	if err != nil {
+		t.Fatalf("WilsonPressure() unexpected error: %v", err)
+	}
+	if k, _ := cubic.WilsonK(propane, 250, pSat); math.Abs(k-1) > 1e-12 {
+		t.Errorf("WilsonK(WilsonPressure()) = %v, want 1", k)
+	}
+
+	tests := []struct {
+		name string
+		c    cubic.Component
+		T, P float64
+		want error
+	}{
+		{"zero temperature", propane, 0, 1, zfactor.ErrTemp},
+		{"zero pressure", propane, 250, 0, zfactor.ErrPressure},
+		{"missing critical properties", cubic.Component{}, 250, 1, zfactor.ErrCriticalProp},
+	}
+	for _, tt := range tests {
+		if _, err := cubic.WilsonK(tt.c, tt.T, tt.P); !errors.Is(err, tt.want) {
+			t.Errorf("%s: WilsonK() error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestWilsonKValues(t *testing.T) {
+	const R = 10 * zfactor.RSI
+	mix := cubic.NewMixtureCfg(&cubic.PR{}, 300, 10, []float64{0.5, 0.5}, []cubic.Component{propane, butane}, R)
+	K, err := cubic.WilsonKValues(mix)
+	if err != nil {
+		t.Fatalf("WilsonKValues() unexpected error: %v", err)
+	}
+	for i, c := range mix.Components {
+		want, _ := cubic.WilsonK(c, mix.T, mix.P)
+		if K[i] != want {
+			t.Errorf("WilsonKValues()[%d] = %v, want %v", i, K[i], want)
+		}
+	}
+	mix.Components[1] = cubic.Component{}
+	if _, err := cubic.WilsonKValues(mix); !errors.Is(err, zfactor.ErrCriticalProp) {
+		t.Errorf("WilsonKValues() error = %v, want %v", err, zfactor.ErrCriticalProp)
+	}
+}
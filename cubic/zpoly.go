@@ -0,0 +1,106 @@
+package cubic
+
+import (
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// ZCubic holds the dimensionless form of the generic cubic EOS written in terms
+// of the compressibility factor:
+//
+//	Z³ + C2·Z² + C1·Z + C0 = 0
+//
+// with A = aP/(RT)² and B = bP/(RT):
+//
+//	C2 = (ε + σ - 1)B - 1
+//	C1 = A + εσB² - (ε + σ)B(B + 1)
+//	C0 = -[εσB²(B + 1) + AB]
+type ZCubic struct {
+	A       float64 // Dimensionless attraction parameter aP/(RT)²
+	B       float64 // Dimensionless co-volume bP/(RT)
+	Sigma   float64 //σ
+	Epsilon float64 //ε
+
+	C2 float64 // Coefficient of Z²
+	C1 float64 // Coefficient of Z
+	C0 float64 // Constant term
+}
+
+// Eval evaluates the polynomial at z.
+func (zc *ZCubic) Eval(z float64) float64 {
+	return ((z+zc.C2)*z+zc.C1)*z + zc.C0
+}
+
+// Derivative evaluates the first derivative of the polynomial with respect to Z at z.
+func (zc *ZCubic) Derivative(z float64) float64 {
+	return (3*z+2*zc.C2)*z + zc.C1
+}
+
+// String implements fmt.Stringer for ZCubic.
+func (zc *ZCubic) String() string {
+	return fmt.Sprintf("ZCubic{A: %g, B: %g, C2: %g, C1: %g, C0: %g}", zc.A, zc.B, zc.C2, zc.C1, zc.C0)
+}
+
+// ZPolynomial builds the cubic in Z for the conditions in cfg.
+//
+// It is the dimensionless equivalent of the volume polynomial solved by
+// SolveForVolume; each root Z corresponds to a molar volume V = ZRT/P.
+func ZPolynomial(cfg *EOSCfg) (*ZCubic, error) {
+	if cfg.T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if cfg.P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	if cfg.Pc <= 0 || cfg.Tc <= 0 {
+		return nil, zfactor.ErrCriticalProp
+	}
+	if cfg.R <= 0 {
+		return nil, zfactor.ErrUniversalConst
+	}
+
+	params, err := cfg.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	alpha := cfg.alpha(cfg.T / cfg.Tc)
+
+	a := calculateA(params.Psi, alpha, cfg.R, cfg.Tc, cfg.Pc)
+	b := calculateB(params.Omega, cfg.R, cfg.Tc, cfg.Pc)
+
+	rt := cfg.R * cfg.T
+	A := a * cfg.P / (rt * rt)
+	B := b * cfg.P / rt
+
+	x := params.Epsilon + params.Sigma
+	y := params.Epsilon * params.Sigma
+
+	return &ZCubic{
+		A:       A,
+		B:       B,
+		Sigma:   params.Sigma,
+		Epsilon: params.Epsilon,
+		C2:      (x-1)*B - 1,
+		C1:      A + y*B*B - x*B*(B+1),
+		C0:      -(y*B*B*(B+1) + A*B),
+	}, nil
+}
+
+// SolveZPolynomial returns the real roots of the polynomial, sorted in ascending
+// order. The smallest root is the liquid-like compressibility factor and the
+// largest the vapor-like one.
+func SolveZPolynomial(zc *ZCubic) ([]float64, error) {
+	roots, err := zfactor.SolveCubic(1, zc.C2, zc.C1, zc.C0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to solve cubic: %w", err)
+	}
+
+	class, err := zfactor.ClassifyCubic(1, zc.C2, zc.C1, zc.C0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify cubic: %w", err)
+	}
+
+	vr := VolumeResult{Volumes: roots, Class: class}
+	return vr.Clean(), nil
+}
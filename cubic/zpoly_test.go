@@ -0,0 +1,48 @@
+package cubic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+func TestZPolynomial(t *testing.T) {
+	// n-butane at 350 K and its vapor pressure (SVNA Example 3.9)
+	cfgs := []*cubic.EOSCfg{
+		cubic.NewvdWCfg(350, 9.4573, 425.1, 37.96, 83.14),
+		cubic.NewRKCfg(350, 9.4573, 425.1, 37.96, 83.14),
+		cubic.NewSRKCfg(350, 9.4573, 425.1, 37.96, 0.200, 83.14),
+		cubic.NewPRCfg(350, 9.4573, 425.1, 37.96, 0.200, 83.14),
+	}
+
+	for _, cfg := range cfgs {
+		poly, err := cubic.ZPolynomial(cfg)
+		if err != nil {
+			t.Fatalf("ZPolynomial(%T) unexpected error: %v", cfg.Type, err)
+		}
+		zs, err := cubic.SolveZPolynomial(poly)
+		if err != nil {
+			t.Fatalf("SolveZPolynomial(%T) unexpected error: %v", cfg.Type, err)
+		}
+
+		volRes, err := cubic.SolveForVolume(cfg)
+		if err != nil {
+			t.Fatalf("SolveForVolume(%T) unexpected error: %v", cfg.Type, err)
+		}
+		vs := volRes.Clean()
+		if len(zs) != len(vs) {
+			t.Fatalf("%T: got %d Z roots, want %d", cfg.Type, len(zs), len(vs))
+		}
+
+		vig := cfg.R * cfg.T / cfg.P
+		for i := range zs {
+			if math.Abs(zs[i]*vig-vs[i]) > 1e-6*vs[i] {
+				t.Errorf("%T: Z[%d]*RT/P = %v, want %v", cfg.Type, i, zs[i]*vig, vs[i])
+			}
+			if r := poly.Eval(zs[i]); math.Abs(r) > 1e-10 {
+				t.Errorf("%T: Eval(%v) = %v, want 0", cfg.Type, zs[i], r)
+			}
+		}
+	}
+}
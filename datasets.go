@@ -0,0 +1,162 @@
+package zfactor
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// Names of the embedded datasets, for DataVersion and PinDataVersion.
+const (
+	DatasetLeeKesler = "lee-kesler" // Lee-Kesler tables of package leekesler
+	DatasetLydersen  = "lydersen"   // Digitized Lydersen chart of package liquids
+	DatasetAntoine   = "antoine"    // Built-in Antoine sets of package antoine
+	DatasetSubstance = "substance"  // Built-in substances of package substance
+)
+
+// DataVersionWarning is the warning emitted when a result is computed from an
+// embedded dataset whose version differs from the version pinned with
+// PinDataVersion, e.g. after an upgrade that improved the digitized Lydersen
+// chart. Results are still returned; the warning tells that they may differ
+// from the results the pinned version gave.
+type DataVersionWarning struct {
+	Dataset string // Name of the dataset
+	Pinned  int    // Version pinned with PinDataVersion
+	Version int    // Version of the embedded dataset
+}
+
+// Error implements the error interface for DataVersionWarning.
+func (w *DataVersionWarning) Error() string {
+	rel := "newer"
+	if !w.Newer() {
+		rel = "older"
+	}
+	return fmt.Sprintf("dataset %s is version %d, %s than the pinned version %d", w.Dataset, w.Version, rel, w.Pinned)
+}
+
+// Newer reports whether the embedded dataset is newer than the pinned version.
+func (w *DataVersionWarning) Newer() bool { return w.Version > w.Pinned }
+
+// pin is a version pinned with PinDataVersion.
+type pin struct {
+	version int
+	warned  bool // The warning of the dataset has been emitted
+}
+
+var (
+	datasetsMu sync.Mutex
+	datasets   = map[string]int{}  // Embedded versions, keyed by dataset name
+	pins       = map[string]*pin{} // Pinned versions, keyed by dataset name
+	anyPinned  atomic.Bool         // len(pins) > 0, see DataPinned
+	warn       = func(err error) { log.Printf("zfactor: warning: %v", err) }
+)
+
+// RegisterDataset records the version of an embedded dataset. The packages
+// that embed a dataset call it at init with their DataVersion constant.
+func RegisterDataset(name string, version int) {
+	datasetsMu.Lock()
+	datasets[name] = version
+	datasetsMu.Unlock()
+}
+
+// DataVersion returns the version of the embedded dataset named name, and
+// false if no imported package embeds it. The version is incremented whenever
+// values of the dataset change.
+func DataVersion(name string) (int, bool) {
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+	v, ok := datasets[name]
+	return v, ok
+}
+
+// DataVersions returns the versions of the datasets embedded by the imported
+// packages, keyed by dataset name.
+func DataVersions() map[string]int {
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+	return maps.Clone(datasets)
+}
+
+// PinDataVersion pins the dataset named name to version, typically the version
+// a program was validated against. When a result is later computed from the
+// dataset and the embedded version differs, a *DataVersionWarning is passed to
+// the warning handler (see SetWarningHandler), once until the dataset is
+// pinned again.
+//
+//	zfactor.PinDataVersion(zfactor.DatasetLydersen, 1)
+//
+// It returns an error if no imported package embeds the dataset.
+func PinDataVersion(name string, version int) error {
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+	if _, ok := datasets[name]; !ok {
+		return fmt.Errorf("unknown dataset %q", name)
+	}
+	pins[name] = &pin{version: version}
+	anyPinned.Store(true)
+	return nil
+}
+
+// UnpinDataVersion removes the pin of the dataset named name.
+func UnpinDataVersion(name string) {
+	datasetsMu.Lock()
+	delete(pins, name)
+	anyPinned.Store(len(pins) > 0)
+	datasetsMu.Unlock()
+}
+
+// CheckDataVersions returns the warnings of the pinned datasets whose embedded
+// version differs from the pinned version, in no particular order, so that a
+// program can fail at startup instead of on the first result. It does not
+// call the warning handler.
+func CheckDataVersions() []*DataVersionWarning {
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+	var ws []*DataVersionWarning
+	for name, p := range pins {
+		if v := datasets[name]; v != p.version {
+			ws = append(ws, &DataVersionWarning{Dataset: name, Pinned: p.version, Version: v})
+		}
+	}
+	return ws
+}
+
+// SetWarningHandler sets the function that receives the warnings of the
+// library, such as *DataVersionWarning, and returns the previous handler. The
+// default handler writes them to the standard logger; nil discards them.
+func SetWarningHandler(h func(error)) func(error) {
+	datasetsMu.Lock()
+	defer datasetsMu.Unlock()
+	prev := warn
+	warn = h
+	return prev
+}
+
+// DataPinned reports whether any dataset is pinned, so that the packages that
+// embed a dataset can skip finding out whether it is used when none is.
+func DataPinned() bool { return anyPinned.Load() }
+
+// UseDataset records that a result is computed from the embedded dataset
+// named name and emits its *DataVersionWarning if the dataset is pinned to
+// another version. The packages that embed a dataset call it; it is cheap
+// when no dataset is pinned.
+func UseDataset(name string) {
+	if !DataPinned() {
+		return
+	}
+	datasetsMu.Lock()
+	p, ok := pins[name]
+	v := datasets[name]
+	if !ok || p.warned || p.version == v {
+		datasetsMu.Unlock()
+		return
+	}
+	p.warned = true
+	h := warn
+	datasetsMu.Unlock()
+	if h != nil {
+		h(&DataVersionWarning{Dataset: name, Pinned: p.version, Version: v})
+	}
+}
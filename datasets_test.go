@@ -0,0 +1,77 @@
+package zfactor
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDataVersions(t *testing.T) {
+	RegisterDataset("test", 3)
+	prev := SetWarningHandler(nil)
+	t.Cleanup(func() {
+		UnpinDataVersion("test")
+		SetWarningHandler(prev)
+		datasetsMu.Lock()
+		delete(datasets, "test")
+		datasetsMu.Unlock()
+	})
+
+	if v, ok := DataVersion("test"); !ok || v != 3 {
+		t.Errorf("DataVersion() = %d, %v, want 3, true", v, ok)
+	}
+	if v := DataVersions()["test"]; v != 3 {
+		t.Errorf("DataVersions()[test] = %d, want 3", v)
+	}
+	if err := PinDataVersion("no such dataset", 1); err == nil {
+		t.Error("PinDataVersion() with unknown dataset expected an error")
+	}
+
+	var got []error
+	SetWarningHandler(func(err error) { got = append(got, err) })
+
+	// A pin of the embedded version does not warn.
+	if err := PinDataVersion("test", 3); err != nil {
+		t.Fatalf("PinDataVersion() unexpected error: %v", err)
+	}
+	UseDataset("test")
+	if len(got) != 0 || len(CheckDataVersions()) != 0 {
+		t.Fatalf("UseDataset() with matching pin warned: %v", got)
+	}
+
+	tests := []struct {
+		pinned int
+		newer  bool
+	}{
+		{2, true},
+		{4, false},
+	}
+	for _, tt := range tests {
+		got = nil
+		if err := PinDataVersion("test", tt.pinned); err != nil {
+			t.Fatalf("PinDataVersion() unexpected error: %v", err)
+		}
+		UseDataset("test")
+		UseDataset("test")
+		if len(got) != 1 {
+			t.Fatalf("pinned %d: got %d warnings, want 1", tt.pinned, len(got))
+		}
+		var w *DataVersionWarning
+		if !errors.As(got[0], &w) {
+			t.Fatalf("pinned %d: warning %T, want *DataVersionWarning", tt.pinned, got[0])
+		}
+		want := DataVersionWarning{Dataset: "test", Pinned: tt.pinned, Version: 3}
+		if *w != want || w.Newer() != tt.newer {
+			t.Errorf("pinned %d: warning = %+v (newer %v), want %+v (newer %v)", tt.pinned, *w, w.Newer(), want, tt.newer)
+		}
+		if ws := CheckDataVersions(); len(ws) != 1 || *ws[0] != want {
+			t.Errorf("CheckDataVersions() = %v, want [%v]", ws, &want)
+		}
+	}
+
+	UnpinDataVersion("test")
+	got = nil
+	UseDataset("test")
+	if len(got) != 0 || len(CheckDataVersions()) != 0 {
+		t.Errorf("UseDataset() after UnpinDataVersion warned: %v", got)
+	}
+}
@@ -0,0 +1,152 @@
+package zfactor
+
+import (
+	"errors"
+	"sync"
+)
+
+// Interpolation selects how tabulated correlations such as the Lee-Kesler tables
+// are interpolated.
+type Interpolation int
+
+const (
+	// Bilinear interpolates linearly in Tr and Pr between the four surrounding
+	// table entries.
+	Bilinear Interpolation = iota
+	// Bicubic interpolates with piecewise bicubic polynomials whose values and
+	// first derivatives are continuous between table cells, for work that
+	// differentiates the interpolated values.
+	Bicubic
+)
+
+// String implements fmt.Stringer for Interpolation.
+func (i Interpolation) String() string {
+	switch i {
+	case Bilinear:
+		return "bilinear"
+	case Bicubic:
+		return "bicubic"
+	default:
+		return "unknown"
+	}
+}
+
+// LeeKeslerMethod selects how the Lee-Kesler correlation is evaluated.
+type LeeKeslerMethod int
+
+const (
+	// LKTables interpolates the published Lee-Kesler tables.
+	LKTables LeeKeslerMethod = iota
+	// LKAnalytic solves the modified Benedict-Webb-Rubin equations of the simple
+	// and reference fluids from which the tables were generated.
+	LKAnalytic
+)
+
+// String implements fmt.Stringer for LeeKeslerMethod.
+func (m LeeKeslerMethod) String() string {
+	switch m {
+	case LKTables:
+		return "tables"
+	case LKAnalytic:
+		return "analytic"
+	default:
+		return "unknown"
+	}
+}
+
+// Config holds the package-wide defaults consumed by convenience APIs when the
+// caller does not supply a value. Explicit arguments always take precedence.
+type Config struct {
+	// EOS names the default cubic equation of state, as accepted by cubic.ByName
+	// (e.g. "PR" or "SRK"). It is used by Substance.CubicConfig when no EOS is given.
+	EOS string
+	// Basis is the default unit basis of generated tables.
+	Basis Basis
+	// R is the default universal gas constant, used when Args.R is 0.
+	R float64
+	// Interpolation is the default interpolation of tabulated correlations.
+	Interpolation Interpolation
+	// LeeKesler selects how Substance.LeeKesler evaluates the Lee-Kesler
+	// correlation.
+	LeeKesler LeeKeslerMethod
+}
+
+// builtinDefaults are the defaults in effect until SetDefaults is called.
+var builtinDefaults = Config{
+	EOS:           "PR",
+	Basis:         MolarBasis,
+	R:             RSI * 10, // bar·cm³/(mol·K)
+	Interpolation: Bilinear,
+	LeeKesler:     LKTables,
+}
+
+var (
+	defaultsMu sync.RWMutex
+	defaults   = builtinDefaults
+	// validEOS checks the EOS names of SetDefaults. It is set by package cubic,
+	// which this package cannot import.
+	validEOS func(name string) error
+)
+
+// RegisterEOSValidator sets the function that SetDefaults uses to reject
+// unknown EOS names. Package cubic registers cubic.ByName at init.
+func RegisterEOSValidator(f func(name string) error) {
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	validEOS = f
+}
+
+// Defaults returns a copy of the current defaults. It is safe for concurrent use.
+func Defaults() Config {
+	defaultsMu.RLock()
+	defer defaultsMu.RUnlock()
+	return defaults
+}
+
+// SetDefaults replaces the defaults. Fields left at their zero value take the
+// built-in defaults: the Peng-Robinson EOS, molar basis, R = 83.14 bar·cm³/(mol·K),
+// bilinear interpolation and the Lee-Kesler tables.
+//
+// It returns an error for an EOS name that cubic.ByName does not accept, once
+// package cubic is imported.
+//
+// It is safe for concurrent use, but is meant to be called once at program
+// start: changing the defaults while calculations run makes their results
+// depend on timing.
+func SetDefaults(c Config) error {
+	if c.R < 0 {
+		return ErrUniversalConst
+	}
+	if c.Basis != MolarBasis && c.Basis != MassBasis {
+		return errors.New("unknown unit basis")
+	}
+	if c.Interpolation != Bilinear && c.Interpolation != Bicubic {
+		return errors.New("unknown interpolation")
+	}
+	if c.LeeKesler != LKTables && c.LeeKesler != LKAnalytic {
+		return errors.New("unknown Lee-Kesler method")
+	}
+	if c.EOS == "" {
+		c.EOS = builtinDefaults.EOS
+	}
+	if c.R == 0 {
+		c.R = builtinDefaults.R
+	}
+
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	if validEOS != nil {
+		if err := validEOS(c.EOS); err != nil {
+			return err
+		}
+	}
+	defaults = c
+	return nil
+}
+
+// ResetDefaults restores the built-in defaults.
+func ResetDefaults() {
+	defaultsMu.Lock()
+	defer defaultsMu.Unlock()
+	defaults = builtinDefaults
+}
@@ -0,0 +1,63 @@
+package zfactor
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestDefaults(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+
+	if got := Defaults(); got != builtinDefaults {
+		t.Fatalf("Defaults() = %+v, want %+v", got, builtinDefaults)
+	}
+
+	if err := SetDefaults(Config{EOS: "SRK", Basis: MassBasis}); err != nil {
+		t.Fatalf("SetDefaults() unexpected error: %v", err)
+	}
+	want := Config{EOS: "SRK", Basis: MassBasis, R: RSI * 10, Interpolation: Bilinear}
+	if got := Defaults(); got != want {
+		t.Errorf("Defaults() = %+v, want %+v", got, want)
+	}
+
+	if err := SetDefaults(Config{R: -1}); err != ErrUniversalConst {
+		t.Errorf("SetDefaults() error = %v, want %v", err, ErrUniversalConst)
+	}
+	if err := SetDefaults(Config{Basis: Basis(7)}); err == nil {
+		t.Error("SetDefaults() with unknown basis expected an error")
+	}
+	if err := SetDefaults(Config{Interpolation: Interpolation(7)}); err == nil {
+		t.Error("SetDefaults() with unknown interpolation expected an error")
+	}
+	if err := SetDefaults(Config{LeeKesler: LeeKeslerMethod(7)}); err == nil {
+		t.Error("SetDefaults() with unknown Lee-Kesler method expected an error")
+	}
+	if got := Defaults(); got != want {
+		t.Errorf("Defaults() after failed SetDefaults = %+v, want %+v", got, want)
+	}
+
+	ResetDefaults()
+	if got := Defaults(); got != builtinDefaults {
+		t.Errorf("Defaults() after ResetDefaults = %+v, want %+v", got, builtinDefaults)
+	}
+}
+
+func TestDefaultsConcurrent(t *testing.T) {
+	t.Cleanup(ResetDefaults)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = SetDefaults(Config{R: float64(i + 1)})
+		}()
+		go func() {
+			defer wg.Done()
+			if r := Defaults().R; r <= 0 {
+				t.Errorf("Defaults().R = %v, want > 0", r)
+			}
+		}()
+	}
+	wg.Wait()
+}
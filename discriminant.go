@@ -0,0 +1,130 @@
+package zfactor
+
+import (
+	"errors"
+	"math"
+)
+
+// discriminantTol is the relative tolerance below which the discriminant of a
+// cubic is considered zero (i.e. the cubic has a multiple root).
+const discriminantTol = 1e-10
+
+// RootClass describes the number and multiplicity of the real roots of a cubic.
+type RootClass int
+
+const (
+	UnknownRoots   RootClass = iota // Not classified
+	OneRealRoot                     // Δ < 0: one real root and a complex-conjugate pair
+	ThreeRealRoots                  // Δ > 0: three distinct real roots
+	DoubleRoot                      // Δ = 0: a double real root and a simple real root
+	TripleRoot                      // Δ = 0 and b² = 3ac: a single real root of multiplicity three
+)
+
+// String implements fmt.Stringer for RootClass.
+func (rc RootClass) String() string {
+	switch rc {
+	case OneRealRoot:
+		return "one real root"
+	case ThreeRealRoots:
+		return "three distinct real roots"
+	case DoubleRoot:
+		return "double real root"
+	case TripleRoot:
+		return "triple real root"
+	default:
+		return "unknown"
+	}
+}
+
+// Distinct returns the number of distinct real roots.
+func (rc RootClass) Distinct() int {
+	switch rc {
+	case OneRealRoot, TripleRoot:
+		return 1
+	case DoubleRoot:
+		return 2
+	case ThreeRealRoots:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Multiplicities returns the multiplicity of each distinct real root.
+//
+// For example, a DoubleRoot returns [2, 1].
+func (rc RootClass) Multiplicities() []int {
+	switch rc {
+	case OneRealRoot:
+		return []int{1}
+	case ThreeRealRoots:
+		return []int{1, 1, 1}
+	case DoubleRoot:
+		return []int{2, 1}
+	case TripleRoot:
+		return []int{3}
+	default:
+		return nil
+	}
+}
+
+// CubicDiscriminant returns the discriminant of ax^3 + bx^2 + cx + d:
+//
+//	Δ = 18abcd - 4b³d + b²c² - 4ac³ - 27a²d²
+//
+// Δ > 0 means three distinct real roots, Δ < 0 one real root and a complex pair,
+// and Δ = 0 a multiple root. For floating point inputs prefer ClassifyCubic, which
+// compares Δ against a relative tolerance.
+func CubicDiscriminant(a, b, c, d float64) float64 {
+	return 18*a*b*c*d - 4*b*b*b*d + b*b*c*c - 4*a*c*c*c - 27*a*a*d*d
+}
+
+// ClassifyCubic reports the number and multiplicity of the real roots of
+// ax^3 + bx^2 + cx + d = 0 without solving it.
+//
+// The discriminant is evaluated on the depressed cubic y^3 + py + q and treated
+// as zero when it is small relative to its terms, so near-multiple roots are
+// classified consistently with SolveCubic.
+func ClassifyCubic(a, b, c, d float64) (RootClass, error) {
+	if a == 0 {
+		return UnknownRoots, errors.New("equation provided is not cubic (a = 0)")
+	}
+	p, q := depress(a, b, c, d)
+	return classifyDepressed(p, q, b/a, c/a), nil
+}
+
+// depress returns p and q of the depressed cubic y^3 + py + q = 0 obtained
+// by substituting x = y - b/(3a).
+func depress(a, b, c, d float64) (p, q float64) {
+	b /= a
+	c /= a
+	d /= a
+	p = c - b*b/3
+	q = 2*b*b*b/27 - b*c/3 + d
+	return p, q
+}
+
+// classifyDepressed classifies the roots of y^3 + py + q = 0. bn and cn are the
+// normalized coefficients b/a and c/a, used as the scale for deciding p ≈ 0.
+func classifyDepressed(p, q, bn, cn float64) RootClass {
+	// t1 + t2 = -Δ for the depressed, monic cubic.
+	t1 := 4 * p * p * p
+	t2 := 27 * q * q
+	scale := math.Abs(t1) + t2
+	if scale == 0 {
+		return TripleRoot
+	}
+
+	rel := (t1 + t2) / scale
+	switch {
+	case rel > discriminantTol:
+		return OneRealRoot
+	case rel < -discriminantTol:
+		return ThreeRealRoots
+	}
+
+	if math.Abs(p) <= math.Sqrt(discriminantTol)*(math.Abs(cn)+bn*bn/3) {
+		return TripleRoot
+	}
+	return DoubleRoot
+}
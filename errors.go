@@ -24,12 +24,18 @@ var (
 	ErrVolume = InputError{Msg: "molar volume (V) cannot be less than or equal to 0"}
 	// ErrHighPressureTwoTerm is returned when the pressure exceeds 15 bar for the two-term virial equation.
 	ErrHighPressureTwoTerm = InputError{Msg: "pressure exceeds the validity limit (15 bar) for the two-term virial equation"}
+	// ErrVirialRange is returned when a state lies outside the validity range of the generalized virial correlation.
+	ErrVirialRange = InputError{Msg: "state outside the validity range of the generalized virial correlation (Vr ≥ 2)"}
 	// ErrInvalidTr is returned when the reduced temperature (Tr) is less than or equal to 0.
 	ErrInvalidTr = InputError{Msg: "reduced temperature (Tr) must be greater than 0"}
 	// ErrInvalidPr is returned when the reduced pressure (Pr) is less than or equal to 0.
 	ErrInvalidPr = InputError{Msg: "reduced pressure (Pr) must be greater than 0"}
 	// ErrMolFracSum is returned when the mole fractions do not add up to 1 or are at least out of the tolerance range.
 	ErrMolFracSum = InputError{Msg: "mole fractions should sum to 1.0"}
+	// ErrCompressibility is returned when the compressibility factor (Z) is less than or equal to 0.
+	ErrCompressibility = InputError{Msg: "compressibility factor (Z) cannot be less than or equal to 0"}
+	// ErrMolarMass is returned when a mass-basis property is requested for a substance whose molar mass is not positive.
+	ErrMolarMass = InputError{Msg: "molar mass (MW) must be greater than 0 for mass-basis properties"}
 	// ErrMolFracVal is returned when the mole fraction is out of range.
 	ErrMolFracVal = InputError{Msg: "mole fractions should sum to 1.0"}
 )
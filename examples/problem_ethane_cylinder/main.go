@@ -1,3 +1,5 @@
+//go:build !noplot
+
 package main
 
 import (
@@ -47,7 +49,10 @@ func main() {
 
 	// Calculate the molar volume (v) using the definition of Z (v = ZRT/P).
 	// Since the system is a closed cylinder, the process is isochoric (constant volume), so v1 = v2.
-	v := z * R * T1 / P1
+	v, err := zfactor.MolarVolume(z, P1, T1, R)
+	if err != nil {
+		log.Fatal(err)
+	}
 	fmt.Printf("Molar Volume at T1: %.4f cm³/mol\n", v)
 
 	// Configure the Soave-Redlich-Kwong (SRK) Equation of State for the final temperature (T2).
@@ -83,7 +88,8 @@ func main() {
 		IsothermLabelColor:    state.Orange,
 		CriticalIsothermColor: state.Red,
 		StatePointColor:       state.Blue,
-		ShowOutputPath:        true,
+		Isochores:             []float64{v}, // The rigid cylinder follows this isochore
+		Output:                state.Output{ShowOutputPath: true},
 	}
 
 	// Generate and save the PV diagram to the specified output file.
@@ -38,7 +38,10 @@ func main() {
 	// Calculate Molar Volume: V = ZRT/P
 	// R = 83.14 bar*cm³/(mol*K) (using zfactor.RSI * 10)
 	R := zfactor.RSI * 10
-	v := z * R * args.T / args.P
+	v, err := zfactor.MolarVolume(z, args.P, args.T, R)
+	if err != nil {
+		log.Fatal(err)
+	}
 	fmt.Printf("Compressibility Factor (Z): %.4f\n", z)
 	fmt.Printf("Molar Volume (V):           %.4f cm³/mol\n", v)
 
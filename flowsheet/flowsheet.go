@@ -0,0 +1,143 @@
+// Package flowsheet provides a simple steady-state process simulator in which
+// material streams flow through a sequence of unit operations.
+//
+// Each unit transforms its inlet stream into an outlet stream and reports the
+// shaft work it exchanges. The solver propagates the stream through the units
+// in order and closes the energy balance of every unit,
+//
+//	Q + W = F (H_out - H_in)
+//
+// where Q is the heat duty and W the work, both positive when added to the
+// stream. Enthalpies and entropies are evaluated from the ideal-gas heat
+// capacity of the stream plus the residual properties of a Provider, for example:
+//
+//	feed := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 1, 10)
+//	sol, err := flowsheet.New(flowsheet.LeeKesler{}, feed).
+//	    Add(stage1, intercooler, stage2).
+//	    Solve()
+//
+// This first version supports linear chains of units only.
+package flowsheet
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Unit is a unit operation acting on a single stream.
+type Unit interface {
+	// Name identifies the unit in results and errors.
+	Name() string
+	// Run returns the outlet stream for the given inlet together with the work
+	// (W) done on the stream. Heat duty is derived from the energy balance.
+	Run(p Provider, in *Stream) (out *Stream, work float64, err error)
+}
+
+// UnitFunc adapts a function to the Unit interface.
+type UnitFunc struct {
+	Label string
+	Func  func(p Provider, in *Stream) (*Stream, float64, error)
+}
+
+func (u UnitFunc) Name() string {
+	return u.Label
+}
+
+func (u UnitFunc) Run(p Provider, in *Stream) (*Stream, float64, error) {
+	return u.Func(p, in)
+}
+
+// Result holds the inlet and outlet of a unit and its energy exchange.
+type Result struct {
+	Unit   string
+	Inlet  *Stream
+	Outlet *Stream
+	Duty   float64 // Heat added to the stream (W)
+	Work   float64 // Work done on the stream (W)
+}
+
+// String implements fmt.Stringer for Result.
+func (r *Result) String() string {
+	return fmt.Sprintf("%s: %g K, %g bar -> %g K, %g bar (Q = %g W, W = %g W)",
+		r.Unit, r.Inlet.T, r.Inlet.P, r.Outlet.T, r.Outlet.P, r.Duty, r.Work)
+}
+
+// Solution is the result of solving a flowsheet.
+type Solution struct {
+	Results   []*Result // Per-unit results in flow order
+	Product   *Stream   // Outlet of the last unit
+	TotalDuty float64   // Sum of the unit duties (W)
+	TotalWork float64   // Sum of the unit works (W)
+}
+
+// Flowsheet is a linear chain of units fed by a single stream.
+type Flowsheet struct {
+	Provider Provider
+	Feed     *Stream
+	Units    []Unit
+}
+
+// New creates a flowsheet evaluated with the given property provider.
+func New(p Provider, feed *Stream) *Flowsheet {
+	return &Flowsheet{Provider: p, Feed: feed}
+}
+
+// Add appends units to the end of the chain and returns the flowsheet.
+func (fs *Flowsheet) Add(units ...Unit) *Flowsheet {
+	fs.Units = append(fs.Units, units...)
+	return fs
+}
+
+// Solve propagates the feed through the units in order.
+func (fs *Flowsheet) Solve() (*Solution, error) {
+	if fs.Provider == nil {
+		return nil, errors.New("configuration error: flowsheet has no property provider")
+	}
+	if fs.Feed == nil {
+		return nil, errors.New("configuration error: flowsheet has no feed stream")
+	}
+	if err := fs.Feed.validate(); err != nil {
+		return nil, fmt.Errorf("feed: %w", err)
+	}
+
+	sol := &Solution{Results: make([]*Result, 0, len(fs.Units))}
+	in := fs.Feed
+	for _, u := range fs.Units {
+		res, err := Run(fs.Provider, u, in)
+		if err != nil {
+			return nil, fmt.Errorf("unit %q: %w", u.Name(), err)
+		}
+		sol.Results = append(sol.Results, res)
+		sol.TotalDuty += res.Duty
+		sol.TotalWork += res.Work
+		in = res.Outlet
+	}
+	sol.Product = in
+
+	return sol, nil
+}
+
+// Run runs a single unit on the inlet stream and closes its energy balance,
+// returning the outlet together with the duty and work of the unit.
+func Run(p Provider, u Unit, in *Stream) (*Result, error) {
+	out, work, err := u.Run(p, in)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, errors.New("unit returned no outlet stream")
+	}
+
+	balance, err := EnthalpyBalance(p, []*Stream{in}, []*Stream{out})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Unit:   u.Name(),
+		Inlet:  in,
+		Outlet: out,
+		Duty:   balance - work,
+		Work:   work,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package flowsheet_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// stage is a compressor with isentropic efficiency eta.
+func stage(name string, P, eta float64) flowsheet.Unit {
+	return flowsheet.UnitFunc{
+		Label: name,
+		Func: func(p flowsheet.Provider, in *flowsheet.Stream) (*flowsheet.Stream, float64, error) {
+			ideal, err := flowsheet.Isentropic(p, in, P)
+			if err != nil {
+				return nil, 0, err
+			}
+			hIn, err := in.Enthalpy(p)
+			if err != nil {
+				return nil, 0, err
+			}
+			hIdeal, err := ideal.Enthalpy(p)
+			if err != nil {
+				return nil, 0, err
+			}
+			dh := (hIdeal - hIn) / eta
+			out, err := flowsheet.TemperatureAtEnthalpy(p, in, P, hIn+dh)
+			if err != nil {
+				return nil, 0, err
+			}
+			return out, in.Flow * dh, nil
+		},
+	}
+}
+
+// cooler brings the stream back to temperature T at constant pressure.
+func cooler(name string, T float64) flowsheet.Unit {
+	return flowsheet.UnitFunc{
+		Label: name,
+		Func: func(p flowsheet.Provider, in *flowsheet.Stream) (*flowsheet.Stream, float64, error) {
+			return in.At(T, in.P), 0, nil
+		},
+	}
+}
+
+func TestCompressionTrain(t *testing.T) {
+	providers := map[string]flowsheet.Provider{
+		"IdealGas":  flowsheet.IdealGas{},
+		"Abbott":    flowsheet.Abbott{},
+		"LeeKesler": flowsheet.LeeKesler{},
+	}
+
+	for name, p := range providers {
+		feed := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 1, 10)
+
+		sol, err := flowsheet.New(p, feed).
+			Add(stage("stage 1", 3, 0.75), cooler("intercooler", 300), stage("stage 2", 9, 0.75)).
+			Solve()
+		if err != nil {
+			t.Fatalf("%s: Solve() unexpected error: %v", name, err)
+		}
+
+		if len(sol.Results) != 3 {
+			t.Fatalf("%s: got %d results, want 3", name, len(sol.Results))
+		}
+		if sol.Product.P != 9 {
+			t.Errorf("%s: product P = %v, want 9", name, sol.Product.P)
+		}
+
+		// Compressors are adiabatic, the intercooler does no work.
+		for _, i := range []int{0, 2} {
+			if r := sol.Results[i]; math.Abs(r.Duty) > 1e-3 || r.Work <= 0 {
+				t.Errorf("%s: %s Q = %v, W = %v; want Q = 0, W > 0", name, r.Unit, r.Duty, r.Work)
+			}
+		}
+		if r := sol.Results[1]; r.Work != 0 || r.Duty >= 0 {
+			t.Errorf("%s: intercooler Q = %v, W = %v; want Q < 0, W = 0", name, r.Duty, r.Work)
+		}
+
+		// Overall energy balance
+		hIn, _ := feed.Enthalpy(p)
+		hOut, _ := sol.Product.Enthalpy(p)
+		if got, want := sol.TotalDuty+sol.TotalWork, feed.Flow*(hOut-hIn); math.Abs(got-want) > 1e-6*math.Abs(want) {
+			t.Errorf("%s: Q + W = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestIsentropic(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	in := flowsheet.NewStream(substance.Ethane, cp.EthaneGas, 320, 5, 1)
+
+	out, err := flowsheet.Isentropic(p, in, 20)
+	if err != nil {
+		t.Fatalf("Isentropic() unexpected error: %v", err)
+	}
+	sIn, _ := in.Entropy(p)
+	sOut, _ := out.Entropy(p)
+	if math.Abs(sOut-sIn) > 1e-4 {
+		t.Errorf("Isentropic() S = %v, want %v", sOut, sIn)
+	}
+	if out.T <= in.T {
+		t.Errorf("Isentropic() T = %v, want > %v", out.T, in.T)
+	}
+}
+
+func TestIsenthalpicIdealGas(t *testing.T) {
+	// Throttling an ideal gas leaves the temperature unchanged.
+	in := flowsheet.NewStream(substance.Propane, cp.PropaneGas, 350, 10, 1)
+
+	out, err := flowsheet.Isenthalpic(flowsheet.IdealGas{}, in, 2)
+	if err != nil {
+		t.Fatalf("Isenthalpic() unexpected error: %v", err)
+	}
+	if math.Abs(out.T-in.T) > 1e-4 {
+		t.Errorf("Isenthalpic() T = %v, want %v", out.T, in.T)
+	}
+}
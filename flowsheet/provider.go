@@ -0,0 +1,43 @@
+package flowsheet
+
+import "github.com/rickykimani/zfactor/substance"
+
+// Provider supplies the residual properties used to evaluate the enthalpy and
+// entropy of a stream. Ideal-gas contributions are computed from the heat
+// capacity of the stream, so a Provider only describes non-ideality.
+//
+// Every substance.Provider is a Provider.
+type Provider interface {
+	// Residual returns the residual enthalpy H^R (J/mol) and residual entropy
+	// S^R (J/(mol·K)) of s at temperature T (K) and pressure P (bar).
+	Residual(s *substance.Substance, T, P float64) (hr, sr float64, err error)
+}
+
+// MixtureProvider is implemented by a Provider that evaluates the residual
+// properties and compressibility factor of multicomponent streams with its own
+// mixing rules. Streams use it instead of evaluating Residual and Z on their
+// Kay's rule pseudo-component.
+type MixtureProvider interface {
+	Provider
+	// MixtureResidual returns the residual enthalpy H^R (J/mol) and residual
+	// entropy S^R (J/(mol·K)) of the mixture of comps at temperature T (K) and
+	// pressure P (bar).
+	MixtureResidual(comps []substance.Component, T, P float64) (hr, sr float64, err error)
+	// MixtureZ returns the compressibility factor of the mixture of comps at
+	// temperature T (K) and pressure P (bar).
+	MixtureZ(comps []substance.Component, T, P float64) (float64, error)
+}
+
+// IdealGas is a Provider that treats every stream as an ideal gas (H^R = S^R = 0).
+type IdealGas = substance.IdealGasProvider
+
+// Abbott is a Provider based on the Abbott (virial) generalized correlations.
+// It is suited to gases at low to moderate pressures.
+type Abbott = substance.AbbottProvider
+
+// LeeKesler is a Provider based on the Lee-Kesler generalized correlation tables.
+type LeeKesler = substance.LeeKeslerProvider
+
+// Cubic is a MixtureProvider based on a cubic equation of state, with the van der
+// Waals mixing rules for mixtures.
+type Cubic = substance.CubicProvider
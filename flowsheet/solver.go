@@ -0,0 +1,157 @@
+package flowsheet
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// solveTol is the temperature tolerance (K) of the process solvers.
+const solveTol = 1e-6
+
+// TemperatureAtEnthalpy returns a copy of s at pressure P whose molar enthalpy
+// equals H (J/mol).
+//
+// The temperature is found by bisection within the validity range of the
+// heat capacities of the stream's components, on which H is monotonically increasing.
+func TemperatureAtEnthalpy(p Provider, s *Stream, P, H float64) (*Stream, error) {
+	return solveT(s, P, H, "enthalpy", func(st *Stream) (float64, error) {
+		return st.Enthalpy(p)
+	})
+}
+
+// TemperatureAtEntropy returns a copy of s at pressure P whose molar entropy
+// equals S (J/(mol·K)).
+//
+// The temperature is found by bisection within the validity range of the
+// heat capacities of the stream's components, on which S is monotonically increasing.
+func TemperatureAtEntropy(p Provider, s *Stream, P, S float64) (*Stream, error) {
+	return solveT(s, P, S, "entropy", func(st *Stream) (float64, error) {
+		return st.Entropy(p)
+	})
+}
+
+// Isenthalpic returns the state reached by s after an adiabatic expansion
+// (throttling) to pressure P, i.e. the state at P with the same enthalpy.
+func Isenthalpic(p Provider, s *Stream, P float64) (*Stream, error) {
+	h, err := s.Enthalpy(p)
+	if err != nil {
+		return nil, err
+	}
+	return TemperatureAtEnthalpy(p, s, P, h)
+}
+
+// Isentropic returns the state reached by s after a reversible adiabatic
+// compression or expansion to pressure P, i.e. the state at P with the same entropy.
+func Isentropic(p Provider, s *Stream, P float64) (*Stream, error) {
+	S, err := s.Entropy(p)
+	if err != nil {
+		return nil, err
+	}
+	return TemperatureAtEntropy(p, s, P, S)
+}
+
+// kStep is the relative pressure step of IsentropicExponent.
+const kStep = 1e-3
+
+// IsentropicExponent returns the real-gas isentropic exponent of s,
+//
+//	k = -(v/P)(∂P/∂v)_s
+//
+// which reduces to Cp/Cv for an ideal gas. The derivative is taken from the
+// volume of the isentropic state at a slightly higher pressure, with the
+// compressibility factors of p (see Stream.Z).
+func IsentropicExponent(p substance.Provider, s *Stream) (float64, error) {
+	z, err := s.Z(p)
+	if err != nil {
+		return 0, err
+	}
+	up, err := Isentropic(p, s, s.P*(1+kStep))
+	if err != nil {
+		return 0, err
+	}
+	zUp, err := up.Z(p)
+	if err != nil {
+		return 0, err
+	}
+	// v ∝ ZT/P
+	ratio := z * s.T / (zUp * up.T) * (1 + kStep)
+	return math.Log1p(kStep) / math.Log(ratio), nil
+}
+
+// solveT finds T such that prop(s at T, P) = target.
+//
+// Starting from s.T, the search steps in the direction of the target with a
+// growing step until the root is bracketed, then bisects. Steps that leave the
+// validity range of the property correlations are retried with a smaller step,
+// and T is always kept within the validity range of the heat capacities.
+func solveT(s *Stream, P, target float64, name string, prop func(*Stream) (float64, error)) (*Stream, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+
+	tMin, tMax := s.tRange()
+	prev := math.Min(math.Max(s.T, tMin), tMax)
+	fPrev, err := prop(s.At(prev, P))
+	if err != nil {
+		return nil, err
+	}
+	if fPrev == target {
+		return s.At(prev, P), nil
+	}
+
+	dir := 1.0
+	if fPrev > target {
+		dir = -1
+	}
+
+	lo, hi := math.NaN(), math.NaN()
+	step := 10.0
+	for i := 0; i < 200 && math.IsNaN(lo); i++ {
+		next := math.Min(math.Max(prev+dir*step, tMin), tMax)
+		if next == prev {
+			break
+		}
+		f, err := prop(s.At(next, P))
+		if err != nil {
+			if step < solveTol {
+				return nil, err
+			}
+			step /= 2
+			continue
+		}
+		if math.Signbit(f-target) != math.Signbit(fPrev-target) {
+			lo, hi = min(prev, next), max(prev, next)
+			break
+		}
+		prev, fPrev = next, f
+		step *= 2
+	}
+	if math.IsNaN(lo) {
+		return nil, fmt.Errorf("no temperature in [%g, %g] K gives %s %g at P = %g bar", tMin, tMax, name, target, P)
+	}
+
+	fLo, err := prop(s.At(lo, P))
+	if err != nil {
+		return nil, err
+	}
+	for hi-lo > solveTol {
+		mid := (lo + hi) / 2
+		f, err := prop(s.At(mid, P))
+		if err != nil {
+			return nil, err
+		}
+		if math.Signbit(f-target) == math.Signbit(fLo-target) {
+			lo, fLo = mid, f
+		} else {
+			hi = mid
+		}
+	}
+
+	return s.At((lo+hi)/2, P), nil
+}
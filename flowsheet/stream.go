@@ -0,0 +1,390 @@
+package flowsheet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Reference state for enthalpy and entropy: ideal gas at TRef and PRef, the same
+// as substance.Properties.
+const (
+	TRef = substance.TRef // K
+	PRef = substance.PRef // bar
+)
+
+// fracTol is the tolerance on the sum of the mole fractions of a stream.
+const fracTol = 1e-4
+
+// Species is a component of a stream together with its ideal-gas heat capacity.
+type Species struct {
+	Substance *substance.Substance
+	Cp        *cp.HeatCapacity // Ideal-gas heat capacity
+	Fraction  float64          // Mole fraction
+}
+
+// Stream is a material stream with a given composition, molar flow rate,
+// temperature and pressure.
+//
+// Enthalpy and entropy are measured from the pure ideal-gas components at TRef
+// and PRef. The ideal-gas part is the mole-fraction weighted sum of the species
+// contributions plus the ideal entropy of mixing, while residual properties are
+// evaluated by the Provider on the Kay's rule pseudo-component of the mixture
+// (see substance.NewLinearMixture), or with the mixing rules of a
+// MixtureProvider.
+type Stream struct {
+	Name       string
+	Components []Species
+	T          float64 // Temperature (K)
+	P          float64 // Pressure (bar)
+	Flow       float64 // Molar flow rate (mol/s)
+}
+
+// NewStream creates a stream of a single pure substance.
+func NewStream(s *substance.Substance, c *cp.HeatCapacity, T, P, flow float64) *Stream {
+	return &Stream{
+		Components: []Species{{Substance: s, Cp: c, Fraction: 1}},
+		T:          T,
+		P:          P,
+		Flow:       flow,
+	}
+}
+
+// validate checks that the stream is fully specified.
+func (s *Stream) validate() error {
+	if len(s.Components) == 0 {
+		return errors.New("stream has no components")
+	}
+	var sum float64
+	for _, c := range s.Components {
+		if c.Substance == nil {
+			return errors.New("component substance cannot be nil")
+		}
+		if c.Cp == nil {
+			return fmt.Errorf("component %s has no heat capacity data", c.Substance.Name)
+		}
+		if c.Fraction < 0 || c.Fraction > 1 {
+			return zfactor.ErrMolFracVal
+		}
+		sum += c.Fraction
+	}
+	if math.Abs(sum-1) > fracTol {
+		return zfactor.ErrMolFracSum
+	}
+	if s.T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if s.P <= 0 {
+		return zfactor.ErrPressure
+	}
+	if s.Flow < 0 {
+		return errors.New("molar flow rate cannot be negative")
+	}
+	return nil
+}
+
+// At returns a copy of the stream at temperature T and pressure P.
+func (s *Stream) At(T, P float64) *Stream {
+	out := s.withFlow(s.Flow)
+	out.T = T
+	out.P = P
+	return out
+}
+
+// withFlow returns a copy of the stream with the given molar flow rate.
+func (s *Stream) withFlow(flow float64) *Stream {
+	out := *s
+	out.Components = append([]Species(nil), s.Components...)
+	out.Flow = flow
+	return &out
+}
+
+// Substance returns the substance used to evaluate residual properties: the
+// component itself for a pure stream, or its Kay's rule pseudo-component.
+func (s *Stream) Substance() (*substance.Substance, error) {
+	if len(s.Components) == 1 {
+		return s.Components[0].Substance, nil
+	}
+	comps := make([]substance.Component, len(s.Components))
+	for i, c := range s.Components {
+		comps[i] = substance.Component{Substance: c.Substance, Fraction: c.Fraction}
+	}
+	return substance.NewLinearMixture(s.label(), comps)
+}
+
+// mixtureComponents returns the components of the stream with normalized
+// fractions, as a stream tolerates a sum within fracTol.
+func (s *Stream) mixtureComponents() []substance.Component {
+	var sum float64
+	for _, c := range s.Components {
+		sum += c.Fraction
+	}
+	comps := make([]substance.Component, len(s.Components))
+	for i, c := range s.Components {
+		comps[i] = substance.Component{Substance: c.Substance, Fraction: c.Fraction / sum}
+	}
+	return comps
+}
+
+// residual returns the residual enthalpy and entropy of the stream from p.
+func (s *Stream) residual(p Provider) (hr, sr float64, err error) {
+	if mp, ok := p.(MixtureProvider); ok && len(s.Components) > 1 {
+		return mp.MixtureResidual(s.mixtureComponents(), s.T, s.P)
+	}
+	sub, err := s.Substance()
+	if err != nil {
+		return 0, 0, err
+	}
+	return p.Residual(sub, s.T, s.P)
+}
+
+// Z returns the compressibility factor of the stream from p, on the same basis
+// as its residual properties: with the mixing rules of p if it is a
+// MixtureProvider, or else on the Kay's rule pseudo-component.
+func (s *Stream) Z(p substance.Provider) (float64, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+	if mp, ok := p.(MixtureProvider); ok && len(s.Components) > 1 {
+		return mp.MixtureZ(s.mixtureComponents(), s.T, s.P)
+	}
+	sub, err := s.Substance()
+	if err != nil {
+		return 0, err
+	}
+	return p.Z(sub, s.T, s.P)
+}
+
+// tRange returns the temperature range over which the heat capacities of all
+// components are valid.
+func (s *Stream) tRange() (float64, float64) {
+	tMin, tMax := 0.0, math.Inf(1)
+	for _, c := range s.Components {
+		tMin = max(tMin, c.Cp.TMin)
+		tMax = min(tMax, c.Cp.TMax)
+	}
+	return tMin, tMax
+}
+
+// Enthalpy returns the molar enthalpy of the stream in J/mol:
+//
+//	H = Σ yi ∫Cp,i dT (TRef → T) + H^R(T, P)
+func (s *Stream) Enthalpy(p Provider) (float64, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+	var hig float64
+	for _, c := range s.Components {
+		dh, err := c.Cp.IdealGasEnthalpyChange(
+			zfactor.Args{T: TRef, P: PRef, R: zfactor.RSI},
+			zfactor.Args{T: s.T, P: s.P, R: zfactor.RSI},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", c.Substance.Name, err)
+		}
+		hig += c.Fraction * dh
+	}
+	hr, _, err := s.residual(p)
+	if err != nil {
+		return 0, err
+	}
+	return hig + hr, nil
+}
+
+// Entropy returns the molar entropy of the stream in J/(mol·K):
+//
+//	S = Σ yi [∫Cp,i/T dT (TRef → T) - R ln(P/PRef)] - R Σ yi ln yi + S^R(T, P)
+func (s *Stream) Entropy(p Provider) (float64, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+	var sig float64
+	for _, c := range s.Components {
+		ds, err := c.Cp.IdealGasEntropyChange(
+			zfactor.Args{T: TRef, P: PRef, R: zfactor.RSI},
+			zfactor.Args{T: s.T, P: s.P, R: zfactor.RSI},
+		)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", c.Substance.Name, err)
+		}
+		sig += c.Fraction * ds
+		if c.Fraction > 0 {
+			sig -= zfactor.RSI * c.Fraction * math.Log(c.Fraction)
+		}
+	}
+	_, sr, err := s.residual(p)
+	if err != nil {
+		return 0, err
+	}
+	return sig + sr, nil
+}
+
+// MW returns the molar mass of the stream in g/mol, Σ yi MWi.
+func (s *Stream) MW() float64 {
+	var mw float64
+	for _, c := range s.Components {
+		if c.Substance != nil {
+			mw += c.Fraction * c.Substance.MW
+		}
+	}
+	return mw
+}
+
+// MassFlow returns the mass flow rate of the stream in kg/s.
+func (s *Stream) MassFlow() float64 {
+	return s.Flow * s.MW() * 1e-3
+}
+
+// SpecificEnthalpy returns the enthalpy of the stream per unit mass in kJ/kg.
+func (s *Stream) SpecificEnthalpy(p Provider) (float64, error) {
+	h, err := s.Enthalpy(p)
+	if err != nil {
+		return 0, err
+	}
+	return zfactor.MassBasis.Energy(h, s.MW())
+}
+
+// SpecificEntropy returns the entropy of the stream per unit mass in kJ/(kg·K).
+func (s *Stream) SpecificEntropy(p Provider) (float64, error) {
+	S, err := s.Entropy(p)
+	if err != nil {
+		return 0, err
+	}
+	return zfactor.MassBasis.Entropy(S, s.MW())
+}
+
+// EnthalpyFlow returns the enthalpy flow rate of the stream, Flow * H, in W.
+func (s *Stream) EnthalpyFlow(p Provider) (float64, error) {
+	h, err := s.Enthalpy(p)
+	if err != nil {
+		return 0, err
+	}
+	return s.Flow * h, nil
+}
+
+// EnthalpyBalance returns the net energy (Q + W, in W) that must be added to
+// the inlet streams to produce the outlet streams:
+//
+//	Q + W = Σ F·H (outlets) - Σ F·H (inlets)
+func EnthalpyBalance(p Provider, inlets, outlets []*Stream) (float64, error) {
+	var sum float64
+	for i, s := range inlets {
+		fh, err := s.EnthalpyFlow(p)
+		if err != nil {
+			return 0, fmt.Errorf("inlet %d: %w", i, err)
+		}
+		sum -= fh
+	}
+	for i, s := range outlets {
+		fh, err := s.EnthalpyFlow(p)
+		if err != nil {
+			return 0, fmt.Errorf("outlet %d: %w", i, err)
+		}
+		sum += fh
+	}
+	return sum, nil
+}
+
+// Mix adiabatically mixes streams into a single outlet at the lowest inlet
+// pressure. The outlet composition follows from the component balances, with
+// components identified by their *substance.Substance, and its temperature
+// from the enthalpy balance.
+func Mix(p Provider, streams ...*Stream) (*Stream, error) {
+	if len(streams) == 0 {
+		return nil, errors.New("no streams to mix")
+	}
+
+	var (
+		flow  float64
+		hFlow float64
+		P     = math.Inf(1)
+		comps []Species
+		index = make(map[*substance.Substance]int)
+	)
+	for i, s := range streams {
+		if err := s.validate(); err != nil {
+			return nil, fmt.Errorf("stream %d: %w", i, err)
+		}
+		fh, err := s.EnthalpyFlow(p)
+		if err != nil {
+			return nil, fmt.Errorf("stream %d: %w", i, err)
+		}
+		hFlow += fh
+		flow += s.Flow
+		P = min(P, s.P)
+
+		for _, c := range s.Components {
+			j, ok := index[c.Substance]
+			if !ok {
+				j = len(comps)
+				index[c.Substance] = j
+				comps = append(comps, Species{Substance: c.Substance, Cp: c.Cp})
+			}
+			// Accumulate component molar flows, normalized below.
+			comps[j].Fraction += s.Flow * c.Fraction
+		}
+	}
+	if flow == 0 {
+		return nil, errors.New("mixed streams have zero total flow")
+	}
+	for j := range comps {
+		comps[j].Fraction /= flow
+	}
+
+	// Start the temperature search from the flow-weighted mean inlet temperature.
+	var tMean float64
+	for _, s := range streams {
+		tMean += s.Flow * s.T
+	}
+	mixed := &Stream{Components: comps, T: tMean / flow, P: P, Flow: flow}
+
+	return TemperatureAtEnthalpy(p, mixed, P, hFlow/flow)
+}
+
+// Split divides the stream into streams of the same intensive state whose
+// flows are the given fractions of the total. The fractions must sum to 1.
+func (s *Stream) Split(fractions ...float64) ([]*Stream, error) {
+	if len(fractions) == 0 {
+		return nil, errors.New("no split fractions given")
+	}
+	var sum float64
+	for _, f := range fractions {
+		if f < 0 || f > 1 {
+			return nil, errors.New("split fractions must be between 0 and 1")
+		}
+		sum += f
+	}
+	if math.Abs(sum-1) > fracTol {
+		return nil, errors.New("split fractions should sum to 1.0")
+	}
+
+	out := make([]*Stream, len(fractions))
+	for i, f := range fractions {
+		out[i] = s.withFlow(f * s.Flow)
+	}
+	return out, nil
+}
+
+// label returns the name of the stream, or a name built from its components.
+func (s *Stream) label() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	names := make([]string, 0, len(s.Components))
+	for _, c := range s.Components {
+		if c.Substance != nil {
+			names = append(names, c.Substance.Name)
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// String implements fmt.Stringer for Stream.
+func (s *Stream) String() string {
+	return fmt.Sprintf("Stream{%s: T: %g K, P: %g bar, Flow: %g mol/s}", s.label(), s.T, s.P, s.Flow)
+}
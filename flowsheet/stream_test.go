@@ -0,0 +1,130 @@
+package flowsheet_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestMix(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	hot := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 400, 10, 3)
+	cold := flowsheet.NewStream(substance.Ethane, cp.EthaneGas, 320, 12, 1)
+
+	mixed, err := flowsheet.Mix(p, hot, cold)
+	if err != nil {
+		t.Fatalf("Mix() unexpected error: %v", err)
+	}
+
+	if mixed.Flow != 4 {
+		t.Errorf("Mix() Flow = %v, want 4", mixed.Flow)
+	}
+	if mixed.P != 10 {
+		t.Errorf("Mix() P = %v, want 10", mixed.P)
+	}
+	if len(mixed.Components) != 2 || math.Abs(mixed.Components[0].Fraction-0.75) > 1e-12 {
+		t.Errorf("Mix() Components = %+v, want 75%% methane", mixed.Components)
+	}
+	if mixed.T <= cold.T || mixed.T >= hot.T {
+		t.Errorf("Mix() T = %v, want between %v and %v", mixed.T, cold.T, hot.T)
+	}
+
+	q, err := flowsheet.EnthalpyBalance(p, []*flowsheet.Stream{hot, cold}, []*flowsheet.Stream{mixed})
+	if err != nil {
+		t.Fatalf("EnthalpyBalance() unexpected error: %v", err)
+	}
+	if math.Abs(q) > 1e-3 {
+		t.Errorf("EnthalpyBalance() = %v, want 0 for adiabatic mixing", q)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	s := flowsheet.NewStream(substance.Propane, cp.PropaneGas, 350, 5, 10)
+
+	parts, err := s.Split(0.3, 0.7)
+	if err != nil {
+		t.Fatalf("Split() unexpected error: %v", err)
+	}
+	if parts[0].Flow != 3 || parts[1].Flow != 7 {
+		t.Errorf("Split() flows = %v, %v, want 3, 7", parts[0].Flow, parts[1].Flow)
+	}
+	parts[0].Components[0].Fraction = 0
+	if s.Components[0].Fraction != 1 {
+		t.Errorf("Split() streams share components with the original")
+	}
+
+	if _, err := s.Split(0.3, 0.3); err == nil {
+		t.Errorf("Split(0.3, 0.3) expected error, got nil")
+	}
+}
+
+func TestStreamMassBasis(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	s, err := flowsheet.Mix(p,
+		flowsheet.NewStream(substance.Methane, cp.MethaneGas, 350, 10, 3),
+		flowsheet.NewStream(substance.Ethane, cp.EthaneGas, 350, 10, 1),
+	)
+	if err != nil {
+		t.Fatalf("Mix() unexpected error: %v", err)
+	}
+
+	wantMW := 0.75*substance.Methane.MW + 0.25*substance.Ethane.MW
+	if math.Abs(s.MW()-wantMW) > 1e-9 {
+		t.Errorf("MW() = %v, want %v", s.MW(), wantMW)
+	}
+	if want := 4 * wantMW * 1e-3; math.Abs(s.MassFlow()-want) > 1e-12 {
+		t.Errorf("MassFlow() = %v, want %v", s.MassFlow(), want)
+	}
+
+	h, err := s.Enthalpy(p)
+	if err != nil {
+		t.Fatalf("Enthalpy() unexpected error: %v", err)
+	}
+	hm, err := s.SpecificEnthalpy(p)
+	if err != nil {
+		t.Fatalf("SpecificEnthalpy() unexpected error: %v", err)
+	}
+	if want := h / wantMW; math.Abs(hm-want) > 1e-9 {
+		t.Errorf("SpecificEnthalpy() = %v, want %v", hm, want)
+	}
+}
+
+func TestStreamMixtureProvider(t *testing.T) {
+	p := flowsheet.Cubic{EOS: &cubic.PR{}}
+	s := &flowsheet.Stream{
+		Components: []flowsheet.Species{
+			{Substance: substance.Methane, Cp: cp.MethaneGas, Fraction: 0.6},
+			{Substance: substance.Ethane, Cp: cp.EthaneGas, Fraction: 0.4},
+		},
+		T: 300, P: 50, Flow: 1,
+	}
+	h, err := s.Enthalpy(p)
+	if err != nil {
+		t.Fatalf("Enthalpy() unexpected error: %v", err)
+	}
+	hig, err := s.Enthalpy(flowsheet.IdealGas{})
+	if err != nil {
+		t.Fatalf("Enthalpy() unexpected error: %v", err)
+	}
+
+	// The residual enthalpy comes from the mixing rules, not the pseudo-component.
+	comps := []substance.Component{{Substance: substance.Methane, Fraction: 0.6}, {Substance: substance.Ethane, Fraction: 0.4}}
+	want, _, err := p.MixtureResidual(comps, s.T, s.P)
+	if err != nil {
+		t.Fatalf("MixtureResidual() unexpected error: %v", err)
+	}
+	if got := h - hig; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Enthalpy() H^R = %v, want %v", got, want)
+	}
+	pseudo, err := s.Substance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kay, _, _ := p.Residual(pseudo, s.T, s.P); kay == want {
+		t.Errorf("Enthalpy() H^R = %v, the Kay's rule value", kay)
+	}
+}
@@ -2,6 +2,8 @@ module github.com/rickykimani/zfactor
 
 go 1.25.5
 
+require gonum.org/v1/plot v0.16.0
+
 require (
 	codeberg.org/go-fonts/liberation v0.5.0 // indirect
 	codeberg.org/go-latex/latex v0.1.0 // indirect
@@ -13,6 +15,5 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/image v0.25.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
-	gonum.org/v1/plot v0.16.0 // indirect
 	rsc.io/pdf v0.1.1 // indirect
 )
@@ -0,0 +1,28 @@
+// Package dataversion reads the versions of the embedded datasets, for the
+// generators of the packages that embed them.
+package dataversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Read returns the version of the dataset named name in the versions.json file
+// of the directory dataDir.
+func Read(dataDir, name string) (int, error) {
+	b, err := os.ReadFile(filepath.Join(dataDir, "versions.json"))
+	if err != nil {
+		return 0, err
+	}
+	var versions map[string]int
+	if err := json.Unmarshal(b, &versions); err != nil {
+		return 0, err
+	}
+	v, ok := versions[name]
+	if !ok {
+		return 0, fmt.Errorf("no version of dataset %s in versions.json", name)
+	}
+	return v, nil
+}
@@ -0,0 +1,48 @@
+package numeric
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// MolFracTolerance is the allowed deviation of the sum of mole fractions from 1.
+const MolFracTolerance = 1e-9
+
+// MoleFractions checks that every mole fraction of y lies in [0, 1] and that
+// they sum to 1 within MolFracTolerance.
+func MoleFractions(y []float64) error {
+	sum := 0.0
+	for _, yi := range y {
+		if yi < 0 || yi > 1 {
+			return zfactor.ErrMolFracVal
+		}
+		sum += yi
+	}
+	if math.Abs(sum-1) > MolFracTolerance {
+		return zfactor.ErrMolFracSum
+	}
+	return nil
+}
+
+// SymmetricMatrix checks that k is a symmetric n×n matrix of binary
+// interaction parameters. name is the field used in the errors.
+func SymmetricMatrix[T comparable](name string, k [][]T, n int) error {
+	if len(k) != n {
+		return fmt.Errorf("%s has %d rows, want one per component (%d)", name, len(k), n)
+	}
+	for i, row := range k {
+		if len(row) != n {
+			return fmt.Errorf("%s row %d has %d columns, want %d", name, i, len(row), n)
+		}
+	}
+	for i := range k {
+		for j := i + 1; j < n; j++ {
+			if k[i][j] != k[j][i] {
+				return fmt.Errorf("%s is not symmetric: k%d%d = %v, k%d%d = %v", name, i+1, j+1, k[i][j], j+1, i+1, k[j][i])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package numeric_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+func TestSolveLinear(t *testing.T) {
+	tests := []struct {
+		name   string
+		A      [][]float64
+		b      []float64
+		want   []float64
+		wantOK bool
+	}{
+		{"pivoting", [][]float64{{0, 2, 1}, {1, 1, 0}, {2, 0, 3}}, []float64{7, 3, 11}, []float64{1, 2, 3}, true},
+		{"singular", [][]float64{{1, 2}, {2, 4}}, []float64{1, 2}, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a00 := tt.A[0][0]
+			got, ok := numeric.SolveLinear(tt.A, tt.b)
+			if ok != tt.wantOK {
+				t.Fatalf("SolveLinear() ok = %v, want %v", ok, tt.wantOK)
+			}
+			for i := range tt.want {
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("SolveLinear()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+			if tt.A[0][0] != a00 {
+				t.Error("SolveLinear() modified A")
+			}
+		})
+	}
+}
+
+func TestMonotoneSlopes(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		want []float64
+	}{
+		{"line", []float64{0, 1, 3}, []float64{0, 2, 6}, []float64{2, 2, 2}},
+		{"extremum", []float64{0, 1, 2}, []float64{0, 1, 0}, []float64{1, 0, -1}},
+		// h0 = 1, h1 = 2, secants 1 and 4: (5 + 4)/(5/1 + 4/4)
+		{"harmonic mean", []float64{0, 1, 3}, []float64{0, 1, 9}, []float64{1, 1.5, 4}},
+		{"missing value", []float64{0, 1, 2, 3}, []float64{0, 1, math.NaN(), 3}, []float64{1, 1, 0, math.NaN()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := numeric.MonotoneSlopes(tt.x, tt.y)
+			for i := range tt.want {
+				if math.IsNaN(tt.want[i]) {
+					continue
+				}
+				if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+					t.Errorf("MonotoneSlopes()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMoleFractions(t *testing.T) {
+	tests := []struct {
+		name string
+		y    []float64
+		want error
+	}{
+		{"valid", []float64{0.25, 0.75}, nil},
+		{"negative", []float64{-0.25, 1.25}, zfactor.ErrMolFracVal},
+		{"sum", []float64{0.5, 0.4}, zfactor.ErrMolFracSum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := numeric.MoleFractions(tt.y); !errors.Is(err, tt.want) {
+				t.Errorf("MoleFractions() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymmetricMatrix(t *testing.T) {
+	tests := []struct {
+		name    string
+		k       [][]float64
+		wantErr bool
+	}{
+		{"symmetric", [][]float64{{0, 0.1}, {0.1, 0}}, false},
+		{"rows", [][]float64{{0, 0.1}}, true},
+		{"columns", [][]float64{{0, 0.1}, {0.1}}, true},
+		{"asymmetric", [][]float64{{0, 0.1}, {0.2, 0}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := numeric.SymmetricMatrix("Kij", tt.k, 2); (err != nil) != tt.wantErr {
+				t.Errorf("SymmetricMatrix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeometricRoots(t *testing.T) {
+	// (x - 0.01)(x - 2)(x - 50) has all its roots on the grid [1e-3, 100].
+	f := func(x float64) (float64, error) { return (x - 0.01) * (x - 2) * (x - 50), nil }
+	got, err := numeric.GeometricRoots(f, 1e-3, 100, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{0.01, 2, 50}
+	if len(got) != len(want) {
+		t.Fatalf("GeometricRoots() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9*want[i] {
+			t.Errorf("GeometricRoots()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSaturationPressure(t *testing.T) {
+	// ln φᴸ - ln φⱽ = ln(5/P): the fugacities are equal at 5 bar. Below 2 bar
+	// there is only a vapor root.
+	phases := func(P float64) (liq, vap *numeric.PhaseRoot, err error) {
+		vap = &numeric.PhaseRoot{V: 100 / P}
+		if P < 2 {
+			return nil, vap, nil
+		}
+		return &numeric.PhaseRoot{V: 1, LnPhi: math.Log(5 / P)}, vap, nil
+	}
+	got, err := numeric.SaturationPressure(phases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got-5) > 1e-6 {
+		t.Errorf("SaturationPressure() = %v, want 5", got)
+	}
+}
@@ -0,0 +1,87 @@
+package numeric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// GeometricRoots returns the roots of f in [lo, hi], in ascending order. f is
+// scanned over a geometric grid of points values and every sign change is
+// refined by bisection. An error of f stops the scan.
+func GeometricRoots(f func(x float64) (float64, error), lo, hi float64, points int) ([]float64, error) {
+	ratio := math.Pow(hi/lo, 1.0/float64(points-1))
+	var roots []float64
+	prevX := lo
+	prevF, err := f(prevX)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < points; i++ {
+		x := lo * math.Pow(ratio, float64(i))
+		F, err := f(x)
+		if err != nil {
+			return nil, err
+		}
+		if (prevF > 0) != (F > 0) {
+			left, right, fLeft := prevX, x, prevF
+			for range 100 {
+				mid := (left + right) / 2
+				fMid, err := f(mid)
+				if err != nil {
+					return nil, err
+				}
+				if (fMid > 0) == (fLeft > 0) {
+					left, fLeft = mid, fMid
+				} else {
+					right = mid
+				}
+			}
+			roots = append(roots, (left+right)/2)
+		}
+		prevX, prevF = x, F
+	}
+	return roots, nil
+}
+
+// PhaseRoot is a root of an equation of state of a pure component.
+type PhaseRoot struct {
+	V     float64 // Molar volume
+	LnPhi float64 // ln φ
+}
+
+// saturationIter is the maximum number of iterations of SaturationPressure.
+const saturationIter = 200
+
+// SaturationPressure returns the pressure (bar) at which the liquid and vapor
+// roots returned by phases have equal fugacities, updating it by P ← P φᴸ/φⱽ
+// from 1 bar. Where there is a single root, phases returns it as liq if it is
+// liquid-like, whose fugacity is close to the vapor pressure at low
+// temperatures, and as vap otherwise, leaving the other nil.
+func SaturationPressure(phases func(P float64) (liq, vap *PhaseRoot, err error)) (float64, error) {
+	P := 1.0
+	for range saturationIter {
+		liq, vap, err := phases(P)
+		if err != nil {
+			return 0, err
+		}
+		// A single root: move the pressure towards the two-root region.
+		if vap == nil {
+			P *= math.Min(math.Exp(liq.LnPhi), 0.9)
+			continue
+		}
+		if liq == nil {
+			P *= 1.5
+			continue
+		}
+		if math.Abs(vap.V-liq.V) < 1e-6*liq.V {
+			return 0, errors.New("saturation pressure: the phases are identical, T may be above the critical temperature")
+		}
+		d := liq.LnPhi - vap.LnPhi
+		if math.Abs(d) < 1e-8 {
+			return P, nil
+		}
+		P *= math.Exp(d)
+	}
+	return 0, fmt.Errorf("saturation pressure did not converge in %d iterations", saturationIter)
+}
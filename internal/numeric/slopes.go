@@ -0,0 +1,43 @@
+package numeric
+
+import "math"
+
+// MonotoneSlopes returns the derivatives at the nodes of the piecewise cubic
+// Hermite interpolant of y(x) that preserves the monotonicity of the data, by
+// the method of Fritsch and Butland (1984): the weighted harmonic mean of the
+// adjacent secants, zero at local extrema, and the one-sided secant at the
+// ends. Nodes next to a missing (NaN) value take the secant on their other
+// side.
+func MonotoneSlopes(x, y []float64) []float64 {
+	n := len(x)
+	d := make([]float64, n)
+	if n < 2 {
+		return d
+	}
+	secant := make([]float64, n-1)
+	for i := range n - 1 {
+		secant[i] = (y[i+1] - y[i]) / (x[i+1] - x[i])
+	}
+	for i := range n {
+		switch {
+		case i == 0:
+			d[i] = secant[0]
+		case i == n-1:
+			d[i] = secant[n-2]
+		case math.IsNaN(secant[i-1]):
+			d[i] = secant[i]
+		case math.IsNaN(secant[i]):
+			d[i] = secant[i-1]
+		case secant[i-1]*secant[i] <= 0:
+			d[i] = 0
+		default:
+			h0, h1 := x[i]-x[i-1], x[i+1]-x[i]
+			w0, w1 := 2*h1+h0, h1+2*h0
+			d[i] = (w0 + w1) / (w0/secant[i-1] + w1/secant[i])
+		}
+		if math.IsNaN(d[i]) {
+			d[i] = 0
+		}
+	}
+	return d
+}
@@ -0,0 +1,127 @@
+package leekesler
+
+import "github.com/rickykimani/zfactor/internal/numeric"
+
+// bicubic holds the coefficients of the bicubic patches of a table, one per
+// cell between Pr[i], Pr[i+1] and Tr[j], Tr[j+1]:
+//
+//	M(u, v) = Σ a[p][q] uᵖ vᵠ, u = (Pr - Pr[i])/ΔPr, v = (Tr - Tr[j])/ΔTr
+//
+// The patches match the table values and the estimated derivatives ∂M/∂Pr,
+// ∂M/∂Tr and ∂²M/∂Pr∂Tr at the cell corners, so that the interpolated values
+// and their first derivatives are continuous across cells.
+type bicubic struct {
+	coeffs [][][4][4]float64 // coeffs[TrIndex][PrIndex]
+}
+
+// patches holds the precomputed patches of the generated tables.
+var patches = map[*table]*bicubic{}
+
+func init() {
+	for _, t := range []*table{Z0Table, Z1Table, H0Table, H1Table, S0Table, S1Table, PHI0Table, PHI1Table} {
+		patches[t] = newBicubic(t)
+	}
+}
+
+// newBicubic computes the patches of t. The derivatives at the table nodes are
+// the monotone slopes of Fritsch and Butland along each axis, which do not
+// overshoot where the values change steeply, such as across the vapor pressure
+// curve.
+func newBicubic(t *table) *bicubic {
+	nTr, nPr := len(t.Tr), len(t.Pr)
+	dPr := make([][]float64, nTr) // ∂M/∂Pr
+	for j := range nTr {
+		dPr[j] = numeric.MonotoneSlopes(t.Pr, t.Values[j])
+	}
+	dTr := make([][]float64, nTr)   // ∂M/∂Tr
+	dPrTr := make([][]float64, nTr) // ∂²M/∂Pr∂Tr
+	for j := range nTr {
+		dTr[j] = make([]float64, nPr)
+		dPrTr[j] = make([]float64, nPr)
+	}
+	col := make([]float64, nTr)
+	dCol := make([]float64, nTr)
+	for i := range nPr {
+		for j := range nTr {
+			col[j], dCol[j] = t.Values[j][i], dPr[j][i]
+		}
+		s, ds := numeric.MonotoneSlopes(t.Tr, col), numeric.MonotoneSlopes(t.Tr, dCol)
+		for j := range nTr {
+			dTr[j][i], dPrTr[j][i] = s[j], ds[j]
+		}
+	}
+
+	b := &bicubic{coeffs: make([][][4][4]float64, nTr-1)}
+	for j := range nTr - 1 {
+		b.coeffs[j] = make([][4][4]float64, nPr-1)
+		hTr := t.Tr[j+1] - t.Tr[j]
+		for i := range nPr - 1 {
+			hPr := t.Pr[i+1] - t.Pr[i]
+			// Corner values and scaled derivatives, indexed [u][v] for u, v in {0, 1}.
+			var f, fu, fv, fuv [2][2]float64
+			for a := range 2 {
+				for c := range 2 {
+					f[a][c] = t.Values[j+c][i+a]
+					fu[a][c] = dPr[j+c][i+a] * hPr
+					fv[a][c] = dTr[j+c][i+a] * hTr
+					fuv[a][c] = dPrTr[j+c][i+a] * hPr * hTr
+				}
+			}
+			b.coeffs[j][i] = patch(f, fu, fv, fuv)
+		}
+	}
+	return b
+}
+
+// patch returns the coefficients a = H F Hᵀ of the bicubic Hermite patch with
+// the corner values f and derivatives fu, fv and fuv of Hermite matrix H.
+func patch(f, fu, fv, fuv [2][2]float64) [4][4]float64 {
+	F := [4][4]float64{
+		{f[0][0], f[0][1], fv[0][0], fv[0][1]},
+		{f[1][0], f[1][1], fv[1][0], fv[1][1]},
+		{fu[0][0], fu[0][1], fuv[0][0], fuv[0][1]},
+		{fu[1][0], fu[1][1], fuv[1][0], fuv[1][1]},
+	}
+	H := [4][4]float64{
+		{1, 0, 0, 0},
+		{0, 0, 1, 0},
+		{-3, 3, -2, -1},
+		{2, -2, 1, 1},
+	}
+	var HF, a [4][4]float64
+	for p := range 4 {
+		for q := range 4 {
+			for k := range 4 {
+				HF[p][q] += H[p][k] * F[k][q]
+			}
+		}
+	}
+	for p := range 4 {
+		for q := range 4 {
+			for k := range 4 {
+				a[p][q] += HF[p][k] * H[q][k]
+			}
+		}
+	}
+	return a
+}
+
+// interpolateBicubic is interpolate with the bicubic patches of t.
+func interpolateBicubic(pr, tr float64, t *table) (float64, error) {
+	i, j, err := t.cell(pr, tr)
+	if err != nil {
+		return 0, err
+	}
+	b, ok := patches[t]
+	if !ok {
+		b = newBicubic(t)
+	}
+	u := (pr - t.Pr[i]) / (t.Pr[i+1] - t.Pr[i])
+	v := (tr - t.Tr[j]) / (t.Tr[j+1] - t.Tr[j])
+	a := &b.coeffs[j][i]
+	var m float64
+	for p := 3; p >= 0; p-- {
+		m = m*u + ((a[p][3]*v+a[p][2])*v+a[p][1])*v + a[p][0]
+	}
+	return m, nil
+}
@@ -0,0 +1,26 @@
+package leekesler
+
+import (
+	"math"
+
+	"github.com/rickykimani/zfactor/chart"
+)
+
+// Chart returns the table as a chart of its values versus Pr along isolines of
+// Tr, for use with the chart package, e.g. to compare it against an imported
+// compressibility chart. Interpolation by chart.At matches At on the table grid.
+// Cells without a value are left out of the isolines.
+func (t *table) Chart(name string) *chart.Chart {
+	c := &chart.Chart{Name: name, Param: "Tr", X: "Pr", Y: name}
+	for j, tr := range t.Tr {
+		pts := make([]chart.Point, 0, len(t.Pr))
+		for i, pr := range t.Pr {
+			if math.IsNaN(t.Values[j][i]) {
+				continue
+			}
+			pts = append(pts, chart.Point{X: pr, Y: t.Values[j][i]})
+		}
+		c.Isolines = append(c.Isolines, chart.Isoline{Param: tr, Points: pts})
+	}
+	return c
+}
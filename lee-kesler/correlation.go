@@ -1,5 +1,10 @@
 package leekesler
 
+import (
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/chart"
+)
+
 // Property is a Lee-Kesler correlation family (Z, H, S, PHI).
 type Property int
 
@@ -15,37 +20,83 @@ const (
 type correlation struct {
 	base   *table // e.g., Z0, H0, S0, PHI0
 	depart *table // e.g., Z1, H1, S1, PHI1
+	interp zfactor.Interpolation
+	// Charts that replace base and depart, if set by WithCharts.
+	baseChart, departChart *chart.Chart
+}
+
+// Option configures the evaluator returned by Correlation.
+type Option func(*correlation)
+
+// WithInterpolation selects the interpolation of the tables, overriding
+// zfactor.Defaults().Interpolation.
+func WithInterpolation(i zfactor.Interpolation) Option {
+	return func(c *correlation) { c.interp = i }
 }
 
-// Correlation returns an evaluator for a property.
+// WithCharts replaces the base and departure tables with charts of the property
+// versus Pr along isolines of Tr, e.g. legacy or proprietary charts imported
+// with the chart package, so that they are queried like the built-in tables. A
+// nil depart gives a departure value of 0, for charts of the property itself,
+// such as the Nelson-Obert compressibility charts. The interpolation set by
+// WithInterpolation does not apply to charts, which chart.Chart.At interpolates
+// linearly.
+func WithCharts(base, depart *chart.Chart) Option {
+	return func(c *correlation) { c.baseChart, c.departChart = base, depart }
+}
+
+// Correlation returns an evaluator for a property. The tables are interpolated
+// as set by zfactor.Defaults().Interpolation unless an option overrides it.
 //
 // Usage:
 //
-//	z0, z1, err := leekesler.Correlation(leekesler.Z).At(Pr, Tr)
-func Correlation(p Property) correlation {
+//	z0, z1, err := leekesler.Correlation(leekesler.CompressibilityFactor).At(Tr, Pr)
+//	z0, z1, err := leekesler.Correlation(leekesler.CompressibilityFactor, leekesler.WithInterpolation(zfactor.Bicubic)).At(Tr, Pr)
+func Correlation(p Property, opts ...Option) correlation {
+	var c correlation
 	switch p {
 	case CompressibilityFactor:
-		return correlation{base: Z0Table, depart: Z1Table}
+		c = correlation{base: Z0Table, depart: Z1Table}
 	case ResidualEnthalpy:
-		return correlation{base: H0Table, depart: H1Table}
+		c = correlation{base: H0Table, depart: H1Table}
 	case ResidualEntropy:
-		return correlation{base: S0Table, depart: S1Table}
+		c = correlation{base: S0Table, depart: S1Table}
 	case FugacityCoefficient:
-		return correlation{base: PHI0Table, depart: PHI1Table}
+		c = correlation{base: PHI0Table, depart: PHI1Table}
 	default:
 		// Fallback to Z
-		return correlation{base: Z0Table, depart: Z1Table} //panic instead?
+		c = correlation{base: Z0Table, depart: Z1Table} //panic instead?
+	}
+	c.interp = zfactor.Defaults().Interpolation
+	for _, opt := range opts {
+		opt(&c)
 	}
+	return c
 }
 
 // At returns the base and departure values at (Tr, Pr).
 // For Z, this returns (Z0, Z1).
 func (c correlation) At(Tr, Pr float64) (float64, float64, error) {
-	v0, err := c.base.At(Tr, Pr)
+	if c.baseChart != nil {
+		v0, err := c.baseChart.At(Tr, Pr)
+		if err != nil || c.departChart == nil {
+			return v0, 0, err
+		}
+		v1, err := c.departChart.At(Tr, Pr)
+		if err != nil {
+			return 0, 0, err
+		}
+		return v0, v1, nil
+	}
+	at := (*table).At
+	if c.interp == zfactor.Bicubic {
+		at = (*table).AtBicubic
+	}
+	v0, err := at(c.base, Tr, Pr)
 	if err != nil {
 		return 0, 0, err
 	}
-	v1, err := c.depart.At(Tr, Pr)
+	v1, err := at(c.depart, Tr, Pr)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -4,14 +4,66 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
 	"os"
 	"strings"
+
+	"github.com/rickykimani/zfactor/internal/dataversion"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
 )
 
+// table is a table of the JSON file. Cells left empty in the published tables
+// are null and written as NaN.
 type table struct {
-	Pr     []float64   `json:"reduced_pressure"`
-	Tr     []float64   `json:"reduced_temperature"`
-	Values [][]float64 `json:"values"`
+	Pr     []float64    `json:"reduced_pressure"`
+	Tr     []float64    `json:"reduced_temperature"`
+	Values [][]*float64 `json:"values"`
+}
+
+// extendPr are the reduced pressures beyond the published tables (Pr = 10) that
+// are added from the modified Benedict-Webb-Rubin equations, which the published
+// values were computed from.
+var extendPr = []float64{12, 14}
+
+// properties maps the keys of the JSON file to the property and to whether the
+// table is the departure ("1") table.
+var properties = map[string]struct {
+	p      leekesler.Property
+	depart bool
+}{
+	"z0": {leekesler.CompressibilityFactor, false}, "z1": {leekesler.CompressibilityFactor, true},
+	"h0": {leekesler.ResidualEnthalpy, false}, "h1": {leekesler.ResidualEnthalpy, true},
+	"s0": {leekesler.ResidualEntropy, false}, "s1": {leekesler.ResidualEntropy, true},
+	"phi0": {leekesler.FugacityCoefficient, false}, "phi1": {leekesler.FugacityCoefficient, true},
+}
+
+// values returns the values of t extended to extendPr, with NaN for empty
+// cells and for states the equations cannot be solved at.
+func values(key string, t table) ([]float64, [][]float64) {
+	prop := properties[strings.ToLower(key)]
+	pr := append(append([]float64(nil), t.Pr...), extendPr...)
+	rows := make([][]float64, len(t.Values))
+	for j, row := range t.Values {
+		for _, v := range row {
+			if v == nil {
+				rows[j] = append(rows[j], math.NaN())
+			} else {
+				rows[j] = append(rows[j], *v)
+			}
+		}
+		for _, p := range extendPr {
+			v0, v1, err := leekesler.Analytic(prop.p, t.Tr[j], p)
+			switch {
+			case err != nil:
+				rows[j] = append(rows[j], math.NaN())
+			case prop.depart:
+				rows[j] = append(rows[j], v1)
+			default:
+				rows[j] = append(rows[j], v0)
+			}
+		}
+	}
+	return pr, rows
 }
 
 func main() {
@@ -37,8 +89,8 @@ type table struct {
 }
 `
 
-	var goCode strings.Builder
-	goCode.WriteString(`// Code generated by go generate; DO NOT EDIT.
+	var header, goCode strings.Builder
+	header.WriteString(`// Code generated by go generate; DO NOT EDIT.
 
 // package leekesler contains the Lee/Kesler generalized correlation 
 // tables for the compressibility factor, residual enthalpy, 
@@ -46,9 +98,17 @@ type table struct {
 package leekesler
 
 // Lee-Kesler generalized correlation tables
-// Generated from parsed PDF tables
+// Generated from parsed PDF tables, extended beyond Pr = 10 with the
+// modified Benedict-Webb-Rubin equations (see Analytic)
 
-` + structCode)
+`)
+	header.WriteString("// DataVersion is the version of the Lee/Kesler tables, from data/versions.json.\n")
+	version, err := dataversion.Read("../data", "lee-kesler")
+	if err != nil {
+		log.Fatal(err)
+	}
+	header.WriteString(fmt.Sprintf("const DataVersion = %d\n\n", version))
+	goCode.WriteString(structCode)
 
 	var count int
 	fmt.Println("#------------------------------------------------------#")
@@ -64,12 +124,15 @@ package leekesler
 		"phi1": "departure fugacity coefficient for the lee/Kesler correlation phi^1",
 	}
 
-	for key, tableList := range tables {
+	// The keys in a fixed order, so that the output is reproducible.
+	for _, key := range []string{"phi0", "phi1", "z0", "z1", "h0", "h1", "s0", "s1"} {
+		tableList := tables[key]
 		if len(tableList) == 0 {
 			continue
 		}
 		// Expect exactly one combined table per key. If multiple exist, take the first.
 		t := tableList[0]
+		pr, rows := values(key, t)
 		varName := strings.ToUpper(key) + "Table"
 		fmt.Printf("Processing %s table\n", varName)
 
@@ -78,10 +141,10 @@ package leekesler
 		}
 
 		goCode.WriteString(fmt.Sprintf("var %s = &table{\n", varName))
-		goCode.WriteString(fmt.Sprintf("\tPr: []float64{%s},\n", floatsToString(t.Pr)))
+		goCode.WriteString(fmt.Sprintf("\tPr: []float64{%s},\n", floatsToString(pr)))
 		goCode.WriteString(fmt.Sprintf("\tTr: []float64{%s},\n", floatsToString(t.Tr)))
 		goCode.WriteString("\tValues: [][]float64{\n")
-		for _, row := range t.Values {
+		for _, row := range rows {
 			goCode.WriteString(fmt.Sprintf("\t\t{%s},\n", floatsToString(row)))
 		}
 		goCode.WriteString("\t},\n")
@@ -92,7 +155,11 @@ package leekesler
 	fmt.Printf("Processed %d Lee/Kesler tables\n", count)
 	fmt.Println("#------------------------------------------------------#")
 
-	err = os.WriteFile(outputFile, []byte(goCode.String()), 0644)
+	// Empty cells are written as math.NaN().
+	if strings.Contains(goCode.String(), "math.NaN()") {
+		header.WriteString("import \"math\"\n\n")
+	}
+	err = os.WriteFile(outputFile, []byte(header.String()+goCode.String()), 0644)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -101,6 +168,10 @@ package leekesler
 func floatsToString(fs []float64) string {
 	strs := make([]string, len(fs))
 	for i, f := range fs {
+		if math.IsNaN(f) {
+			strs[i] = "math.NaN()"
+			continue
+		}
 		strs[i] = fmt.Sprintf("%.4f", f)
 	}
 	return strings.Join(strs, ", ")
@@ -2,32 +2,87 @@ package leekesler
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"sort"
+
+	"github.com/rickykimani/zfactor"
 )
 
+func init() {
+	zfactor.RegisterDataset(zfactor.DatasetLeeKesler, DataVersion)
+}
+
+var (
+	// ErrOutOfRange is wrapped by a *RangeError for states beyond the bounds of
+	// the tables, Tr 0.30–4.0 and Pr 0.01–14.
+	ErrOutOfRange = errors.New("lee-kesler: state out of the range of the tables")
+	// ErrMissingRegion is wrapped by a *RangeError for states within the bounds of
+	// the tables whose interpolation needs cells that have no value.
+	ErrMissingRegion = errors.New("lee-kesler: state in a region of the tables without values")
+)
+
+// RangeError is returned by table lookups for states the tables do not cover.
+// Use errors.Is with ErrOutOfRange or ErrMissingRegion to tell the cases apart:
+// only the first can be helped by another method, such as Analytic, while the
+// second is a gap in the data of an otherwise covered range.
+type RangeError struct {
+	Tr, Pr float64 // Requested state
+	// Missing is true if the state is within the bounds of the tables but in a
+	// region without values.
+	Missing bool
+	// Bounds of the tables, in [Min, Max] order.
+	TrMin, TrMax float64
+	PrMin, PrMax float64
+}
+
+func (e *RangeError) Error() string {
+	if e.Missing {
+		return fmt.Sprintf("lee-kesler: no table values around Tr = %g, Pr = %g", e.Tr, e.Pr)
+	}
+	switch {
+	case e.Pr < e.PrMin || e.Pr > e.PrMax:
+		return fmt.Sprintf("lee-kesler: reduced pressure %g out of range [%g, %g]", e.Pr, e.PrMin, e.PrMax)
+	default:
+		return fmt.Sprintf("lee-kesler: reduced temperature %g out of range [%g, %g]", e.Tr, e.TrMin, e.TrMax)
+	}
+}
+
+// Unwrap returns ErrMissingRegion or ErrOutOfRange.
+func (e *RangeError) Unwrap() error {
+	if e.Missing {
+		return ErrMissingRegion
+	}
+	return ErrOutOfRange
+}
+
 // At returns the interpolated value at the given reduced pressure (pr)
-// and reduced temperature (tr). Returns an error if pr or tr are out of range.
+// and reduced temperature (tr). Returns a *RangeError if pr or tr are out of
+// range or the interpolation needs a cell without a value.
 //
 // Usage:
 //
 //	v, err := leekesler.Z0Table.At(1.2, 0.66)
 func (t table) At(Tr, Pr float64) (float64, error) {
+	zfactor.UseDataset(zfactor.DatasetLeeKesler)
 	return interpolate(Pr, Tr, t)
 }
 
+// AtBicubic is At with bicubic interpolation: the values of the table and
+// their first derivatives in Tr and Pr are continuous, where At has kinks at the
+// table nodes. The patches of the package tables are computed at init.
+func (t *table) AtBicubic(Tr, Pr float64) (float64, error) {
+	zfactor.UseDataset(zfactor.DatasetLeeKesler)
+	return interpolateBicubic(Pr, Tr, t)
+}
+
 // interpolate performs bilinear interpolation on the provided table.
-// Returns an error if pr or tr are out of range.
+// Returns a *RangeError if pr or tr are out of range.
 func interpolate(pr, tr float64, table table) (float64, error) {
-	//bounds
-	if pr < table.Pr[0] || pr > table.Pr[len(table.Pr)-1] {
-		return 0, errors.New("reduced pressure out of range")
+	i, j, err := table.cell(pr, tr)
+	if err != nil {
+		return 0, err
 	}
-	if tr < table.Tr[0] || tr > table.Tr[len(table.Tr)-1] {
-		return 0, errors.New("reduced temperature out of range")
-	}
-
-	i := findIndex(table.Pr, pr)
-	j := findIndex(table.Tr, tr)
 
 	x1, x2 := table.Pr[i], table.Pr[i+1]
 	y1, y2 := table.Tr[j], table.Tr[j+1]
@@ -47,6 +102,31 @@ func interpolate(pr, tr float64, table table) (float64, error) {
 
 }
 
+// cell returns the indices i of Pr and j of Tr of the table cell containing
+// (pr, tr), or a *RangeError if the state is out of range or a corner of the
+// cell has no value.
+func (t *table) cell(pr, tr float64) (i, j int, err error) {
+	//bounds
+	rangeErr := &RangeError{
+		Tr: tr, Pr: pr,
+		TrMin: t.Tr[0], TrMax: t.Tr[len(t.Tr)-1],
+		PrMin: t.Pr[0], PrMax: t.Pr[len(t.Pr)-1],
+	}
+	if pr < rangeErr.PrMin || pr > rangeErr.PrMax || tr < rangeErr.TrMin || tr > rangeErr.TrMax {
+		return 0, 0, rangeErr
+	}
+
+	i = findIndex(t.Pr, pr)
+	j = findIndex(t.Tr, tr)
+	for _, v := range []float64{t.Values[j][i], t.Values[j][i+1], t.Values[j+1][i], t.Values[j+1][i+1]} {
+		if math.IsNaN(v) {
+			rangeErr.Missing = true
+			return 0, 0, rangeErr
+		}
+	}
+	return i, j, nil
+}
+
 func findIndex(arr []float64, val float64) int {
 	if len(arr) < 2 {
 		return -1
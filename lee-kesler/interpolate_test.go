@@ -0,0 +1,173 @@
+package leekesler
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/chart"
+)
+
+func TestTableAt(t *testing.T) {
+	testCases := []struct {
+		name    string
+		tr, pr  float64
+		want    float64
+		wantErr error
+	}{
+		{"Published grid point", 1.5, 10, 1.1339, nil},
+		{"Extended grid point", 1.5, 14, 1.4194, nil},
+		{"Between Pr 10 and 12", 1.5, 11, (1.1339 + 1.2764) / 2, nil},
+		{"Pr beyond the tables", 1.5, 15, 0, ErrOutOfRange},
+		{"Tr below the tables", 0.25, 1, 0, ErrOutOfRange},
+		{"Tr above the tables", 4.5, 1, 0, ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Z0Table.At(tc.tr, tc.pr)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("At(%v, %v) error = %v, want %v", tc.tr, tc.pr, err, tc.wantErr)
+			}
+			if math.Abs(got-tc.want) > 1e-4 {
+				t.Errorf("At(%v, %v) = %v, want %v", tc.tr, tc.pr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTableAtMissingRegion(t *testing.T) {
+	tab := &table{
+		Pr: []float64{1, 2, 3},
+		Tr: []float64{1, 2},
+		Values: [][]float64{
+			{1, 2, math.NaN()},
+			{3, 4, 5},
+		},
+	}
+	if got, err := tab.At(1.5, 1.5); err != nil || got != 2.5 {
+		t.Errorf("At(1.5, 1.5) = %v, %v, want 2.5, nil", got, err)
+	}
+
+	_, err := tab.At(1.5, 2.5)
+	var rangeErr *RangeError
+	if !errors.As(err, &rangeErr) || !rangeErr.Missing {
+		t.Fatalf("At(1.5, 2.5) error = %v, want a *RangeError for a missing region", err)
+	}
+	if !errors.Is(err, ErrMissingRegion) || errors.Is(err, ErrOutOfRange) {
+		t.Errorf("At(1.5, 2.5) error = %v, want %v only", err, ErrMissingRegion)
+	}
+
+	_, err = tab.At(1.5, 4)
+	if !errors.As(err, &rangeErr) || rangeErr.Missing || rangeErr.PrMax != 3 {
+		t.Errorf("At(1.5, 4) error = %#v, want out of range with PrMax = 3", err)
+	}
+	for _, e := range []error{err, &RangeError{Missing: true}} {
+		if !strings.HasPrefix(e.Error(), "lee-kesler: ") {
+			t.Errorf("RangeError.Error() = %q, want the lee-kesler: prefix", e.Error())
+		}
+	}
+}
+
+func TestTableAtBicubic(t *testing.T) {
+	// The patches reproduce the table at its nodes.
+	for _, tab := range []*table{Z0Table, H1Table, PHI0Table} {
+		for _, node := range [][2]int{{0, 0}, {27, 9}, {39, 16}} {
+			tr, pr := tab.Tr[node[0]], tab.Pr[node[1]]
+			got, err := tab.AtBicubic(tr, pr)
+			if want := tab.Values[node[0]][node[1]]; err != nil || math.Abs(got-want) > 1e-12 {
+				t.Errorf("AtBicubic(%v, %v) = %v, %v, want %v", tr, pr, got, err, want)
+			}
+		}
+	}
+
+	// Between nodes, closer to the equations the tables were generated from.
+	for _, pt := range []struct{ tr, pr float64 }{{1.08, 0.94}, {1.55, 0.9}, {0.62, 4}} {
+		want, _, _ := Analytic(CompressibilityFactor, pt.tr, pt.pr)
+		got, err := Z0Table.AtBicubic(pt.tr, pt.pr)
+		if err != nil || math.Abs(got-want) > 5e-4 {
+			t.Errorf("AtBicubic(%v, %v) = %v, %v, want about %v", pt.tr, pt.pr, got, err, want)
+		}
+	}
+
+	// The slope in Tr is continuous across the node Tr = 1.5, unlike that of At.
+	const h = 1e-6
+	slopes := func(at func(float64, float64) (float64, error)) (left, right float64) {
+		m0, _ := at(1.5-h, 2.5)
+		m1, _ := at(1.5, 2.5)
+		m2, _ := at(1.5+h, 2.5)
+		return (m1 - m0) / h, (m2 - m1) / h
+	}
+	if left, right := slopes(Z0Table.AtBicubic); math.Abs(left-right) > 1e-4 {
+		t.Errorf("AtBicubic() slopes at Tr = 1.5 = %v, %v, want equal", left, right)
+	}
+	if left, right := slopes(Z0Table.At); math.Abs(left-right) < 0.1 {
+		t.Errorf("At() slopes at Tr = 1.5 = %v, %v, want a kink", left, right)
+	}
+
+	if _, err := Z0Table.AtBicubic(1.5, 15); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("AtBicubic(1.5, 15) error = %v, want %v", err, ErrOutOfRange)
+	}
+	tab := &table{
+		Pr:     []float64{1, 2, 3},
+		Tr:     []float64{1, 2},
+		Values: [][]float64{{1, 2, math.NaN()}, {3, 4, 5}},
+	}
+	if got, err := tab.AtBicubic(1.5, 1.5); err != nil || math.IsNaN(got) {
+		t.Errorf("AtBicubic(1.5, 1.5) = %v, %v, want a value next to the missing cell", got, err)
+	}
+	if _, err := tab.AtBicubic(1.5, 2.5); !errors.Is(err, ErrMissingRegion) {
+		t.Errorf("AtBicubic(1.5, 2.5) error = %v, want %v", err, ErrMissingRegion)
+	}
+}
+
+func TestCorrelationInterpolation(t *testing.T) {
+	t.Cleanup(zfactor.ResetDefaults)
+	const tr, pr = 1.08, 0.94
+	want0, _ := Z0Table.AtBicubic(tr, pr)
+	want1, _ := Z1Table.AtBicubic(tr, pr)
+
+	z0, z1, err := Correlation(CompressibilityFactor, WithInterpolation(zfactor.Bicubic)).At(tr, pr)
+	if err != nil || z0 != want0 || z1 != want1 {
+		t.Errorf("Correlation(WithInterpolation(Bicubic)).At() = %v, %v, %v, want %v, %v", z0, z1, err, want0, want1)
+	}
+	if z0, _, _ := Correlation(CompressibilityFactor).At(tr, pr); z0 == want0 {
+		t.Errorf("Correlation().At() = %v with the bilinear default, want not the bicubic %v", z0, want0)
+	}
+
+	if err := zfactor.SetDefaults(zfactor.Config{Interpolation: zfactor.Bicubic}); err != nil {
+		t.Fatal(err)
+	}
+	if z0, _, _ := Correlation(CompressibilityFactor).At(tr, pr); z0 != want0 {
+		t.Errorf("Correlation().At() with the bicubic default = %v, want %v", z0, want0)
+	}
+	// An explicit option wins over the default.
+	bilinear, _ := Z0Table.At(tr, pr)
+	if z0, _, _ := Correlation(CompressibilityFactor, WithInterpolation(zfactor.Bilinear)).At(tr, pr); z0 != bilinear {
+		t.Errorf("Correlation(WithInterpolation(Bilinear)).At() = %v, want %v", z0, bilinear)
+	}
+}
+
+func TestCorrelationWithCharts(t *testing.T) {
+	// A chart of Z itself, like the Nelson-Obert charts.
+	z := &chart.Chart{Name: "test", Param: "Tr", X: "Pr", Y: "Z", Isolines: []chart.Isoline{
+		{Param: 1, Points: []chart.Point{{X: 1, Y: 0.8}, {X: 2, Y: 0.6}}},
+		{Param: 2, Points: []chart.Point{{X: 1, Y: 0.9}, {X: 2, Y: 0.8}}},
+	}}
+	z0, z1, err := Correlation(CompressibilityFactor, WithCharts(z, nil)).At(1.5, 1.5)
+	if err != nil || math.Abs(z0-0.775) > 1e-12 || z1 != 0 {
+		t.Errorf("Correlation(WithCharts(z, nil)).At(1.5, 1.5) = %v, %v, %v, want 0.775, 0", z0, z1, err)
+	}
+	if _, _, err := Correlation(CompressibilityFactor, WithCharts(z, nil)).At(1.5, 3); err == nil {
+		t.Error("Correlation(WithCharts()).At() outside the chart want error")
+	}
+
+	// Charts of the tables match the tables.
+	const tr, pr = 1.1, 1.3
+	want0, want1, _ := Correlation(CompressibilityFactor).At(tr, pr)
+	z0, z1, err = Correlation(CompressibilityFactor, WithCharts(Z0Table.Chart("Z0"), Z1Table.Chart("Z1"))).At(tr, pr)
+	if err != nil || math.Abs(z0-want0) > 1e-12 || math.Abs(z1-want1) > 1e-12 {
+		t.Errorf("Correlation(WithCharts(Z0, Z1)).At() = %v, %v, %v, want %v, %v", z0, z1, err, want0, want1)
+	}
+}
@@ -0,0 +1,189 @@
+package leekesler
+
+import (
+	"errors"
+	"math"
+)
+
+// fluid holds the constants of the modified Benedict-Webb-Rubin equation of Lee
+// and Kesler (1975) for the simple or the reference fluid.
+type fluid struct {
+	b1, b2, b3, b4 float64
+	c1, c2, c3, c4 float64
+	d1, d2         float64
+	beta, gamma    float64
+}
+
+var (
+	// simple is the simple fluid (ω = 0).
+	simple = fluid{
+		b1: 0.1181193, b2: 0.265728, b3: 0.154790, b4: 0.030323,
+		c1: 0.0236744, c2: 0.0186984, c3: 0, c4: 0.042724,
+		d1: 0.155488e-4, d2: 0.623689e-4,
+		beta: 0.65392, gamma: 0.060167,
+	}
+	// reference is the reference fluid, n-octane.
+	reference = fluid{
+		b1: 0.2026579, b2: 0.331511, b3: 0.027655, b4: 0.203488,
+		c1: 0.0313385, c2: 0.0503618, c3: 0.016901, c4: 0.041577,
+		d1: 0.48736e-4, d2: 0.0740336e-4,
+		beta: 1.226, gamma: 0.03754,
+	}
+)
+
+// omegaRef is the acentric factor of the reference fluid.
+const omegaRef = 0.3978
+
+// virial returns the density coefficients B, C and D at Tr.
+func (f *fluid) virial(tr float64) (B, C, D float64) {
+	B = f.b1 - f.b2/tr - f.b3/(tr*tr) - f.b4/(tr*tr*tr)
+	C = f.c1 - f.c2/tr + f.c3/(tr*tr*tr)
+	D = f.d1 + f.d2/tr
+	return B, C, D
+}
+
+// z returns the compressibility factor at Tr and the ideal reduced volume
+// Vr = Pc V/(R Tc):
+//
+//	Z = 1 + B/Vr + C/Vr² + D/Vr⁵ + c₄/(Tr³ Vr²) (β + γ/Vr²) exp(-γ/Vr²)
+func (f *fluid) z(tr, vr float64) float64 {
+	B, C, D := f.virial(tr)
+	v2 := vr * vr
+	return 1 + B/vr + C/v2 + D/(v2*v2*vr) +
+		f.c4/(tr*tr*tr*v2)*(f.beta+f.gamma/v2)*math.Exp(-f.gamma/v2)
+}
+
+// e returns the exponential term E of the departure functions.
+func (f *fluid) e(tr, vr float64) float64 {
+	g := f.gamma / (vr * vr)
+	return f.c4 / (2 * tr * tr * tr * f.gamma) * (f.beta + 1 - (f.beta+1+g)*math.Exp(-g))
+}
+
+// properties returns the value of property p of the fluid at Tr and Vr with
+// compressibility factor Z. The fugacity coefficient is returned as ln φ.
+func (f *fluid) properties(p Property, tr, vr, Z float64) float64 {
+	v2 := vr * vr
+	v5 := v2 * v2 * vr
+	E := f.e(tr, vr)
+	switch p {
+	case ResidualEnthalpy:
+		return tr * (Z - 1 - (f.b2+2*f.b3/tr+3*f.b4/(tr*tr))/(tr*vr) -
+			(f.c2-3*f.c3/(tr*tr))/(2*tr*v2) + f.d2/(5*tr*v5) + 3*E)
+	case ResidualEntropy:
+		return math.Log(Z) - (f.b1+f.b3/(tr*tr)+2*f.b4/(tr*tr*tr))/vr -
+			(f.c1-2*f.c3/(tr*tr*tr))/(2*v2) - f.d1/(5*v5) + 2*E
+	case FugacityCoefficient:
+		B, C, D := f.virial(tr)
+		return Z - 1 - math.Log(Z) + B/vr + C/(2*v2) + D/(5*v5) + E
+	default:
+		return Z
+	}
+}
+
+// volumes returns the reduced volumes Vr at which the fluid has the reduced
+// pressure Pr = Z Tr/Vr at Tr, in ascending order. The pressure is scanned over
+// a geometric grid of Vr and its sign changes refined by bisection.
+func (f *fluid) volumes(tr, pr float64) []float64 {
+	const points = 400
+	F := func(vr float64) float64 { return f.z(tr, vr)*tr/vr - pr }
+	lo, hi := 0.01, 10*tr/pr+1
+	ratio := math.Pow(hi/lo, 1.0/(points-1))
+
+	var roots []float64
+	prevV := lo
+	prevF := F(prevV)
+	for i := 1; i < points; i++ {
+		vr := lo * math.Pow(ratio, float64(i))
+		fv := F(vr)
+		if (prevF > 0) != (fv > 0) {
+			left, right, fLeft := prevV, vr, prevF
+			for range 100 {
+				mid := (left + right) / 2
+				fMid := F(mid)
+				if (fMid > 0) == (fLeft > 0) {
+					left, fLeft = mid, fMid
+				} else {
+					right = mid
+				}
+			}
+			roots = append(roots, (left+right)/2)
+		}
+		prevV, prevF = vr, fv
+	}
+	return roots
+}
+
+// stable returns the index of the root of the fluid with the lowest fugacity
+// among the reduced volumes roots at Tr.
+func (f *fluid) stable(tr float64, roots []float64) (int, error) {
+	best, bestLnPhi := -1, math.Inf(1)
+	for i, vr := range roots {
+		Z := f.z(tr, vr)
+		if Z <= 0 {
+			continue
+		}
+		if lnPhi := f.properties(FugacityCoefficient, tr, vr, Z); lnPhi < bestLnPhi {
+			best, bestLnPhi = i, lnPhi
+		}
+	}
+	if best < 0 {
+		return 0, errors.New("lee-kesler: no physical volume root found")
+	}
+	return best, nil
+}
+
+// Analytic returns the simple fluid and departure values of property p at
+// reduced temperature Tr and reduced pressure Pr from the modified
+// Benedict-Webb-Rubin equations of Lee and Kesler (1975), in the form of the
+// tables returned by Correlation(p).At:
+//
+//	M = M⁰ + ω M¹ with M¹ = (Mʳ - M⁰)/ωʳ
+//
+// for Z, H^R/(RTc) and S^R/R, and φ = φ⁰ (φ¹)^ω for the fugacity coefficient.
+// The reduced volumes of both fluids are solved on the root of the stable phase
+// of the simple fluid. Unlike the tables, the values do not suffer from
+// interpolation near Tr = 1 and are not limited to the table ranges.
+func Analytic(p Property, Tr, Pr float64) (float64, float64, error) {
+	if Tr <= 0 {
+		return 0, 0, errors.New("reduced temperature must be greater than 0")
+	}
+	if Pr < 0 {
+		return 0, 0, errors.New("reduced pressure cannot be negative")
+	}
+	if Pr == 0 {
+		// The ideal gas limit.
+		switch p {
+		case CompressibilityFactor:
+			return 1, 0, nil
+		case FugacityCoefficient:
+			return 1, 1, nil
+		default:
+			return 0, 0, nil
+		}
+	}
+	// The phase is that of the simple fluid, and the reference fluid is taken in
+	// the same phase, on its root closest to the volume of the simple fluid, as in
+	// the tables: between the vapor pressure curves of the two fluids, the
+	// reference fluid is on its metastable vapor root.
+	roots0 := simple.volumes(Tr, Pr)
+	rootsR := reference.volumes(Tr, Pr)
+	if len(roots0) == 0 || len(rootsR) == 0 {
+		return 0, 0, errors.New("lee-kesler: no volume root found")
+	}
+	i, err := simple.stable(Tr, roots0)
+	if err != nil {
+		return 0, 0, err
+	}
+	vr0, vrR := roots0[i], rootsR[0]
+	for _, vr := range rootsR[1:] {
+		if math.Abs(math.Log(vr/vr0)) < math.Abs(math.Log(vrR/vr0)) {
+			vrR = vr
+		}
+	}
+	m0 := simple.properties(p, Tr, vr0, simple.z(Tr, vr0))
+	mr := reference.properties(p, Tr, vrR, reference.z(Tr, vrR))
+	if p == FugacityCoefficient {
+		return math.Exp(m0), math.Exp((mr - m0) / omegaRef), nil
+	}
+	return m0, (mr - m0) / omegaRef, nil
+}
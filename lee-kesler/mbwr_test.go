@@ -0,0 +1,67 @@
+package leekesler
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnalytic(t *testing.T) {
+	// Grid points of the tables away from the vapor pressure curve and the
+	// critical point, where the tables reproduce the equations to their
+	// precision.
+	points := []struct{ Tr, Pr float64 }{
+		{0.5, 1}, {0.9, 0.4}, {0.9, 5}, {1.1, 0.6}, {1.5, 1}, {2, 5}, {3, 10}, {2, 14},
+	}
+	props := []struct {
+		p   Property
+		tol float64
+	}{
+		{CompressibilityFactor, 1e-3},
+		{ResidualEnthalpy, 1e-2},
+		{ResidualEntropy, 1e-2},
+		{FugacityCoefficient, 2e-3},
+	}
+	for _, pp := range props {
+		c := Correlation(pp.p)
+		for _, pt := range points {
+			t0, t1, err := c.At(pt.Tr, pt.Pr)
+			if err != nil {
+				t.Fatalf("At(%v, %v) unexpected error: %v", pt.Tr, pt.Pr, err)
+			}
+			a0, a1, err := Analytic(pp.p, pt.Tr, pt.Pr)
+			if err != nil {
+				t.Fatalf("Analytic(%v, %v, %v) unexpected error: %v", pp.p, pt.Tr, pt.Pr, err)
+			}
+			if math.Abs(a0-t0) > pp.tol || math.Abs(a1-t1) > pp.tol {
+				t.Errorf("Analytic(%v, %v, %v) = %v, %v, want tables %v, %v", pp.p, pt.Tr, pt.Pr, a0, a1, t0, t1)
+			}
+		}
+	}
+
+	// Ideal gas limit and invalid states.
+	if z0, z1, err := Analytic(CompressibilityFactor, 1.2, 0); err != nil || z0 != 1 || z1 != 0 {
+		t.Errorf("Analytic(Z, 1.2, 0) = %v, %v, %v, want 1, 0, nil", z0, z1, err)
+	}
+	if _, _, err := Analytic(CompressibilityFactor, 0, 1); err == nil {
+		t.Error("Analytic() with Tr = 0 expected error, got nil")
+	}
+}
+
+func TestAnalyticSaturation(t *testing.T) {
+	// At the vapor pressure of the simple fluid the liquid and vapor have equal
+	// fugacities, so the stable phase changes there: Z⁰ jumps between Pr just
+	// below and just above the vapor pressure.
+	const tr = 0.8
+	pSat := math.Exp(lnReducedVaporPressureSimple(tr))
+	vap, _, err := Analytic(CompressibilityFactor, tr, 0.97*pSat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	liq, _, err := Analytic(CompressibilityFactor, tr, 1.03*pSat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vap < 0.7 || liq > 0.1 {
+		t.Errorf("Analytic(Z) around Pr = %.4f = %v, %v, want vapor then liquid", pSat, vap, liq)
+	}
+}
@@ -6,7 +6,11 @@
 package leekesler
 
 // Lee-Kesler generalized correlation tables
-// Generated from parsed PDF tables
+// Generated from parsed PDF tables, extended beyond Pr = 10 with the
+// modified Benedict-Webb-Rubin equations (see Analytic)
+
+// DataVersion is the version of the Lee/Kesler tables, from data/versions.json.
+const DataVersion = 1
 
 // table represents a Lee/Kesler generalized correlation table
 type table struct {
@@ -15,386 +19,386 @@ type table struct {
 	Values [][]float64 //Values => f(Pr[i], Tr[j])
 }
 
-// PHI0Table contains combined high pressure and low pressure data for the base fugacity coefficient for the lee/Kesler correlation (log(phi)^0)
+// PHI0Table contains combined high pressure and low pressure data for the base fugacity coefficient for the lee/Kesler correlation phi^0
 var PHI0Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{0.0002, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
-		{0.0034, 0.0007, 0.0003, 0.0002, 0.0001, 0.0001, 0.0001, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
-		{0.0272, 0.0055, 0.0028, 0.0014, 0.0007, 0.0005, 0.0004, 0.0003, 0.0003, 0.0003, 0.0002, 0.0002, 0.0002, 0.0002, 0.0003},
-		{0.1321, 0.0266, 0.0135, 0.0069, 0.0036, 0.0025, 0.0020, 0.0016, 0.0014, 0.0012, 0.0010, 0.0008, 0.0008, 0.0009, 0.0012},
-		{0.4529, 0.0912, 0.0461, 0.0235, 0.0122, 0.0085, 0.0067, 0.0055, 0.0048, 0.0041, 0.0034, 0.0028, 0.0025, 0.0027, 0.0034},
-		{0.9817, 0.2432, 0.1227, 0.0625, 0.0325, 0.0225, 0.0176, 0.0146, 0.0127, 0.0107, 0.0089, 0.0072, 0.0063, 0.0066, 0.0080},
-		{0.9840, 0.5383, 0.2716, 0.1384, 0.0718, 0.0497, 0.0386, 0.0321, 0.0277, 0.0234, 0.0193, 0.0154, 0.0132, 0.0135, 0.0160},
-		{0.9886, 0.9419, 0.5212, 0.2655, 0.1374, 0.0948, 0.0738, 0.0611, 0.0527, 0.0445, 0.0364, 0.0289, 0.0244, 0.0245, 0.0282},
-		{0.9908, 0.9528, 0.9057, 0.4560, 0.2360, 0.1626, 0.1262, 0.1045, 0.0902, 0.0759, 0.0619, 0.0488, 0.0406, 0.0402, 0.0453},
-		{0.9931, 0.9616, 0.9226, 0.7178, 0.3715, 0.2559, 0.1982, 0.1641, 0.1413, 0.1188, 0.0966, 0.0757, 0.0625, 0.0610, 0.0673},
-		{0.9931, 0.9683, 0.9354, 0.8730, 0.5445, 0.3750, 0.2904, 0.2404, 0.2065, 0.1738, 0.1409, 0.1102, 0.0899, 0.0867, 0.0942},
-		{0.9954, 0.9727, 0.9462, 0.8933, 0.7534, 0.5188, 0.4018, 0.3319, 0.2858, 0.2399, 0.1945, 0.1517, 0.1227, 0.1175, 0.1256},
-		{0.9954, 0.9772, 0.9550, 0.9099, 0.8204, 0.6823, 0.5297, 0.4375, 0.3767, 0.3162, 0.2564, 0.1995, 0.1607, 0.1524, 0.1611},
-		{0.9954, 0.9795, 0.9594, 0.9183, 0.8375, 0.7551, 0.6109, 0.5058, 0.4355, 0.3656, 0.2972, 0.2307, 0.1854, 0.1754, 0.1841},
-		{0.9954, 0.9817, 0.9616, 0.9226, 0.8472, 0.7709, 0.6668, 0.5521, 0.4764, 0.3999, 0.3251, 0.2523, 0.2028, 0.1910, 0.2000},
-		{0.9954, 0.9817, 0.9638, 0.9268, 0.8570, 0.7852, 0.7112, 0.5984, 0.5164, 0.4345, 0.3532, 0.2748, 0.2203, 0.2075, 0.2163},
-		{0.9954, 0.9817, 0.9638, 0.9290, 0.8610, 0.7925, 0.7211, 0.6223, 0.5370, 0.4529, 0.3681, 0.2864, 0.2296, 0.2158, 0.2244},
-		{0.9977, 0.9840, 0.9661, 0.9311, 0.8650, 0.7980, 0.7295, 0.6442, 0.5572, 0.4699, 0.3828, 0.2978, 0.2388, 0.2244, 0.2328},
-		{0.9977, 0.9840, 0.9661, 0.9333, 0.8690, 0.8035, 0.7379, 0.6668, 0.5781, 0.4875, 0.3972, 0.3097, 0.2483, 0.2328, 0.2415},
-		{0.9977, 0.9840, 0.9683, 0.9354, 0.8730, 0.8110, 0.7464, 0.6792, 0.5970, 0.5047, 0.4121, 0.3214, 0.2576, 0.2415, 0.2500},
-		{0.9977, 0.9840, 0.9683, 0.9376, 0.8770, 0.8166, 0.7551, 0.6902, 0.6166, 0.5224, 0.4266, 0.3334, 0.2673, 0.2506, 0.2582},
-		{0.9977, 0.9863, 0.9705, 0.9441, 0.8872, 0.8318, 0.7762, 0.7194, 0.6607, 0.5728, 0.4710, 0.3690, 0.2958, 0.2773, 0.2844},
-		{0.9977, 0.9886, 0.9750, 0.9506, 0.9016, 0.8531, 0.8072, 0.7586, 0.7112, 0.6412, 0.5408, 0.4285, 0.3451, 0.3228, 0.3296},
-		{0.9977, 0.9886, 0.9795, 0.9572, 0.9141, 0.8730, 0.8318, 0.7907, 0.7499, 0.6918, 0.6026, 0.4875, 0.3954, 0.3690, 0.3750},
-		{0.9977, 0.9908, 0.9817, 0.9616, 0.9247, 0.8892, 0.8531, 0.8166, 0.7834, 0.7328, 0.6546, 0.5420, 0.4446, 0.4150, 0.4198},
-		{0.9977, 0.9931, 0.9863, 0.9705, 0.9419, 0.9141, 0.8872, 0.8590, 0.8318, 0.7943, 0.7345, 0.6383, 0.5383, 0.5058, 0.5093},
-		{0.9977, 0.9931, 0.9886, 0.9772, 0.9550, 0.9333, 0.9120, 0.8892, 0.8690, 0.8395, 0.7925, 0.7145, 0.6237, 0.5902, 0.5943},
-		{1.0000, 0.9954, 0.9908, 0.9817, 0.9638, 0.9462, 0.9290, 0.9141, 0.8974, 0.8730, 0.8375, 0.7745, 0.6966, 0.6668, 0.6714},
-		{1.0000, 0.9954, 0.9931, 0.9863, 0.9727, 0.9572, 0.9441, 0.9311, 0.9183, 0.8995, 0.8710, 0.8222, 0.7586, 0.7328, 0.7430},
-		{1.0000, 0.9977, 0.9954, 0.9886, 0.9772, 0.9661, 0.9550, 0.9462, 0.9354, 0.9204, 0.8995, 0.8610, 0.8091, 0.7907, 0.8054},
-		{1.0000, 0.9977, 0.9954, 0.9908, 0.9817, 0.9727, 0.9661, 0.9572, 0.9484, 0.9376, 0.9204, 0.8913, 0.8531, 0.8414, 0.8590},
-		{1.0000, 0.9977, 0.9954, 0.9931, 0.9863, 0.9795, 0.9727, 0.9661, 0.9594, 0.9506, 0.9376, 0.9162, 0.8872, 0.8831, 0.9057},
-		{1.0000, 0.9977, 0.9977, 0.9954, 0.9886, 0.9840, 0.9795, 0.9727, 0.9683, 0.9616, 0.9528, 0.9354, 0.9183, 0.9183, 0.9462},
-		{1.0000, 1.0000, 0.9977, 0.9977, 0.9931, 0.9908, 0.9886, 0.9840, 0.9817, 0.9795, 0.9727, 0.9661, 0.9616, 0.9727, 1.0093},
-		{1.0000, 1.0000, 1.0000, 0.9977, 0.9977, 0.9954, 0.9931, 0.9931, 0.9908, 0.9908, 0.9886, 0.9863, 0.9931, 1.0116, 1.0568},
-		{1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 0.9977, 0.9977, 0.9977, 0.9977, 0.9977, 0.9977, 1.0023, 1.0162, 1.0399, 1.0889},
-		{1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0023, 1.0046, 1.0069, 1.0116, 1.0328, 1.0593, 1.1117},
-		{1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0046, 1.0046, 1.0069, 1.0069, 1.0116, 1.0209, 1.0423, 1.0740, 1.1298},
-		{1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0046, 1.0069, 1.0093, 1.0116, 1.0139, 1.0186, 1.0304, 1.0593, 1.0914, 1.1508},
-		{1.0000, 1.0000, 1.0000, 1.0023, 1.0046, 1.0069, 1.0093, 1.0116, 1.0139, 1.0162, 1.0233, 1.0375, 1.0666, 1.0990, 1.1588},
+		{0.0002, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
+		{0.0034, 0.0007, 0.0003, 0.0002, 0.0001, 0.0001, 0.0001, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0001, 0.0001},
+		{0.0272, 0.0055, 0.0028, 0.0014, 0.0007, 0.0005, 0.0004, 0.0003, 0.0003, 0.0003, 0.0002, 0.0002, 0.0002, 0.0002, 0.0003, 0.0004, 0.0005},
+		{0.1321, 0.0266, 0.0135, 0.0069, 0.0036, 0.0025, 0.0020, 0.0016, 0.0014, 0.0012, 0.0010, 0.0008, 0.0008, 0.0009, 0.0012, 0.0015, 0.0019},
+		{0.4529, 0.0912, 0.0461, 0.0235, 0.0122, 0.0085, 0.0067, 0.0055, 0.0048, 0.0041, 0.0034, 0.0028, 0.0025, 0.0027, 0.0034, 0.0042, 0.0053},
+		{0.9817, 0.2432, 0.1227, 0.0625, 0.0325, 0.0225, 0.0176, 0.0146, 0.0127, 0.0107, 0.0089, 0.0072, 0.0063, 0.0066, 0.0080, 0.0097, 0.0120},
+		{0.9840, 0.5383, 0.2716, 0.1384, 0.0718, 0.0497, 0.0386, 0.0321, 0.0277, 0.0234, 0.0193, 0.0154, 0.0132, 0.0135, 0.0160, 0.0189, 0.0229},
+		{0.9886, 0.9419, 0.5212, 0.2655, 0.1374, 0.0948, 0.0738, 0.0611, 0.0527, 0.0445, 0.0364, 0.0289, 0.0244, 0.0245, 0.0282, 0.0327, 0.0388},
+		{0.9908, 0.9528, 0.9057, 0.4560, 0.2360, 0.1626, 0.1262, 0.1045, 0.0902, 0.0759, 0.0619, 0.0488, 0.0406, 0.0402, 0.0453, 0.0516, 0.0602},
+		{0.9931, 0.9616, 0.9226, 0.7178, 0.3715, 0.2559, 0.1982, 0.1641, 0.1413, 0.1188, 0.0966, 0.0757, 0.0625, 0.0610, 0.0673, 0.0756, 0.0871},
+		{0.9931, 0.9683, 0.9354, 0.8730, 0.5445, 0.3750, 0.2904, 0.2404, 0.2065, 0.1738, 0.1409, 0.1102, 0.0899, 0.0867, 0.0942, 0.1046, 0.1189},
+		{0.9954, 0.9727, 0.9462, 0.8933, 0.7534, 0.5188, 0.4018, 0.3319, 0.2858, 0.2399, 0.1945, 0.1517, 0.1227, 0.1175, 0.1256, 0.1380, 0.1553},
+		{0.9954, 0.9772, 0.9550, 0.9099, 0.8204, 0.6823, 0.5297, 0.4375, 0.3767, 0.3162, 0.2564, 0.1995, 0.1607, 0.1524, 0.1611, 0.1753, 0.1954},
+		{0.9954, 0.9795, 0.9594, 0.9183, 0.8375, 0.7551, 0.6109, 0.5058, 0.4355, 0.3656, 0.2972, 0.2307, 0.1854, 0.1754, 0.1841, 0.1993, 0.2210},
+		{0.9954, 0.9817, 0.9616, 0.9226, 0.8472, 0.7709, 0.6668, 0.5521, 0.4764, 0.3999, 0.3251, 0.2523, 0.2028, 0.1910, 0.2000, 0.2158, 0.2385},
+		{0.9954, 0.9817, 0.9638, 0.9268, 0.8570, 0.7852, 0.7112, 0.5984, 0.5164, 0.4345, 0.3532, 0.2748, 0.2203, 0.2075, 0.2163, 0.2328, 0.2565},
+		{0.9954, 0.9817, 0.9638, 0.9290, 0.8610, 0.7925, 0.7211, 0.6223, 0.5370, 0.4529, 0.3681, 0.2864, 0.2296, 0.2158, 0.2244, 0.2414, 0.2655},
+		{0.9977, 0.9840, 0.9661, 0.9311, 0.8650, 0.7980, 0.7295, 0.6442, 0.5572, 0.4699, 0.3828, 0.2978, 0.2388, 0.2244, 0.2328, 0.2500, 0.2747},
+		{0.9977, 0.9840, 0.9661, 0.9333, 0.8690, 0.8035, 0.7379, 0.6668, 0.5781, 0.4875, 0.3972, 0.3097, 0.2483, 0.2328, 0.2415, 0.2588, 0.2839},
+		{0.9977, 0.9840, 0.9683, 0.9354, 0.8730, 0.8110, 0.7464, 0.6792, 0.5970, 0.5047, 0.4121, 0.3214, 0.2576, 0.2415, 0.2500, 0.2676, 0.2932},
+		{0.9977, 0.9840, 0.9683, 0.9376, 0.8770, 0.8166, 0.7551, 0.6902, 0.6166, 0.5224, 0.4266, 0.3334, 0.2673, 0.2506, 0.2582, 0.2765, 0.3025},
+		{0.9977, 0.9863, 0.9705, 0.9441, 0.8872, 0.8318, 0.7762, 0.7194, 0.6607, 0.5728, 0.4710, 0.3690, 0.2958, 0.2773, 0.2844, 0.3035, 0.3308},
+		{0.9977, 0.9886, 0.9750, 0.9506, 0.9016, 0.8531, 0.8072, 0.7586, 0.7112, 0.6412, 0.5408, 0.4285, 0.3451, 0.3228, 0.3296, 0.3493, 0.3785},
+		{0.9977, 0.9886, 0.9795, 0.9572, 0.9141, 0.8730, 0.8318, 0.7907, 0.7499, 0.6918, 0.6026, 0.4875, 0.3954, 0.3690, 0.3750, 0.3956, 0.4265},
+		{0.9977, 0.9908, 0.9817, 0.9616, 0.9247, 0.8892, 0.8531, 0.8166, 0.7834, 0.7328, 0.6546, 0.5420, 0.4446, 0.4150, 0.4198, 0.4418, 0.4742},
+		{0.9977, 0.9931, 0.9863, 0.9705, 0.9419, 0.9141, 0.8872, 0.8590, 0.8318, 0.7943, 0.7345, 0.6383, 0.5383, 0.5058, 0.5093, 0.5324, 0.5671},
+		{0.9977, 0.9931, 0.9886, 0.9772, 0.9550, 0.9333, 0.9120, 0.8892, 0.8690, 0.8395, 0.7925, 0.7145, 0.6237, 0.5902, 0.5943, 0.6182, 0.6549},
+		{1.0000, 0.9954, 0.9908, 0.9817, 0.9638, 0.9462, 0.9290, 0.9141, 0.8974, 0.8730, 0.8375, 0.7745, 0.6966, 0.6668, 0.6714, 0.6975, 0.7357},
+		{1.0000, 0.9954, 0.9931, 0.9863, 0.9727, 0.9572, 0.9441, 0.9311, 0.9183, 0.8995, 0.8710, 0.8222, 0.7586, 0.7328, 0.7430, 0.7693, 0.8089},
+		{1.0000, 0.9977, 0.9954, 0.9886, 0.9772, 0.9661, 0.9550, 0.9462, 0.9354, 0.9204, 0.8995, 0.8610, 0.8091, 0.7907, 0.8054, 0.8333, 0.8742},
+		{1.0000, 0.9977, 0.9954, 0.9908, 0.9817, 0.9727, 0.9661, 0.9572, 0.9484, 0.9376, 0.9204, 0.8913, 0.8531, 0.8414, 0.8590, 0.8895, 0.9318},
+		{1.0000, 0.9977, 0.9954, 0.9931, 0.9863, 0.9795, 0.9727, 0.9661, 0.9594, 0.9506, 0.9376, 0.9162, 0.8872, 0.8831, 0.9057, 0.9385, 0.9821},
+		{1.0000, 0.9977, 0.9977, 0.9954, 0.9886, 0.9840, 0.9795, 0.9727, 0.9683, 0.9616, 0.9528, 0.9354, 0.9183, 0.9183, 0.9462, 0.9808, 1.0256},
+		{1.0000, 1.0000, 0.9977, 0.9977, 0.9931, 0.9908, 0.9886, 0.9840, 0.9817, 0.9795, 0.9727, 0.9661, 0.9616, 0.9727, 1.0093, 1.0482, 1.0950},
+		{1.0000, 1.0000, 1.0000, 0.9977, 0.9977, 0.9954, 0.9931, 0.9931, 0.9908, 0.9908, 0.9886, 0.9863, 0.9931, 1.0116, 1.0568, 1.0970, 1.1454},
+		{1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 0.9977, 0.9977, 0.9977, 0.9977, 0.9977, 0.9977, 1.0023, 1.0162, 1.0399, 1.0889, 1.1319, 1.1813},
+		{1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0023, 1.0046, 1.0069, 1.0116, 1.0328, 1.0593, 1.1117, 1.1565, 1.2063},
+		{1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0046, 1.0046, 1.0069, 1.0069, 1.0116, 1.0209, 1.0423, 1.0740, 1.1298, 1.1736, 1.2234},
+		{1.0000, 1.0000, 1.0000, 1.0023, 1.0023, 1.0046, 1.0069, 1.0093, 1.0116, 1.0139, 1.0186, 1.0304, 1.0593, 1.0914, 1.1508, 1.1954, 1.2438},
+		{1.0000, 1.0000, 1.0000, 1.0023, 1.0046, 1.0069, 1.0093, 1.0116, 1.0139, 1.0162, 1.0233, 1.0375, 1.0666, 1.0990, 1.1588, 1.2004, 1.2465},
 	},
 }
 
-// PHI1Table contains combined high pressure and low pressure data for the departure fugacity coefficient for the lee/Kesler correlation (log(phi)^1)
+// PHI1Table contains combined high pressure and low pressure data for the departure fugacity coefficient for the lee/Kesler correlation phi^1
 var PHI1Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
-		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
-		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
-		{0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0001, 0.0001, 0.0001, 0.0001},
-		{0.0014, 0.0014, 0.0014, 0.0014, 0.0014, 0.0014, 0.0013, 0.0013, 0.0013, 0.0013, 0.0012, 0.0011, 0.0009, 0.0008, 0.0006},
-		{0.9705, 0.0069, 0.0068, 0.0068, 0.0066, 0.0065, 0.0064, 0.0063, 0.0062, 0.0061, 0.0058, 0.0053, 0.0045, 0.0039, 0.0031},
-		{0.9795, 0.0227, 0.0226, 0.0223, 0.0220, 0.0216, 0.0213, 0.0210, 0.0207, 0.0202, 0.0194, 0.0179, 0.0154, 0.0133, 0.0108},
-		{0.9863, 0.9311, 0.0572, 0.0568, 0.0559, 0.0551, 0.0543, 0.0535, 0.0527, 0.0516, 0.0497, 0.0461, 0.0401, 0.0350, 0.0289},
-		{0.9908, 0.9528, 0.9036, 0.1182, 0.1163, 0.1147, 0.1131, 0.1116, 0.1102, 0.1079, 0.1040, 0.0970, 0.0851, 0.0752, 0.0629},
-		{0.9931, 0.9683, 0.9332, 0.2112, 0.2078, 0.2050, 0.2022, 0.1994, 0.1972, 0.1932, 0.1871, 0.1754, 0.1552, 0.1387, 0.1178},
-		{0.9954, 0.9772, 0.9550, 0.9057, 0.3302, 0.3257, 0.3212, 0.3168, 0.3133, 0.3076, 0.2978, 0.2812, 0.2512, 0.2265, 0.1954},
-		{0.9977, 0.9863, 0.9705, 0.9375, 0.4774, 0.4708, 0.4654, 0.4590, 0.4539, 0.4457, 0.4325, 0.4093, 0.3698, 0.3365, 0.2951},
-		{0.9977, 0.9908, 0.9795, 0.9594, 0.9141, 0.6323, 0.6250, 0.6165, 0.6095, 0.5998, 0.5834, 0.5546, 0.5058, 0.4645, 0.4130},
-		{0.9977, 0.9931, 0.9840, 0.9705, 0.9354, 0.8953, 0.7227, 0.7144, 0.7063, 0.6950, 0.6761, 0.6457, 0.5916, 0.5470, 0.4898},
-		{0.9977, 0.9931, 0.9885, 0.9750, 0.9484, 0.9183, 0.7888, 0.7797, 0.7691, 0.7568, 0.7379, 0.7063, 0.6501, 0.6026, 0.5432},
-		{1.0000, 0.9954, 0.9908, 0.9795, 0.9594, 0.9354, 0.9078, 0.8413, 0.8318, 0.8185, 0.7998, 0.7656, 0.7096, 0.6607, 0.5984},
-		{1.0000, 0.9954, 0.9908, 0.9817, 0.9638, 0.9440, 0.9225, 0.8729, 0.8630, 0.8492, 0.8298, 0.7962, 0.7379, 0.6887, 0.6266},
-		{1.0000, 0.9954, 0.9931, 0.9840, 0.9683, 0.9528, 0.9332, 0.9036, 0.8913, 0.8790, 0.8590, 0.8241, 0.7674, 0.7178, 0.6546},
-		{1.0000, 0.9977, 0.9931, 0.9863, 0.9727, 0.9594, 0.9440, 0.9311, 0.9204, 0.9078, 0.8872, 0.8531, 0.7962, 0.7464, 0.6823},
-		{1.0000, 0.9977, 0.9931, 0.9885, 0.9772, 0.9638, 0.9528, 0.9462, 0.9462, 0.9333, 0.9162, 0.8831, 0.8241, 0.7745, 0.7096},
-		{1.0000, 0.9977, 0.9954, 0.9908, 0.9795, 0.9705, 0.9616, 0.9572, 0.9661, 0.9594, 0.9419, 0.9099, 0.8531, 0.8035, 0.7379},
-		{1.0000, 0.9977, 0.9977, 0.9954, 0.9885, 0.9863, 0.9840, 0.9840, 0.9954, 1.0186, 1.0162, 0.9886, 0.9354, 0.8872, 0.8222},
-		{1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0046, 1.0093, 1.0163, 1.0280, 1.0593, 1.0990, 1.1015, 1.0617, 1.0186, 0.9572},
-		{1.0000, 1.0000, 1.0023, 1.0046, 1.0116, 1.0186, 1.0257, 1.0375, 1.0520, 1.0814, 1.1376, 1.1858, 1.1722, 1.1403, 1.0864},
-		{1.0000, 1.0023, 1.0046, 1.0069, 1.0163, 1.0280, 1.0399, 1.0544, 1.0691, 1.0990, 1.1588, 1.2388, 1.2647, 1.2474, 1.2050},
-		{1.0000, 1.0023, 1.0069, 1.0116, 1.0257, 1.0399, 1.0544, 1.0716, 1.0914, 1.1194, 1.1776, 1.2853, 1.3868, 1.4125, 1.4061},
-		{1.0000, 1.0046, 1.0069, 1.0139, 1.0304, 1.0471, 1.0642, 1.0815, 1.0990, 1.1298, 1.1858, 1.2942, 1.4488, 1.5171, 1.5524},
-		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0865, 1.1041, 1.1350, 1.1858, 1.2942, 1.4689, 1.5740, 1.6520},
-		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0691, 1.0865, 1.1041, 1.1350, 1.1858, 1.2883, 1.4689, 1.5996, 1.7140},
-		{1.0000, 1.0046, 1.0093, 1.0163, 1.0328, 1.0496, 1.0691, 1.0865, 1.1041, 1.1324, 1.1803, 1.2794, 1.4622, 1.6033, 1.7458},
-		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0840, 1.1015, 1.1298, 1.1749, 1.2706, 1.4488, 1.5959, 1.7620},
-		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0815, 1.0990, 1.1272, 1.1695, 1.2618, 1.4355, 1.5849, 1.7620},
-		{1.0000, 1.0046, 1.0069, 1.0163, 1.0304, 1.0471, 1.0642, 1.0815, 1.0965, 1.1220, 1.1641, 1.2503, 1.4191, 1.5704, 1.7539},
-		{1.0000, 1.0046, 1.0069, 1.0139, 1.0304, 1.0447, 1.0593, 1.0765, 1.0914, 1.1143, 1.1535, 1.2331, 1.3900, 1.5346, 1.7219},
-		{1.0000, 1.0046, 1.0069, 1.0139, 1.0280, 1.0423, 1.0568, 1.0716, 1.0864, 1.1066, 1.1429, 1.2190, 1.3614, 1.4997, 1.6866},
-		{1.0000, 1.0023, 1.0069, 1.0139, 1.0257, 1.0399, 1.0544, 1.0666, 1.0814, 1.1015, 1.1350, 1.2023, 1.3397, 1.4689, 1.6482},
-		{1.0000, 1.0023, 1.0069, 1.0116, 1.0257, 1.0375, 1.0496, 1.0642, 1.0765, 1.0940, 1.1272, 1.1912, 1.3183, 1.4388, 1.6144},
-		{1.0000, 1.0023, 1.0069, 1.0116, 1.0233, 1.0352, 1.0471, 1.0593, 1.0715, 1.0889, 1.1194, 1.1803, 1.3002, 1.4158, 1.5813},
-		{1.0000, 1.0023, 1.0046, 1.0023, 1.0209, 1.0304, 1.0423, 1.0520, 1.0617, 1.0789, 1.1041, 1.1561, 1.2618, 1.3614, 1.5101},
-		{1.0000, 1.0023, 1.0046, 1.0093, 1.0186, 1.0280, 1.0375, 1.0471, 1.0544, 1.0691, 1.0914, 1.1403, 1.2303, 1.3213, 1.4555},
+		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
+		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
+		{0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000, 0.0000},
+		{0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0002, 0.0001, 0.0001, 0.0001, 0.0001, 0.0001, 0.0001},
+		{0.0014, 0.0014, 0.0014, 0.0014, 0.0014, 0.0014, 0.0013, 0.0013, 0.0013, 0.0013, 0.0012, 0.0011, 0.0009, 0.0008, 0.0006, 0.0005, 0.0005},
+		{0.9705, 0.0069, 0.0068, 0.0068, 0.0066, 0.0065, 0.0064, 0.0063, 0.0062, 0.0061, 0.0058, 0.0053, 0.0045, 0.0039, 0.0031, 0.0027, 0.0023},
+		{0.9795, 0.0227, 0.0226, 0.0223, 0.0220, 0.0216, 0.0213, 0.0210, 0.0207, 0.0202, 0.0194, 0.0179, 0.0154, 0.0133, 0.0108, 0.0094, 0.0082},
+		{0.9863, 0.9311, 0.0572, 0.0568, 0.0559, 0.0551, 0.0543, 0.0535, 0.0527, 0.0516, 0.0497, 0.0461, 0.0401, 0.0350, 0.0289, 0.0254, 0.0225},
+		{0.9908, 0.9528, 0.9036, 0.1182, 0.1163, 0.1147, 0.1131, 0.1116, 0.1102, 0.1079, 0.1040, 0.0970, 0.0851, 0.0752, 0.0629, 0.0562, 0.0503},
+		{0.9931, 0.9683, 0.9332, 0.2112, 0.2078, 0.2050, 0.2022, 0.1994, 0.1972, 0.1932, 0.1871, 0.1754, 0.1552, 0.1387, 0.1178, 0.1063, 0.0962},
+		{0.9954, 0.9772, 0.9550, 0.9057, 0.3302, 0.3257, 0.3212, 0.3168, 0.3133, 0.3076, 0.2978, 0.2812, 0.2512, 0.2265, 0.1954, 0.1782, 0.1628},
+		{0.9977, 0.9863, 0.9705, 0.9375, 0.4774, 0.4708, 0.4654, 0.4590, 0.4539, 0.4457, 0.4325, 0.4093, 0.3698, 0.3365, 0.2951, 0.2714, 0.2504},
+		{0.9977, 0.9908, 0.9795, 0.9594, 0.9141, 0.6323, 0.6250, 0.6165, 0.6095, 0.5998, 0.5834, 0.5546, 0.5058, 0.4645, 0.4130, 0.3833, 0.3568},
+		{0.9977, 0.9931, 0.9840, 0.9705, 0.9354, 0.8953, 0.7227, 0.7144, 0.7063, 0.6950, 0.6761, 0.6457, 0.5916, 0.5470, 0.4898, 0.4575, 0.4281},
+		{0.9977, 0.9931, 0.9885, 0.9750, 0.9484, 0.9183, 0.7888, 0.7797, 0.7691, 0.7568, 0.7379, 0.7063, 0.6501, 0.6026, 0.5432, 0.5093, 0.4782},
+		{1.0000, 0.9954, 0.9908, 0.9795, 0.9594, 0.9354, 0.9078, 0.8413, 0.8318, 0.8185, 0.7998, 0.7656, 0.7096, 0.6607, 0.5984, 0.5625, 0.5298},
+		{1.0000, 0.9954, 0.9908, 0.9817, 0.9638, 0.9440, 0.9225, 0.8729, 0.8630, 0.8492, 0.8298, 0.7962, 0.7379, 0.6887, 0.6266, 0.5895, 0.5562},
+		{1.0000, 0.9954, 0.9931, 0.9840, 0.9683, 0.9528, 0.9332, 0.9036, 0.8913, 0.8790, 0.8590, 0.8241, 0.7674, 0.7178, 0.6546, 0.6167, 0.5828},
+		{1.0000, 0.9977, 0.9931, 0.9863, 0.9727, 0.9594, 0.9440, 0.9311, 0.9204, 0.9078, 0.8872, 0.8531, 0.7962, 0.7464, 0.6823, 0.6441, 0.6096},
+		{1.0000, 0.9977, 0.9931, 0.9885, 0.9772, 0.9638, 0.9528, 0.9462, 0.9462, 0.9333, 0.9162, 0.8831, 0.8241, 0.7745, 0.7096, 0.6716, 0.6367},
+		{1.0000, 0.9977, 0.9954, 0.9908, 0.9795, 0.9705, 0.9616, 0.9572, 0.9661, 0.9594, 0.9419, 0.9099, 0.8531, 0.8035, 0.7379, 0.6993, 0.6640},
+		{1.0000, 0.9977, 0.9977, 0.9954, 0.9885, 0.9863, 0.9840, 0.9840, 0.9954, 1.0186, 1.0162, 0.9886, 0.9354, 0.8872, 0.8222, 0.7824, 0.7463},
+		{1.0000, 1.0000, 1.0000, 1.0000, 1.0023, 1.0046, 1.0093, 1.0163, 1.0280, 1.0593, 1.0990, 1.1015, 1.0617, 1.0186, 0.9572, 0.9195, 0.8835},
+		{1.0000, 1.0000, 1.0023, 1.0046, 1.0116, 1.0186, 1.0257, 1.0375, 1.0520, 1.0814, 1.1376, 1.1858, 1.1722, 1.1403, 1.0864, 1.0513, 1.0174},
+		{1.0000, 1.0023, 1.0046, 1.0069, 1.0163, 1.0280, 1.0399, 1.0544, 1.0691, 1.0990, 1.1588, 1.2388, 1.2647, 1.2474, 1.2050, 1.1749, 1.1448},
+		{1.0000, 1.0023, 1.0069, 1.0116, 1.0257, 1.0399, 1.0544, 1.0716, 1.0914, 1.1194, 1.1776, 1.2853, 1.3868, 1.4125, 1.4061, 1.3900, 1.3720},
+		{1.0000, 1.0046, 1.0069, 1.0139, 1.0304, 1.0471, 1.0642, 1.0815, 1.0990, 1.1298, 1.1858, 1.2942, 1.4488, 1.5171, 1.5524, 1.5576, 1.5560},
+		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0865, 1.1041, 1.1350, 1.1858, 1.2942, 1.4689, 1.5740, 1.6520, 1.6796, 1.6962},
+		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0691, 1.0865, 1.1041, 1.1350, 1.1858, 1.2883, 1.4689, 1.5996, 1.7140, 1.7623, 1.7971},
+		{1.0000, 1.0046, 1.0093, 1.0163, 1.0328, 1.0496, 1.0691, 1.0865, 1.1041, 1.1324, 1.1803, 1.2794, 1.4622, 1.6033, 1.7458, 1.8139, 1.8654},
+		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0840, 1.1015, 1.1298, 1.1749, 1.2706, 1.4488, 1.5959, 1.7620, 1.8422, 1.9080},
+		{1.0000, 1.0046, 1.0069, 1.0163, 1.0328, 1.0496, 1.0666, 1.0815, 1.0990, 1.1272, 1.1695, 1.2618, 1.4355, 1.5849, 1.7620, 1.8538, 1.9311},
+		{1.0000, 1.0046, 1.0069, 1.0163, 1.0304, 1.0471, 1.0642, 1.0815, 1.0965, 1.1220, 1.1641, 1.2503, 1.4191, 1.5704, 1.7539, 1.8537, 1.9400},
+		{1.0000, 1.0046, 1.0069, 1.0139, 1.0304, 1.0447, 1.0593, 1.0765, 1.0914, 1.1143, 1.1535, 1.2331, 1.3900, 1.5346, 1.7219, 1.8328, 1.9305},
+		{1.0000, 1.0046, 1.0069, 1.0139, 1.0280, 1.0423, 1.0568, 1.0716, 1.0864, 1.1066, 1.1429, 1.2190, 1.3614, 1.4997, 1.6866, 1.7986, 1.9013},
+		{1.0000, 1.0023, 1.0069, 1.0139, 1.0257, 1.0399, 1.0544, 1.0666, 1.0814, 1.1015, 1.1350, 1.2023, 1.3397, 1.4689, 1.6482, 1.7602, 1.8640},
+		{1.0000, 1.0023, 1.0069, 1.0116, 1.0257, 1.0375, 1.0496, 1.0642, 1.0765, 1.0940, 1.1272, 1.1912, 1.3183, 1.4388, 1.6144, 1.7219, 1.8246},
+		{1.0000, 1.0023, 1.0069, 1.0116, 1.0233, 1.0352, 1.0471, 1.0593, 1.0715, 1.0889, 1.1194, 1.1803, 1.3002, 1.4158, 1.5813, 1.6854, 1.7857},
+		{1.0000, 1.0023, 1.0046, 1.0023, 1.0209, 1.0304, 1.0423, 1.0520, 1.0617, 1.0789, 1.1041, 1.1561, 1.2618, 1.3614, 1.5101, 1.6053, 1.6978},
+		{1.0000, 1.0023, 1.0046, 1.0093, 1.0186, 1.0280, 1.0375, 1.0471, 1.0544, 1.0691, 1.0914, 1.1403, 1.2303, 1.3213, 1.4555, 1.5405, 1.6249},
 	},
 }
 
 // Z0Table contains combined high pressure and low pressure data for the base compressibility factor for the lee/Kesler correlation (Z^0)
 var Z0Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{0.0029, 0.0145, 0.0290, 0.0579, 0.1158, 0.1737, 0.2315, 0.2892, 0.3479, 0.4335, 0.5775, 0.8648, 1.4366, 2.0048, 2.8507},
-		{0.0026, 0.0130, 0.0261, 0.0522, 0.1043, 0.1564, 0.2084, 0.2604, 0.3123, 0.3901, 0.5195, 0.7775, 1.2902, 1.7987, 2.5539},
-		{0.0024, 0.0119, 0.0239, 0.0477, 0.0953, 0.1429, 0.1904, 0.2379, 0.2853, 0.3563, 0.4744, 0.7095, 1.1758, 1.6373, 2.3211},
-		{0.0022, 0.0110, 0.0221, 0.0442, 0.0882, 0.1322, 0.1762, 0.2200, 0.2638, 0.3294, 0.4384, 0.6551, 1.0841, 1.5077, 2.1338},
-		{0.0021, 0.0103, 0.0207, 0.0413, 0.0825, 0.1236, 0.1647, 0.2056, 0.2465, 0.3077, 0.4092, 0.6110, 1.0094, 1.4017, 1.9801},
-		{0.9804, 0.0098, 0.0195, 0.0390, 0.0778, 0.1166, 0.1553, 0.1939, 0.2323, 0.2899, 0.3853, 0.5747, 0.9475, 1.3137, 1.8520},
-		{0.9849, 0.0093, 0.0186, 0.0371, 0.0741, 0.1109, 0.1476, 0.1842, 0.2207, 0.2753, 0.3657, 0.5446, 0.8959, 1.2398, 1.7440},
-		{0.9881, 0.9377, 0.0178, 0.0356, 0.0710, 0.1063, 0.1415, 0.1765, 0.2113, 0.2634, 0.3495, 0.5197, 0.8526, 1.1773, 1.6519},
-		{0.9904, 0.9504, 0.8958, 0.0344, 0.0687, 0.1027, 0.1366, 0.1703, 0.2038, 0.2538, 0.3364, 0.4991, 0.8161, 1.1341, 1.5729},
-		{0.9922, 0.9598, 0.9165, 0.0336, 0.0670, 0.1001, 0.1330, 0.1656, 0.1981, 0.2464, 0.3260, 0.4823, 0.7854, 1.0787, 1.5047},
-		{0.9935, 0.9669, 0.9319, 0.8539, 0.0661, 0.0985, 0.1307, 0.1626, 0.1942, 0.2411, 0.3182, 0.4690, 0.7598, 1.0400, 1.4456},
-		{0.9946, 0.9725, 0.9436, 0.8810, 0.0661, 0.0983, 0.1301, 0.1614, 0.1924, 0.2382, 0.3132, 0.4591, 0.7388, 1.0071, 1.3943},
-		{0.9954, 0.9768, 0.9528, 0.9015, 0.7800, 0.1006, 0.1321, 0.1630, 0.1935, 0.2383, 0.3114, 0.4527, 0.7220, 0.9793, 1.3496},
-		{0.9959, 0.9790, 0.9573, 0.9115, 0.8059, 0.6635, 0.1359, 0.1664, 0.1963, 0.2405, 0.3122, 0.4507, 0.7138, 0.9648, 1.3257},
-		{0.9961, 0.9803, 0.9600, 0.9174, 0.8206, 0.6967, 0.1410, 0.1705, 0.1998, 0.2432, 0.3138, 0.4501, 0.7092, 0.9561, 1.3108},
-		{0.9963, 0.9815, 0.9625, 0.9227, 0.8338, 0.7240, 0.5580, 0.1779, 0.2055, 0.2474, 0.3164, 0.4504, 0.7052, 0.9480, 1.2968},
-		{0.9965, 0.9821, 0.9637, 0.9253, 0.8398, 0.7360, 0.5887, 0.1844, 0.2097, 0.2503, 0.3182, 0.4508, 0.7035, 0.9442, 1.2901},
-		{0.9966, 0.9826, 0.9648, 0.9277, 0.8455, 0.7471, 0.6138, 0.1959, 0.2154, 0.2538, 0.3204, 0.4514, 0.7018, 0.9406, 1.2835},
-		{0.9967, 0.9832, 0.9659, 0.9300, 0.8509, 0.7574, 0.6355, 0.2901, 0.2237, 0.2583, 0.3229, 0.4522, 0.7004, 0.9372, 1.2772},
-		{0.9968, 0.9837, 0.9669, 0.9322, 0.8561, 0.7671, 0.6542, 0.4648, 0.2370, 0.2640, 0.3260, 0.4533, 0.6991, 0.9339, 1.2710},
-		{0.9969, 0.9842, 0.9679, 0.9343, 0.8610, 0.7761, 0.6710, 0.5146, 0.2629, 0.2715, 0.3297, 0.4547, 0.6980, 0.9307, 1.2650},
-		{0.9971, 0.9855, 0.9707, 0.9401, 0.8743, 0.8002, 0.7130, 0.6026, 0.4437, 0.3131, 0.3452, 0.4604, 0.6956, 0.9222, 1.2481},
-		{0.9975, 0.9874, 0.9747, 0.9485, 0.8930, 0.8323, 0.7649, 0.6880, 0.5984, 0.4580, 0.3953, 0.4770, 0.6950, 0.9110, 1.2232},
-		{0.9978, 0.9891, 0.9780, 0.9554, 0.9081, 0.8576, 0.8032, 0.7443, 0.6803, 0.5798, 0.4760, 0.5042, 0.6987, 0.9033, 1.2021},
-		{0.9981, 0.9904, 0.9808, 0.9611, 0.9205, 0.8779, 0.8330, 0.7858, 0.7363, 0.6605, 0.5605, 0.5425, 0.7069, 0.8990, 1.1844},
-		{0.9985, 0.9926, 0.9852, 0.9702, 0.9396, 0.9083, 0.8764, 0.8438, 0.8111, 0.7624, 0.6908, 0.6344, 0.7358, 0.8998, 1.1580},
-		{0.9988, 0.9942, 0.9884, 0.9768, 0.9534, 0.9298, 0.9062, 0.8827, 0.8595, 0.8256, 0.7753, 0.7202, 0.7761, 0.9112, 1.1419},
-		{0.9991, 0.9954, 0.9909, 0.9818, 0.9636, 0.9456, 0.9278, 0.9103, 0.8933, 0.8689, 0.8328, 0.7887, 0.8200, 0.9297, 1.1339},
-		{0.9993, 0.9964, 0.9928, 0.9856, 0.9714, 0.9575, 0.9439, 0.9308, 0.9180, 0.9000, 0.8738, 0.8410, 0.8617, 0.9518, 1.1320},
-		{0.9994, 0.9971, 0.9943, 0.9886, 0.9775, 0.9667, 0.9563, 0.9463, 0.9367, 0.9234, 0.9043, 0.8809, 0.8984, 0.9745, 1.1343},
-		{0.9995, 0.9977, 0.9955, 0.9910, 0.9823, 0.9739, 0.9659, 0.9583, 0.9511, 0.9413, 0.9275, 0.9118, 0.9297, 0.9961, 1.1391},
-		{0.9996, 0.9982, 0.9964, 0.9929, 0.9861, 0.9796, 0.9735, 0.9678, 0.9624, 0.9552, 0.9456, 0.9359, 0.9557, 1.0157, 1.1452},
-		{0.9997, 0.9986, 0.9972, 0.9944, 0.9892, 0.9842, 0.9796, 0.9754, 0.9715, 0.9664, 0.9599, 0.9550, 0.9772, 1.0328, 1.1516},
-		{0.9998, 0.9992, 0.9983, 0.9967, 0.9937, 0.9910, 0.9886, 0.9865, 0.9847, 0.9826, 0.9806, 0.9827, 1.0094, 1.0600, 1.1635},
-		{0.9999, 0.9996, 0.9991, 0.9983, 0.9969, 0.9957, 0.9948, 0.9941, 0.9936, 0.9935, 0.9945, 1.0011, 1.0313, 1.0793, 1.1728},
-		{1.0000, 0.9998, 0.9997, 0.9994, 0.9991, 0.9990, 0.9990, 0.9993, 0.9998, 1.0010, 1.0040, 1.0137, 1.0463, 1.0926, 1.1792},
-		{1.0000, 1.0000, 1.0001, 1.0002, 1.0007, 1.0013, 1.0021, 1.0031, 1.0042, 1.0063, 1.0106, 1.0223, 1.0565, 1.1016, 1.1830},
-		{1.0000, 1.0002, 1.0004, 1.0008, 1.0018, 1.0030, 1.0043, 1.0057, 1.0074, 1.0101, 1.0153, 1.0284, 1.0635, 1.1075, 1.1848},
-		{1.0001, 1.0004, 1.0008, 1.0017, 1.0035, 1.0055, 1.0075, 1.0097, 1.0120, 1.0156, 1.0221, 1.0368, 1.0723, 1.1138, 1.1834},
-		{1.0001, 1.0005, 1.0010, 1.0021, 1.0043, 1.0066, 1.0090, 1.0115, 1.0140, 1.0179, 1.0249, 1.0401, 1.0747, 1.1136, 1.1773},
+		{0.0029, 0.0145, 0.0290, 0.0579, 0.1158, 0.1737, 0.2315, 0.2892, 0.3479, 0.4335, 0.5775, 0.8648, 1.4366, 2.0048, 2.8507, 3.4107, 3.9675},
+		{0.0026, 0.0130, 0.0261, 0.0522, 0.1043, 0.1564, 0.2084, 0.2604, 0.3123, 0.3901, 0.5195, 0.7775, 1.2902, 1.7987, 2.5539, 3.0529, 3.5483},
+		{0.0024, 0.0119, 0.0239, 0.0477, 0.0953, 0.1429, 0.1904, 0.2379, 0.2853, 0.3563, 0.4744, 0.7095, 1.1758, 1.6373, 2.3211, 2.7720, 3.2190},
+		{0.0022, 0.0110, 0.0221, 0.0442, 0.0882, 0.1322, 0.1762, 0.2200, 0.2638, 0.3294, 0.4384, 0.6551, 1.0841, 1.5077, 2.1338, 2.5457, 2.9535},
+		{0.0021, 0.0103, 0.0207, 0.0413, 0.0825, 0.1236, 0.1647, 0.2056, 0.2465, 0.3077, 0.4092, 0.6110, 1.0094, 1.4017, 1.9801, 2.3598, 2.7352},
+		{0.9804, 0.0098, 0.0195, 0.0390, 0.0778, 0.1166, 0.1553, 0.1939, 0.2323, 0.2899, 0.3853, 0.5747, 0.9475, 1.3137, 1.8520, 2.2046, 2.5528},
+		{0.9849, 0.0093, 0.0186, 0.0371, 0.0741, 0.1109, 0.1476, 0.1842, 0.2207, 0.2753, 0.3657, 0.5446, 0.8959, 1.2398, 1.7440, 2.0734, 2.3983},
+		{0.9881, 0.9377, 0.0178, 0.0356, 0.0710, 0.1063, 0.1415, 0.1765, 0.2113, 0.2634, 0.3495, 0.5197, 0.8526, 1.1773, 1.6519, 1.9614, 2.2662},
+		{0.9904, 0.9504, 0.8958, 0.0344, 0.0687, 0.1027, 0.1366, 0.1703, 0.2038, 0.2538, 0.3364, 0.4991, 0.8161, 1.1341, 1.5729, 1.8650, 2.1521},
+		{0.9922, 0.9598, 0.9165, 0.0336, 0.0670, 0.1001, 0.1330, 0.1656, 0.1981, 0.2464, 0.3260, 0.4823, 0.7854, 1.0787, 1.5047, 1.7813, 2.0530},
+		{0.9935, 0.9669, 0.9319, 0.8539, 0.0661, 0.0985, 0.1307, 0.1626, 0.1942, 0.2411, 0.3182, 0.4690, 0.7598, 1.0400, 1.4456, 1.7085, 1.9663},
+		{0.9946, 0.9725, 0.9436, 0.8810, 0.0661, 0.0983, 0.1301, 0.1614, 0.1924, 0.2382, 0.3132, 0.4591, 0.7388, 1.0071, 1.3943, 1.6447, 1.8900},
+		{0.9954, 0.9768, 0.9528, 0.9015, 0.7800, 0.1006, 0.1321, 0.1630, 0.1935, 0.2383, 0.3114, 0.4527, 0.7220, 0.9793, 1.3496, 1.5887, 1.8228},
+		{0.9959, 0.9790, 0.9573, 0.9115, 0.8059, 0.6635, 0.1359, 0.1664, 0.1963, 0.2405, 0.3122, 0.4507, 0.7138, 0.9648, 1.3257, 1.5585, 1.7862},
+		{0.9961, 0.9803, 0.9600, 0.9174, 0.8206, 0.6967, 0.1410, 0.1705, 0.1998, 0.2432, 0.3138, 0.4501, 0.7092, 0.9561, 1.3108, 1.5395, 1.7633},
+		{0.9963, 0.9815, 0.9625, 0.9227, 0.8338, 0.7240, 0.5580, 0.1779, 0.2055, 0.2474, 0.3164, 0.4504, 0.7052, 0.9480, 1.2968, 1.5216, 1.7414},
+		{0.9965, 0.9821, 0.9637, 0.9253, 0.8398, 0.7360, 0.5887, 0.1844, 0.2097, 0.2503, 0.3182, 0.4508, 0.7035, 0.9442, 1.2901, 1.5129, 1.7308},
+		{0.9966, 0.9826, 0.9648, 0.9277, 0.8455, 0.7471, 0.6138, 0.1959, 0.2154, 0.2538, 0.3204, 0.4514, 0.7018, 0.9406, 1.2835, 1.5045, 1.7205},
+		{0.9967, 0.9832, 0.9659, 0.9300, 0.8509, 0.7574, 0.6355, 0.2901, 0.2237, 0.2583, 0.3229, 0.4522, 0.7004, 0.9372, 1.2772, 1.4963, 1.7105},
+		{0.9968, 0.9837, 0.9669, 0.9322, 0.8561, 0.7671, 0.6542, 0.4648, 0.2370, 0.2640, 0.3260, 0.4533, 0.6991, 0.9339, 1.2710, 1.4882, 1.7006},
+		{0.9969, 0.9842, 0.9679, 0.9343, 0.8610, 0.7761, 0.6710, 0.5146, 0.2629, 0.2715, 0.3297, 0.4547, 0.6980, 0.9307, 1.2650, 1.4804, 1.6910},
+		{0.9971, 0.9855, 0.9707, 0.9401, 0.8743, 0.8002, 0.7130, 0.6026, 0.4437, 0.3131, 0.3452, 0.4604, 0.6956, 0.9222, 1.2481, 1.4582, 1.6635},
+		{0.9975, 0.9874, 0.9747, 0.9485, 0.8930, 0.8323, 0.7649, 0.6880, 0.5984, 0.4580, 0.3953, 0.4770, 0.6950, 0.9110, 1.2232, 1.4248, 1.6218},
+		{0.9978, 0.9891, 0.9780, 0.9554, 0.9081, 0.8576, 0.8032, 0.7443, 0.6803, 0.5798, 0.4760, 0.5042, 0.6987, 0.9033, 1.2021, 1.3955, 1.5847},
+		{0.9981, 0.9904, 0.9808, 0.9611, 0.9205, 0.8779, 0.8330, 0.7858, 0.7363, 0.6605, 0.5605, 0.5425, 0.7069, 0.8990, 1.1844, 1.3699, 1.5517},
+		{0.9985, 0.9926, 0.9852, 0.9702, 0.9396, 0.9083, 0.8764, 0.8438, 0.8111, 0.7624, 0.6908, 0.6344, 0.7358, 0.8998, 1.1580, 1.3285, 1.4964},
+		{0.9988, 0.9942, 0.9884, 0.9768, 0.9534, 0.9298, 0.9062, 0.8827, 0.8595, 0.8256, 0.7753, 0.7202, 0.7761, 0.9112, 1.1419, 1.2980, 1.4531},
+		{0.9991, 0.9954, 0.9909, 0.9818, 0.9636, 0.9456, 0.9278, 0.9103, 0.8933, 0.8689, 0.8328, 0.7887, 0.8200, 0.9297, 1.1339, 1.2764, 1.4194},
+		{0.9993, 0.9964, 0.9928, 0.9856, 0.9714, 0.9575, 0.9439, 0.9308, 0.9180, 0.9000, 0.8738, 0.8410, 0.8617, 0.9518, 1.1320, 1.2618, 1.3936},
+		{0.9994, 0.9971, 0.9943, 0.9886, 0.9775, 0.9667, 0.9563, 0.9463, 0.9367, 0.9234, 0.9043, 0.8809, 0.8984, 0.9745, 1.1343, 1.2525, 1.3739},
+		{0.9995, 0.9977, 0.9955, 0.9910, 0.9823, 0.9739, 0.9659, 0.9583, 0.9511, 0.9413, 0.9275, 0.9118, 0.9297, 0.9961, 1.1391, 1.2470, 1.3591},
+		{0.9996, 0.9982, 0.9964, 0.9929, 0.9861, 0.9796, 0.9735, 0.9678, 0.9624, 0.9552, 0.9456, 0.9359, 0.9557, 1.0157, 1.1452, 1.2442, 1.3478},
+		{0.9997, 0.9986, 0.9972, 0.9944, 0.9892, 0.9842, 0.9796, 0.9754, 0.9715, 0.9664, 0.9599, 0.9550, 0.9772, 1.0328, 1.1516, 1.2430, 1.3393},
+		{0.9998, 0.9992, 0.9983, 0.9967, 0.9937, 0.9910, 0.9886, 0.9865, 0.9847, 0.9826, 0.9806, 0.9827, 1.0094, 1.0600, 1.1635, 1.2430, 1.3273},
+		{0.9999, 0.9996, 0.9991, 0.9983, 0.9969, 0.9957, 0.9948, 0.9941, 0.9936, 0.9935, 0.9945, 1.0011, 1.0313, 1.0793, 1.1728, 1.2439, 1.3191},
+		{1.0000, 0.9998, 0.9997, 0.9994, 0.9991, 0.9990, 0.9990, 0.9993, 0.9998, 1.0010, 1.0040, 1.0137, 1.0463, 1.0926, 1.1792, 1.2440, 1.3124},
+		{1.0000, 1.0000, 1.0001, 1.0002, 1.0007, 1.0013, 1.0021, 1.0031, 1.0042, 1.0063, 1.0106, 1.0223, 1.0565, 1.1016, 1.1830, 1.2431, 1.3062},
+		{1.0000, 1.0002, 1.0004, 1.0008, 1.0018, 1.0030, 1.0043, 1.0057, 1.0074, 1.0101, 1.0153, 1.0284, 1.0635, 1.1075, 1.1848, 1.2412, 1.3001},
+		{1.0001, 1.0004, 1.0008, 1.0017, 1.0035, 1.0055, 1.0075, 1.0097, 1.0120, 1.0156, 1.0221, 1.0368, 1.0723, 1.1138, 1.1834, 1.2330, 1.2842},
+		{1.0001, 1.0005, 1.0010, 1.0021, 1.0043, 1.0066, 1.0090, 1.0115, 1.0140, 1.0179, 1.0249, 1.0401, 1.0747, 1.1136, 1.1773, 1.2221, 1.2680},
 	},
 }
 
 // Z1Table contains combined high pressure and low pressure data for the departure compressibility factor for the lee/Kesler correlation (Z^1)
 var Z1Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{-0.0008, -0.0040, -0.0081, -0.0161, -0.0323, -0.0484, -0.0645, -0.0806, -0.0966, -0.1207, -0.1608, -0.2407, -0.3996, -0.5572, -0.7915},
-		{-0.0009, -0.0046, -0.0093, -0.0185, -0.0370, -0.0554, -0.0738, -0.0921, -0.1105, -0.1379, -0.1834, -0.2738, -0.4523, -0.6279, -0.8863},
-		{-0.0010, -0.0048, -0.0095, -0.0190, -0.0380, -0.0570, -0.0758, -0.0946, -0.1134, -0.1414, -0.1879, -0.2799, -0.4603, -0.6365, -0.8936},
-		{-0.0009, -0.0047, -0.0094, -0.0187, -0.0374, -0.0560, -0.0745, -0.0929, -0.1113, -0.1387, -0.1840, -0.2734, -0.4475, -0.6162, -0.8608},
-		{-0.0009, -0.0045, -0.0090, -0.0181, -0.0360, -0.0539, -0.0716, -0.0893, -0.1069, -0.1330, -0.1762, -0.2611, -0.4253, -0.5831, -0.8099},
-		{-0.0314, -0.0043, -0.0086, -0.0172, -0.0343, -0.0513, -0.0682, -0.0849, -0.1015, -0.1263, -0.1669, -0.2465, -0.3991, -0.5446, -0.7521},
-		{-0.0205, -0.0041, -0.0082, -0.0164, -0.0326, -0.0487, -0.0646, -0.0803, -0.0960, -0.1192, -0.1572, -0.2312, -0.3718, -0.5047, -0.6928},
-		{-0.0137, -0.0772, -0.0078, -0.0156, -0.0309, -0.0461, -0.0611, -0.0759, -0.0906, -0.1122, -0.1476, -0.2160, -0.3447, -0.4653, -0.6346},
-		{-0.0093, -0.0507, -0.1161, -0.0148, -0.0294, -0.0438, -0.0579, -0.0718, -0.0855, -0.1057, -0.1385, -0.2013, -0.3184, -0.4270, -0.5785},
-		{-0.0064, -0.0339, -0.0744, -0.0143, -0.0282, -0.0417, -0.0550, -0.0681, -0.0808, -0.0996, -0.1298, -0.1872, -0.2929, -0.3901, -0.5250},
-		{-0.0044, -0.0228, -0.0487, -0.1160, -0.0272, -0.0401, -0.0526, -0.0648, -0.0767, -0.0940, -0.1217, -0.1736, -0.2682, -0.3545, -0.4740},
-		{-0.0029, -0.0152, -0.0319, -0.0715, -0.0268, -0.0391, -0.0509, -0.0622, -0.0731, -0.0888, -0.1138, -0.1602, -0.2439, -0.3201, -0.4254},
-		{-0.0019, -0.0099, -0.0205, -0.0442, -0.1118, -0.0396, -0.0503, -0.0604, -0.0701, -0.0840, -0.1059, -0.1463, -0.2195, -0.2862, -0.3788},
-		{-0.0015, -0.0075, -0.0154, -0.0326, -0.0763, -0.1662, -0.0514, -0.0602, -0.0687, -0.0810, -0.1007, -0.1374, -0.2045, -0.2661, -0.3516},
-		{-0.0012, -0.0062, -0.0126, -0.0262, -0.0589, -0.1110, -0.0540, -0.0607, -0.0678, -0.0788, -0.0967, -0.1310, -0.1943, -0.2526, -0.3339},
-		{-0.0010, -0.0050, -0.0101, -0.0208, -0.0450, -0.0770, -0.1647, -0.0623, -0.0669, -0.0759, -0.0921, -0.1240, -0.1837, -0.2391, -0.3163},
-		{-0.0009, -0.0044, -0.0090, -0.0184, -0.0390, -0.0641, -0.1100, -0.0641, -0.0661, -0.0740, -0.0893, -0.1202, -0.1783, -0.2322, -0.3075},
-		{-0.0008, -0.0039, -0.0079, -0.0161, -0.0335, -0.0531, -0.0796, -0.0680, -0.0646, -0.0715, -0.0861, -0.1162, -0.1728, -0.2254, -0.2989},
-		{-0.0007, -0.0034, -0.0069, -0.0140, -0.0285, -0.0435, -0.0588, -0.0879, -0.0609, -0.0678, -0.0824, -0.1118, -0.1672, -0.2185, -0.2902},
-		{-0.0006, -0.0030, -0.0060, -0.0120, -0.0240, -0.0351, -0.0429, -0.0223, -0.0473, -0.0621, -0.0778, -0.1072, -0.1615, -0.2116, -0.2816},
-		{-0.0005, -0.0026, -0.0051, -0.0102, -0.0198, -0.0277, -0.0303, -0.0062, -0.0227, -0.0524, -0.0722, -0.1021, -0.1556, -0.2047, -0.2731},
-		{-0.0003, -0.0015, -0.0029, -0.0054, -0.0092, -0.0097, -0.0032, 0.0220, 0.1059, 0.0451, -0.0432, -0.0838, -0.1370, -0.1835, -0.2476},
-		{0.0000, 0.0000, 0.0001, 0.0007, 0.0038, 0.0106, 0.0236, 0.0476, 0.0897, 0.1630, 0.0698, -0.0373, -0.1021, -0.1469, -0.2056},
-		{0.0002, 0.0011, 0.0023, 0.0052, 0.0127, 0.0237, 0.0396, 0.0625, 0.0943, 0.1548, 0.1667, 0.0332, -0.0611, -0.1084, -0.1642},
-		{0.0004, 0.0019, 0.0039, 0.0084, 0.0190, 0.0326, 0.0499, 0.0719, 0.0991, 0.1477, 0.1990, 0.1095, -0.0141, -0.0678, -0.1231},
-		{0.0006, 0.0030, 0.0061, 0.0125, 0.0267, 0.0429, 0.0612, 0.0819, 0.1048, 0.1420, 0.1991, 0.2079, 0.0875, 0.0176, -0.0423},
-		{0.0007, 0.0036, 0.0072, 0.0147, 0.0306, 0.0477, 0.0661, 0.0857, 0.1063, 0.1383, 0.1894, 0.2397, 0.1737, 0.1008, 0.0350},
-		{0.0008, 0.0039, 0.0078, 0.0158, 0.0323, 0.0497, 0.0677, 0.0864, 0.1055, 0.1345, 0.1806, 0.2433, 0.2309, 0.1717, 0.1058},
-		{0.0008, 0.0040, 0.0080, 0.0162, 0.0330, 0.0501, 0.0677, 0.0855, 0.1035, 0.1303, 0.1729, 0.2381, 0.2631, 0.2255, 0.1673},
-		{0.0008, 0.0040, 0.0081, 0.0163, 0.0329, 0.0497, 0.0667, 0.0838, 0.1008, 0.1259, 0.1658, 0.2305, 0.2788, 0.2628, 0.2179},
-		{0.0008, 0.0040, 0.0081, 0.0162, 0.0325, 0.0488, 0.0652, 0.0814, 0.0978, 0.1216, 0.1593, 0.2224, 0.2846, 0.2871, 0.2576},
-		{0.0008, 0.0040, 0.0079, 0.0159, 0.0318, 0.0477, 0.0635, 0.0792, 0.0947, 0.1173, 0.1532, 0.2144, 0.2848, 0.3017, 0.2876},
-		{0.0008, 0.0039, 0.0078, 0.0155, 0.0310, 0.0464, 0.0617, 0.0767, 0.0916, 0.1133, 0.1476, 0.2069, 0.2819, 0.3097, 0.3096},
-		{0.0007, 0.0037, 0.0074, 0.0147, 0.0293, 0.0437, 0.0579, 0.0719, 0.0857, 0.1057, 0.1374, 0.1932, 0.2720, 0.3135, 0.3355},
-		{0.0007, 0.0035, 0.0070, 0.0139, 0.0276, 0.0411, 0.0544, 0.0675, 0.0803, 0.0989, 0.1285, 0.1812, 0.2602, 0.3089, 0.3459},
-		{0.0007, 0.0033, 0.0066, 0.0131, 0.0260, 0.0387, 0.0512, 0.0634, 0.0754, 0.0929, 0.1207, 0.1706, 0.2484, 0.3009, 0.3475},
-		{0.0006, 0.0031, 0.0062, 0.0124, 0.0245, 0.0365, 0.0483, 0.0598, 0.0711, 0.0876, 0.1138, 0.1613, 0.2372, 0.2915, 0.3443},
-		{0.0006, 0.0029, 0.0059, 0.0117, 0.0232, 0.0345, 0.0456, 0.0565, 0.0672, 0.0828, 0.1076, 0.1529, 0.2268, 0.2817, 0.3385},
-		{0.0005, 0.0026, 0.0052, 0.0103, 0.0204, 0.0303, 0.0401, 0.0497, 0.0591, 0.0728, 0.0949, 0.1356, 0.2042, 0.2584, 0.3194},
-		{0.0005, 0.0023, 0.0046, 0.0091, 0.0182, 0.0270, 0.0357, 0.0443, 0.0527, 0.0651, 0.0849, 0.1219, 0.1857, 0.2378, 0.2994},
+		{-0.0008, -0.0040, -0.0081, -0.0161, -0.0323, -0.0484, -0.0645, -0.0806, -0.0966, -0.1207, -0.1608, -0.2407, -0.3996, -0.5572, -0.7915, -0.9464, -1.1002},
+		{-0.0009, -0.0046, -0.0093, -0.0185, -0.0370, -0.0554, -0.0738, -0.0921, -0.1105, -0.1379, -0.1834, -0.2738, -0.4523, -0.6279, -0.8863, -1.0555, -1.2226},
+		{-0.0010, -0.0048, -0.0095, -0.0190, -0.0380, -0.0570, -0.0758, -0.0946, -0.1134, -0.1414, -0.1879, -0.2799, -0.4603, -0.6365, -0.8936, -1.0608, -1.2250},
+		{-0.0009, -0.0047, -0.0094, -0.0187, -0.0374, -0.0560, -0.0745, -0.0929, -0.1113, -0.1387, -0.1840, -0.2734, -0.4475, -0.6162, -0.8608, -1.0186, -1.1729},
+		{-0.0009, -0.0045, -0.0090, -0.0181, -0.0360, -0.0539, -0.0716, -0.0893, -0.1069, -0.1330, -0.1762, -0.2611, -0.4253, -0.5831, -0.8099, -0.9555, -1.0973},
+		{-0.0314, -0.0043, -0.0086, -0.0172, -0.0343, -0.0513, -0.0682, -0.0849, -0.1015, -0.1263, -0.1669, -0.2465, -0.3991, -0.5446, -0.7521, -0.8846, -1.0131},
+		{-0.0205, -0.0041, -0.0082, -0.0164, -0.0326, -0.0487, -0.0646, -0.0803, -0.0960, -0.1192, -0.1572, -0.2312, -0.3718, -0.5047, -0.6928, -0.8123, -0.9277},
+		{-0.0137, -0.0772, -0.0078, -0.0156, -0.0309, -0.0461, -0.0611, -0.0759, -0.0906, -0.1122, -0.1476, -0.2160, -0.3447, -0.4653, -0.6346, -0.7416, -0.8447},
+		{-0.0093, -0.0507, -0.1161, -0.0148, -0.0294, -0.0438, -0.0579, -0.0718, -0.0855, -0.1057, -0.1385, -0.2013, -0.3184, -0.4270, -0.5785, -0.6738, -0.7655},
+		{-0.0064, -0.0339, -0.0744, -0.0143, -0.0282, -0.0417, -0.0550, -0.0681, -0.0808, -0.0996, -0.1298, -0.1872, -0.2929, -0.3901, -0.5250, -0.6096, -0.6908},
+		{-0.0044, -0.0228, -0.0487, -0.1160, -0.0272, -0.0401, -0.0526, -0.0648, -0.0767, -0.0940, -0.1217, -0.1736, -0.2682, -0.3545, -0.4740, -0.5488, -0.6206},
+		{-0.0029, -0.0152, -0.0319, -0.0715, -0.0268, -0.0391, -0.0509, -0.0622, -0.0731, -0.0888, -0.1138, -0.1602, -0.2439, -0.3201, -0.4254, -0.4913, -0.5546},
+		{-0.0019, -0.0099, -0.0205, -0.0442, -0.1118, -0.0396, -0.0503, -0.0604, -0.0701, -0.0840, -0.1059, -0.1463, -0.2195, -0.2862, -0.3788, -0.4368, -0.4926},
+		{-0.0015, -0.0075, -0.0154, -0.0326, -0.0763, -0.1662, -0.0514, -0.0602, -0.0687, -0.0810, -0.1007, -0.1374, -0.2045, -0.2661, -0.3516, -0.4054, -0.4571},
+		{-0.0012, -0.0062, -0.0126, -0.0262, -0.0589, -0.1110, -0.0540, -0.0607, -0.0678, -0.0788, -0.0967, -0.1310, -0.1943, -0.2526, -0.3339, -0.3849, -0.4341},
+		{-0.0010, -0.0050, -0.0101, -0.0208, -0.0450, -0.0770, -0.1647, -0.0623, -0.0669, -0.0759, -0.0921, -0.1240, -0.1837, -0.2391, -0.3163, -0.3648, -0.4116},
+		{-0.0009, -0.0044, -0.0090, -0.0184, -0.0390, -0.0641, -0.1100, -0.0641, -0.0661, -0.0740, -0.0893, -0.1202, -0.1783, -0.2322, -0.3075, -0.3549, -0.4005},
+		{-0.0008, -0.0039, -0.0079, -0.0161, -0.0335, -0.0531, -0.0796, -0.0680, -0.0646, -0.0715, -0.0861, -0.1162, -0.1728, -0.2254, -0.2989, -0.3451, -0.3896},
+		{-0.0007, -0.0034, -0.0069, -0.0140, -0.0285, -0.0435, -0.0588, -0.0879, -0.0609, -0.0678, -0.0824, -0.1118, -0.1672, -0.2185, -0.2902, -0.3353, -0.3787},
+		{-0.0006, -0.0030, -0.0060, -0.0120, -0.0240, -0.0351, -0.0429, -0.0223, -0.0473, -0.0621, -0.0778, -0.1072, -0.1615, -0.2116, -0.2816, -0.3257, -0.3680},
+		{-0.0005, -0.0026, -0.0051, -0.0102, -0.0198, -0.0277, -0.0303, -0.0062, -0.0227, -0.0524, -0.0722, -0.1021, -0.1556, -0.2047, -0.2731, -0.3161, -0.3574},
+		{-0.0003, -0.0015, -0.0029, -0.0054, -0.0092, -0.0097, -0.0032, 0.0220, 0.1059, 0.0451, -0.0432, -0.0838, -0.1370, -0.1835, -0.2476, -0.2877, -0.3262},
+		{0.0000, 0.0000, 0.0001, 0.0007, 0.0038, 0.0106, 0.0236, 0.0476, 0.0897, 0.1630, 0.0698, -0.0373, -0.1021, -0.1469, -0.2056, -0.2418, -0.2762},
+		{0.0002, 0.0011, 0.0023, 0.0052, 0.0127, 0.0237, 0.0396, 0.0625, 0.0943, 0.1548, 0.1667, 0.0332, -0.0611, -0.1084, -0.1642, -0.1973, -0.2285},
+		{0.0004, 0.0019, 0.0039, 0.0084, 0.0190, 0.0326, 0.0499, 0.0719, 0.0991, 0.1477, 0.1990, 0.1095, -0.0141, -0.0678, -0.1231, -0.1542, -0.1828},
+		{0.0006, 0.0030, 0.0061, 0.0125, 0.0267, 0.0429, 0.0612, 0.0819, 0.1048, 0.1420, 0.1991, 0.2079, 0.0875, 0.0176, -0.0423, -0.0717, -0.0972},
+		{0.0007, 0.0036, 0.0072, 0.0147, 0.0306, 0.0477, 0.0661, 0.0857, 0.1063, 0.1383, 0.1894, 0.2397, 0.1737, 0.1008, 0.0350, 0.0054, -0.0188},
+		{0.0008, 0.0039, 0.0078, 0.0158, 0.0323, 0.0497, 0.0677, 0.0864, 0.1055, 0.1345, 0.1806, 0.2433, 0.2309, 0.1717, 0.1058, 0.0759, 0.0523},
+		{0.0008, 0.0040, 0.0080, 0.0162, 0.0330, 0.0501, 0.0677, 0.0855, 0.1035, 0.1303, 0.1729, 0.2381, 0.2631, 0.2255, 0.1673, 0.1384, 0.1158},
+		{0.0008, 0.0040, 0.0081, 0.0163, 0.0329, 0.0497, 0.0667, 0.0838, 0.1008, 0.1259, 0.1658, 0.2305, 0.2788, 0.2628, 0.2179, 0.1921, 0.1711},
+		{0.0008, 0.0040, 0.0081, 0.0162, 0.0325, 0.0488, 0.0652, 0.0814, 0.0978, 0.1216, 0.1593, 0.2224, 0.2846, 0.2871, 0.2576, 0.2365, 0.2183},
+		{0.0008, 0.0040, 0.0079, 0.0159, 0.0318, 0.0477, 0.0635, 0.0792, 0.0947, 0.1173, 0.1532, 0.2144, 0.2848, 0.3017, 0.2876, 0.2723, 0.2577},
+		{0.0008, 0.0039, 0.0078, 0.0155, 0.0310, 0.0464, 0.0617, 0.0767, 0.0916, 0.1133, 0.1476, 0.2069, 0.2819, 0.3097, 0.3096, 0.3003, 0.2898},
+		{0.0007, 0.0037, 0.0074, 0.0147, 0.0293, 0.0437, 0.0579, 0.0719, 0.0857, 0.1057, 0.1374, 0.1932, 0.2720, 0.3135, 0.3355, 0.3375, 0.3356},
+		{0.0007, 0.0035, 0.0070, 0.0139, 0.0276, 0.0411, 0.0544, 0.0675, 0.0803, 0.0989, 0.1285, 0.1812, 0.2602, 0.3089, 0.3459, 0.3571, 0.3628},
+		{0.0007, 0.0033, 0.0066, 0.0131, 0.0260, 0.0387, 0.0512, 0.0634, 0.0754, 0.0929, 0.1207, 0.1706, 0.2484, 0.3009, 0.3475, 0.3655, 0.3774},
+		{0.0006, 0.0031, 0.0062, 0.0124, 0.0245, 0.0365, 0.0483, 0.0598, 0.0711, 0.0876, 0.1138, 0.1613, 0.2372, 0.2915, 0.3443, 0.3672, 0.3838},
+		{0.0006, 0.0029, 0.0059, 0.0117, 0.0232, 0.0345, 0.0456, 0.0565, 0.0672, 0.0828, 0.1076, 0.1529, 0.2268, 0.2817, 0.3385, 0.3648, 0.3850},
+		{0.0005, 0.0026, 0.0052, 0.0103, 0.0204, 0.0303, 0.0401, 0.0497, 0.0591, 0.0728, 0.0949, 0.1356, 0.2042, 0.2584, 0.3194, 0.3504, 0.3760},
+		{0.0005, 0.0023, 0.0046, 0.0091, 0.0182, 0.0270, 0.0357, 0.0443, 0.0527, 0.0651, 0.0849, 0.1219, 0.1857, 0.2378, 0.2994, 0.3322, 0.3600},
 	},
 }
 
 // H0Table contains combined high pressure and low pressure data for the base residual enthalpy for the lee/Kesler correlation ((H^R)^0/RTc)
 var H0Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{-6.0450, -6.0430, -6.0400, -6.0340, -6.0220, -6.0110, -5.9990, -5.9870, -5.9750, -5.9570, -5.9270, -5.8680, -5.7480, -5.6280, -5.4460},
-		{-5.9060, -5.9040, -5.9010, -5.8950, -5.8820, -5.8700, -5.8580, -5.8450, -5.8330, -5.8140, -5.7830, -5.7210, -5.5950, -5.4690, -5.2780},
-		{-5.7630, -5.7610, -5.7570, -5.7510, -5.7380, -5.7260, -5.7130, -5.7000, -5.6870, -5.6680, -5.6360, -5.5720, -5.4420, -5.3110, -5.1130},
-		{-5.6150, -5.6120, -5.6090, -5.6030, -5.5900, -5.5770, -5.5640, -5.5510, -5.5380, -5.5190, -5.4860, -5.4210, -5.2880, -5.1540, -5.9500},
-		{-5.4650, -5.4630, -5.4590, -5.4530, -5.4400, -5.4270, -5.4140, -5.4010, -5.3880, -5.3690, -5.3360, -5.2790, -5.1350, -4.9990, -4.7910},
-		{-0.0320, -5.3120, -5.3090, -5.3030, -5.2900, -5.2780, -5.2650, -5.2520, -5.2390, -5.2200, -5.1870, -5.1210, -4.9860, -4.8490, -4.6380},
-		{-0.0270, -5.1620, -5.1590, -5.1530, -5.1410, -5.1290, -5.1160, -5.1040, -5.0910, -5.0730, -5.0410, -4.9760, -4.8420, -4.7940, -4.4920},
-		{-0.0230, -0.1180, -5.0080, -5.0020, -4.9910, -4.9800, -4.9680, -4.9560, -4.9490, -4.9270, -4.8960, -4.8330, -4.7020, -4.5650, -4.3530},
-		{-0.0200, -0.1010, -0.2130, -4.8480, -4.8380, -4.8280, -4.8180, -4.8080, -4.7970, -4.7810, -4.7520, -4.6930, -4.5660, -4.4320, -4.2210},
-		{-0.0170, -0.0880, -0.1830, -4.6870, -4.6790, -4.6720, -4.6640, -4.6550, -4.6460, -4.6320, -4.6070, -4.5540, -4.4340, -4.3930, -4.0950},
-		{-0.0150, -0.0780, -0.1600, -0.3450, -4.5070, -4.5040, -4.4990, -4.4940, -4.4880, -4.4780, -4.4590, -4.4130, -4.3030, -4.1780, -3.9740},
-		{-0.0140, -0.0690, -0.1410, -0.3000, -4.3090, -4.3130, -4.3160, -4.3160, -4.3160, -4.3120, -4.3020, -4.2690, -4.1730, -4.0560, -3.8570},
-		{-0.0120, -0.0620, -0.1260, -0.2640, -0.5960, -4.0740, -4.0940, -4.1080, -4.1180, -4.1270, -4.1320, -4.1190, -4.0430, -3.9350, -3.7440},
-		{-0.0110, -0.0580, -0.1180, -0.2460, -0.5450, -0.9600, -3.9200, -3.9530, -3.9760, -4.0000, -4.0200, -4.0240, -3.9630, -3.8630, -3.6780},
-		{-0.0110, -0.0560, -0.1130, -0.2350, -0.5160, -0.8850, -3.7630, -3.8250, -3.8650, -3.9040, -3.9400, -3.9580, -3.9100, -3.8150, -3.6340},
-		{-0.0110, -0.0540, -0.1090, -0.2250, -0.4900, -0.8240, -1.3560, -3.6580, -3.7320, -3.7960, -3.8530, -3.8900, -3.8560, -3.7670, -3.5910},
-		{-0.0100, -0.0530, -0.1070, -0.2210, -0.4780, -0.7970, -1.2730, -3.5440, -3.6520, -3.7360, -3.8060, -3.8540, -3.8290, -3.7430, -3.5690},
-		{-0.0100, -0.0520, -0.1050, -0.2160, -0.4660, -0.7730, -1.2060, -3.3760, -3.5580, -3.6700, -3.7580, -3.8180, -3.8010, -3.7190, -3.5480},
-		{-0.0100, -0.0510, -0.1030, -0.2120, -0.4550, -0.7500, -1.1510, -2.5840, -3.4410, -3.5980, -3.7060, -3.7820, -3.7740, -3.6950, -3.5260},
-		{-0.0100, -0.0500, -0.1010, -0.2080, -0.4450, -0.7210, -1.1020, -1.7960, -3.2830, -3.5160, -3.6520, -3.7440, -3.7460, -3.6710, -3.5050},
-		{-0.0100, -0.0490, -0.0990, -0.2030, -0.4340, -0.7080, -1.0600, -1.6270, -3.0390, -3.4220, -3.5950, -3.7050, -3.7180, -3.6470, -3.4840},
-		{-0.0090, -0.0460, -0.0940, -0.1920, -0.4070, -0.6540, -0.9550, -1.3590, -2.0340, -3.0300, -3.3980, -3.5830, -3.6320, -3.5750, -3.4200},
-		{-0.0080, -0.0420, -0.0860, -0.1750, -0.3670, -0.5810, -0.8270, -1.1200, -1.4870, -2.2030, -2.9650, -3.3530, -3.4840, -3.4530, -3.3150},
-		{-0.0080, -0.0390, -0.0790, -0.1600, -0.3340, -0.5230, -0.7320, -0.9680, -1.2390, -1.7190, -2.4790, -3.0910, -3.3290, -3.3290, -3.2110},
-		{-0.0070, -0.0360, -0.0730, -0.1480, -0.3050, -0.4740, -0.6570, -0.8570, -1.0760, -1.4430, -2.0790, -2.8010, -3.1660, -3.2020, -3.1070},
-		{-0.0060, -0.0310, -0.0630, -0.1270, -0.2590, -0.3990, -0.5450, -0.6980, -0.8600, -1.1160, -1.5600, -2.2740, -2.8250, -2.9420, -2.8990},
-		{-0.0050, -0.0270, -0.0550, -0.1100, -0.2240, -0.3410, -0.4630, -0.5880, -0.7160, -0.9150, -1.2530, -1.8570, -2.4860, -2.6790, -2.6920},
-		{-0.0050, -0.0240, -0.0480, -0.0970, -0.1960, -0.2970, -0.4000, -0.5050, -0.6110, -0.7740, -1.0460, -1.5490, -2.1750, -2.4210, -2.4860},
-		{-0.0040, -0.0210, -0.0430, -0.0860, -0.1730, -0.2610, -0.3500, -0.4400, -0.5310, -0.6670, -0.8940, -1.3180, -1.9040, -2.1770, -2.2850},
-		{-0.0040, -0.0190, -0.0380, -0.0760, -0.1530, -0.2310, -0.3090, -0.3870, -0.4460, -0.5830, -0.7770, -1.1390, -1.6720, -1.9530, -2.0910},
-		{-0.0030, -0.0170, -0.0340, -0.0680, -0.1370, -0.2060, -0.2750, -0.3440, -0.4130, -0.5150, -0.6830, -0.9960, -1.4760, -1.7510, -1.9080},
-		{-0.0030, -0.0150, -0.0310, -0.0620, -0.1230, -0.1850, -0.2460, -0.3070, -0.3680, -0.4580, -0.6060, -0.8800, -1.3090, -1.5710, -1.7360},
-		{-0.0030, -0.0140, -0.0280, -0.0560, -0.1110, -0.1670, -0.2220, -0.2760, -0.3300, -0.4110, -0.5410, -0.7820, -1.1670, -1.4110, -1.5770},
-		{-0.0020, -0.0120, -0.0230, -0.0460, -0.0920, -0.1370, -0.1820, -0.2260, -0.2690, -0.3340, -0.4370, -0.6290, -0.9370, -1.1430, -1.2950},
-		{-0.0020, -0.0100, -0.0190, -0.0380, -0.0760, -0.1140, -0.1500, -0.1870, -0.2220, -0.2750, -0.3590, -0.5130, -0.7610, -0.9290, -1.0580},
-		{-0.0020, -0.0080, -0.0160, -0.0320, -0.0640, -0.0950, -0.1250, -0.1550, -0.1850, -0.2280, -0.2970, -0.4220, -0.6210, -0.7560, -0.8580},
-		{-0.0010, -0.0070, -0.0140, -0.0270, -0.0540, -0.0800, -0.1050, -0.1300, -0.1540, -0.1900, -0.2460, -0.3480, -0.5080, -0.6140, -0.6890},
-		{-0.0010, -0.0060, -0.0110, -0.0230, -0.0450, -0.0670, -0.0880, -0.1090, -0.1290, -0.1590, -0.2050, -0.2880, -0.4150, -0.4950, -0.5450},
-		{-0.0010, -0.0040, -0.0070, -0.0150, -0.0290, -0.0430, -0.0560, -0.0690, -0.0810, -0.0990, -0.1270, -0.1740, -0.2390, -0.2700, -0.2640},
-		{-0.0000, -0.0020, -0.0050, -0.0090, -0.0170, -0.0260, -0.0330, -0.0410, -0.0480, -0.0580, -0.0720, -0.0950, -0.1160, -0.1100, -0.0610},
+		{-6.0450, -6.0430, -6.0400, -6.0340, -6.0220, -6.0110, -5.9990, -5.9870, -5.9750, -5.9570, -5.9270, -5.8680, -5.7480, -5.6280, -5.4460, -5.3243, -5.2020},
+		{-5.9060, -5.9040, -5.9010, -5.8950, -5.8820, -5.8700, -5.8580, -5.8450, -5.8330, -5.8140, -5.7830, -5.7210, -5.5950, -5.4690, -5.2780, -5.1499, -5.0211},
+		{-5.7630, -5.7610, -5.7570, -5.7510, -5.7380, -5.7260, -5.7130, -5.7000, -5.6870, -5.6680, -5.6360, -5.5720, -5.4420, -5.3110, -5.1130, -4.9796, -4.8455},
+		{-5.6150, -5.6120, -5.6090, -5.6030, -5.5900, -5.5770, -5.5640, -5.5510, -5.5380, -5.5190, -5.4860, -5.4210, -5.2880, -5.1540, -5.9500, -4.8122, -4.6740},
+		{-5.4650, -5.4630, -5.4590, -5.4530, -5.4400, -5.4270, -5.4140, -5.4010, -5.3880, -5.3690, -5.3360, -5.2790, -5.1350, -4.9990, -4.7910, -4.6502, -4.5086},
+		{-0.0320, -5.3120, -5.3090, -5.3030, -5.2900, -5.2780, -5.2650, -5.2520, -5.2390, -5.2200, -5.1870, -5.1210, -4.9860, -4.8490, -4.6380, -4.4951, -4.3510},
+		{-0.0270, -5.1620, -5.1590, -5.1530, -5.1410, -5.1290, -5.1160, -5.1040, -5.0910, -5.0730, -5.0410, -4.9760, -4.8420, -4.7940, -4.4920, -4.3477, -4.2019},
+		{-0.0230, -0.1180, -5.0080, -5.0020, -4.9910, -4.9800, -4.9680, -4.9560, -4.9490, -4.9270, -4.8960, -4.8330, -4.7020, -4.5650, -4.3530, -4.2081, -4.0611},
+		{-0.0200, -0.1010, -0.2130, -4.8480, -4.8380, -4.8280, -4.8180, -4.8080, -4.7970, -4.7810, -4.7520, -4.6930, -4.5660, -4.4320, -4.2210, -4.0758, -3.9283},
+		{-0.0170, -0.0880, -0.1830, -4.6870, -4.6790, -4.6720, -4.6640, -4.6550, -4.6460, -4.6320, -4.6070, -4.5540, -4.4340, -4.3930, -4.0950, -3.9502, -3.8027},
+		{-0.0150, -0.0780, -0.1600, -0.3450, -4.5070, -4.5040, -4.4990, -4.4940, -4.4880, -4.4780, -4.4590, -4.4130, -4.3030, -4.1780, -3.9740, -3.8305, -3.6836},
+		{-0.0140, -0.0690, -0.1410, -0.3000, -4.3090, -4.3130, -4.3160, -4.3160, -4.3160, -4.3120, -4.3020, -4.2690, -4.1730, -4.0560, -3.8570, -3.7159, -3.5702},
+		{-0.0120, -0.0620, -0.1260, -0.2640, -0.5960, -4.0740, -4.0940, -4.1080, -4.1180, -4.1270, -4.1320, -4.1190, -4.0430, -3.9350, -3.7440, -3.6058, -3.4617},
+		{-0.0110, -0.0580, -0.1180, -0.2460, -0.5450, -0.9600, -3.9200, -3.9530, -3.9760, -4.0000, -4.0200, -4.0240, -3.9630, -3.8630, -3.6780, -3.5415, -3.3987},
+		{-0.0110, -0.0560, -0.1130, -0.2350, -0.5160, -0.8850, -3.7630, -3.8250, -3.8650, -3.9040, -3.9400, -3.9580, -3.9100, -3.8150, -3.6340, -3.4993, -3.3575},
+		{-0.0110, -0.0540, -0.1090, -0.2250, -0.4900, -0.8240, -1.3560, -3.6580, -3.7320, -3.7960, -3.8530, -3.8900, -3.8560, -3.7670, -3.5910, -3.4576, -3.3169},
+		{-0.0100, -0.0530, -0.1070, -0.2210, -0.4780, -0.7970, -1.2730, -3.5440, -3.6520, -3.7360, -3.8060, -3.8540, -3.8290, -3.7430, -3.5690, -3.4369, -3.2968},
+		{-0.0100, -0.0520, -0.1050, -0.2160, -0.4660, -0.7730, -1.2060, -3.3760, -3.5580, -3.6700, -3.7580, -3.8180, -3.8010, -3.7190, -3.5480, -3.4163, -3.2768},
+		{-0.0100, -0.0510, -0.1030, -0.2120, -0.4550, -0.7500, -1.1510, -2.5840, -3.4410, -3.5980, -3.7060, -3.7820, -3.7740, -3.6950, -3.5260, -3.3958, -3.2570},
+		{-0.0100, -0.0500, -0.1010, -0.2080, -0.4450, -0.7210, -1.1020, -1.7960, -3.2830, -3.5160, -3.6520, -3.7440, -3.7460, -3.6710, -3.5050, -3.3755, -3.2372},
+		{-0.0100, -0.0490, -0.0990, -0.2030, -0.4340, -0.7080, -1.0600, -1.6270, -3.0390, -3.4220, -3.5950, -3.7050, -3.7180, -3.6470, -3.4840, -3.3552, -3.2176},
+		{-0.0090, -0.0460, -0.0940, -0.1920, -0.4070, -0.6540, -0.9550, -1.3590, -2.0340, -3.0300, -3.3980, -3.5830, -3.6320, -3.5750, -3.4200, -3.2949, -3.1595},
+		{-0.0080, -0.0420, -0.0860, -0.1750, -0.3670, -0.5810, -0.8270, -1.1200, -1.4870, -2.2030, -2.9650, -3.3530, -3.4840, -3.4530, -3.3150, -3.1959, -3.0645},
+		{-0.0080, -0.0390, -0.0790, -0.1600, -0.3340, -0.5230, -0.7320, -0.9680, -1.2390, -1.7190, -2.4790, -3.0910, -3.3290, -3.3290, -3.2110, -3.0984, -2.9716},
+		{-0.0070, -0.0360, -0.0730, -0.1480, -0.3050, -0.4740, -0.6570, -0.8570, -1.0760, -1.4430, -2.0790, -2.8010, -3.1660, -3.2020, -3.1070, -3.0022, -2.8806},
+		{-0.0060, -0.0310, -0.0630, -0.1270, -0.2590, -0.3990, -0.5450, -0.6980, -0.8600, -1.1160, -1.5600, -2.2740, -2.8250, -2.9420, -2.8990, -2.8123, -2.7025},
+		{-0.0050, -0.0270, -0.0550, -0.1100, -0.2240, -0.3410, -0.4630, -0.5880, -0.7160, -0.9150, -1.2530, -1.8570, -2.4860, -2.6790, -2.6920, -2.6250, -2.5287},
+		{-0.0050, -0.0240, -0.0480, -0.0970, -0.1960, -0.2970, -0.4000, -0.5050, -0.6110, -0.7740, -1.0460, -1.5490, -2.1750, -2.4210, -2.4860, -2.4403, -2.3585},
+		{-0.0040, -0.0210, -0.0430, -0.0860, -0.1730, -0.2610, -0.3500, -0.4400, -0.5310, -0.6670, -0.8940, -1.3180, -1.9040, -2.1770, -2.2850, -2.2591, -2.1917},
+		{-0.0040, -0.0190, -0.0380, -0.0760, -0.1530, -0.2310, -0.3090, -0.3870, -0.4460, -0.5830, -0.7770, -1.1390, -1.6720, -1.9530, -2.0910, -2.0829, -2.0291},
+		{-0.0030, -0.0170, -0.0340, -0.0680, -0.1370, -0.2060, -0.2750, -0.3440, -0.4130, -0.5150, -0.6830, -0.9960, -1.4760, -1.7510, -1.9080, -1.9132, -1.8714},
+		{-0.0030, -0.0150, -0.0310, -0.0620, -0.1230, -0.1850, -0.2460, -0.3070, -0.3680, -0.4580, -0.6060, -0.8800, -1.3090, -1.5710, -1.7360, -1.7515, -1.7195},
+		{-0.0030, -0.0140, -0.0280, -0.0560, -0.1110, -0.1670, -0.2220, -0.2760, -0.3300, -0.4110, -0.5410, -0.7820, -1.1670, -1.4110, -1.5770, -1.5987, -1.5743},
+		{-0.0020, -0.0120, -0.0230, -0.0460, -0.0920, -0.1370, -0.1820, -0.2260, -0.2690, -0.3340, -0.4370, -0.6290, -0.9370, -1.1430, -1.2950, -1.3213, -1.3055},
+		{-0.0020, -0.0100, -0.0190, -0.0380, -0.0760, -0.1140, -0.1500, -0.1870, -0.2220, -0.2750, -0.3590, -0.5130, -0.7610, -0.9290, -1.0580, -1.0807, -1.0667},
+		{-0.0020, -0.0080, -0.0160, -0.0320, -0.0640, -0.0950, -0.1250, -0.1550, -0.1850, -0.2280, -0.2970, -0.4220, -0.6210, -0.7560, -0.8580, -0.8732, -0.8564},
+		{-0.0010, -0.0070, -0.0140, -0.0270, -0.0540, -0.0800, -0.1050, -0.1300, -0.1540, -0.1900, -0.2460, -0.3480, -0.5080, -0.6140, -0.6890, -0.6943, -0.6720},
+		{-0.0010, -0.0060, -0.0110, -0.0230, -0.0450, -0.0670, -0.0880, -0.1090, -0.1290, -0.1590, -0.2050, -0.2880, -0.4150, -0.4950, -0.5450, -0.5393, -0.5100},
+		{-0.0010, -0.0040, -0.0070, -0.0150, -0.0290, -0.0430, -0.0560, -0.0690, -0.0810, -0.0990, -0.1270, -0.1740, -0.2390, -0.2700, -0.2640, -0.2323, -0.1834},
+		{-0.0000, -0.0020, -0.0050, -0.0090, -0.0170, -0.0260, -0.0330, -0.0410, -0.0480, -0.0580, -0.0720, -0.0950, -0.1160, -0.1100, -0.0610, -0.0064, 0.0611},
 	},
 }
 
 // H1Table contains combined high pressure and low pressure data for the departure residual enthalpy for the lee/Kesler correlation ((H^R)^1/RTc)
 var H1Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{-11.0980, -11.0960, -11.0950, -11.0910, -11.0830, -11.0760, -11.0690, -11.0620, -11.0550, -11.0440, -11.0270, -10.9920, -10.9350, -10.8720, -10.7810},
-		{-10.6560, -10.6550, -10.6540, -10.6530, -10.6500, -10.6460, -10.6430, -10.6400, -10.6370, -10.6320, -10.6240, -10.6090, -10.5810, -10.5540, -10.5290},
-		{-10.1210, -10.1210, -10.1210, -10.1200, -10.1210, -10.1210, -10.1210, -10.1210, -10.1210, -10.1210, -10.1220, -10.1230, -10.1280, -10.1350, -10.1500},
-		{-9.5150, -9.5150, -9.5160, -9.5170, -9.5190, -9.5210, -9.5230, -9.5250, -9.5270, -9.5310, -9.5370, -9.5490, -9.5760, -9.6110, -9.6630},
-		{-8.8680, -8.8690, -8.8700, -8.8720, -8.8760, -8.8800, -8.8840, -8.8880, -8.8920, -8.8990, -8.9090, -8.9320, -8.9780, -9.0300, -9.1110},
-		{-0.0800, -8.2110, -8.2120, -8.2150, -8.2210, -8.2260, -8.2320, -8.2380, -8.2430, -8.2520, -8.2670, -8.2980, -8.3600, -8.4250, -8.5310},
-		{-0.0590, -7.5680, -7.5700, -7.5730, -7.5790, -7.5850, -7.5910, -7.5960, -7.6030, -7.6140, -7.6320, -7.6690, -7.7450, -7.8240, -7.9500},
-		{-0.0450, -0.2470, -6.9490, -6.9520, -6.9590, -6.9660, -6.9730, -6.9800, -6.9870, -6.9970, -7.0170, -7.0590, -7.1470, -7.2390, -7.3810},
-		{-0.0340, -0.1850, -0.4150, -6.3600, -6.3670, -6.3730, -6.3810, -6.3880, -6.3950, -6.4070, -6.4290, -6.4750, -6.5740, -6.6770, -6.8370},
-		{-0.0270, -0.1420, -0.3060, -5.7960, -5.8020, -5.8090, -5.8160, -5.8240, -5.8320, -5.8450, -5.8680, -5.9180, -6.0270, -6.1420, -6.3180},
-		{-0.0210, -0.1100, -0.2340, -0.5420, -5.2660, -5.2710, -5.2780, -5.2850, -5.2930, -5.3060, -5.3300, -5.3850, -5.5060, -5.6320, -5.8240},
-		{-0.0170, -0.0870, -0.1820, -0.4010, -4.7530, -4.7540, -4.7580, -4.7630, -4.7710, -4.7840, -4.8100, -4.8720, -5.0000, -5.1490, -5.3580},
-		{-0.0140, -0.0700, -0.1440, -0.3080, -0.7510, -4.2540, -4.2480, -4.2490, -4.2550, -4.2680, -4.2980, -4.3710, -4.5300, -4.6880, -4.9160},
-		{-0.0120, -0.0610, -0.1260, -0.2650, -0.6120, -1.2360, -3.9420, -3.9340, -3.9370, -3.9510, -3.9870, -4.0730, -4.2510, -4.4220, -4.6620},
-		{-0.0110, -0.0560, -0.1150, -0.2410, -0.5420, -0.9940, -3.7370, -3.7120, -3.7130, -3.7300, -3.7730, -3.8730, -4.0680, -4.2480, -4.4970},
-		{-0.0100, -0.0520, -0.1050, -0.2190, -0.4830, -0.8370, -1.6160, -3.4700, -3.4670, -3.4920, -3.5510, -3.6700, -3.8850, -4.0770, -4.3360},
-		{-0.0100, -0.0500, -0.1010, -0.2090, -0.4570, -0.7760, -1.3240, -3.3320, -3.3270, -3.3630, -3.4340, -3.5680, -3.7950, -3.9920, -4.2570},
-		{-0.0090, -0.0480, -0.0970, -0.2000, -0.4330, -0.7220, -1.1540, -3.1640, -3.1640, -3.2230, -3.3130, -3.4640, -3.7050, -3.9090, -4.1780},
-		{-0.0090, -0.0460, -0.0930, -0.1910, -0.4100, -0.6750, -1.0340, -2.4710, -2.9520, -3.0650, -3.1860, -3.3580, -3.6150, -3.8250, -4.1000},
-		{-0.0090, -0.0440, -0.0890, -0.1830, -0.3890, -0.6320, -0.9400, -1.3750, -2.5950, -2.8800, -3.0510, -3.2510, -3.5250, -3.7420, -4.0230},
-		{-0.0080, -0.0420, -0.0850, -0.1750, -0.3700, -0.5940, -0.8630, -1.1800, -1.7230, -2.6500, -2.9060, -3.1420, -3.4350, -3.6610, -3.9470},
-		{-0.0070, -0.0370, -0.0750, -0.1530, -0.3180, -0.4980, -0.6910, -0.8770, -0.8780, -1.4960, -2.3810, -2.8000, -3.1670, -3.4180, -3.7220},
-		{-0.0060, -0.0300, -0.0610, -0.1230, -0.2510, -0.3810, -0.5070, -0.6170, -0.6730, -0.6170, -1.2610, -2.1670, -2.7200, -3.0230, -3.3620},
-		{-0.0050, -0.0250, -0.0500, -0.0990, -0.1990, -0.2960, -0.3850, -0.4590, -0.5030, -0.4870, -0.6040, -1.4970, -2.2750, -2.6410, -3.0190},
-		{-0.0040, -0.0200, -0.0400, -0.0800, -0.1580, -0.2320, -0.2970, -0.3490, -0.3810, -0.3810, -0.3610, -0.9340, -1.8400, -2.2730, -2.6920},
-		{-0.0030, -0.0130, -0.0260, -0.0520, -0.1000, -0.1420, -0.1770, -0.2030, -0.2180, -0.2180, -0.1780, -0.3000, -1.0660, -1.5920, -2.0860},
-		{-0.0020, -0.0080, -0.0160, -0.0320, -0.0600, -0.0830, -0.1000, -0.1110, -0.1150, -0.1280, -0.0700, -0.0440, -0.5040, -1.0120, -1.5470},
-		{-0.0010, -0.0050, -0.0090, -0.0180, -0.0320, -0.0420, -0.0480, -0.0490, -0.0460, -0.0320, 0.0080, 0.0780, -0.1420, -0.5560, -1.0800},
-		{-0.0000, -0.0020, -0.0040, -0.0070, -0.0120, -0.0130, -0.0110, -0.0050, 0.0040, 0.0230, 0.0650, 0.1510, 0.0820, -0.2170, -0.6890},
-		{-0.0000, -0.0000, -0.0000, -0.0000, 0.0030, 0.0090, 0.0170, 0.0270, 0.0400, 0.0630, 0.1090, 0.2020, 0.2230, 0.0280, -0.3690},
-		{0.0000, 0.0010, 0.0030, 0.0060, 0.0150, 0.0250, 0.0370, 0.0510, 0.0670, 0.0940, 0.1430, 0.2410, 0.3170, 0.2030, -0.1120},
-		{0.0010, 0.0030, 0.0050, 0.0110, 0.0230, 0.0370, 0.0530, 0.0700, 0.0880, 0.1170, 0.1690, 0.2710, 0.3810, 0.3300, 0.0920},
-		{0.0010, 0.0030, 0.0070, 0.0150, 0.0300, 0.0470, 0.0650, 0.0850, 0.1050, 0.1360, 0.1900, 0.2950, 0.4280, 0.4240, 0.2550},
-		{0.0010, 0.0050, 0.0100, 0.0200, 0.0400, 0.0620, 0.0830, 0.1060, 0.1280, 0.1630, 0.2210, 0.3310, 0.4930, 0.5510, 0.4890},
-		{0.0010, 0.0060, 0.0120, 0.0230, 0.0470, 0.0710, 0.0950, 0.1200, 0.1440, 0.1810, 0.2420, 0.3560, 0.5350, 0.6310, 0.6450},
-		{0.0010, 0.0060, 0.0130, 0.0260, 0.0520, 0.0780, 0.1040, 0.1300, 0.1560, 0.1940, 0.2570, 0.3760, 0.5670, 0.6870, 0.7540},
-		{0.0010, 0.0070, 0.0140, 0.0280, 0.0550, 0.0820, 0.1100, 0.1370, 0.1640, 0.2040, 0.2690, 0.3910, 0.5910, 0.7290, 0.8360},
-		{0.0010, 0.0070, 0.0140, 0.0290, 0.0580, 0.0860, 0.1140, 0.1420, 0.1700, 0.2110, 0.2780, 0.4030, 0.6110, 0.7630, 0.8990},
-		{0.0020, 0.0080, 0.0160, 0.0310, 0.0620, 0.0920, 0.1220, 0.1520, 0.1810, 0.2240, 0.2940, 0.4250, 0.6500, 0.8270, 1.0150},
-		{0.0020, 0.0080, 0.0160, 0.0320, 0.0640, 0.0960, 0.1270, 0.1580, 0.1880, 0.2330, 0.3060, 0.4420, 0.6800, 0.8740, 1.0970},
+		{-11.0980, -11.0960, -11.0950, -11.0910, -11.0830, -11.0760, -11.0690, -11.0620, -11.0550, -11.0440, -11.0270, -10.9920, -10.9350, -10.8720, -10.7810, -10.7228, -10.6655},
+		{-10.6560, -10.6550, -10.6540, -10.6530, -10.6500, -10.6460, -10.6430, -10.6400, -10.6370, -10.6320, -10.6240, -10.6090, -10.5810, -10.5540, -10.5290, -10.5107, -10.4935},
+		{-10.1210, -10.1210, -10.1210, -10.1200, -10.1210, -10.1210, -10.1210, -10.1210, -10.1210, -10.1210, -10.1220, -10.1230, -10.1280, -10.1350, -10.1500, -10.1623, -10.1762},
+		{-9.5150, -9.5150, -9.5160, -9.5170, -9.5190, -9.5210, -9.5230, -9.5250, -9.5270, -9.5310, -9.5370, -9.5490, -9.5760, -9.6110, -9.6630, -9.6996, -9.7378},
+		{-8.8680, -8.8690, -8.8700, -8.8720, -8.8760, -8.8800, -8.8840, -8.8880, -8.8920, -8.8990, -8.9090, -8.9320, -8.9780, -9.0300, -9.1110, -9.1672, -9.2249},
+		{-0.0800, -8.2110, -8.2120, -8.2150, -8.2210, -8.2260, -8.2320, -8.2380, -8.2430, -8.2520, -8.2670, -8.2980, -8.3600, -8.4250, -8.5310, -8.6037, -8.6772},
+		{-0.0590, -7.5680, -7.5700, -7.5730, -7.5790, -7.5850, -7.5910, -7.5960, -7.6030, -7.6140, -7.6320, -7.6690, -7.7450, -7.8240, -7.9500, -8.0359, -8.1225},
+		{-0.0450, -0.2470, -6.9490, -6.9520, -6.9590, -6.9660, -6.9730, -6.9800, -6.9870, -6.9970, -7.0170, -7.0590, -7.1470, -7.2390, -7.3810, -7.4798, -7.5777},
+		{-0.0340, -0.1850, -0.4150, -6.3600, -6.3670, -6.3730, -6.3810, -6.3880, -6.3950, -6.4070, -6.4290, -6.4750, -6.5740, -6.6770, -6.8370, -6.9445, -7.0524},
+		{-0.0270, -0.1420, -0.3060, -5.7960, -5.8020, -5.8090, -5.8160, -5.8240, -5.8320, -5.8450, -5.8680, -5.9180, -6.0270, -6.1420, -6.3180, -6.4344, -6.5513},
+		{-0.0210, -0.1100, -0.2340, -0.5420, -5.2660, -5.2710, -5.2780, -5.2850, -5.2930, -5.3060, -5.3300, -5.3850, -5.5060, -5.6320, -5.8240, -5.9509, -6.0762},
+		{-0.0170, -0.0870, -0.1820, -0.4010, -4.7530, -4.7540, -4.7580, -4.7630, -4.7710, -4.7840, -4.8100, -4.8720, -5.0000, -5.1490, -5.3580, -5.4937, -5.6271},
+		{-0.0140, -0.0700, -0.1440, -0.3080, -0.7510, -4.2540, -4.2480, -4.2490, -4.2550, -4.2680, -4.2980, -4.3710, -4.5300, -4.6880, -4.9160, -5.0618, -5.2031},
+		{-0.0120, -0.0610, -0.1260, -0.2650, -0.6120, -1.2360, -3.9420, -3.9340, -3.9370, -3.9510, -3.9870, -4.0730, -4.2510, -4.4220, -4.6620, -4.8140, -4.9602},
+		{-0.0110, -0.0560, -0.1150, -0.2410, -0.5420, -0.9940, -3.7370, -3.7120, -3.7130, -3.7300, -3.7730, -3.8730, -4.0680, -4.2480, -4.4970, -4.6534, -4.8029},
+		{-0.0100, -0.0520, -0.1050, -0.2190, -0.4830, -0.8370, -1.6160, -3.4700, -3.4670, -3.4920, -3.5510, -3.6700, -3.8850, -4.0770, -4.3360, -4.4963, -4.6491},
+		{-0.0100, -0.0500, -0.1010, -0.2090, -0.4570, -0.7760, -1.3240, -3.3320, -3.3270, -3.3630, -3.4340, -3.5680, -3.7950, -3.9920, -4.2570, -4.4191, -4.5735},
+		{-0.0090, -0.0480, -0.0970, -0.2000, -0.4330, -0.7220, -1.1540, -3.1640, -3.1640, -3.2230, -3.3130, -3.4640, -3.7050, -3.9090, -4.1780, -4.3426, -4.4987},
+		{-0.0090, -0.0460, -0.0930, -0.1910, -0.4100, -0.6750, -1.0340, -2.4710, -2.9520, -3.0650, -3.1860, -3.3580, -3.6150, -3.8250, -4.1000, -4.2670, -4.4248},
+		{-0.0090, -0.0440, -0.0890, -0.1830, -0.3890, -0.6320, -0.9400, -1.3750, -2.5950, -2.8800, -3.0510, -3.2510, -3.5250, -3.7420, -4.0230, -4.1922, -4.3517},
+		{-0.0080, -0.0420, -0.0850, -0.1750, -0.3700, -0.5940, -0.8630, -1.1800, -1.7230, -2.6500, -2.9060, -3.1420, -3.4350, -3.6610, -3.9470, -4.1182, -4.2794},
+		{-0.0070, -0.0370, -0.0750, -0.1530, -0.3180, -0.4980, -0.6910, -0.8770, -0.8780, -1.4960, -2.3810, -2.8000, -3.1670, -3.4180, -3.7220, -3.9009, -4.0672},
+		{-0.0060, -0.0300, -0.0610, -0.1230, -0.2510, -0.3810, -0.5070, -0.6170, -0.6730, -0.6170, -1.2610, -2.1670, -2.7200, -3.0230, -3.3620, -3.5535, -3.7286},
+		{-0.0050, -0.0250, -0.0500, -0.0990, -0.1990, -0.2960, -0.3850, -0.4590, -0.5030, -0.4870, -0.6040, -1.4970, -2.2750, -2.6410, -3.0190, -3.2236, -3.4077},
+		{-0.0040, -0.0200, -0.0400, -0.0800, -0.1580, -0.2320, -0.2970, -0.3490, -0.3810, -0.3810, -0.3610, -0.9340, -1.8400, -2.2730, -2.6920, -2.9102, -3.1034},
+		{-0.0030, -0.0130, -0.0260, -0.0520, -0.1000, -0.1420, -0.1770, -0.2030, -0.2180, -0.2180, -0.1780, -0.3000, -1.0660, -1.5920, -2.0860, -2.3305, -2.5410},
+		{-0.0020, -0.0080, -0.0160, -0.0320, -0.0600, -0.0830, -0.1000, -0.1110, -0.1150, -0.1280, -0.0700, -0.0440, -0.5040, -1.0120, -1.5470, -1.8116, -2.0362},
+		{-0.0010, -0.0050, -0.0090, -0.0180, -0.0320, -0.0420, -0.0480, -0.0490, -0.0460, -0.0320, 0.0080, 0.0780, -0.1420, -0.5560, -1.0800, -1.3535, -1.5859},
+		{-0.0000, -0.0020, -0.0040, -0.0070, -0.0120, -0.0130, -0.0110, -0.0050, 0.0040, 0.0230, 0.0650, 0.1510, 0.0820, -0.2170, -0.6890, -0.9561, -1.1883},
+		{-0.0000, -0.0000, -0.0000, -0.0000, 0.0030, 0.0090, 0.0170, 0.0270, 0.0400, 0.0630, 0.1090, 0.2020, 0.2230, 0.0280, -0.3690, -0.6175, -0.8411},
+		{0.0000, 0.0010, 0.0030, 0.0060, 0.0150, 0.0250, 0.0370, 0.0510, 0.0670, 0.0940, 0.1430, 0.2410, 0.3170, 0.2030, -0.1120, -0.3333, -0.5414},
+		{0.0010, 0.0030, 0.0050, 0.0110, 0.0230, 0.0370, 0.0530, 0.0700, 0.0880, 0.1170, 0.1690, 0.2710, 0.3810, 0.3300, 0.0920, -0.0973, -0.2850},
+		{0.0010, 0.0030, 0.0070, 0.0150, 0.0300, 0.0470, 0.0650, 0.0850, 0.1050, 0.1360, 0.1900, 0.2950, 0.4280, 0.4240, 0.2550, 0.0976, -0.0668},
+		{0.0010, 0.0050, 0.0100, 0.0200, 0.0400, 0.0620, 0.0830, 0.1060, 0.1280, 0.1630, 0.2210, 0.3310, 0.4930, 0.5510, 0.4890, 0.3921, 0.2754},
+		{0.0010, 0.0060, 0.0120, 0.0230, 0.0470, 0.0710, 0.0950, 0.1200, 0.1440, 0.1810, 0.2420, 0.3560, 0.5350, 0.6310, 0.6450, 0.5971, 0.5235},
+		{0.0010, 0.0060, 0.0130, 0.0260, 0.0520, 0.0780, 0.1040, 0.1300, 0.1560, 0.1940, 0.2570, 0.3760, 0.5670, 0.6870, 0.7540, 0.7448, 0.7074},
+		{0.0010, 0.0070, 0.0140, 0.0280, 0.0550, 0.0820, 0.1100, 0.1370, 0.1640, 0.2040, 0.2690, 0.3910, 0.5910, 0.7290, 0.8360, 0.8558, 0.8479},
+		{0.0010, 0.0070, 0.0140, 0.0290, 0.0580, 0.0860, 0.1140, 0.1420, 0.1700, 0.2110, 0.2780, 0.4030, 0.6110, 0.7630, 0.8990, 0.9427, 0.9588},
+		{0.0020, 0.0080, 0.0160, 0.0310, 0.0620, 0.0920, 0.1220, 0.1520, 0.1810, 0.2240, 0.2940, 0.4250, 0.6500, 0.8270, 1.0150, 1.0991, 1.1584},
+		{0.0020, 0.0080, 0.0160, 0.0320, 0.0640, 0.0960, 0.1270, 0.1580, 0.1880, 0.2330, 0.3060, 0.4420, 0.6800, 0.8740, 1.0970, 1.2092, 1.2978},
 	},
 }
 
 // S0Table contains combined high pressure and low pressure data for the base residual entropy for the lee/Kesler correlation ((S^R)^0/R)
 var S0Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{-11.6140, -10.0080, -9.3190, -8.6350, -7.9610, -7.5740, -7.3040, -7.0990, -6.9350, -6.7400, -6.4970, -6.1800, -5.8470, -5.6830, -5.5780},
-		{-11.1850, -9.5790, -8.8900, -8.2050, -7.5290, -7.1400, -6.8690, -6.6630, -6.4970, -6.2990, -6.0520, -5.7280, -5.3760, -5.1940, -5.0600},
-		{-10.8020, -9.1960, -8.5060, -7.8210, -7.1440, -6.7550, -6.4830, -6.2750, -6.1090, -5.9090, -5.6600, -5.3300, -4.9670, -4.7720, -4.6190},
-		{-10.4530, -8.8470, -8.1570, -7.4720, -6.7940, -6.4040, -6.1320, -5.9240, -5.7570, -5.5570, -5.3060, -4.9740, -4.6030, -4.4010, -4.2340},
-		{-10.1370, -8.5310, -7.8410, -7.1560, -6.4790, -6.0890, -5.8160, -5.6080, -5.4410, -5.2400, -4.9890, -4.6560, -4.2820, -4.0740, -3.8990},
-		{-0.0380, -8.2450, -7.5550, -6.8700, -6.1930, -5.8030, -5.5310, -5.3240, -5.1570, -4.9560, -4.7060, -4.3730, -3.9980, -3.7880, -3.6070},
-		{-0.0290, -7.9830, -7.2940, -6.6100, -5.9330, -5.5440, -5.2730, -5.0660, -4.9000, -4.7000, -4.4510, -4.1200, -3.7470, -3.5370, -3.3530},
-		{-0.0230, -0.1220, -7.0520, -6.3680, -5.6940, -5.3060, -5.0360, -4.8300, -4.6650, -4.4670, -4.2200, -3.8920, -3.5230, -3.3150, -3.1310},
-		{-0.0180, -0.0960, -0.2060, -6.1400, -5.4670, -5.0820, -4.8140, -4.6100, -4.4460, -4.2500, -4.0070, -3.6840, -3.3220, -3.1170, -2.9350},
-		{-0.0150, -0.0780, -0.1640, -5.9170, -5.2480, -4.8660, -4.6000, -4.3990, -4.2380, -4.0450, -3.8070, -3.4910, -3.1380, -2.9390, -2.7610},
-		{-0.0130, -0.0640, -0.1340, -0.2940, -5.0260, -4.6940, -4.3880, -4.1910, -4.0340, -3.8460, -3.6150, -3.3100, -2.9700, -2.7770, -2.6050},
-		{-0.0110, -0.0540, -0.1110, -0.2390, -4.7850, -4.4180, -4.1660, -3.9760, -3.8250, -3.6460, -3.4250, -3.1350, -2.8120, -2.6290, -2.4630},
-		{-0.0090, -0.0460, -0.0940, -0.1990, -0.4630, -4.1450, -3.9120, -3.7380, -3.5990, -3.4340, -3.2310, -2.9640, -2.6630, -2.4910, -2.3340},
-		{-0.0080, -0.0420, -0.0850, -0.1790, -0.4080, -0.7500, -3.7230, -3.5690, -3.4440, -3.2950, -3.1080, -2.8600, -2.5770, -2.4120, -2.2620},
-		{-0.0080, -0.0390, -0.0800, -0.1680, -0.3770, -0.6710, -3.5560, -3.4330, -3.3260, -3.1930, -3.0230, -2.7900, -2.5200, -2.3620, -2.2150},
-		{-0.0070, -0.0370, -0.0750, -0.1570, -0.3500, -0.6070, -1.0560, -3.2590, -3.1880, -3.0810, -2.9320, -2.7190, -2.4630, -2.3120, -2.1700},
-		{-0.0070, -0.0360, -0.0730, -0.1530, -0.3370, -0.5800, -0.9710, -3.1420, -3.1060, -3.0190, -2.8840, -2.6820, -2.4360, -2.2870, -2.1480},
-		{-0.0070, -0.0350, -0.0710, -0.1480, -0.3260, -0.5550, -0.9030, -2.9720, -3.0100, -2.9530, -2.8350, -2.6460, -2.4080, -2.2630, -2.1260},
-		{-0.0070, -0.0340, -0.0690, -0.1440, -0.3150, -0.5320, -0.8470, -2.1780, -2.8930, -2.8790, -2.7840, -2.6090, -2.3800, -2.2390, -2.1050},
-		{-0.0070, -0.0330, -0.0670, -0.1390, -0.3040, -0.5100, -0.7990, -1.3910, -2.7360, -2.7980, -2.7300, -2.5710, -2.3520, -2.2150, -2.0830},
-		{-0.0060, -0.0320, -0.0650, -0.1350, -0.2940, -0.4910, -0.7570, -1.2250, -2.4950, -2.7060, -2.6730, -2.5330, -2.3250, -2.1910, -2.0620},
-		{-0.0060, -0.0300, -0.0600, -0.1240, -0.2670, -0.4390, -0.6560, -0.9650, -1.5230, -2.3280, -2.4830, -2.4150, -2.2420, -2.1210, -2.0010},
-		{-0.0050, -0.0260, -0.0530, -0.1080, -0.2300, -0.3710, -0.5370, -0.7420, -1.0120, -1.5570, -2.0810, -2.2020, -2.1040, -2.0070, -1.9030},
-		{-0.0050, -0.0230, -0.0470, -0.0960, -0.2010, -0.3190, -0.4520, -0.6070, -0.7900, -1.1260, -1.6490, -1.9680, -1.9660, -1.8970, -1.8100},
-		{-0.0040, -0.0210, -0.0420, -0.0850, -0.1770, -0.2770, -0.3890, -0.5120, -0.6510, -0.8900, -1.3080, -1.7270, -1.8270, -1.7890, -1.7220},
-		{-0.0030, -0.0170, -0.0330, -0.0680, -0.1400, -0.2170, -0.2980, -0.3850, -0.4780, -0.6280, -0.8910, -1.2990, -1.5540, -1.5810, -1.5560},
-		{-0.0030, -0.0140, -0.0270, -0.0560, -0.1140, -0.1740, -0.2370, -0.3030, -0.3750, -0.4780, -0.6630, -0.9900, -1.3030, -1.3860, -1.4020},
-		{-0.0020, -0.0110, -0.0230, -0.0460, -0.0940, -0.1430, -0.1940, -0.2460, -0.2990, -0.3810, -0.5200, -0.7770, -1.0880, -1.2080, -1.2600},
-		{-0.0020, -0.0100, -0.0190, -0.0390, -0.0790, -0.1200, -0.1620, -0.2040, -0.2470, -0.3120, -0.4210, -0.6280, -0.9130, -1.0500, -1.1300},
-		{-0.0020, -0.0080, -0.0170, -0.0330, -0.0670, -0.1020, -0.1370, -0.1720, -0.2080, -0.2610, -0.3500, -0.5190, -0.7730, -0.9150, -1.0130},
-		{-0.0010, -0.0070, -0.0140, -0.0290, -0.0580, -0.0880, -0.1170, -0.1470, -0.1770, -0.2220, -0.2960, -0.4380, -0.6610, -0.7990, -0.9080},
-		{-0.0010, -0.0060, -0.0130, -0.0250, -0.0510, -0.0760, -0.1020, -0.1270, -0.1530, -0.1910, -0.2550, -0.3750, -0.5700, -0.7020, -0.8150},
-		{-0.0010, -0.0060, -0.0110, -0.0220, -0.0440, -0.0670, -0.0890, -0.1110, -0.1340, -0.1670, -0.2210, -0.6250, -0.4970, -0.6200, -0.7330},
-		{-0.0010, -0.0040, -0.0090, -0.0180, -0.0350, -0.0530, -0.0700, -0.0870, -0.1050, -0.1300, -0.1720, -0.2510, -0.3880, -0.4920, -0.5990},
-		{-0.0010, -0.0040, -0.0070, -0.0140, -0.0280, -0.0420, -0.0560, -0.0700, -0.0840, -0.1040, -0.1380, -0.2010, -0.3110, -0.3990, -0.4960},
-		{-0.0010, -0.0030, -0.0060, -0.0120, -0.0230, -0.0350, -0.0460, -0.0580, -0.0690, -0.0860, -0.1130, -0.1640, -0.2550, -0.3290, -0.4160},
-		{-0.0000, -0.0020, -0.0050, -0.0100, -0.0200, -0.0290, -0.0390, -0.0480, -0.0580, -0.0720, -0.0940, -0.1370, -0.2130, -0.2770, -0.3530},
-		{-0.0000, -0.0020, -0.0040, -0.0080, -0.0170, -0.0250, -0.0330, -0.0410, -0.0490, -0.0610, -0.0800, -0.1160, -0.1810, -0.2360, -0.3030},
-		{-0.0000, -0.0010, -0.0030, -0.0060, -0.0120, -0.0170, -0.0230, -0.0290, -0.0340, -0.0420, -0.0560, -0.0810, -0.1260, -0.1660, -0.2160},
-		{-0.0000, -0.0010, -0.0020, -0.0040, -0.0090, -0.0130, -0.0170, -0.0210, -0.0250, -0.0310, -0.0410, -0.0590, -0.0930, -0.1230, -0.1620},
+		{-11.6140, -10.0080, -9.3190, -8.6350, -7.9610, -7.5740, -7.3040, -7.0990, -6.9350, -6.7400, -6.4970, -6.1800, -5.8470, -5.6830, -5.5780, -5.5591, -5.5650},
+		{-11.1850, -9.5790, -8.8900, -8.2050, -7.5290, -7.1400, -6.8690, -6.6630, -6.4970, -6.2990, -6.0520, -5.7280, -5.3760, -5.1940, -5.0600, -5.0211, -5.0068},
+		{-10.8020, -9.1960, -8.5060, -7.8210, -7.1440, -6.7550, -6.4830, -6.2750, -6.1090, -5.9090, -5.6600, -5.3300, -4.9670, -4.7720, -4.6190, -4.5662, -4.5378},
+		{-10.4530, -8.8470, -8.1570, -7.4720, -6.7940, -6.4040, -6.1320, -5.9240, -5.7570, -5.5570, -5.3060, -4.9740, -4.6030, -4.4010, -4.2340, -4.1719, -4.1336},
+		{-10.1370, -8.5310, -7.8410, -7.1560, -6.4790, -6.0890, -5.8160, -5.6080, -5.4410, -5.2400, -4.9890, -4.6560, -4.2820, -4.0740, -3.8990, -3.8302, -3.7850},
+		{-0.0380, -8.2450, -7.5550, -6.8700, -6.1930, -5.8030, -5.5310, -5.3240, -5.1570, -4.9560, -4.7060, -4.3730, -3.9980, -3.7880, -3.6070, -3.5345, -3.4845},
+		{-0.0290, -7.9830, -7.2940, -6.6100, -5.9330, -5.5440, -5.2730, -5.0660, -4.9000, -4.7000, -4.4510, -4.1200, -3.7470, -3.5370, -3.3530, -3.2780, -3.2248},
+		{-0.0230, -0.1220, -7.0520, -6.3680, -5.6940, -5.3060, -5.0360, -4.8300, -4.6650, -4.4670, -4.2200, -3.8920, -3.5230, -3.3150, -3.1310, -3.0544, -2.9994},
+		{-0.0180, -0.0960, -0.2060, -6.1400, -5.4670, -5.0820, -4.8140, -4.6100, -4.4460, -4.2500, -4.0070, -3.6840, -3.3220, -3.1170, -2.9350, -2.8582, -2.8024},
+		{-0.0150, -0.0780, -0.1640, -5.9170, -5.2480, -4.8660, -4.6000, -4.3990, -4.2380, -4.0450, -3.8070, -3.4910, -3.1380, -2.9390, -2.7610, -2.6848, -2.6290},
+		{-0.0130, -0.0640, -0.1340, -0.2940, -5.0260, -4.6940, -4.3880, -4.1910, -4.0340, -3.8460, -3.6150, -3.3100, -2.9700, -2.7770, -2.6050, -2.5303, -2.4752},
+		{-0.0110, -0.0540, -0.1110, -0.2390, -4.7850, -4.4180, -4.1660, -3.9760, -3.8250, -3.6460, -3.4250, -3.1350, -2.8120, -2.6290, -2.4630, -2.3913, -2.3377},
+		{-0.0090, -0.0460, -0.0940, -0.1990, -0.4630, -4.1450, -3.9120, -3.7380, -3.5990, -3.4340, -3.2310, -2.9640, -2.6630, -2.4910, -2.3340, -2.2654, -2.2137},
+		{-0.0080, -0.0420, -0.0850, -0.1790, -0.4080, -0.7500, -3.7230, -3.5690, -3.4440, -3.2950, -3.1080, -2.8600, -2.5770, -2.4120, -2.2620, -2.1951, -2.1449},
+		{-0.0080, -0.0390, -0.0800, -0.1680, -0.3770, -0.6710, -3.5560, -3.4330, -3.3260, -3.1930, -3.0230, -2.7900, -2.5200, -2.3620, -2.2150, -2.1502, -2.1010},
+		{-0.0070, -0.0370, -0.0750, -0.1570, -0.3500, -0.6070, -1.0560, -3.2590, -3.1880, -3.0810, -2.9320, -2.7190, -2.4630, -2.3120, -2.1700, -2.1068, -2.0587},
+		{-0.0070, -0.0360, -0.0730, -0.1530, -0.3370, -0.5800, -0.9710, -3.1420, -3.1060, -3.0190, -2.8840, -2.6820, -2.4360, -2.2870, -2.1480, -2.0856, -2.0381},
+		{-0.0070, -0.0350, -0.0710, -0.1480, -0.3260, -0.5550, -0.9030, -2.9720, -3.0100, -2.9530, -2.8350, -2.6460, -2.4080, -2.2630, -2.1260, -2.0647, -2.0178},
+		{-0.0070, -0.0340, -0.0690, -0.1440, -0.3150, -0.5320, -0.8470, -2.1780, -2.8930, -2.8790, -2.7840, -2.6090, -2.3800, -2.2390, -2.1050, -2.0441, -1.9979},
+		{-0.0070, -0.0330, -0.0670, -0.1390, -0.3040, -0.5100, -0.7990, -1.3910, -2.7360, -2.7980, -2.7300, -2.5710, -2.3520, -2.2150, -2.0830, -2.0238, -1.9782},
+		{-0.0060, -0.0320, -0.0650, -0.1350, -0.2940, -0.4910, -0.7570, -1.2250, -2.4950, -2.7060, -2.6730, -2.5330, -2.3250, -2.1910, -2.0620, -2.0038, -1.9589},
+		{-0.0060, -0.0300, -0.0600, -0.1240, -0.2670, -0.4390, -0.6560, -0.9650, -1.5230, -2.3280, -2.4830, -2.4150, -2.2420, -2.1210, -2.0010, -1.9456, -1.9027},
+		{-0.0050, -0.0260, -0.0530, -0.1080, -0.2300, -0.3710, -0.5370, -0.7420, -1.0120, -1.5570, -2.0810, -2.2020, -2.1040, -2.0070, -1.9030, -1.8535, -1.8143},
+		{-0.0050, -0.0230, -0.0470, -0.0960, -0.2010, -0.3190, -0.4520, -0.6070, -0.7900, -1.1260, -1.6490, -1.9680, -1.9660, -1.8970, -1.8100, -1.7668, -1.7318},
+		{-0.0040, -0.0210, -0.0420, -0.0850, -0.1770, -0.2770, -0.3890, -0.5120, -0.6510, -0.8900, -1.3080, -1.7270, -1.8270, -1.7890, -1.7220, -1.6849, -1.6543},
+		{-0.0030, -0.0170, -0.0330, -0.0680, -0.1400, -0.2170, -0.2980, -0.3850, -0.4780, -0.6280, -0.8910, -1.2990, -1.5540, -1.5810, -1.5560, -1.5329, -1.5117},
+		{-0.0030, -0.0140, -0.0270, -0.0560, -0.1140, -0.1740, -0.2370, -0.3030, -0.3750, -0.4780, -0.6630, -0.9900, -1.3030, -1.3860, -1.4020, -1.3941, -1.3829},
+		{-0.0020, -0.0110, -0.0230, -0.0460, -0.0940, -0.1430, -0.1940, -0.2460, -0.2990, -0.3810, -0.5200, -0.7770, -1.0880, -1.2080, -1.2600, -1.2666, -1.2654},
+		{-0.0020, -0.0100, -0.0190, -0.0390, -0.0790, -0.1200, -0.1620, -0.2040, -0.2470, -0.3120, -0.4210, -0.6280, -0.9130, -1.0500, -1.1300, -1.1496, -1.1578},
+		{-0.0020, -0.0080, -0.0170, -0.0330, -0.0670, -0.1020, -0.1370, -0.1720, -0.2080, -0.2610, -0.3500, -0.5190, -0.7730, -0.9150, -1.0130, -1.0428, -1.0592},
+		{-0.0010, -0.0070, -0.0140, -0.0290, -0.0580, -0.0880, -0.1170, -0.1470, -0.1770, -0.2220, -0.2960, -0.4380, -0.6610, -0.7990, -0.9080, -0.9458, -0.9690},
+		{-0.0010, -0.0060, -0.0130, -0.0250, -0.0510, -0.0760, -0.1020, -0.1270, -0.1530, -0.1910, -0.2550, -0.3750, -0.5700, -0.7020, -0.8150, -0.8584, -0.8869},
+		{-0.0010, -0.0060, -0.0110, -0.0220, -0.0440, -0.0670, -0.0890, -0.1110, -0.1340, -0.1670, -0.2210, -0.6250, -0.4970, -0.6200, -0.7330, -0.7800, -0.8124},
+		{-0.0010, -0.0040, -0.0090, -0.0180, -0.0350, -0.0530, -0.0700, -0.0870, -0.1050, -0.1300, -0.1720, -0.2510, -0.3880, -0.4920, -0.5990, -0.6476, -0.6842},
+		{-0.0010, -0.0040, -0.0070, -0.0140, -0.0280, -0.0420, -0.0560, -0.0700, -0.0840, -0.1040, -0.1380, -0.2010, -0.3110, -0.3990, -0.4960, -0.5428, -0.5802},
+		{-0.0010, -0.0030, -0.0060, -0.0120, -0.0230, -0.0350, -0.0460, -0.0580, -0.0690, -0.0860, -0.1130, -0.1640, -0.2550, -0.3290, -0.4160, -0.4597, -0.4960},
+		{-0.0000, -0.0020, -0.0050, -0.0100, -0.0200, -0.0290, -0.0390, -0.0480, -0.0580, -0.0720, -0.0940, -0.1370, -0.2130, -0.2770, -0.3530, -0.3934, -0.4276},
+		{-0.0000, -0.0020, -0.0040, -0.0080, -0.0170, -0.0250, -0.0330, -0.0410, -0.0490, -0.0610, -0.0800, -0.1160, -0.1810, -0.2360, -0.3030, -0.3399, -0.3717},
+		{-0.0000, -0.0010, -0.0030, -0.0060, -0.0120, -0.0170, -0.0230, -0.0290, -0.0340, -0.0420, -0.0560, -0.0810, -0.1260, -0.1660, -0.2160, -0.2448, -0.2706},
+		{-0.0000, -0.0010, -0.0020, -0.0040, -0.0090, -0.0130, -0.0170, -0.0210, -0.0250, -0.0310, -0.0410, -0.0590, -0.0930, -0.1230, -0.1620, -0.1843, -0.2051},
 	},
 }
 
 // S1Table contains combined high pressure and low pressure data for the departure residual entropy for the lee/Kesler correlation ((S^R)^1/R)
 var S1Table = &table{
-	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000},
+	Pr: []float64{0.0100, 0.0500, 0.1000, 0.2000, 0.4000, 0.6000, 0.8000, 1.0000, 1.2000, 1.5000, 2.0000, 3.0000, 5.0000, 7.0000, 10.0000, 12.0000, 14.0000},
 	Tr: []float64{0.3000, 0.3500, 0.4000, 0.4500, 0.5000, 0.5500, 0.6000, 0.6500, 0.7000, 0.7500, 0.8000, 0.8500, 0.9000, 0.9300, 0.9500, 0.9700, 0.9800, 0.9900, 1.0000, 1.0100, 1.0200, 1.0500, 1.1000, 1.1500, 1.2000, 1.3000, 1.4000, 1.5000, 1.6000, 1.7000, 1.8000, 1.9000, 2.0000, 2.2000, 2.4000, 2.6000, 2.8000, 3.0000, 3.5000, 4.0000},
 	Values: [][]float64{
-		{-16.7820, -16.7740, -16.7640, -16.7440, -16.7050, -16.6650, -16.6260, -16.5860, -16.5470, -16.4880, -16.3900, -16.1950, -15.8370, -15.4680, -14.9250},
-		{-15.4130, -15.4080, -15.4010, -15.3870, -15.3590, -15.3330, -15.3050, -15.2780, -15.2510, -15.2110, -15.1440, -15.0110, -14.7510, -14.4960, -14.1530},
-		{-13.9900, -13.9860, -13.9810, -13.9720, -13.9530, -13.9340, -13.9150, -13.8960, -13.8770, -13.8490, -13.8030, -13.7140, -13.5410, -13.3760, -13.1440},
-		{-12.5640, -12.5610, -12.5580, -12.5510, -12.5370, -12.5230, -12.5090, -12.4960, -12.4820, -12.4620, -12.4300, -12.3670, -12.2480, -12.1450, -11.9990},
-		{-11.2020, -11.2000, -11.1970, -11.0920, -11.0820, -11.1720, -11.1620, -11.1530, -11.1430, -11.1290, -11.1070, -11.0630, -10.9850, -10.9200, -10.8360},
-		{-0.1150, -9.9480, -9.9460, -9.9420, -9.9350, -9.9280, -9.9210, -9.9140, -9.9070, -9.8970, -9.8820, -9.8530, -9.8060, -9.7690, -9.7320},
-		{-0.0780, -8.8280, -8.8260, -8.8230, -8.8170, -8.8110, -8.8060, -8.7990, -8.7940, -8.7870, -8.7770, -8.7600, -8.7360, -8.7230, -8.7200},
-		{-0.0550, -0.3090, -7.8320, -7.8290, -7.8240, -7.8190, -7.8150, -7.5100, -7.8070, -7.8010, -7.7940, -7.7840, -7.7790, -7.7850, -7.8110},
-		{-0.0400, -0.2160, -0.4910, -6.9510, -6.9450, -6.9410, -6.9370, -6.9330, -6.9300, -6.9260, -6.9220, -6.9190, -6.9290, -6.9520, -7.0020},
-		{-0.0290, -0.1560, -0.3400, -6.1730, -6.1670, -6.1620, -6.1580, -6.1550, -6.1520, -6.1490, -6.1470, -6.1490, -6.1740, -6.2130, -6.2850},
-		{-0.0220, -0.1160, -0.2460, -0.5780, -5.4750, -5.4680, -5.4620, -5.4580, -5.4550, -5.4530, -5.4520, -5.4610, -5.5010, -5.5550, -5.6480},
-		{-0.0170, -0.0880, -0.1830, -0.4000, -4.8530, -4.8410, -4.8320, -4.8260, -4.8220, -4.8200, -4.8220, -4.8390, -4.8980, -4.9690, -5.0820},
-		{-0.0130, -0.0680, -0.1400, -0.3010, -0.7440, -4.2690, -4.2490, -4.2380, -4.2320, -4.2300, -4.2360, -4.2670, -4.3510, -4.4420, -4.5780},
-		{-0.0110, -0.0580, -0.1200, -0.2540, -0.5930, -1.2190, -3.9140, -3.8940, -3.8850, -3.8840, -3.8960, -3.9410, -4.0460, -4.1510, -4.3000},
-		{-0.0100, -0.0530, -0.1090, -0.2280, -0.5170, -0.9610, -3.6970, -3.6580, -3.6470, -3.6480, -3.6690, -3.7280, -3.8510, -3.9660, -4.1250},
-		{-0.0100, -0.0480, -0.0990, -0.2060, -0.4560, -0.7970, -1.5700, -3.4060, -3.3910, -3.4010, -3.4370, -3.5170, -3.6610, -3.7880, -3.9570},
-		{-0.0090, -0.0460, -0.0940, -0.1960, -0.4290, -0.7340, -1.2700, -3.2640, -3.2470, -3.2680, -3.3180, -3.4120, -3.5690, -3.7010, -3.8750},
-		{-0.0090, -0.0440, -0.0900, -0.1860, -0.4050, -0.6800, -1.0980, -3.0930, -3.0820, -3.1260, -3.1950, -3.3060, -3.4770, -3.6160, -3.7960},
-		{-0.0080, -0.0420, -0.0860, -0.1770, -0.3820, -0.6320, -0.9770, -2.3990, -2.8680, -2.9670, -3.0670, -3.2000, -3.3870, -3.5320, -3.7170},
-		{-0.0080, -0.0400, -0.0820, -0.1690, -0.3610, -0.5900, -0.8830, -1.3060, -2.5130, -2.7840, -2.9330, -3.0940, -3.2970, -3.4500, -3.6400},
-		{-0.0080, -0.0390, -0.0780, -0.1610, -0.3420, -0.5520, -0.8070, -1.1130, -1.6550, -2.5570, -2.7900, -2.9860, -3.2090, -3.3690, -3.5650},
-		{-0.0070, -0.0340, -0.0690, -0.1400, -0.2920, -0.4600, -0.6420, -0.8200, -0.8310, -1.4430, -2.2830, -2.6550, -2.9490, -3.1340, -3.3480},
-		{-0.0050, -0.0280, -0.0550, -0.1120, -0.2290, -0.3500, -0.4700, -0.5770, -0.6400, -0.6180, -1.2410, -2.0670, -2.5340, -2.7670, -3.0130},
-		{-0.0050, -0.0230, -0.0450, -0.0910, -0.1830, -0.2750, -0.3610, -0.4370, -0.4890, -0.5020, -0.6540, -1.4710, -2.1380, -2.4280, -2.7080},
-		{-0.0040, -0.0190, -0.0370, -0.0750, -0.1490, -0.2200, -0.2860, -0.3430, -0.3850, -0.4120, -0.4470, -0.9910, -1.7670, -2.1150, -2.4300},
-		{-0.0030, -0.0130, -0.0260, -0.0520, -0.1020, -0.1480, -0.1900, -0.2260, -0.2540, -0.2820, -0.3000, -0.4810, -1.1470, -1.5690, -1.9440},
-		{-0.0020, -0.0100, -0.0190, -0.0370, -0.0720, -0.1040, -0.1330, -0.1580, -0.1780, -0.2000, -0.2200, -0.2900, -0.7300, -1.1380, -1.5440},
-		{-0.0010, -0.0070, -0.0140, -0.0270, -0.0530, -0.0760, -0.0970, -0.1150, -0.1300, -0.1470, -0.1660, -0.2060, -0.4790, -0.8230, -1.2220},
-		{-0.0010, -0.0050, -0.0110, -0.0210, -0.0400, -0.0570, -0.0730, -0.0860, -0.0980, -0.1120, -0.1290, -0.1590, -0.3340, -0.6040, -0.9690},
-		{-0.0010, -0.0040, -0.0080, -0.0160, -0.0310, -0.0440, -0.0560, -0.0670, -0.0760, -0.0870, -0.1020, -0.1270, -0.2480, -0.4560, -0.7750},
-		{-0.0010, -0.0030, -0.0060, -0.0130, -0.0240, -0.0350, -0.0440, -0.0530, -0.0600, -0.0700, -0.0830, -0.1050, -0.1950, -0.3550, -0.6280},
-		{-0.0010, -0.0030, -0.0050, -0.0100, -0.0190, -0.0280, -0.0360, -0.0430, -0.0490, -0.0570, -0.0690, -0.0890, -0.1600, -0.2860, -0.5180},
-		{-0.0000, -0.0020, -0.0040, -0.0080, -0.0160, -0.0230, -0.0290, -0.0350, -0.0400, -0.0480, -0.0580, -0.0770, -0.1360, -0.2380, -0.4340},
-		{-0.0000, -0.0010, -0.0030, -0.0060, -0.0110, -0.0160, -0.0210, -0.0250, -0.0290, -0.0350, -0.0430, -0.0600, -0.1050, -0.1780, -0.3220},
-		{-0.0000, -0.0010, -0.0020, -0.0040, -0.0080, -0.0120, -0.0150, -0.0190, -0.0220, -0.0270, -0.0340, -0.0480, -0.0860, -0.1430, -0.2540},
-		{-0.0000, -0.0010, -0.0020, -0.0030, -0.0060, -0.0090, -0.0120, -0.0150, -0.0180, -0.0210, -0.0280, -0.0410, -0.0740, -0.1200, -0.2100},
-		{-0.0000, -0.0010, -0.0010, -0.0030, -0.0050, -0.0080, -0.0100, -0.0120, -0.0140, -0.0180, -0.0230, -0.0250, -0.0650, -0.1040, -0.1800},
-		{-0.0000, -0.0010, -0.0010, -0.0020, -0.0040, -0.0060, -0.0080, -0.0100, -0.0120, -0.0150, -0.0200, -0.0310, -0.0580, -0.0930, -0.1580},
-		{-0.0000, -0.0000, -0.0010, -0.0010, -0.0030, -0.0040, -0.0060, -0.0070, -0.0090, -0.0110, -0.0150, -0.0240, -0.0460, -0.0730, -0.1220},
-		{-0.0000, -0.0000, -0.0010, -0.0010, -0.0020, -0.0030, -0.0050, -0.0060, -0.0070, -0.0090, -0.0120, -0.0200, -0.0380, -0.0600, -0.1000},
+		{-16.7820, -16.7740, -16.7640, -16.7440, -16.7050, -16.6650, -16.6260, -16.5860, -16.5470, -16.4880, -16.3900, -16.1950, -15.8370, -15.4680, -14.9250, -14.5725, -14.2240},
+		{-15.4130, -15.4080, -15.4010, -15.3870, -15.3590, -15.3330, -15.3050, -15.2780, -15.2510, -15.2110, -15.1440, -15.0110, -14.7510, -14.4960, -14.1530, -13.9234, -13.6989},
+		{-13.9900, -13.9860, -13.9810, -13.9720, -13.9530, -13.9340, -13.9150, -13.8960, -13.8770, -13.8490, -13.8030, -13.7140, -13.5410, -13.3760, -13.1440, -12.9971, -12.8559},
+		{-12.5640, -12.5610, -12.5580, -12.5510, -12.5370, -12.5230, -12.5090, -12.4960, -12.4820, -12.4620, -12.4300, -12.3670, -12.2480, -12.1450, -11.9990, -11.9091, -11.8255},
+		{-11.2020, -11.2000, -11.1970, -11.0920, -11.0820, -11.1720, -11.1620, -11.1530, -11.1430, -11.1290, -11.1070, -11.0630, -10.9850, -10.9200, -10.8360, -10.7881, -10.7455},
+		{-0.1150, -9.9480, -9.9460, -9.9420, -9.9350, -9.9280, -9.9210, -9.9140, -9.9070, -9.8970, -9.8820, -9.8530, -9.8060, -9.7690, -9.7320, -9.7143, -9.7019},
+		{-0.0780, -8.8280, -8.8260, -8.8230, -8.8170, -8.8110, -8.8060, -8.7990, -8.7940, -8.7870, -8.7770, -8.7600, -8.7360, -8.7230, -8.7200, -8.7260, -8.7365},
+		{-0.0550, -0.3090, -7.8320, -7.8290, -7.8240, -7.8190, -7.8150, -7.5100, -7.8070, -7.8010, -7.7940, -7.7840, -7.7790, -7.7850, -7.8110, -7.8356, -7.8642},
+		{-0.0400, -0.2160, -0.4910, -6.9510, -6.9450, -6.9410, -6.9370, -6.9330, -6.9300, -6.9260, -6.9220, -6.9190, -6.9290, -6.9520, -7.0020, -7.0421, -7.0853},
+		{-0.0290, -0.1560, -0.3400, -6.1730, -6.1670, -6.1620, -6.1580, -6.1550, -6.1520, -6.1490, -6.1470, -6.1490, -6.1740, -6.2130, -6.2850, -6.3380, -6.3937},
+		{-0.0220, -0.1160, -0.2460, -0.5780, -5.4750, -5.4680, -5.4620, -5.4580, -5.4550, -5.4530, -5.4520, -5.4610, -5.5010, -5.5550, -5.6480, -5.7137, -5.7802},
+		{-0.0170, -0.0880, -0.1830, -0.4000, -4.8530, -4.8410, -4.8320, -4.8260, -4.8220, -4.8200, -4.8220, -4.8390, -4.8980, -4.9690, -5.0820, -5.1592, -5.2356},
+		{-0.0130, -0.0680, -0.1400, -0.3010, -0.7440, -4.2690, -4.2490, -4.2380, -4.2320, -4.2300, -4.2360, -4.2670, -4.3510, -4.4420, -4.5780, -4.6653, -4.7508},
+		{-0.0110, -0.0580, -0.1200, -0.2540, -0.5930, -1.2190, -3.9140, -3.8940, -3.8850, -3.8840, -3.8960, -3.9410, -4.0460, -4.1510, -4.3000, -4.3945, -4.4853},
+		{-0.0100, -0.0530, -0.1090, -0.2280, -0.5170, -0.9610, -3.6970, -3.6580, -3.6470, -3.6480, -3.6690, -3.7280, -3.8510, -3.9660, -4.1250, -4.2236, -4.3179},
+		{-0.0100, -0.0480, -0.0990, -0.2060, -0.4560, -0.7970, -1.5700, -3.4060, -3.3910, -3.4010, -3.4370, -3.5170, -3.6610, -3.7880, -3.9570, -4.0599, -4.1577},
+		{-0.0090, -0.0460, -0.0940, -0.1960, -0.4290, -0.7340, -1.2700, -3.2640, -3.2470, -3.2680, -3.3180, -3.4120, -3.5690, -3.7010, -3.8750, -3.9807, -4.0801},
+		{-0.0090, -0.0440, -0.0900, -0.1860, -0.4050, -0.6800, -1.0980, -3.0930, -3.0820, -3.1260, -3.1950, -3.3060, -3.4770, -3.6160, -3.7960, -3.9031, -4.0042},
+		{-0.0080, -0.0420, -0.0860, -0.1770, -0.3820, -0.6320, -0.9770, -2.3990, -2.8680, -2.9670, -3.0670, -3.2000, -3.3870, -3.5320, -3.7170, -3.8271, -3.9299},
+		{-0.0080, -0.0400, -0.0820, -0.1690, -0.3610, -0.5900, -0.8830, -1.3060, -2.5130, -2.7840, -2.9330, -3.0940, -3.2970, -3.4500, -3.6400, -3.7527, -3.8571},
+		{-0.0080, -0.0390, -0.0780, -0.1610, -0.3420, -0.5520, -0.8070, -1.1130, -1.6550, -2.5570, -2.7900, -2.9860, -3.2090, -3.3690, -3.5650, -3.6798, -3.7859},
+		{-0.0070, -0.0340, -0.0690, -0.1400, -0.2920, -0.4600, -0.6420, -0.8200, -0.8310, -1.4430, -2.2830, -2.6550, -2.9490, -3.1340, -3.3480, -3.4697, -3.5809},
+		{-0.0050, -0.0280, -0.0550, -0.1120, -0.2290, -0.3500, -0.4700, -0.5770, -0.6400, -0.6180, -1.2410, -2.0670, -2.5340, -2.7670, -3.0130, -3.1465, -3.2658},
+		{-0.0050, -0.0230, -0.0450, -0.0910, -0.1830, -0.2750, -0.3610, -0.4370, -0.4890, -0.5020, -0.6540, -1.4710, -2.1380, -2.4280, -2.7080, -2.8531, -2.9805},
+		{-0.0040, -0.0190, -0.0370, -0.0750, -0.1490, -0.2200, -0.2860, -0.3430, -0.3850, -0.4120, -0.4470, -0.9910, -1.7670, -2.1150, -2.4300, -2.5863, -2.7214},
+		{-0.0030, -0.0130, -0.0260, -0.0520, -0.1020, -0.1480, -0.1900, -0.2260, -0.2540, -0.2820, -0.3000, -0.4810, -1.1470, -1.5690, -1.9440, -2.1220, -2.2709},
+		{-0.0020, -0.0100, -0.0190, -0.0370, -0.0720, -0.1040, -0.1330, -0.1580, -0.1780, -0.2000, -0.2200, -0.2900, -0.7300, -1.1380, -1.5440, -1.7372, -1.8965},
+		{-0.0010, -0.0070, -0.0140, -0.0270, -0.0530, -0.0760, -0.0970, -0.1150, -0.1300, -0.1470, -0.1660, -0.2060, -0.4790, -0.8230, -1.2220, -1.4209, -1.5857},
+		{-0.0010, -0.0050, -0.0110, -0.0210, -0.0400, -0.0570, -0.0730, -0.0860, -0.0980, -0.1120, -0.1290, -0.1590, -0.3340, -0.6040, -0.9690, -1.1642, -1.3289},
+		{-0.0010, -0.0040, -0.0080, -0.0160, -0.0310, -0.0440, -0.0560, -0.0670, -0.0760, -0.0870, -0.1020, -0.1270, -0.2480, -0.4560, -0.7750, -0.9587, -1.1183},
+		{-0.0010, -0.0030, -0.0060, -0.0130, -0.0240, -0.0350, -0.0440, -0.0530, -0.0600, -0.0700, -0.0830, -0.1050, -0.1950, -0.3550, -0.6280, -0.7962, -0.9468},
+		{-0.0010, -0.0030, -0.0050, -0.0100, -0.0190, -0.0280, -0.0360, -0.0430, -0.0490, -0.0570, -0.0690, -0.0890, -0.1600, -0.2860, -0.5180, -0.6685, -0.8081},
+		{-0.0000, -0.0020, -0.0040, -0.0080, -0.0160, -0.0230, -0.0290, -0.0350, -0.0400, -0.0480, -0.0580, -0.0770, -0.1360, -0.2380, -0.4340, -0.5684, -0.6961},
+		{-0.0000, -0.0010, -0.0030, -0.0060, -0.0110, -0.0160, -0.0210, -0.0250, -0.0290, -0.0350, -0.0430, -0.0600, -0.1050, -0.1780, -0.3220, -0.4276, -0.5326},
+		{-0.0000, -0.0010, -0.0020, -0.0040, -0.0080, -0.0120, -0.0150, -0.0190, -0.0220, -0.0270, -0.0340, -0.0480, -0.0860, -0.1430, -0.2540, -0.3382, -0.4244},
+		{-0.0000, -0.0010, -0.0020, -0.0030, -0.0060, -0.0090, -0.0120, -0.0150, -0.0180, -0.0210, -0.0280, -0.0410, -0.0740, -0.1200, -0.2100, -0.2790, -0.3507},
+		{-0.0000, -0.0010, -0.0010, -0.0030, -0.0050, -0.0080, -0.0100, -0.0120, -0.0140, -0.0180, -0.0230, -0.0250, -0.0650, -0.1040, -0.1800, -0.2378, -0.2985},
+		{-0.0000, -0.0010, -0.0010, -0.0020, -0.0040, -0.0060, -0.0080, -0.0100, -0.0120, -0.0150, -0.0200, -0.0310, -0.0580, -0.0930, -0.1580, -0.2078, -0.2602},
+		{-0.0000, -0.0000, -0.0010, -0.0010, -0.0030, -0.0040, -0.0060, -0.0070, -0.0090, -0.0110, -0.0150, -0.0240, -0.0460, -0.0730, -0.1220, -0.1593, -0.1983},
+		{-0.0000, -0.0000, -0.0010, -0.0010, -0.0020, -0.0030, -0.0050, -0.0060, -0.0070, -0.0090, -0.0120, -0.0200, -0.0380, -0.0600, -0.1000, -0.1298, -0.1610},
 	},
 }
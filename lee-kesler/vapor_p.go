@@ -22,6 +22,26 @@ func lnReducedVaporPressureCorrection(Tr float64) float64 {
 	return 15.2518 - 15.6875/Tr - 13.4721*math.Log(Tr) + 0.43577*math.Pow(Tr, 6)
 }
 
+// SimpleFluidVaporPressure returns the reduced vapor pressure Pr0 of the simple
+// fluid (ω = 0) at reduced temperature Tr < 1,
+//
+//	ln Pr0 = 5.92714 - 6.09648/Tr - 1.28862 ln Tr + 0.169347 Tr⁶
+//
+// The tables hold vapor values at pressures up to Pr0 and liquid values above
+// it, so their interpolation between the two nodes around Pr0 mixes the
+// phases.
+//
+// It returns an error if Tr <= 0 or Tr >= 1.
+func SimpleFluidVaporPressure(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Tr >= 1 {
+		return 0, errors.New("no vapor pressure at or above the critical temperature")
+	}
+	return math.Exp(lnReducedVaporPressureSimple(Tr)), nil
+}
+
 // EstimateAcentricFactor calculates the acentric factor (ω) using the Lee-Kesler correlation.
 // It requires the Normal Boiling Point (Tn), Critical Temperature (Tc), and Critical Pressure (Pc).
 //
@@ -0,0 +1,39 @@
+package leekesler
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSimpleFluidVaporPressure(t *testing.T) {
+	// The tables change from vapor to liquid values in the cell of Pr around
+	// Pr0, where Z0 has its largest jump along the isotherm.
+	for j, tr := range Z0Table.Tr {
+		ps, err := SimpleFluidVaporPressure(tr)
+		if tr >= 1 {
+			if err == nil {
+				t.Errorf("SimpleFluidVaporPressure(%v) expected an error", tr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("SimpleFluidVaporPressure(%v) unexpected error: %v", tr, err)
+		}
+		if ps < Z0Table.Pr[0] {
+			continue
+		}
+		row := Z0Table.Values[j]
+		jump, cell := 0.0, 0
+		for i := range len(row) - 1 {
+			if d := math.Abs(row[i+1] - row[i]); d > jump {
+				jump, cell = d, i
+			}
+		}
+		if lo, hi := Z0Table.Pr[cell], Z0Table.Pr[cell+1]; ps*(1+1e-3) <= lo || ps >= hi {
+			t.Errorf("Tr = %v: Pr0 = %.5f, want within the jump of the table at Pr %v-%v", tr, ps, lo, hi)
+		}
+	}
+	if _, err := SimpleFluidVaporPressure(0); err == nil {
+		t.Error("SimpleFluidVaporPressure(0) expected an error")
+	}
+}
@@ -0,0 +1,87 @@
+package liquids
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// RackettExpansion calculates the volume expansivity β = (1/V)(dV/dT) of a
+// saturated liquid by differentiating the Rackett equation:
+//
+//	β = -(2/7) ln(Zc) (1 - Tr)^(-5/7) / Tc
+//
+// Where:
+//   - Zc is the critical compressibility factor.
+//   - Tr is the reduced temperature (T/Tc).
+//   - Tc is the critical temperature (K).
+//
+// The result is in 1/K. Liquid volumes depend weakly on pressure, so β along the
+// saturation curve is a good estimate of the isobaric expansivity of the
+// compressed liquid. The expansivity diverges at the critical point, so Tr must
+// be below 1.
+func RackettExpansion(Zc, Tr, Tc float64) (float64, error) {
+	if Zc <= 0 || Tc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Tr >= 1 {
+		return 0, fmt.Errorf("liquid expansivity requires Tr < 1, got %g", Tr)
+	}
+
+	return -2.0 / 7.0 * math.Log(Zc) * math.Pow(1-Tr, -5.0/7.0) / Tc, nil
+}
+
+// TaitCompressibility calculates the isothermal compressibility κ = -(1/V)(dV/dP)
+// of a compressed liquid from the Tait equation of Thomson, Brobst and Hankinson:
+//
+//	V = Vs [1 - C ln((B + P)/(B + Psat))]
+//	κ = C / ((B + P)(1 - C ln((B + P)/(B + Psat))))
+//
+// with the generalized parameters
+//
+//	B/Pc = -1 + a(1 - Tr)^(1/3) + b(1 - Tr)^(2/3) + d(1 - Tr) + e(1 - Tr)^(4/3)
+//	e = exp(f + gω + hω²),  C = j + kω
+//
+// Where:
+//   - Tr is the reduced temperature (T/Tc).
+//   - Pc is the critical pressure.
+//   - omega is the acentric factor.
+//   - P is the pressure and Psat the vapor pressure at T, in the units of Pc.
+//
+// The result is in the reciprocal units of Pc. P must not be below Psat, where
+// the liquid is not stable.
+func TaitCompressibility(Tr, Pc, omega, P, Psat float64) (float64, error) {
+	if Pc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Tr >= 1 {
+		return 0, fmt.Errorf("Tait equation requires Tr < 1, got %g", Tr)
+	}
+	if P < Psat {
+		return 0, fmt.Errorf("pressure %g is below the vapor pressure %g", P, Psat)
+	}
+
+	const (
+		a = -9.070217
+		b = 62.45326
+		d = -135.1102
+		f = 4.79594
+		g = 0.250047
+		h = 1.14188
+		j = 0.0861488
+		k = 0.0344483
+	)
+	x := math.Cbrt(1 - Tr)
+	e := math.Exp(f + g*omega + h*omega*omega)
+	B := Pc * (-1 + a*x + b*x*x + d*x*x*x + e*x*x*x*x)
+	C := j + k*omega
+
+	return C / ((B + P) * (1 - C*math.Log((B+P)/(B+Psat)))), nil
+}
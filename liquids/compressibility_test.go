@@ -0,0 +1,66 @@
+package liquids
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRackettExpansion(t *testing.T) {
+	// Propane at 296 K (Tr = 0.8): β ≈ 3.1e-3 1/K
+	got, err := RackettExpansion(0.276, 0.8, 369.8)
+	if err != nil {
+		t.Fatalf("RackettExpansion() unexpected error: %v", err)
+	}
+	want := -2.0 / 7.0 * math.Log(0.276) * math.Pow(0.2, -5.0/7.0) / 369.8
+	if math.Abs(got-want) > 1e-12 {
+		t.Errorf("RackettExpansion() = %v, want %v", got, want)
+	}
+
+	// β = d(ln Vsat)/dT from the Rackett equation itself.
+	const h = 1e-6
+	vLo, _ := Vsat(200, 0.276, 0.8-h)
+	vHi, _ := Vsat(200, 0.276, 0.8+h)
+	if fd := (math.Log(vHi) - math.Log(vLo)) / (2 * h * 369.8); math.Abs(got-fd) > 1e-6*got {
+		t.Errorf("RackettExpansion() = %v, want d(ln Vsat)/dT = %v", got, fd)
+	}
+
+	for _, tr := range []float64{0, 1, 1.2} {
+		if _, err := RackettExpansion(0.276, tr, 369.8); err == nil {
+			t.Errorf("RackettExpansion(Tr = %v) expected an error", tr)
+		}
+	}
+}
+
+func TestTaitCompressibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		tr, P   float64
+		wantErr bool
+	}{
+		{"at saturation", 0.7, 5, false},
+		{"compressed", 0.7, 200, false},
+		{"below Psat", 0.7, 4, true},
+		{"supercritical", 1.05, 50, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TaitCompressibility(tt.tr, 42.48, 0.152, tt.P, 5)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TaitCompressibility() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// Compressibilities of ordinary liquids are of order 1e-4 1/bar.
+			if !tt.wantErr && (got < 1e-5 || got > 1e-3) {
+				t.Errorf("TaitCompressibility() = %v, want between 1e-5 and 1e-3 1/bar", got)
+			}
+		})
+	}
+
+	// Liquids stiffen under compression.
+	k1, _ := TaitCompressibility(0.7, 42.48, 0.152, 5, 5)
+	k2, _ := TaitCompressibility(0.7, 42.48, 0.152, 200, 5)
+	if k2 >= k1 {
+		t.Errorf("TaitCompressibility() at 200 bar = %v, want less than %v at 5 bar", k2, k1)
+	}
+}
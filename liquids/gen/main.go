@@ -8,6 +8,8 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/rickykimani/zfactor/internal/dataversion"
 )
 
 type point struct {
@@ -68,6 +70,12 @@ func main() {
 	var sb strings.Builder
 	sb.WriteString("// Code generated by gen/main.go; DO NOT EDIT.\n\n")
 	sb.WriteString("package liquids\n\n")
+	sb.WriteString("// DataVersion is the version of the digitized Lydersen chart, from data/versions.json.\n")
+	version, err := dataversion.Read(filepath.Join(cwd, "..", "data"), "lydersen")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(&sb, "const DataVersion = %d\n\n", version)
 	sb.WriteString("var lydersenData = LydersenTable{\n")
 
 	// Saturation
@@ -0,0 +1,41 @@
+package liquids
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// CpLiquid estimates the heat capacity of a liquid from the ideal-gas heat
+// capacity at the same temperature using the Rowlinson-Bondi corresponding-states
+// correlation:
+//
+//	(CpL - Cp°)/R = 1.586 + 0.49/(1 - Tr) + ω[4.2775 + 6.3(1 - Tr)^(1/3)/Tr + 0.4355/(1 - Tr)]
+//
+// Where:
+//   - T is the temperature and Tc the critical temperature (K).
+//   - omega is the acentric factor.
+//   - CpIdeal is the ideal-gas heat capacity Cp°/R, as tabulated by the cp package.
+//
+// The result is the liquid heat capacity CpL/R. The correlation applies to
+// saturated liquids below the critical temperature and is typically accurate to
+// within 5% for non-polar and slightly polar fluids, deteriorating close to Tc.
+func CpLiquid(T, Tc, omega, CpIdeal float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if Tc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+
+	tr := T / Tc
+	if tr >= 1 {
+		return 0, fmt.Errorf("Rowlinson-Bondi correlation requires Tr < 1, got %g", tr)
+	}
+
+	x := 1 - tr
+	dcp := 1.586 + 0.49/x + omega*(4.2775+6.3*math.Cbrt(x)/tr+0.4355/x)
+
+	return CpIdeal + dcp, nil
+}
@@ -0,0 +1,35 @@
+package liquids
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCpLiquid(t *testing.T) {
+	tests := []struct {
+		name     string
+		T, Tc, w float64
+		cpIdeal  float64
+		want     float64
+		wantErr  bool
+	}{
+		{"simple fluid Tr=0.5", 250, 500, 0, 4, 6.566, false},
+		{"Tr=0.7 omega=0.2", 350, 500, 0.2, 10, 15.570146, false},
+		{"at Tc", 500, 500, 0.2, 10, 0, true},
+		{"zero T", 0, 500, 0.2, 10, 0, true},
+		{"zero Tc", 300, 0, 0.2, 10, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CpLiquid(tt.T, tt.Tc, tt.w, tt.cpIdeal)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CpLiquid() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-5 {
+				t.Errorf("CpLiquid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
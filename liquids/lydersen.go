@@ -3,14 +3,25 @@
 // Note: The Lydersen chart implementation relies on digitized data. Users should use
 // the ReducedDensity function with care, as the underlying data values may change
 // in subsequent versions as digitization accuracy improves or as better data sources
-// are integrated.
+// are integrated. Each change increments DataVersion; a program can pin the version
+// it was validated against with zfactor.PinDataVersion(zfactor.DatasetLydersen, v)
+// to get a *zfactor.DataVersionWarning when the chart differs.
 package liquids
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sort"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/chart"
 )
 
+func init() {
+	zfactor.RegisterDataset(zfactor.DatasetLydersen, DataVersion)
+}
+
 type point struct {
 	Pr   float64
 	RhoR float64
@@ -26,14 +37,51 @@ type LydersenTable struct {
 	Isotherms  []isotherm
 }
 
+// ErrBelowSaturation is returned by ReducedDensity for states below the saturation
+// curve of the Lydersen chart, i.e. in the vapor region, where the chart does not apply.
+var ErrBelowSaturation = errors.New("state is below the saturation curve of the Lydersen chart")
+
+// Option configures ReducedDensity.
+type Option func(*options)
+
+type options struct {
+	chart *chart.Chart
+}
+
+// WithChart replaces the Lydersen chart with c, a chart of rho_r versus Pr along
+// isolines of Tr, e.g. a legacy or proprietary chart imported with the chart
+// package. The saturation curve of the Lydersen chart does not apply to c, so
+// ErrBelowSaturation is not returned; chart.Chart.At rejects states outside the
+// isolines of c.
+func WithChart(c *chart.Chart) Option {
+	return func(o *options) { o.chart = c }
+}
+
 // ReducedDensity calculates the reduced density (rho_r) for a given reduced temperature (Tr)
-// and reduced pressure (Pr) using the Lydersen chart data.
+// and reduced pressure (Pr) using the Lydersen chart data, or the chart set by WithChart.
 // It performs bilinear interpolation between isotherms and pressure points.
-func ReducedDensity(Tr, Pr float64) (float64, error) {
+//
+// Where the saturation curve of the chart is resolved (Tr >= 0.7), a Pr below the
+// saturation pressure returns ErrBelowSaturation rather than a value interpolated
+// across the vapor-liquid boundary.
+func ReducedDensity(Tr, Pr float64, opts ...Option) (float64, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.chart != nil {
+		return o.chart.At(Tr, Pr)
+	}
+	zfactor.UseDataset(zfactor.DatasetLydersen)
 	isotherms := lydersenData.Isotherms
 	if len(isotherms) == 0 {
 		return 0, fmt.Errorf("lydersen table is empty")
 	}
+	if Tr < 1 {
+		if prSat, err := saturationPr(Tr); err == nil && Pr < prSat {
+			return 0, fmt.Errorf("Pr %g is below the saturation pressure (Pr = %.4g) at Tr %g: %w", Pr, prSat, Tr, ErrBelowSaturation)
+		}
+	}
 
 	// Helper for fallback interpolation between Tr=0.9 and Tr=1.0
 	// This handles cases where intermediate isotherms
@@ -152,3 +200,67 @@ func interpolatePr(points []point, Pr float64) (float64, error) {
 	frac := (Pr - pLow.Pr) / (pHigh.Pr - pLow.Pr)
 	return pLow.RhoR + frac*(pHigh.RhoR-pLow.RhoR), nil
 }
+
+// SaturatedReducedDensity calculates the reduced density (rho_r) of the saturated
+// liquid at reduced temperature Tr from the saturation curve of the Lydersen chart.
+//
+// The curve is digitized against Pr; the saturation pressure at Tr is located from
+// the points where the isotherms meet it, interpolating ln Pr linearly in 1/Tr.
+// The isotherms below Tr = 0.7 start at Pr = 0, so the curve is only resolved for
+// 0.7 <= Tr <= 1. For a smooth analytic alternative, see ReducedDensitySat.
+func SaturatedReducedDensity(Tr float64) (float64, error) {
+	zfactor.UseDataset(zfactor.DatasetLydersen)
+	if Tr > 1 {
+		return 0, errors.New("no saturated liquid above the critical temperature")
+	}
+	prSat, err := saturationPr(Tr)
+	if err != nil {
+		return 0, err
+	}
+	return interpolatePr(lydersenData.Saturation, prSat)
+}
+
+// saturationPr returns the reduced saturation pressure at Tr, from the first
+// points of the isotherms that start on the saturation curve and the critical
+// point.
+func saturationPr(Tr float64) (float64, error) {
+	type knot struct{ Tr, Pr float64 }
+	var knots []knot
+	for _, iso := range lydersenData.Isotherms {
+		if iso.Tr < 1 && len(iso.Points) > 0 && iso.Points[0].Pr > 0 {
+			knots = append(knots, knot{iso.Tr, iso.Points[0].Pr})
+		}
+	}
+	knots = append(knots, knot{1, 1})
+
+	if Tr < knots[0].Tr {
+		return 0, fmt.Errorf("Tr %g is below the minimum Tr (%g) of the Lydersen saturation curve", Tr, knots[0].Tr)
+	}
+	idx := sort.Search(len(knots), func(i int) bool { return knots[i].Tr >= Tr })
+	if idx == len(knots) {
+		return 0, fmt.Errorf("Tr %g is above the critical point", Tr)
+	}
+	if knots[idx].Tr == Tr {
+		return knots[idx].Pr, nil
+	}
+	lo, hi := knots[idx-1], knots[idx]
+	frac := (1/Tr - 1/lo.Tr) / (1/hi.Tr - 1/lo.Tr)
+	return math.Exp(math.Log(lo.Pr) + frac*(math.Log(hi.Pr)-math.Log(lo.Pr))), nil
+}
+
+// LydersenChart returns the Lydersen reduced density isotherms as a chart of
+// rho_r versus Pr along isolines of Tr, for use with the chart package, e.g. to
+// compare them against an imported chart. Unlike ReducedDensity, chart.At does not
+// bridge the isotherms between Tr = 0.9 and 1.0 that end at low pressures.
+func LydersenChart() *chart.Chart {
+	zfactor.UseDataset(zfactor.DatasetLydersen)
+	c := &chart.Chart{Name: "Lydersen", Param: "Tr", X: "Pr", Y: "rho_r"}
+	for _, iso := range lydersenData.Isotherms {
+		pts := make([]chart.Point, len(iso.Points))
+		for i, p := range iso.Points {
+			pts[i] = chart.Point{X: p.Pr, Y: p.RhoR}
+		}
+		c.Isolines = append(c.Isolines, chart.Isoline{Param: iso.Tr, Points: pts})
+	}
+	return c
+}
@@ -2,6 +2,9 @@
 
 package liquids
 
+// DataVersion is the version of the digitized Lydersen chart, from data/versions.json.
+const DataVersion = 1
+
 var lydersenData = LydersenTable{
 	Saturation: []point{
 		{Pr: 0, RhoR: 2.6423},
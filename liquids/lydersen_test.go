@@ -1,7 +1,12 @@
 package liquids
 
 import (
+	"errors"
+	"math"
 	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/chart"
 )
 
 func TestReducedDensity(t *testing.T) {
@@ -85,3 +90,82 @@ func TestReducedDensity(t *testing.T) {
 		})
 	}
 }
+
+func TestSaturatedReducedDensity(t *testing.T) {
+	tests := []struct {
+		name    string
+		tr      float64
+		want    float64
+		wantErr bool
+	}{
+		{"isotherm Tr=0.7", 0.7, 2.5273, false},
+		{"critical point", 1, 1, false},
+		{"between isotherms", 0.85, 2.0486, false},
+		{"below curve", 0.5, 0, true},
+		{"above Tc", 1.05, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SaturatedReducedDensity(tt.tr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SaturatedReducedDensity() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-3 {
+				t.Errorf("SaturatedReducedDensity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReducedDensityBelowSaturation(t *testing.T) {
+	// At Tr = 0.8 the chart isotherm starts on the saturation curve near Pr = 0.25.
+	if _, err := ReducedDensity(0.8, 0.1); !errors.Is(err, ErrBelowSaturation) {
+		t.Errorf("ReducedDensity() error = %v, want %v", err, ErrBelowSaturation)
+	}
+	if _, err := ReducedDensity(0.8, 0.5); err != nil {
+		t.Errorf("ReducedDensity() above saturation error = %v", err)
+	}
+}
+
+func TestReducedDensityDataVersion(t *testing.T) {
+	var got []error
+	prev := zfactor.SetWarningHandler(func(err error) { got = append(got, err) })
+	t.Cleanup(func() {
+		zfactor.UnpinDataVersion(zfactor.DatasetLydersen)
+		zfactor.SetWarningHandler(prev)
+	})
+
+	if v, ok := zfactor.DataVersion(zfactor.DatasetLydersen); !ok || v != DataVersion {
+		t.Fatalf("zfactor.DataVersion() = %d, %v, want %d, true", v, ok, DataVersion)
+	}
+	if err := zfactor.PinDataVersion(zfactor.DatasetLydersen, DataVersion-1); err != nil {
+		t.Fatalf("zfactor.PinDataVersion() unexpected error: %v", err)
+	}
+	if _, err := ReducedDensity(0.8, 5); err != nil {
+		t.Fatalf("ReducedDensity() unexpected error: %v", err)
+	}
+	var w *zfactor.DataVersionWarning
+	if len(got) != 1 || !errors.As(got[0], &w) || !w.Newer() {
+		t.Errorf("ReducedDensity() warnings = %v, want one newer *DataVersionWarning", got)
+	}
+}
+
+func TestReducedDensityWithChart(t *testing.T) {
+	c := &chart.Chart{Name: "test", Param: "Tr", X: "Pr", Y: "rho_r", Isolines: []chart.Isoline{
+		{Param: 0.6, Points: []chart.Point{{X: 0, Y: 2.8}, {X: 10, Y: 3}}},
+		{Param: 0.8, Points: []chart.Point{{X: 0, Y: 2.4}, {X: 10, Y: 2.6}}},
+	}}
+	if got, err := ReducedDensity(0.7, 5, WithChart(c)); err != nil || math.Abs(got-2.7) > 1e-12 {
+		t.Errorf("ReducedDensity(0.7, 5, WithChart()) = %v, %v, want 2.7", got, err)
+	}
+	if _, err := ReducedDensity(0.9, 5, WithChart(c)); err == nil {
+		t.Error("ReducedDensity() above the isolines of the chart want error")
+	}
+	// The chart of the built-in data reproduces it on an isotherm.
+	want, _ := ReducedDensity(0.85, 2)
+	if got, err := ReducedDensity(0.85, 2, WithChart(LydersenChart())); err != nil || math.Abs(got-want) > 1e-12 {
+		t.Errorf("ReducedDensity(0.85, 2, WithChart(LydersenChart())) = %v, %v, want %v", got, err, want)
+	}
+}
@@ -1,6 +1,7 @@
 package liquids
 
 import (
+	"errors"
 	"math"
 
 	"github.com/rickykimani/zfactor"
@@ -32,3 +33,27 @@ func Vsat(Vc, Zc, Tr float64) (float64, error) {
 
 	return v, nil
 }
+
+// lydersenZc is the critical compressibility factor the Lydersen chart is drawn for.
+const lydersenZc = 0.27
+
+// ReducedDensitySat calculates the reduced density ρr = ρ/ρc of the saturated
+// liquid at reduced temperature Tr, as a smooth analytic counterpart of the
+// saturation curve of the Lydersen chart.
+//
+// It is the Yamada-Gunn form of the Rackett equation,
+//
+//	ρr = Vc/Vsat = Zra^(-(1 - Tr)^(2/7))
+//
+// with Zra set to Zc = 0.27, the value the Lydersen chart is drawn for. It
+// returns 1 at the critical point and an error above it, where no saturated
+// liquid exists.
+func ReducedDensitySat(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Tr > 1 {
+		return 0, errors.New("no saturated liquid above the critical temperature")
+	}
+	return math.Pow(lydersenZc, -math.Pow(1-Tr, 2.0/7.0)), nil
+}
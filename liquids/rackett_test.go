@@ -0,0 +1,34 @@
+package liquids
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReducedDensitySat(t *testing.T) {
+	tests := []struct {
+		name    string
+		Tr      float64
+		want    float64
+		wantErr bool
+	}{
+		{"critical point", 1, 1, false},
+		{"Tr=0.7", 0.7, 2.530031, false},
+		{"Tr=0.5", 0.5, 2.927335, false},
+		{"above Tc", 1.1, 0, true},
+		{"zero Tr", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReducedDensitySat(tt.Tr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReducedDensitySat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-5 {
+				t.Errorf("ReducedDensitySat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package meter provides gas flow measurement calculations for differential
+// pressure meters in the style of ISO 5167: the expansibility (expansion) factor
+// of orifice plates, nozzles and Venturi tubes, the mass flow from the measured
+// differential pressure, and the density corrections that convert between
+// flowing, design and base conditions.
+//
+// The upstream density, Z and isentropic exponent of the gas are evaluated by a
+// substance.Provider on a flowsheet.Stream, so real-gas effects follow the
+// selected correlation or equation of state.
+//
+// Units: T in K, P and differential pressures in bar, diameters in m, mass flow
+// in kg/s and density in kg/m³.
+package meter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Element is the primary element of a differential pressure meter.
+type Element int
+
+const (
+	// Orifice is a concentric square-edged orifice plate (ISO 5167-2).
+	Orifice Element = iota
+	// Nozzle is an ISA 1932 or long radius nozzle (ISO 5167-3).
+	Nozzle
+	// Venturi is a classical Venturi tube (ISO 5167-4).
+	Venturi
+)
+
+// String implements fmt.Stringer for Element.
+func (e Element) String() string {
+	switch e {
+	case Orifice:
+		return "orifice"
+	case Nozzle:
+		return "nozzle"
+	case Venturi:
+		return "Venturi"
+	default:
+		return fmt.Sprintf("Element(%d)", int(e))
+	}
+}
+
+// Base conditions of standard volumes, 15 °C and 1 atm (ISO 13443).
+const (
+	TBase = 288.15         // K
+	PBase = zfactor.AtmBar // bar
+)
+
+// MolarVolumeBase is the molar volume at the base conditions (m³/mol). Standard
+// volumes are ideal-gas volumes, V = nR·TBase/PBase, so that they convert to
+// moles independently of the gas and the provider; Supercompressibility
+// corrects them for the compressibility at the base conditions.
+const MolarVolumeBase = zfactor.RSI * TBase / (PBase * 1e5)
+
+// ExpansionFactor returns the expansibility factor ε of element e with diameter
+// ratio beta = d/D, pressure ratio tau = p₂/p₁ across the element and isentropic
+// exponent kappa. For an orifice plate (ISO 5167-2:2003),
+//
+//	ε = 1 - (0.351 + 0.256β⁴ + 0.93β⁸)[1 - τ^(1/κ)]
+//
+// and for nozzles and Venturi tubes, which expand the gas isentropically,
+//
+//	ε = √{ κτ^(2/κ)/(κ-1) · (1-β⁴)/(1-β⁴τ^(2/κ)) · (1-τ^((κ-1)/κ))/(1-τ) }
+//
+// The standard restricts the equations to τ ≥ 0.75.
+func ExpansionFactor(e Element, beta, tau, kappa float64) (float64, error) {
+	if beta <= 0 || beta >= 1 {
+		return 0, fmt.Errorf("diameter ratio %g must be in (0, 1)", beta)
+	}
+	if tau <= 0 || tau > 1 {
+		return 0, fmt.Errorf("pressure ratio %g must be in (0, 1]", tau)
+	}
+	if kappa <= 1 {
+		return 0, fmt.Errorf("isentropic exponent %g must be greater than 1", kappa)
+	}
+	b4 := beta * beta * beta * beta
+	switch e {
+	case Orifice:
+		return 1 - (0.351+0.256*b4+0.93*b4*b4)*(1-math.Pow(tau, 1/kappa)), nil
+	case Nozzle, Venturi:
+		if tau == 1 {
+			return 1, nil
+		}
+		t2k := math.Pow(tau, 2/kappa)
+		e2 := kappa * t2k / (kappa - 1) * (1 - b4) / (1 - b4*t2k) *
+			(1 - math.Pow(tau, (kappa-1)/kappa)) / (1 - tau)
+		return math.Sqrt(e2), nil
+	default:
+		return 0, fmt.Errorf("unknown meter element %v", e)
+	}
+}
+
+// Meter is a differential pressure flow meter.
+type Meter struct {
+	Element Element
+	D       float64 // Internal pipe diameter upstream of the element (m)
+	Bore    float64 // Orifice or throat diameter d (m)
+	// C is the discharge coefficient, e.g. from the Reader-Harris/Gallagher
+	// equation for orifice plates or a calibration.
+	C float64
+}
+
+// Beta returns the diameter ratio β = d/D.
+func (m *Meter) Beta() float64 {
+	return m.Bore / m.D
+}
+
+// Measurement is the flow through a meter evaluated from the upstream state
+// and the differential pressure.
+type Measurement struct {
+	Z         float64 // Upstream compressibility factor
+	Kappa     float64 // Upstream isentropic exponent
+	Density   float64 // Upstream density ρ₁ (kg/m³)
+	Expansion float64 // Expansibility factor ε
+	MassFlow  float64 // Mass flow rate (kg/s)
+	MolarFlow float64 // Molar flow rate (mol/s)
+	// StandardFlow is the ideal-gas volumetric flow rate at TBase and PBase
+	// (m³/s), MolarFlow·MolarVolumeBase.
+	StandardFlow float64
+}
+
+// Flow returns the flow through m of the gas upstream, with the temperature and
+// pressure of the upstream tapping, at the differential pressure dp (bar):
+//
+//	qm = C/√(1 - β⁴) ε (π/4) d² √(2 Δp ρ₁)
+//
+// upstream.Flow is ignored.
+func (m *Meter) Flow(p substance.Provider, upstream *flowsheet.Stream, dp float64) (*Measurement, error) {
+	if p == nil {
+		return nil, errors.New("configuration error: provider cannot be nil")
+	}
+	if upstream == nil {
+		return nil, errors.New("configuration error: upstream cannot be nil")
+	}
+	if m.D <= 0 || m.Bore <= 0 {
+		return nil, errors.New("meter diameters must be greater than 0")
+	}
+	if m.C <= 0 {
+		return nil, errors.New("discharge coefficient must be greater than 0")
+	}
+	if dp <= 0 || dp >= upstream.P {
+		return nil, fmt.Errorf("differential pressure %g bar must be in (0, %g) bar", dp, upstream.P)
+	}
+	z, err := upstream.Z(p)
+	if err != nil {
+		return nil, err
+	}
+	kappa, err := flowsheet.IsentropicExponent(p, upstream)
+	if err != nil {
+		return nil, err
+	}
+	beta := m.Beta()
+	eps, err := ExpansionFactor(m.Element, beta, 1-dp/upstream.P, kappa)
+	if err != nil {
+		return nil, err
+	}
+	rho := density(upstream.P, upstream.T, z, upstream.MW())
+
+	b4 := beta * beta * beta * beta
+	qm := m.C / math.Sqrt(1-b4) * eps * math.Pi / 4 * m.Bore * m.Bore * math.Sqrt(2*dp*1e5*rho)
+	molar := qm / (upstream.MW() * 1e-3)
+	return &Measurement{
+		Z:            z,
+		Kappa:        kappa,
+		Density:      rho,
+		Expansion:    eps,
+		MassFlow:     qm,
+		MolarFlow:    molar,
+		StandardFlow: molar * MolarVolumeBase,
+	}, nil
+}
+
+// density returns ρ = PM/(ZRT) in kg/m³ for P in bar and M in g/mol.
+func density(P, T, z, mw float64) float64 {
+	return P * 1e5 * mw * 1e-3 / (z * zfactor.RSI * T)
+}
+
+// Density returns the density (kg/m³) of the gas of s at temperature T (K) and
+// pressure P (bar), with the compressibility factor of p.
+func Density(p substance.Provider, s *flowsheet.Stream, T, P float64) (float64, error) {
+	at := s.At(T, P)
+	z, err := at.Z(p)
+	if err != nil {
+		return 0, err
+	}
+	return density(P, T, z, s.MW()), nil
+}
+
+// DensityCorrection returns the factor √(ρ/ρ_design) that corrects the mass flow
+// indicated by a differential pressure meter configured for the design state
+// (TDesign, PDesign) to the flowing state of s. The dependence on Z makes it the
+// real-gas equivalent of the pressure and temperature corrections of
+// ideal-gas flow computers.
+func DensityCorrection(p substance.Provider, s *flowsheet.Stream, TDesign, PDesign float64) (float64, error) {
+	rho, err := Density(p, s, s.T, s.P)
+	if err != nil {
+		return 0, err
+	}
+	rhoDesign, err := Density(p, s, TDesign, PDesign)
+	if err != nil {
+		return 0, fmt.Errorf("design conditions: %w", err)
+	}
+	return math.Sqrt(rho / rhoDesign), nil
+}
+
+// Supercompressibility returns the supercompressibility factor
+// Fpv = √(Z_base/Z_flowing) of s, from the flowing state of s and the base
+// conditions TBase and PBase. It corrects standard volumes computed with the
+// ideal gas law for the compressibility of the gas.
+func Supercompressibility(p substance.Provider, s *flowsheet.Stream) (float64, error) {
+	zf, err := s.Z(p)
+	if err != nil {
+		return 0, err
+	}
+	zb, err := s.At(TBase, PBase).Z(p)
+	if err != nil {
+		return 0, fmt.Errorf("base conditions: %w", err)
+	}
+	return math.Sqrt(zb / zf), nil
+}
@@ -0,0 +1,124 @@
+package meter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/meter"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestExpansionFactor(t *testing.T) {
+	tests := []struct {
+		name             string
+		e                meter.Element
+		beta, tau, kappa float64
+		want             float64
+	}{
+		// 1 - (0.351 + 0.256·0.5⁴ + 0.93·0.5⁸)(1 - 0.9^(1/1.3))
+		{"orifice", meter.Orifice, 0.5, 0.9, 1.3, 0.971146},
+		{"orifice without pressure drop", meter.Orifice, 0.6, 1, 1.3, 1},
+		{"venturi without pressure drop", meter.Venturi, 0.6, 1, 1.3, 1},
+	}
+	for _, tt := range tests {
+		got, err := meter.ExpansionFactor(tt.e, tt.beta, tt.tau, tt.kappa)
+		if err != nil {
+			t.Errorf("%s: ExpansionFactor() unexpected error: %v", tt.name, err)
+			continue
+		}
+		if math.Abs(got-tt.want) > 1e-5 {
+			t.Errorf("%s: ExpansionFactor() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Venturi: ε decreases with the pressure drop and tends to 1 without it.
+	near, _ := meter.ExpansionFactor(meter.Venturi, 0.6, 0.9999, 1.3)
+	far, _ := meter.ExpansionFactor(meter.Venturi, 0.6, 0.8, 1.3)
+	if math.Abs(near-1) > 1e-4 || far >= near {
+		t.Errorf("ExpansionFactor(Venturi) = %v at τ = 0.9999 and %v at τ = 0.8, want about 1 and less", near, far)
+	}
+
+	for _, bad := range [][3]float64{{0, 0.9, 1.3}, {1, 0.9, 1.3}, {0.5, 0, 1.3}, {0.5, 0.9, 1}} {
+		if _, err := meter.ExpansionFactor(meter.Orifice, bad[0], bad[1], bad[2]); err == nil {
+			t.Errorf("ExpansionFactor(%v) expected error, got nil", bad)
+		}
+	}
+}
+
+func TestFlow(t *testing.T) {
+	gas := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 50, 0)
+	m := &meter.Meter{Element: meter.Orifice, D: 0.2, Bore: 0.1, C: 0.6}
+
+	// For an ideal gas, ρ = PM/(RT) and κ = Cp/Cv.
+	ideal, err := m.Flow(flowsheet.IdealGas{}, gas, 0.5)
+	if err != nil {
+		t.Fatalf("Flow() unexpected error: %v", err)
+	}
+	rho := 50e5 * substance.Methane.MW * 1e-3 / (zfactor.RSI * 300)
+	if math.Abs(ideal.Density-rho) > 1e-9*rho || ideal.Z != 1 {
+		t.Errorf("Flow() ideal density = %v, Z = %v, want %v, 1", ideal.Density, ideal.Z, rho)
+	}
+	eps, _ := meter.ExpansionFactor(meter.Orifice, 0.5, 1-0.5/50, ideal.Kappa)
+	want := 0.6 / math.Sqrt(1-0.0625) * eps * math.Pi / 4 * 0.01 * math.Sqrt(2*0.5e5*rho)
+	if math.Abs(ideal.MassFlow-want) > 1e-9*want {
+		t.Errorf("Flow() mass flow = %v, want %v", ideal.MassFlow, want)
+	}
+	if got := ideal.MolarFlow * substance.Methane.MW * 1e-3; math.Abs(got-ideal.MassFlow) > 1e-9*want {
+		t.Errorf("Flow() molar flow × MW = %v, want %v", got, ideal.MassFlow)
+	}
+
+	// The real gas is denser, so more mass flows at the same differential pressure.
+	lk, err := m.Flow(flowsheet.LeeKesler{}, gas, 0.5)
+	if err != nil {
+		t.Fatalf("Flow() unexpected error: %v", err)
+	}
+	if lk.Z >= 1 || lk.MassFlow <= ideal.MassFlow {
+		t.Errorf("Flow() real Z = %v, mass flow = %v, want Z < 1 and more than %v", lk.Z, lk.MassFlow, ideal.MassFlow)
+	}
+	if ratio := lk.MassFlow / ideal.MassFlow; math.Abs(ratio-math.Sqrt(1/lk.Z)) > 2e-3 {
+		t.Errorf("Flow() real/ideal mass flow = %v, want about √(1/Z) = %v", ratio, math.Sqrt(1/lk.Z))
+	}
+
+	// Standard volumes are ideal-gas volumes whatever the provider.
+	if want := lk.MolarFlow * zfactor.RSI * meter.TBase / (meter.PBase * 1e5); math.Abs(lk.StandardFlow-want) > 1e-12*want {
+		t.Errorf("Flow() standard flow = %v, want %v", lk.StandardFlow, want)
+	}
+
+	if _, err := m.Flow(flowsheet.IdealGas{}, gas, 60); err == nil {
+		t.Error("Flow() with differential pressure above the line pressure expected error, got nil")
+	}
+	if _, err := m.Flow(flowsheet.IdealGas{}, nil, 0.5); err == nil {
+		t.Error("Flow() with a nil upstream stream expected error, got nil")
+	}
+	if _, err := m.Flow(nil, gas, 0.5); err == nil {
+		t.Error("Flow() with a nil provider expected error, got nil")
+	}
+}
+
+func TestDensityCorrections(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	gas := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 310, 40, 0)
+
+	if f, err := meter.DensityCorrection(p, gas, 310, 40); err != nil || math.Abs(f-1) > 1e-12 {
+		t.Errorf("DensityCorrection() at design conditions = %v, %v, want 1", f, err)
+	}
+	// Higher pressure than the design: denser gas, more mass per indicated flow.
+	f, err := meter.DensityCorrection(p, gas, 310, 30)
+	if err != nil {
+		t.Fatalf("DensityCorrection() unexpected error: %v", err)
+	}
+	if f <= math.Sqrt(40.0/30) {
+		t.Errorf("DensityCorrection() = %v, want above the ideal-gas value %v", f, math.Sqrt(40.0/30))
+	}
+
+	fpv, err := meter.Supercompressibility(p, gas)
+	if err != nil {
+		t.Fatalf("Supercompressibility() unexpected error: %v", err)
+	}
+	if fpv <= 1 || fpv > 1.05 {
+		t.Errorf("Supercompressibility() = %v, want slightly above 1", fpv)
+	}
+}
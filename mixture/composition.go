@@ -0,0 +1,203 @@
+// Package mixture provides the composition of gas mixtures and the derived
+// mixture properties shared by the mixture-related calculations of this module.
+package mixture
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Basis is the basis on which a composition is specified.
+type Basis int
+
+const (
+	Mole   Basis = iota // Mole fractions
+	Mass                // Mass fractions
+	Volume              // Volume fractions of the pure gases at the same T and P
+)
+
+// String implements fmt.Stringer for Basis.
+func (b Basis) String() string {
+	switch b {
+	case Mole:
+		return "mole"
+	case Mass:
+		return "mass"
+	case Volume:
+		return "volume"
+	default:
+		return fmt.Sprintf("Basis(%d)", int(b))
+	}
+}
+
+// Composition is the composition of a mixture, stored as mole fractions.
+//
+// Volume fractions refer to the pure components as ideal gases at the mixture
+// temperature and pressure (Amagat's law), so they are equal to mole fractions.
+type Composition struct {
+	substances []*substance.Substance
+	y          []float64
+}
+
+// New creates a composition from amounts of each substance on the given basis.
+//
+// The amounts need not sum to 1: they are normalized, so percentages or
+// absolute amounts (e.g. kg of each component for the Mass basis) may be used.
+func New(basis Basis, substances []*substance.Substance, amounts []float64) (*Composition, error) {
+	if len(substances) == 0 {
+		return nil, errors.New("mixture must have at least one component")
+	}
+	if len(substances) != len(amounts) {
+		return nil, fmt.Errorf("got %d substances and %d amounts", len(substances), len(amounts))
+	}
+
+	y := make([]float64, len(amounts))
+	for i, s := range substances {
+		if s == nil {
+			return nil, errors.New("component substance cannot be nil")
+		}
+		if amounts[i] < 0 {
+			return nil, errors.New("component amount cannot be negative")
+		}
+		switch basis {
+		case Mole, Volume:
+			y[i] = amounts[i]
+		case Mass:
+			if s.MW <= 0 {
+				return nil, fmt.Errorf("%s has no molar mass", s.Name)
+			}
+			y[i] = amounts[i] / s.MW
+		default:
+			return nil, fmt.Errorf("unknown composition basis %v", basis)
+		}
+	}
+	if err := normalize(y); err != nil {
+		return nil, err
+	}
+
+	return &Composition{
+		substances: append([]*substance.Substance(nil), substances...),
+		y:          y,
+	}, nil
+}
+
+// FromComponents creates a composition from substance.Component values, whose
+// fractions are taken as mole fractions.
+func FromComponents(components []substance.Component) (*Composition, error) {
+	subs := make([]*substance.Substance, len(components))
+	y := make([]float64, len(components))
+	for i, c := range components {
+		subs[i] = c.Substance
+		y[i] = c.Fraction
+	}
+	return New(Mole, subs, y)
+}
+
+// Len returns the number of components.
+func (c *Composition) Len() int {
+	return len(c.substances)
+}
+
+// Substances returns the components of the mixture.
+func (c *Composition) Substances() []*substance.Substance {
+	return append([]*substance.Substance(nil), c.substances...)
+}
+
+// MoleFractions returns a copy of the mole fractions.
+func (c *Composition) MoleFractions() []float64 {
+	return append([]float64(nil), c.y...)
+}
+
+// MassFractions returns the mass fractions wᵢ = yᵢMWᵢ / MW.
+func (c *Composition) MassFractions() []float64 {
+	mw := c.MW()
+	w := make([]float64, len(c.y))
+	for i, s := range c.substances {
+		w[i] = c.y[i] * s.MW / mw
+	}
+	return w
+}
+
+// VolumeFractions returns the ideal-gas volume fractions, which equal the mole fractions.
+func (c *Composition) VolumeFractions() []float64 {
+	return c.MoleFractions()
+}
+
+// Fractions returns the composition on the given basis.
+func (c *Composition) Fractions(basis Basis) ([]float64, error) {
+	switch basis {
+	case Mole:
+		return c.MoleFractions(), nil
+	case Mass:
+		return c.MassFractions(), nil
+	case Volume:
+		return c.VolumeFractions(), nil
+	default:
+		return nil, fmt.Errorf("unknown composition basis %v", basis)
+	}
+}
+
+// Fraction returns the mole fraction of s, or 0 if s is not a component.
+func (c *Composition) Fraction(s *substance.Substance) float64 {
+	var y float64
+	for i, sub := range c.substances {
+		if sub == s {
+			y += c.y[i]
+		}
+	}
+	return y
+}
+
+// MW returns the molar mass of the mixture, MW = Σ yᵢMWᵢ.
+func (c *Composition) MW() float64 {
+	var mw float64
+	for i, s := range c.substances {
+		mw += c.y[i] * s.MW
+	}
+	return mw
+}
+
+// Components returns the composition as substance.Component values.
+func (c *Composition) Components() []substance.Component {
+	comps := make([]substance.Component, len(c.substances))
+	for i, s := range c.substances {
+		comps[i] = substance.Component{Substance: s, Fraction: c.y[i]}
+	}
+	return comps
+}
+
+// PseudoComponent returns the Kay's rule pseudo-component of the mixture (see
+// substance.NewLinearMixture), suitable for the generalized correlations.
+func (c *Composition) PseudoComponent(name string) (*substance.Substance, error) {
+	if name == "" {
+		name = c.String()
+	}
+	return substance.NewLinearMixture(name, c.Components())
+}
+
+// String implements fmt.Stringer for Composition.
+func (c *Composition) String() string {
+	parts := make([]string, len(c.substances))
+	for i, s := range c.substances {
+		parts[i] = fmt.Sprintf("%s: %.4g", s.Name, c.y[i])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// normalize scales v so that its elements sum to 1.
+func normalize(v []float64) error {
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	if sum <= 0 {
+		return errors.New("component amounts must not all be zero")
+	}
+	for i := range v {
+		v[i] /= sum
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package mixture_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/mixture"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestBasisConversion(t *testing.T) {
+	subs := []*substance.Substance{substance.Methane, substance.NButane}
+
+	// 1 kg of each: moles are 1/MW.
+	c, err := mixture.New(mixture.Mass, subs, []float64{1, 1})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	m1, m2 := 1/substance.Methane.MW, 1/substance.NButane.MW
+	want := []float64{m1 / (m1 + m2), m2 / (m1 + m2)}
+	for i, y := range c.MoleFractions() {
+		if math.Abs(y-want[i]) > 1e-12 {
+			t.Errorf("MoleFractions()[%d] = %v, want %v", i, y, want[i])
+		}
+	}
+	for i, w := range c.MassFractions() {
+		if math.Abs(w-0.5) > 1e-12 {
+			t.Errorf("MassFractions()[%d] = %v, want 0.5", i, w)
+		}
+	}
+
+	// MW of the mixture equals total mass over total moles.
+	if got, wantMW := c.MW(), 2/(m1+m2); math.Abs(got-wantMW) > 1e-9 {
+		t.Errorf("MW() = %v, want %v", got, wantMW)
+	}
+}
+
+func TestNormalization(t *testing.T) {
+	subs := []*substance.Substance{substance.Methane, substance.Ethane, substance.Propane}
+	c, err := mixture.New(mixture.Volume, subs, []float64{85, 10, 5})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	want := []float64{0.85, 0.10, 0.05}
+	for i, y := range c.MoleFractions() {
+		if math.Abs(y-want[i]) > 1e-12 {
+			t.Errorf("MoleFractions()[%d] = %v, want %v", i, y, want[i])
+		}
+	}
+
+	pc, err := c.PseudoComponent("")
+	if err != nil {
+		t.Fatalf("PseudoComponent() unexpected error: %v", err)
+	}
+	wantTc := 0.85*substance.Methane.Critical.Tc + 0.10*substance.Ethane.Critical.Tc + 0.05*substance.Propane.Critical.Tc
+	if math.Abs(pc.Critical.Tc-wantTc) > 1e-9 {
+		t.Errorf("PseudoComponent() Tc = %v, want %v", pc.Critical.Tc, wantTc)
+	}
+
+	if _, err := mixture.New(mixture.Mole, subs, []float64{0, 0, 0}); err == nil {
+		t.Errorf("New() with zero amounts expected error, got nil")
+	}
+	if _, err := mixture.New(mixture.Mole, subs, []float64{1, -1, 1}); err == nil {
+		t.Errorf("New() with a negative amount expected error, got nil")
+	}
+}
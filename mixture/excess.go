@@ -0,0 +1,145 @@
+package mixture
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/activity"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// ExcessModel is a model of the excess enthalpy of mixtures, i.e. the heat
+// released on mixing the pure components at constant T and P is -Hᴱ.
+type ExcessModel interface {
+	// ExcessEnthalpy returns Hᴱ (J/mol) of the mixture with mole fractions x of
+	// the substances at temperature T (K) and pressure P (bar).
+	ExcessEnthalpy(substances []*substance.Substance, x []float64, T, P float64) (float64, error)
+}
+
+// ExcessEnthalpy returns the excess enthalpy Hᴱ (J/mol) of the mixture c at
+// temperature T (K) and pressure P (bar),
+//
+//	Hᴱ = H - Σ xᵢ Hᵢ
+//
+// from model, e.g. CubicExcess or ActivityExcess. The duty of a blender that
+// mixes the pure components isothermally is Hᴱ per mole of product; ideal
+// mixing takes it as 0.
+func ExcessEnthalpy(c *Composition, T, P float64, model ExcessModel) (float64, error) {
+	if model == nil {
+		return 0, errors.New("configuration error: excess enthalpy model cannot be nil")
+	}
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return 0, zfactor.ErrPressure
+	}
+	return model.ExcessEnthalpy(c.Substances(), c.MoleFractions(), T, P)
+}
+
+// CubicExcess is an ExcessModel based on a cubic equation of state with the van
+// der Waals mixing rules. The ideal-gas enthalpies cancel, so
+//
+//	Hᴱ = Hᴿ(mixture) - Σ xᵢ Hᴿᵢ
+//
+// with the departures of the mixture and of each pure component at T and P.
+type CubicExcess struct {
+	// EOS is the equation of state. If nil, cubic.DefaultEOS is used.
+	EOS cubic.EOSType
+	// Kij is the symmetric matrix of binary interaction parameters, indexed like
+	// the components. If nil, all kij are 0.
+	Kij [][]float64
+	// Phase selects the volume root of the mixture and of every pure component,
+	// e.g. phase.Liquid for liquid blends. If phase.Unknown, each takes its root
+	// with the lowest Gibbs energy, so Hᴱ includes the latent heat of any
+	// component whose stable phase differs from that of the mixture.
+	Phase phase.Phase
+}
+
+// ExcessEnthalpy implements ExcessModel.
+func (m CubicExcess) ExcessEnthalpy(substances []*substance.Substance, x []float64, T, P float64) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	eos := m.EOS
+	if eos == nil {
+		var err error
+		if eos, err = cubic.DefaultEOS(); err != nil {
+			return 0, err
+		}
+	}
+	comps := make([]cubic.Component, len(substances))
+	for i, s := range substances {
+		comps[i] = s.CubicComponent()
+	}
+	hr := func(y []float64) (float64, error) {
+		mix := cubic.NewMixtureCfg(eos, T, P, y, comps, R)
+		mix.Kij = m.Kij
+		Z, _, err := cubic.PhaseLogPhi(mix, m.Phase)
+		if err != nil {
+			return 0, err
+		}
+		return cubic.ResidualEnthalpy(mix, Z)
+	}
+
+	he, err := hr(x)
+	if err != nil {
+		return 0, err
+	}
+	for i, s := range substances {
+		if x[i] == 0 {
+			continue
+		}
+		pure := make([]float64, len(x))
+		pure[i] = 1
+		hi, err := hr(pure)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", s.Name, err)
+		}
+		he -= x[i] * hi
+	}
+	return 0.1 * he, nil // bar*cm^3/mol -> J/mol
+}
+
+// ActivityExcess is an ExcessModel based on a liquid activity coefficient model,
+// whose components must be in the order of the mixture. Hᴱ follows from the
+// temperature dependence of the activity coefficients by the Gibbs-Helmholtz
+// equation,
+//
+//	Hᴱ = -RT² Σ xᵢ ∂ln γᵢ/∂T
+//
+// evaluated by central differences. The pressure is ignored. Models whose
+// parameters do not depend on T, such as Margules with constant A, give Hᴱ = 0.
+type ActivityExcess struct {
+	Model activity.Model
+}
+
+// ExcessEnthalpy implements ExcessModel.
+func (m ActivityExcess) ExcessEnthalpy(substances []*substance.Substance, x []float64, T, P float64) (float64, error) {
+	if m.Model == nil {
+		return 0, errors.New("configuration error: activity model cannot be nil")
+	}
+	if n := len(m.Model.Composition()); n != len(x) {
+		return 0, fmt.Errorf("activity model has %d components, mixture has %d", n, len(x))
+	}
+	model := m.Model.WithComposition(x)
+	h := 1e-4 * T
+	up, err := model.WithTemperature(T + h).Activity()
+	if err != nil {
+		return 0, err
+	}
+	down, err := model.WithTemperature(T - h).Activity()
+	if err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i, xi := range x {
+		if xi == 0 {
+			continue
+		}
+		sum += xi * (math.Log(up[i]) - math.Log(down[i])) / (2 * h)
+	}
+	return -zfactor.RSI * T * T * sum, nil
+}
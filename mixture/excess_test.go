@@ -0,0 +1,99 @@
+package mixture_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/activity/wilson"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/mixture"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestActivityExcess(t *testing.T) {
+	x := []float64{0.4, 0.6}
+	c, err := mixture.New(mixture.Mole, []*substance.Substance{substance.Methanol, substance.Water}, x)
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	const T = 323.15
+	V := []float64{40.7, 18.1}
+	a := [][]float64{{0, 1500}, {2500, 0}}
+	model := mixture.ActivityExcess{Model: wilson.Wilson{T: 300, X: []float64{0.5, 0.5}, V: V, Interaction: a}}
+
+	got, err := mixture.ExcessEnthalpy(c, T, 1, model)
+	if err != nil {
+		t.Fatalf("ExcessEnthalpy() unexpected error: %v", err)
+	}
+	// With Λij = (Vj/Vi) exp(-aij/RT) and constant aij, Hᴱ = Σᵢ xᵢ Σⱼ xⱼ Λij aij / Σⱼ xⱼ Λij.
+	var want float64
+	for i := range x {
+		var num, den float64
+		for j := range x {
+			l := V[j] / V[i] * math.Exp(-a[i][j]/(zfactor.RSI*T))
+			num += x[j] * l * a[i][j]
+			den += x[j] * l
+		}
+		want += x[i] * num / den
+	}
+	if math.Abs(got-want) > 1e-4*math.Abs(want) {
+		t.Errorf("ExcessEnthalpy() = %v, want %v", got, want)
+	}
+}
+
+func TestCubicExcess(t *testing.T) {
+	subs := []*substance.Substance{substance.Propane, substance.NButane}
+	const T, P = 280.0, 20.0
+	he := func(x []float64, kij float64) float64 {
+		t.Helper()
+		c, err := mixture.New(mixture.Mole, subs, x)
+		if err != nil {
+			t.Fatalf("New() unexpected error: %v", err)
+		}
+		model := mixture.CubicExcess{EOS: &cubic.PR{}, Kij: [][]float64{{0, kij}, {kij, 0}}, Phase: phase.Liquid}
+		got, err := mixture.ExcessEnthalpy(c, T, P, model)
+		if err != nil {
+			t.Fatalf("ExcessEnthalpy() unexpected error: %v", err)
+		}
+		return got
+	}
+
+	if got := he([]float64{1, 0}, 0.05); math.Abs(got) > 1e-9 {
+		t.Errorf("ExcessEnthalpy() of pure propane = %v, want 0", got)
+	}
+	// Hᴱ is small for a nearly ideal alkane pair and grows with kij, which
+	// weakens the cross attraction.
+	ideal, weak := he([]float64{0.5, 0.5}, 0), he([]float64{0.5, 0.5}, 0.05)
+	if math.Abs(ideal) > 100 {
+		t.Errorf("ExcessEnthalpy() with kij = 0 = %v J/mol, want |Hᴱ| < 100 J/mol", ideal)
+	}
+	if weak <= ideal {
+		t.Errorf("ExcessEnthalpy() with kij = 0.05 = %v, want more than %v with kij = 0", weak, ideal)
+	}
+}
+
+func TestExcessEnthalpyErrors(t *testing.T) {
+	c, err := mixture.New(mixture.Mole, []*substance.Substance{substance.Methanol, substance.Water}, []float64{0.5, 0.5})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+	three := wilson.Wilson{T: 300, X: []float64{0.2, 0.3, 0.5}, V: []float64{1, 1, 1}, Interaction: [][]float64{{0, 0, 0}, {0, 0, 0}, {0, 0, 0}}}
+	tests := []struct {
+		name  string
+		T, P  float64
+		model mixture.ExcessModel
+	}{
+		{"nil model", 300, 1, nil},
+		{"zero temperature", 0, 1, mixture.CubicExcess{}},
+		{"zero pressure", 300, 0, mixture.CubicExcess{}},
+		{"nil activity model", 300, 1, mixture.ActivityExcess{}},
+		{"component count", 300, 1, mixture.ActivityExcess{Model: three}},
+	}
+	for _, tt := range tests {
+		if _, err := mixture.ExcessEnthalpy(c, tt.T, tt.P, tt.model); err == nil {
+			t.Errorf("%s: ExcessEnthalpy() expected error, got nil", tt.name)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package mixture
+
+import (
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// ZFunc returns the compressibility factor of a pure substance at temperature
+// T (K) and pressure P (bar).
+type ZFunc func(s *substance.Substance, T, P float64) (float64, error)
+
+// LeeKeslerZ is a ZFunc based on the Lee-Kesler correlation.
+func LeeKeslerZ(s *substance.Substance, T, P float64) (float64, error) {
+	return s.LeeKesler(zfactor.Args{T: T, P: P}, leekesler.CompressibilityFactor)
+}
+
+// AmagatZ estimates the mixture compressibility factor by Amagat's law of
+// additive volumes, weighting the pure-component Z at the mixture T and P:
+//
+//	Z = Σ yᵢ Zᵢ(T, P)
+//
+// This is an approximation that ignores interactions between unlike molecules.
+// It is intended as a quick screening value; use a mixture EOS for design work.
+// If z is nil, LeeKeslerZ is used.
+func AmagatZ(c *Composition, T, P float64, z ZFunc) (float64, error) {
+	return idealZ(c, T, P, z, false)
+}
+
+// DaltonZ estimates the mixture compressibility factor by Dalton's law of
+// additive pressures, weighting the pure-component Z at the mixture T and the
+// component partial pressure:
+//
+//	Z = Σ yᵢ Zᵢ(T, yᵢP)
+//
+// Like AmagatZ, this is a screening approximation only. If z is nil, LeeKeslerZ is used.
+func DaltonZ(c *Composition, T, P float64, z ZFunc) (float64, error) {
+	return idealZ(c, T, P, z, true)
+}
+
+// idealZ evaluates the mole-fraction weighted Z, at the partial pressure of each
+// component if partial is set.
+func idealZ(c *Composition, T, P float64, z ZFunc, partial bool) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return 0, zfactor.ErrPressure
+	}
+	if z == nil {
+		z = LeeKeslerZ
+	}
+
+	var zMix float64
+	for i, s := range c.substances {
+		y := c.y[i]
+		if y == 0 {
+			continue
+		}
+		p := P
+		if partial {
+			p = y * P
+		}
+		zi, err := z(s, T, p)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", s.Name, err)
+		}
+		zMix += y * zi
+	}
+	return zMix, nil
+}
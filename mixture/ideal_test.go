@@ -0,0 +1,52 @@
+package mixture_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/mixture"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestIdealZ(t *testing.T) {
+	c, err := mixture.New(mixture.Mole, []*substance.Substance{substance.Methane, substance.Ethane}, []float64{0.7, 0.3})
+	if err != nil {
+		t.Fatalf("New() unexpected error: %v", err)
+	}
+
+	// Pure-component Z linear in P: Z = 1 - P/100.
+	linear := func(s *substance.Substance, T, P float64) (float64, error) {
+		return 1 - P/100, nil
+	}
+
+	amagat, err := mixture.AmagatZ(c, 300, 50, linear)
+	if err != nil {
+		t.Fatalf("AmagatZ() unexpected error: %v", err)
+	}
+	if want := 0.5; math.Abs(amagat-want) > 1e-12 {
+		t.Errorf("AmagatZ() = %v, want %v", amagat, want)
+	}
+
+	dalton, err := mixture.DaltonZ(c, 300, 50, linear)
+	if err != nil {
+		t.Fatalf("DaltonZ() unexpected error: %v", err)
+	}
+	// Σ yᵢ(1 - yᵢP/100) = 1 - (0.49 + 0.09) * 0.5
+	if want := 0.71; math.Abs(dalton-want) > 1e-12 {
+		t.Errorf("DaltonZ() = %v, want %v", dalton, want)
+	}
+
+	// Both estimates with Lee-Kesler should give a gas-like Z below 1.
+	for name, f := range map[string]func(*mixture.Composition, float64, float64, mixture.ZFunc) (float64, error){
+		"AmagatZ": mixture.AmagatZ,
+		"DaltonZ": mixture.DaltonZ,
+	} {
+		z, err := f(c, 300, 50, nil)
+		if err != nil {
+			t.Fatalf("%s() unexpected error: %v", name, err)
+		}
+		if z <= 0.5 || z >= 1 {
+			t.Errorf("%s() = %v, want in (0.5, 1)", name, z)
+		}
+	}
+}
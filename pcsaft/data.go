@@ -0,0 +1,54 @@
+package pcsaft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Parameters of Gross and Sadowski (2001), fitted to vapor pressures and
+// saturated liquid densities.
+var (
+	Methane       = &Component{Name: "Methane", MW: 16.043, M: 1.0000, Sigma: 3.7039, Epsilon: 150.03}
+	Ethane        = &Component{Name: "Ethane", MW: 30.070, M: 1.6069, Sigma: 3.5206, Epsilon: 191.42}
+	Propane       = &Component{Name: "Propane", MW: 44.097, M: 2.0020, Sigma: 3.6184, Epsilon: 208.11}
+	NButane       = &Component{Name: "n-Butane", MW: 58.123, M: 2.3316, Sigma: 3.7086, Epsilon: 222.88}
+	Isobutane     = &Component{Name: "Isobutane", MW: 58.123, M: 2.2616, Sigma: 3.7574, Epsilon: 216.53}
+	NPentane      = &Component{Name: "n-Pentane", MW: 72.150, M: 2.6896, Sigma: 3.7729, Epsilon: 231.20}
+	NHexane       = &Component{Name: "n-Hexane", MW: 86.177, M: 3.0576, Sigma: 3.7983, Epsilon: 236.77}
+	NHeptane      = &Component{Name: "n-Heptane", MW: 100.204, M: 3.4831, Sigma: 3.8049, Epsilon: 238.40}
+	NOctane       = &Component{Name: "n-Octane", MW: 114.231, M: 3.8176, Sigma: 3.8373, Epsilon: 242.78}
+	NDecane       = &Component{Name: "n-Decane", MW: 142.285, M: 4.6627, Sigma: 3.8384, Epsilon: 243.87}
+	Cyclohexane   = &Component{Name: "Cyclohexane", MW: 84.161, M: 2.5303, Sigma: 3.8499, Epsilon: 278.11}
+	Benzene       = &Component{Name: "Benzene", MW: 78.114, M: 2.4653, Sigma: 3.6478, Epsilon: 287.35}
+	Toluene       = &Component{Name: "Toluene", MW: 92.141, M: 2.8149, Sigma: 3.7169, Epsilon: 285.69}
+	Ethylene      = &Component{Name: "Ethylene", MW: 28.054, M: 1.5930, Sigma: 3.4450, Epsilon: 176.47}
+	Propylene     = &Component{Name: "Propylene", MW: 42.081, M: 1.9597, Sigma: 3.5356, Epsilon: 207.19}
+	Nitrogen      = &Component{Name: "Nitrogen", MW: 28.014, M: 1.2053, Sigma: 3.3130, Epsilon: 90.96}
+	Oxygen        = &Component{Name: "Oxygen", MW: 31.999, M: 1.1217, Sigma: 3.2098, Epsilon: 114.96}
+	Argon         = &Component{Name: "Argon", MW: 39.948, M: 0.9285, Sigma: 3.4784, Epsilon: 122.23}
+	CarbonDioxide = &Component{Name: "Carbon dioxide", MW: 44.010, M: 2.0729, Sigma: 2.7852, Epsilon: 169.21}
+)
+
+// components lists the parameters of the package by name.
+var components = []*Component{
+	Methane, Ethane, Propane, NButane, Isobutane, NPentane, NHexane, NHeptane,
+	NOctane, NDecane, Cyclohexane, Benzene, Toluene, Ethylene, Propylene,
+	Nitrogen, Oxygen, Argon, CarbonDioxide,
+}
+
+// ForSubstance returns the PC-SAFT parameters of s from the package table,
+// matched by name. Associating substances are not covered, as the package has no
+// association term; use cpa for them.
+func ForSubstance(s *substance.Substance) (*Component, error) {
+	if s == nil {
+		return nil, fmt.Errorf("substance cannot be nil")
+	}
+	for _, c := range components {
+		if strings.EqualFold(c.Name, s.Name) {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("pcsaft: no parameters for %s", s.Name)
+}
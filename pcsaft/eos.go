@@ -0,0 +1,342 @@
+package pcsaft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor/internal/numeric"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Universal constants of the power series I₁ and I₂ (Gross and Sadowski, 2001,
+// Table 1): aᵢ(m̄) = a0ᵢ + (m̄-1)/m̄ a1ᵢ + (m̄-1)(m̄-2)/m̄² a2ᵢ, and likewise bᵢ.
+var (
+	a0 = [7]float64{0.9105631445, 0.6361281449, 2.6861347891, -26.547362491, 97.759208784, -159.59154087, 91.297774084}
+	a1 = [7]float64{-0.3084016918, 0.1860531159, -2.5030047259, 21.419793629, -65.255885330, 83.318680481, -33.746922930}
+	a2 = [7]float64{-0.0906148351, 0.4527842806, 0.5962700728, -1.7241829131, -4.1302112531, 13.776631870, -8.6728470368}
+	b0 = [7]float64{0.7240946941, 2.2382791861, -4.0025849485, -21.003576815, 26.855641363, 206.55133841, -355.60235612}
+	b1 = [7]float64{-0.5755498075, 0.6995095521, 3.8925673390, -17.215471648, 192.67226447, -161.82646165, -165.20769346}
+	b2 = [7]float64{0.0976883116, -0.2557574982, -9.1558561530, 20.642075974, -38.804430052, 93.626774077, -29.666905585}
+)
+
+// maxPacking is the upper bound of the packing fraction scanned for roots,
+// slightly below that of close-packed spheres, π/(3√2).
+const maxPacking = 0.7404
+
+// model holds the density-independent quantities of a configuration at its
+// temperature.
+type model struct {
+	cfg  *Config
+	m, d []float64 // Segment numbers and diameters (Å)
+	mbar float64   // Mean segment number Σ xᵢ mᵢ
+	// a and b are the coefficients of I₁ and I₂ at m̄; da and db their
+	// derivatives with respect to each mole fraction.
+	a, b   [7]float64
+	da, db [][7]float64
+	// m2es3 is m²εσ³ = Σᵢ Σⱼ xᵢ xⱼ mᵢ mⱼ (εᵢⱼ/kT) σᵢⱼ³ and m2e2s3 is m²ε²σ³
+	// with (εᵢⱼ/kT)²; dm2es3 and dm2e2s3 are their mole fraction derivatives.
+	m2es3, m2e2s3   float64
+	dm2es3, dm2e2s3 []float64
+}
+
+// newModel validates cfg and evaluates its parameters at cfg.T.
+func newModel(cfg *Config) (*model, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	n := len(cfg.Components)
+	m := &model{
+		cfg: cfg, m: make([]float64, n), d: make([]float64, n),
+		da: make([][7]float64, n), db: make([][7]float64, n),
+		dm2es3: make([]float64, n), dm2e2s3: make([]float64, n),
+	}
+	for i, c := range cfg.Components {
+		m.m[i], m.d[i] = c.M, c.diameter(cfg.T)
+		m.mbar += cfg.Y[i] * c.M
+	}
+	mb := m.mbar
+	f1, f2 := (mb-1)/mb, (mb-1)*(mb-2)/(mb*mb)
+	for j := range 7 {
+		m.a[j] = a0[j] + f1*a1[j] + f2*a2[j]
+		m.b[j] = b0[j] + f1*b1[j] + f2*b2[j]
+	}
+	// ∂m̄/∂xₖ = mₖ
+	d1, d2 := 1/(mb*mb), (3-4/mb)/(mb*mb)
+	for k, mk := range m.m {
+		for j := range 7 {
+			m.da[k][j] = mk * (d1*a1[j] + d2*a2[j])
+			m.db[k][j] = mk * (d1*b1[j] + d2*b2[j])
+		}
+	}
+	for i, ci := range cfg.Components {
+		for j, cj := range cfg.Components {
+			k := 0.0
+			if cfg.Kij != nil && i != j {
+				k = cfg.Kij[i][j]
+			}
+			e := math.Sqrt(ci.Epsilon*cj.Epsilon) * (1 - k) / cfg.T
+			s := (ci.Sigma + cj.Sigma) / 2
+			s3 := s * s * s
+			mm := ci.M * cj.M
+			m.m2es3 += cfg.Y[i] * cfg.Y[j] * mm * e * s3
+			m.m2e2s3 += cfg.Y[i] * cfg.Y[j] * mm * e * e * s3
+			m.dm2es3[i] += 2 * cfg.Y[j] * mm * e * s3
+			m.dm2e2s3[i] += 2 * cfg.Y[j] * mm * e * e * s3
+		}
+	}
+	return m, nil
+}
+
+// density returns the number density ρ (1/Å³) at packing fraction eta.
+func (m *model) density(eta float64) float64 {
+	var s float64
+	for i, y := range m.cfg.Y {
+		s += y * m.m[i] * m.d[i] * m.d[i] * m.d[i]
+	}
+	return 6 * eta / (math.Pi * s)
+}
+
+// volume returns the molar volume (cm³/mol) at number density rho (1/Å³).
+func volume(rho float64) float64 {
+	return avogadro / (rho * 1e24)
+}
+
+// terms holds the residual properties at a density.
+type terms struct {
+	ar float64   // ã^res = A^res/(NkT)
+	z  float64   // Compressibility factor
+	ax []float64 // ∂ã^res/∂xₖ at constant T and ρ
+}
+
+// evaluate returns the residual properties at number density rho (1/Å³). The
+// mole fraction derivatives follow Appendix A of Gross and Sadowski (2001).
+func (m *model) evaluate(rho float64) terms {
+	x := m.cfg.Y
+	n := len(x)
+	var zeta [4]float64
+	zx := make([][4]float64, n)
+	for k := range n {
+		dn := 1.0
+		for p := range 4 {
+			zx[k][p] = math.Pi / 6 * rho * m.m[k] * dn
+			zeta[p] += x[k] * zx[k][p]
+			dn *= m.d[k]
+		}
+	}
+	z0, z1, z2, z3 := zeta[0], zeta[1], zeta[2], zeta[3]
+	eta, ome := z3, 1-z3
+	lnOme := math.Log(ome)
+
+	// Hard spheres.
+	ahs := (3*z1*z2/ome + z2*z2*z2/(z3*ome*ome) + (z2*z2*z2/(z3*z3)-z0)*lnOme) / z0
+	zhs := z3/ome + 3*z1*z2/(z0*ome*ome) + (3*z2*z2*z2-z3*z2*z2*z2)/(z0*ome*ome*ome)
+
+	// Hard chains, with the contact values gᵢᵢ of the like segments.
+	g := make([]float64, n)
+	ahc, zhc := m.mbar*ahs, m.mbar*zhs
+	for i := range n {
+		dd := m.d[i] / 2 // dᵢdⱼ/(dᵢ + dⱼ)
+		g[i] = 1/ome + dd*3*z2/(ome*ome) + dd*dd*2*z2*z2/(ome*ome*ome)
+		rdg := z3/(ome*ome) + dd*(3*z2/(ome*ome)+6*z2*z3/(ome*ome*ome)) +
+			dd*dd*(4*z2*z2/(ome*ome*ome)+6*z2*z2*z3/(ome*ome*ome*ome))
+		ahc -= x[i] * (m.m[i] - 1) * math.Log(g[i])
+		zhc -= x[i] * (m.m[i] - 1) * rdg / g[i]
+	}
+
+	// Dispersion.
+	var I1, I2, dI1, dI2 float64 // I and ∂(ηI)/∂η
+	pow := 1.0
+	for j := range 7 {
+		I1 += m.a[j] * pow
+		I2 += m.b[j] * pow
+		dI1 += m.a[j] * float64(j+1) * pow
+		dI2 += m.b[j] * float64(j+1) * pow
+		pow *= eta
+	}
+	e2, e3, e4 := eta*eta, eta*eta*eta, eta*eta*eta*eta
+	A := (8*eta - 2*e2) / (ome * ome * ome * ome)
+	B := (20*eta - 27*e2 + 12*e3 - 2*e4) / math.Pow(ome*(2-eta), 2)
+	C1 := 1 / (1 + m.mbar*A + (1-m.mbar)*B)
+	C2 := -C1 * C1 * (m.mbar*(-4*e2+20*eta+8)/math.Pow(ome, 5) +
+		(1-m.mbar)*(2*e3+12*e2-48*eta+40)/math.Pow(ome*(2-eta), 3))
+	adisp := -2*math.Pi*rho*I1*m.m2es3 - math.Pi*rho*m.mbar*C1*I2*m.m2e2s3
+	zdisp := -2*math.Pi*rho*dI1*m.m2es3 - math.Pi*rho*m.mbar*(C1*dI2+C2*eta*I2)*m.m2e2s3
+
+	t := terms{ar: ahc + adisp, z: 1 + zhc + zdisp, ax: make([]float64, n)}
+	for k := range n {
+		z0k, z1k, z2k, z3k := zx[k][0], zx[k][1], zx[k][2], zx[k][3]
+		mk := m.m[k]
+
+		ahsx := -z0k/z0*ahs + (3*(z1k*z2+z1*z2k)/ome+
+			3*z1*z2*z3k/(ome*ome)+
+			3*z2*z2*z2k/(z3*ome*ome)+
+			z2*z2*z2*z3k*(3*z3-1)/(z3*z3*ome*ome*ome)+
+			((3*z2*z2*z2k*z3-2*z2*z2*z2*z3k)/(z3*z3*z3)-z0k)*lnOme+
+			(z0-z2*z2*z2/(z3*z3))*z3k/ome)/z0
+		ahcx := mk*ahs + m.mbar*ahsx - (mk-1)*math.Log(g[k])
+		for i := range n {
+			dd := m.d[i] / 2
+			gx := z3k/(ome*ome) + dd*(3*z2k/(ome*ome)+6*z2*z3k/(ome*ome*ome)) +
+				dd*dd*(4*z2*z2k/(ome*ome*ome)+6*z2*z2*z3k/(ome*ome*ome*ome))
+			ahcx -= x[i] * (m.m[i] - 1) * gx / g[i]
+		}
+
+		C1x := C2*z3k - C1*C1*mk*(A-B)
+		var I1x, I2x float64
+		pow := 1.0
+		for j := range 7 {
+			I1x += m.da[k][j] * pow
+			I2x += m.db[k][j] * pow
+			if j < 6 {
+				I1x += m.a[j+1] * float64(j+1) * z3k * pow
+				I2x += m.b[j+1] * float64(j+1) * z3k * pow
+			}
+			pow *= eta
+		}
+		adispx := -2*math.Pi*rho*(I1x*m.m2es3+I1*m.dm2es3[k]) -
+			math.Pi*rho*((mk*C1*I2+m.mbar*C1x*I2+m.mbar*C1*I2x)*m.m2e2s3+m.mbar*C1*I2*m.dm2e2s3[k])
+		t.ax[k] = ahcx + adispx
+	}
+	return t
+}
+
+// pressure returns the pressure (bar) at number density rho (1/Å³) with
+// compressibility factor z.
+func (m *model) pressure(rho, z float64) float64 {
+	return z * R * m.cfg.T / volume(rho)
+}
+
+// roots returns the packing fractions at which the pressure equals m.cfg.P, in
+// ascending order. The pressure is scanned over a geometric grid of η from well
+// inside the ideal-gas region to close packing, and its sign changes refined by
+// bisection.
+func (m *model) roots() ([]float64, error) {
+	P := m.cfg.P
+	f := func(eta float64) (float64, error) {
+		rho := m.density(eta)
+		return m.pressure(rho, m.evaluate(rho).z) - P, nil
+	}
+	// The packing fraction of the ideal gas at P, ρ = P/kT.
+	rhoIG := P / (R * m.cfg.T) * avogadro / 1e24
+	roots, err := numeric.GeometricRoots(f, 1e-3*rhoIG/m.density(1), maxPacking, 300)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) == 0 {
+		return nil, errors.New("no density root found")
+	}
+	return roots, nil
+}
+
+// State is the solution of PC-SAFT at the temperature and pressure of a Config.
+type State struct {
+	V     float64   // Molar volume (cm³/mol)
+	Z     float64   // Compressibility factor
+	Eta   float64   // Packing fraction η = ζ₃
+	LnPhi []float64 // ln φ̂ᵢ of the components
+	Ar    float64   // Reduced residual Helmholtz energy A^res/(nRT) at T and V
+}
+
+// state evaluates the state at packing fraction eta.
+func (m *model) state(eta float64) (*State, error) {
+	rho := m.density(eta)
+	t := m.evaluate(rho)
+	if t.z <= 0 {
+		return nil, fmt.Errorf("non-physical root: Z = %v", t.z)
+	}
+	s := &State{V: volume(rho), Z: t.z, Eta: eta, Ar: t.ar, LnPhi: make([]float64, len(t.ax))}
+
+	// μᵢ^res/kT = ã^res + (Z - 1) + ∂ã/∂xᵢ - Σⱼ xⱼ ∂ã/∂xⱼ, and ln φ̂ᵢ = μᵢ^res/kT - ln Z.
+	var sum float64
+	for j, y := range m.cfg.Y {
+		sum += y * t.ax[j]
+	}
+	for i := range s.LnPhi {
+		s.LnPhi[i] = t.ar + t.z - 1 + t.ax[i] - sum - math.Log(t.z)
+	}
+	return s, nil
+}
+
+// Solve returns the state of cfg on the density root of phase p: the densest
+// for phase.Liquid, the least dense for phase.Vapor or phase.Supercritical, or
+// the one with the lowest Gibbs energy, Σ yᵢ ln φ̂ᵢ, for phase.Unknown.
+func Solve(cfg *Config, p phase.Phase) (*State, error) {
+	m, err := newModel(cfg)
+	if err != nil {
+		return nil, err
+	}
+	roots, err := m.roots()
+	if err != nil {
+		return nil, err
+	}
+	if p != phase.Unknown {
+		// phase.Root picks the smallest root for liquids, so it is applied to the
+		// volumes, which decrease with the packing fraction.
+		volumes := make([]float64, len(roots))
+		for i, eta := range roots {
+			volumes[len(roots)-1-i] = volume(m.density(eta))
+		}
+		V, err := phase.Root(volumes, p)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range volumes {
+			if v == V {
+				return m.state(roots[len(roots)-1-i])
+			}
+		}
+	}
+	var best *State
+	bestG := math.Inf(1)
+	for _, eta := range roots {
+		s, err := m.state(eta)
+		if err != nil {
+			continue
+		}
+		var g float64
+		for i, y := range cfg.Y {
+			g += y * s.LnPhi[i]
+		}
+		if g < bestG {
+			best, bestG = s, g
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no physical density root found")
+	}
+	return best, nil
+}
+
+// SaturationPressure returns the vapor pressure (bar) of the pure component c at
+// temperature T, where the fugacities of the liquid and vapor roots are equal.
+// The pressure is updated by P ← P φᴸ/φⱽ.
+func SaturationPressure(c *Component, T float64) (float64, error) {
+	return numeric.SaturationPressure(func(P float64) (liq, vap *numeric.PhaseRoot, err error) {
+		m, err := newModel(NewPureCfg(c, T, P))
+		if err != nil {
+			return nil, nil, err
+		}
+		roots, err := m.roots()
+		if err != nil {
+			return nil, nil, err
+		}
+		dense, err := m.state(roots[len(roots)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+		liq = &numeric.PhaseRoot{V: dense.V, LnPhi: dense.LnPhi[0]}
+		// A single root is passed on as the liquid if it is dense.
+		if len(roots) == 1 {
+			if roots[0] > 0.3 {
+				return liq, nil, nil
+			}
+			return nil, liq, nil
+		}
+		v, err := m.state(roots[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return liq, &numeric.PhaseRoot{V: v.V, LnPhi: v.LnPhi[0]}, nil
+	})
+}
@@ -0,0 +1,124 @@
+// Package pcsaft implements the Perturbed-Chain SAFT (PC-SAFT) equation of state
+// of Gross and Sadowski (2001) for non-associating fluids.
+//
+// A molecule is a chain of m spherical segments of diameter σ interacting with
+// the dispersion energy ε. The residual Helmholtz energy is the sum of a
+// hard-chain reference and a dispersion perturbation,
+//
+//	ã^res = ã^hc + ã^disp
+//	ã^hc = m̄ ã^hs - Σᵢ xᵢ (mᵢ - 1) ln gᵢᵢ^hs(dᵢᵢ)
+//
+// where ã^hs is the hard-sphere term of Boublík and Mansoori et al. and gᵢᵢ^hs the
+// hard-sphere radial distribution function at contact, both evaluated with the
+// temperature-dependent segment diameter dᵢ = σᵢ [1 - 0.12 exp(-3εᵢ/kT)]. The
+// dispersion term is a second-order perturbation in the segment density,
+//
+//	ã^disp = -2πρ I₁(η, m̄) m²εσ³ - πρ m̄ C₁ I₂(η, m̄) m²ε²σ³
+//
+// with the universal power series I₁ and I₂ in the packing fraction η. Cross
+// parameters follow the Berthelot-Lorentz rules, σᵢⱼ = (σᵢ + σⱼ)/2 and
+// εᵢⱼ = √(εᵢεⱼ)(1 - kᵢⱼ).
+//
+// Unlike a cubic EOS, the density is found numerically: Solve scans the
+// pressure over the packing fraction and refines every root, returning Z and the
+// fugacity coefficients on the root of the requested phase, so that results can
+// be compared with cubic.PhaseLogPhi on the same mixture.
+//
+// Units: T in K, P in bar, V in cm³/mol and σ in Å.
+package pcsaft
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+// R is the gas constant in the units of the package, bar·cm³/(mol·K).
+const R = zfactor.RSI * 10
+
+// avogadro is the Avogadro constant (1/mol).
+const avogadro = 6.02214076e23
+
+// Component holds the PC-SAFT parameters of a substance, fitted to its vapor
+// pressures and liquid densities.
+type Component struct {
+	Name    string
+	MW      float64 // Molar mass (g/mol)
+	M       float64 // Segment number m
+	Sigma   float64 // Segment diameter σ (Å)
+	Epsilon float64 // Dispersion energy ε/k (K)
+}
+
+// diameter returns the temperature-dependent segment diameter d (Å).
+func (c *Component) diameter(T float64) float64 {
+	return c.Sigma * (1 - 0.12*math.Exp(-3*c.Epsilon/T))
+}
+
+// validate checks the parameters of c.
+func (c *Component) validate() error {
+	if c.M <= 0 || c.Sigma <= 0 || c.Epsilon <= 0 {
+		return fmt.Errorf("%s: PC-SAFT parameters m, σ and ε must be positive", c.Name)
+	}
+	return nil
+}
+
+// Config holds a mixture, or a pure component, at a temperature and pressure.
+type Config struct {
+	Components []*Component
+	Y          []float64 // Mole fractions of the components
+	T          float64   // Temperature (K)
+	P          float64   // Pressure (bar)
+	// Kij is the symmetric matrix of binary interaction parameters of the cross
+	// dispersion energies, εij = √(εi εj)(1 - kij). If nil, all kij are 0.
+	Kij [][]float64
+}
+
+// NewConfig creates a configuration for a mixture of comps with mole fractions y
+// at temperature T and pressure P.
+func NewConfig(T, P float64, y []float64, comps []*Component) *Config {
+	return &Config{Components: comps, Y: y, T: T, P: P}
+}
+
+// NewPureCfg creates a configuration for the pure component c at temperature T
+// and pressure P.
+func NewPureCfg(c *Component, T, P float64) *Config {
+	return NewConfig(T, P, []float64{1}, []*Component{c})
+}
+
+// validate checks the configuration.
+func (cfg *Config) validate() error {
+	if cfg == nil {
+		return errors.New("configuration error: config cannot be nil")
+	}
+	n := len(cfg.Components)
+	if n == 0 {
+		return errors.New("mixture must have at least one component")
+	}
+	if len(cfg.Y) != n {
+		return fmt.Errorf("got %d mole fractions for %d components", len(cfg.Y), n)
+	}
+	if err := numeric.MoleFractions(cfg.Y); err != nil {
+		return err
+	}
+	for _, c := range cfg.Components {
+		if c == nil {
+			return errors.New("component cannot be nil")
+		}
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+	if cfg.T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if cfg.P <= 0 {
+		return zfactor.ErrPressure
+	}
+	if cfg.Kij != nil {
+		return numeric.SymmetricMatrix("Kij", cfg.Kij, n)
+	}
+	return nil
+}
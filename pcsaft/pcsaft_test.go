@@ -0,0 +1,130 @@
+package pcsaft_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/pcsaft"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestSaturationPressure(t *testing.T) {
+	// Normal boiling points.
+	tests := []struct {
+		c *pcsaft.Component
+		T float64
+	}{
+		{pcsaft.Methane, 111.66},
+		{pcsaft.Propane, 231.05},
+		{pcsaft.NHexane, 341.88},
+		{pcsaft.Benzene, 353.24},
+		{pcsaft.Nitrogen, 77.35},
+	}
+	for _, tt := range tests {
+		got, err := pcsaft.SaturationPressure(tt.c, tt.T)
+		if err != nil {
+			t.Errorf("SaturationPressure(%s) unexpected error: %v", tt.c.Name, err)
+			continue
+		}
+		if math.Abs(got-zfactor.AtmBar)/zfactor.AtmBar > 0.02 {
+			t.Errorf("SaturationPressure(%s, %v) = %.4f bar, want %.4f bar within 2%%", tt.c.Name, tt.T, got, zfactor.AtmBar)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	liq, err := pcsaft.Solve(pcsaft.NewPureCfg(pcsaft.NHexane, 298.15, 1), phase.Liquid)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	if rho := 1000 * pcsaft.NHexane.MW / liq.V; math.Abs(rho-655)/655 > 0.015 {
+		t.Errorf("Solve() liquid density = %.1f kg/m³, want 655 kg/m³ within 1.5%%", rho)
+	}
+
+	// The vapor and liquid roots below the vapor pressure, and the stable one.
+	cfg := pcsaft.NewPureCfg(pcsaft.NHexane, 298.15, 0.1)
+	vap, err := pcsaft.Solve(cfg, phase.Vapor)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	liq, err = pcsaft.Solve(cfg, phase.Liquid)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	stable, err := pcsaft.Solve(cfg, phase.Unknown)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	if !(vap.Z > 0.95 && vap.Z < 1) || liq.Z > 0.01 || vap.V <= liq.V {
+		t.Errorf("Solve() vapor Z = %v, liquid Z = %v, want Z near 1 and below 0.01", vap.Z, liq.Z)
+	}
+	if stable.V != vap.V {
+		t.Errorf("Solve(phase.Unknown) V = %v, want the vapor root %v", stable.V, vap.V)
+	}
+}
+
+func TestComponentLogPhi(t *testing.T) {
+	comps := []*pcsaft.Component{pcsaft.Methane, pcsaft.NDecane}
+	kij := [][]float64{{0, 0.02}, {0.02, 0}}
+	const T, P = 350.0, 100.0
+
+	// ln φ of the mixture from the residual Helmholtz energy, G^R/RT = A^r/RT + Z - 1 - ln Z.
+	nLnPhi := func(n []float64) float64 {
+		total := n[0] + n[1]
+		cfg := pcsaft.NewConfig(T, P, []float64{n[0] / total, n[1] / total}, comps)
+		cfg.Kij = kij
+		s, err := pcsaft.Solve(cfg, phase.Liquid)
+		if err != nil {
+			t.Fatalf("Solve() unexpected error: %v", err)
+		}
+		return total * (s.Ar + s.Z - 1 - math.Log(s.Z))
+	}
+
+	y := []float64{0.3, 0.7}
+	cfg := pcsaft.NewConfig(T, P, y, comps)
+	cfg.Kij = kij
+	s, err := pcsaft.Solve(cfg, phase.Liquid)
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+	// ln φ̂ᵢ is the partial molar derivative ∂(n ln φ)/∂nᵢ at constant T and P.
+	const h = 1e-5
+	for i := range y {
+		up, down := append([]float64(nil), y...), append([]float64(nil), y...)
+		up[i] += h
+		down[i] -= h
+		want := (nLnPhi(up) - nLnPhi(down)) / (2 * h)
+		if math.Abs(s.LnPhi[i]-want) > 1e-5 {
+			t.Errorf("LnPhi[%d] = %v, want ∂(n ln φ)/∂nᵢ = %v", i, s.LnPhi[i], want)
+		}
+	}
+}
+
+func TestForSubstance(t *testing.T) {
+	if c, err := pcsaft.ForSubstance(substance.CarbonDioxide); err != nil || c != pcsaft.CarbonDioxide {
+		t.Errorf("ForSubstance(CarbonDioxide) = %v, %v, want pcsaft.CarbonDioxide", c, err)
+	}
+	if _, err := pcsaft.ForSubstance(substance.Water); err == nil {
+		t.Error("ForSubstance(Water) expected an error for missing parameters")
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *pcsaft.Config
+	}{
+		{"nil config", nil},
+		{"zero temperature", pcsaft.NewPureCfg(pcsaft.Methane, 0, 1)},
+		{"zero pressure", pcsaft.NewPureCfg(pcsaft.Methane, 300, 0)},
+		{"mole fraction sum", pcsaft.NewConfig(300, 1, []float64{0.5, 0.4}, []*pcsaft.Component{pcsaft.Methane, pcsaft.Ethane})},
+		{"missing segment number", pcsaft.NewPureCfg(&pcsaft.Component{Name: "X", Sigma: 3.5, Epsilon: 200}, 300, 1)},
+	}
+	for _, tt := range tests {
+		if _, err := pcsaft.Solve(tt.cfg, phase.Liquid); err == nil {
+			t.Errorf("%s: Solve() expected error, got nil", tt.name)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Package petro characterizes petroleum fractions as pseudo-components.
+//
+// A cut is described by its normal boiling point Tb and specific gravity SG,
+// from which the Twu or Riazi-Daubert correlations estimate the critical
+// properties, acentric factor and molar mass. The result is a
+// *substance.Substance that can be used with the cubic EOS, Lee-Kesler and
+// other machinery of this module:
+//
+//	s, err := petro.Cut{Name: "C7+ cut", Tb: 371.6, SG: 0.7217}.Substance()
+//	cfg := s.CubicConfig(&cubic.PR{}, zfactor.Args{T: 400, P: 10, R: 83.14})
+package petro
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Properties are the estimated properties of a petroleum fraction.
+//
+// Units follow the substance database: Kelvin, bar and cm³/mol.
+type Properties struct {
+	MW       float64 // Molar mass (g/mol)
+	Tc       float64 // Critical temperature (K)
+	Pc       float64 // Critical pressure (bar)
+	Vc       float64 // Critical volume (cm³/mol)
+	Zc       float64 // Critical compressibility factor
+	Acentric float64 // Acentric factor
+}
+
+// Method selects the characterization correlations.
+type Method int
+
+const (
+	MethodTwu          Method = iota // Twu (1984), see Twu
+	MethodRiaziDaubert               // Riazi-Daubert (1980), see RiaziDaubert
+)
+
+// String implements fmt.Stringer for Method.
+func (m Method) String() string {
+	switch m {
+	case MethodTwu:
+		return "Twu"
+	case MethodRiaziDaubert:
+		return "Riazi-Daubert"
+	default:
+		return fmt.Sprintf("Method(%d)", int(m))
+	}
+}
+
+// Cut is a petroleum fraction characterized by its boiling point and specific gravity.
+type Cut struct {
+	Name   string
+	Tb     float64 // Normal (mean average) boiling point (K)
+	SG     float64 // Specific gravity at 60°F/60°F
+	Method Method  // Characterization method. Defaults to MethodTwu.
+}
+
+// Properties estimates the properties of the cut with the selected method.
+func (c Cut) Properties() (*Properties, error) {
+	switch c.Method {
+	case MethodTwu:
+		return Twu(c.Tb, c.SG)
+	case MethodRiaziDaubert:
+		return RiaziDaubert(c.Tb, c.SG)
+	default:
+		return nil, fmt.Errorf("unknown characterization method %v", c.Method)
+	}
+}
+
+// Comparison cross-validates the Twu and Riazi-Daubert characterizations of a cut.
+//
+// Deviations are percentages of Riazi-Daubert relative to Twu, except for
+// AcentricDev which is an absolute difference.
+type Comparison struct {
+	Twu          *Properties
+	RiaziDaubert *Properties
+	MWDev        float64
+	TcDev        float64
+	PcDev        float64
+	VcDev        float64
+	AcentricDev  float64
+}
+
+// CrossValidate characterizes the cut with both methods and reports their
+// deviations. Large deviations indicate that the cut lies outside the range
+// where the correlations agree, and the estimates should be used with care.
+func (c Cut) CrossValidate() (*Comparison, error) {
+	twu, err := Twu(c.Tb, c.SG)
+	if err != nil {
+		return nil, fmt.Errorf("twu: %w", err)
+	}
+	rd, err := RiaziDaubert(c.Tb, c.SG)
+	if err != nil {
+		return nil, fmt.Errorf("riazi-daubert: %w", err)
+	}
+	dev := func(a, b float64) float64 {
+		return 100 * (b - a) / a
+	}
+	return &Comparison{
+		Twu:          twu,
+		RiaziDaubert: rd,
+		MWDev:        dev(twu.MW, rd.MW),
+		TcDev:        dev(twu.Tc, rd.Tc),
+		PcDev:        dev(twu.Pc, rd.Pc),
+		VcDev:        dev(twu.Vc, rd.Vc),
+		AcentricDev:  rd.Acentric - twu.Acentric,
+	}, nil
+}
+
+// Substance returns the cut as a pseudo-component.
+func (c Cut) Substance() (*substance.Substance, error) {
+	p, err := c.Properties()
+	if err != nil {
+		return nil, err
+	}
+	name := c.Name
+	if name == "" {
+		name = fmt.Sprintf("Tb %g K, SG %g", c.Tb, c.SG)
+	}
+	return &substance.Substance{
+		Name:     name,
+		MW:       p.MW,
+		Acentric: p.Acentric,
+		Tn:       c.Tb,
+		Critical: substance.CriticalProps{
+			Tc: p.Tc,
+			Pc: p.Pc,
+			Vc: p.Vc,
+			Zc: p.Zc,
+		},
+	}, nil
+}
+
+// newProperties completes the estimated properties with Zc and the Lee-Kesler acentric factor.
+func newProperties(tb, mw, tc, pc, vc float64) (*Properties, error) {
+	w, err := leekesler.EstimateAcentricFactor(tb, tc, pc)
+	if err != nil {
+		return nil, err
+	}
+	return &Properties{
+		MW:       mw,
+		Tc:       tc,
+		Pc:       pc,
+		Vc:       vc,
+		Zc:       pc * vc / (10 * zfactor.RSI * tc),
+		Acentric: w,
+	}, nil
+}
+
+// validateCut checks the boiling point and specific gravity of a cut.
+func validateCut(tb, sg float64) error {
+	if tb <= 0 {
+		return zfactor.ErrTemp
+	}
+	if sg <= 0 {
+		return errors.New("specific gravity must be greater than 0")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package petro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/petro"
+)
+
+// n-paraffins: the correlations should reproduce the pure-component data closely.
+var paraffins = []struct {
+	name           string
+	tb, sg         float64
+	mw, tc, pc, ac float64
+}{
+	{"n-Heptane", 371.6, 0.6880, 100.20, 540.2, 27.40, 0.350},
+	{"n-Octane", 398.8, 0.7068, 114.23, 568.7, 24.90, 0.400},
+	{"n-Decane", 447.3, 0.7342, 142.28, 617.7, 21.10, 0.492},
+}
+
+func TestTwu(t *testing.T) {
+	for _, p := range paraffins {
+		got, err := petro.Twu(p.tb, p.sg)
+		if err != nil {
+			t.Fatalf("%s: Twu() unexpected error: %v", p.name, err)
+		}
+		checkProps(t, p.name, got, p.mw, p.tc, p.pc, p.ac, 2, 0.03)
+	}
+}
+
+func checkProps(t *testing.T, name string, got *petro.Properties, mw, tc, pc, ac, tol, acTol float64) {
+	t.Helper()
+	for _, c := range []struct {
+		prop      string
+		got, want float64
+	}{
+		{"MW", got.MW, mw},
+		{"Tc", got.Tc, tc},
+		{"Pc", got.Pc, pc},
+	} {
+		if dev := 100 * math.Abs(c.got-c.want) / c.want; dev > tol {
+			t.Errorf("%s: %s = %.4g, want %.4g (%.2f%% > %g%%)", name, c.prop, c.got, c.want, dev, tol)
+		}
+	}
+	if math.Abs(got.Acentric-ac) > acTol {
+		t.Errorf("%s: Acentric = %.3f, want %.3f", name, got.Acentric, ac)
+	}
+}
+
+func TestRiaziDaubert(t *testing.T) {
+	for _, p := range paraffins {
+		got, err := petro.RiaziDaubert(p.tb, p.sg)
+		if err != nil {
+			t.Fatalf("%s: RiaziDaubert() unexpected error: %v", p.name, err)
+		}
+		// Riazi-Daubert overestimates the molar mass of light paraffins by a few percent.
+		checkProps(t, p.name, got, p.mw, p.tc, p.pc, p.ac, 7, 0.05)
+	}
+}
+
+func TestCrossValidate(t *testing.T) {
+	cut := petro.Cut{Tb: 398.8, SG: 0.7068}
+	cmp, err := cut.CrossValidate()
+	if err != nil {
+		t.Fatalf("CrossValidate() unexpected error: %v", err)
+	}
+	for name, dev := range map[string]float64{"MW": cmp.MWDev, "Tc": cmp.TcDev, "Pc": cmp.PcDev} {
+		if math.Abs(dev) > 7 {
+			t.Errorf("CrossValidate() %s deviation = %.2f%%, want within 7%%", name, dev)
+		}
+	}
+
+	cut.Method = petro.MethodRiaziDaubert
+	s, err := cut.Substance()
+	if err != nil {
+		t.Fatalf("Substance() unexpected error: %v", err)
+	}
+	if s.Critical.Tc != cmp.RiaziDaubert.Tc {
+		t.Errorf("Substance() Tc = %v, want Riazi-Daubert %v", s.Critical.Tc, cmp.RiaziDaubert.Tc)
+	}
+}
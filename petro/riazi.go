@@ -0,0 +1,33 @@
+package petro
+
+import "math"
+
+// RiaziDaubert estimates the critical properties and molar mass of a petroleum
+// fraction from its normal boiling point tb (K) and specific gravity sg
+// (60°F/60°F) using the Riazi-Daubert (1980) two-parameter correlations,
+//
+//	θ = a Tb^b SG^c
+//
+// with Tb in °R. They are recommended for fractions with molar masses of about
+// 70 to 300 g/mol. As with Twu, the acentric factor is estimated with the
+// Lee-Kesler vapor pressure correlation.
+//
+// Reference: M. R. Riazi and T. E. Daubert, Hydrocarbon Processing 59 (1980) 115-116.
+func RiaziDaubert(tb, sg float64) (*Properties, error) {
+	if err := validateCut(tb, sg); err != nil {
+		return nil, err
+	}
+
+	Tb := tb * rankinePerKelvin
+	theta := func(a, b, c float64) float64 {
+		return a * math.Pow(Tb, b) * math.Pow(sg, c)
+	}
+
+	mw := theta(4.5673e-5, 2.1962, -1.0164)
+	tc := theta(24.2787, 0.58848, 0.3596)   // °R
+	pc := theta(3.12281e9, -2.3125, 2.3201) // psia
+	vc := theta(7.0434e-7, 2.3829, -1.683)  // ft³/lb
+
+	// 1 ft³/lb = 62.428 cm³/g
+	return newProperties(tb, mw, tc/rankinePerKelvin, pc*barPerPsia, vc*mw*cm3molPerFt3lbmol)
+}
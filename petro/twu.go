@@ -0,0 +1,100 @@
+package petro
+
+import (
+	"errors"
+	"math"
+)
+
+// Unit conversions used by the Twu correlations, which are written in field units.
+const (
+	rankinePerKelvin  = 1.8
+	barPerPsia        = 0.0689475729
+	cm3molPerFt3lbmol = 62.42796
+)
+
+// Twu estimates the critical properties and molar mass of a petroleum fraction
+// from its normal boiling point tb (K) and specific gravity sg (60°F/60°F)
+// using the Twu (1984) correlations.
+//
+// The properties of the normal paraffin with the same boiling point are computed
+// first and then corrected for the difference between sg and the specific gravity
+// of that paraffin. The acentric factor is estimated from Tb, Tc and Pc with the
+// Lee-Kesler vapor pressure correlation.
+//
+// Reference: C. H. Twu, Fluid Phase Equilibria 16 (1984) 137-150.
+func Twu(tb, sg float64) (*Properties, error) {
+	if err := validateCut(tb, sg); err != nil {
+		return nil, err
+	}
+
+	Tb := tb * rankinePerKelvin
+	sqTb := math.Sqrt(Tb)
+
+	// Normal paraffin reference properties
+	tcp := Tb / (0.533272 + 0.191017e-3*Tb + 0.779681e-7*Tb*Tb - 0.284376e-10*Tb*Tb*Tb + 0.959468e28/math.Pow(Tb, 13))
+	a := 1 - Tb/tcp
+	pcp := math.Pow(3.83354+1.19629*math.Sqrt(a)+34.8888*a+36.1952*a*a+104.193*math.Pow(a, 4), 2)
+	vcp := math.Pow(1-(0.419869-0.505839*a-1.56436*a*a*a-9481.70*math.Pow(a, 14)), -8)
+	sgp := 0.843593 - 0.128624*a - 3.36159*a*a*a - 13749.5*math.Pow(a, 12)
+	mwp, err := paraffinMW(Tb)
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := func(f float64) float64 {
+		r := (1 + 2*f) / (1 - 2*f)
+		return r * r
+	}
+
+	// Critical temperature
+	dsgT := math.Exp(5*(sgp-sg)) - 1
+	fT := dsgT * (-0.362456/sqTb + (0.0398285-0.948125/sqTb)*dsgT)
+	tc := tcp * ratio(fT)
+
+	// Critical volume
+	dsgV := math.Exp(4*(sgp*sgp-sg*sg)) - 1
+	fV := dsgV * (0.466590/sqTb + (-0.182421+3.01721/sqTb)*dsgV)
+	vc := vcp * ratio(fV)
+
+	// Critical pressure
+	dsgP := math.Exp(0.5*(sgp-sg)) - 1
+	fP := dsgP * ((2.53262 - 46.1955/sqTb - 0.00127885*Tb) + (-11.4277+252.140/sqTb+0.00230535*Tb)*dsgP)
+	pc := pcp * (tc / tcp) * (vcp / vc) * ratio(fP)
+
+	// Molar mass
+	dsgM := math.Exp(5*(sgp-sg)) - 1
+	x := math.Abs(0.0123420 - 0.328086/sqTb)
+	fM := dsgM * (x + (-0.0175691+0.193168/sqTb)*dsgM)
+	mw := math.Exp(math.Log(mwp) * ratio(fM))
+
+	return newProperties(tb, mw, tc/rankinePerKelvin, pc*barPerPsia, vc*cm3molPerFt3lbmol)
+}
+
+// paraffinMW solves Tb(θ) = exp(5.71419 + 2.71579θ - 0.286590θ² - 39.8544/θ - 0.122488/θ²)
+// - 24.7522θ + 35.3155θ², with θ = ln MW, for the molar mass of the normal
+// paraffin boiling at Tb (°R).
+func paraffinMW(Tb float64) (float64, error) {
+	f := func(th float64) float64 {
+		return math.Exp(5.71419+2.71579*th-0.286590*th*th-39.8544/th-0.122488/(th*th)) - 24.7522*th + 35.3155*th*th - Tb
+	}
+
+	// Initial guess from Twu: MW ≈ Tb / (10.44 - 0.0052 Tb)
+	th := math.Log(Tb / (10.44 - 0.0052*Tb))
+	if math.IsNaN(th) || th <= 0 {
+		th = math.Log(100)
+	}
+	const h = 1e-6
+	for range 100 {
+		fx := f(th)
+		d := (f(th+h) - f(th-h)) / (2 * h)
+		if d == 0 {
+			break
+		}
+		step := fx / d
+		th -= step
+		if math.Abs(step) < 1e-12 {
+			return math.Exp(th), nil
+		}
+	}
+	return 0, errors.New("twu: paraffin molar mass iteration did not converge")
+}
@@ -0,0 +1,66 @@
+// Package phase defines the phases of a pure fluid or mixture, shared by root
+// selection in the equations of state, states, flash results and diagrams.
+package phase
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Phase describes the region of the phase diagram a state falls in.
+type Phase int
+
+const (
+	Unknown       Phase = iota // Phase could not be determined
+	Liquid                     // Subcritical liquid (T < Tc and P > Psat for a pure fluid)
+	Vapor                      // Subcritical vapor (T < Tc and P <= Psat for a pure fluid)
+	Supercritical              // Supercritical fluid (T >= Tc)
+	TwoPhase                   // Coexisting liquid and vapor, e.g. a flash inside the envelope
+	Solid                      // Solid
+)
+
+// String implements fmt.Stringer for Phase.
+func (p Phase) String() string {
+	switch p {
+	case Liquid:
+		return "liquid"
+	case Vapor:
+		return "vapor"
+	case Supercritical:
+		return "supercritical"
+	case TwoPhase:
+		return "two-phase"
+	case Solid:
+		return "solid"
+	default:
+		return "unknown"
+	}
+}
+
+// Fluid reports whether p is a single fluid phase: liquid, vapor or supercritical.
+func (p Phase) Fluid() bool {
+	return p == Liquid || p == Vapor || p == Supercritical
+}
+
+// errNoRoots is returned by Root when there is no root to select from.
+var errNoRoots = errors.New("no real volume roots found")
+
+// Root selects the molar volume (or Z) of phase p among the real roots of a
+// cubic equation of state, sorted in ascending order: the smallest root is the
+// liquid and the largest the vapor. A supercritical fluid has a single
+// physical root, the largest.
+//
+// It returns an error if roots is empty or p is not a single fluid phase.
+func Root(roots []float64, p Phase) (float64, error) {
+	if len(roots) == 0 {
+		return 0, errNoRoots
+	}
+	switch p {
+	case Liquid:
+		return roots[0], nil
+	case Vapor, Supercritical:
+		return roots[len(roots)-1], nil
+	default:
+		return 0, fmt.Errorf("no volume root for %v phase", p)
+	}
+}
@@ -0,0 +1,48 @@
+package phase_test
+
+import (
+	"testing"
+
+	"github.com/rickykimani/zfactor/phase"
+)
+
+func TestRoot(t *testing.T) {
+	roots := []float64{80, 400, 2000}
+	tests := []struct {
+		roots   []float64
+		p       phase.Phase
+		want    float64
+		wantErr bool
+	}{
+		{roots, phase.Liquid, 80, false},
+		{roots, phase.Vapor, 2000, false},
+		{roots, phase.Supercritical, 2000, false},
+		{[]float64{150}, phase.Liquid, 150, false},
+		{roots, phase.TwoPhase, 0, true},
+		{roots, phase.Unknown, 0, true},
+		{nil, phase.Vapor, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := phase.Root(tt.roots, tt.p)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Root(%v, %v) error = %v, wantErr %v", tt.roots, tt.p, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Root(%v, %v) = %v, want %v", tt.roots, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	for p, want := range map[phase.Phase]string{
+		phase.Unknown:  "unknown",
+		phase.Liquid:   "liquid",
+		phase.TwoPhase: "two-phase",
+		phase.Solid:    "solid",
+	} {
+		if got := p.String(); got != want {
+			t.Errorf("%d.String() = %q, want %q", int(p), got, want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Package pipeline computes the flow capacity of gas pipeline segments with the
+// Weymouth and Panhandle equations, corrected for real-gas behavior.
+//
+// The equations for isothermal, steady flow in a horizontal pipe integrate the
+// momentum balance over the pressure drop, which the usual forms do with one
+// compressibility factor at the mean pressure. Here Z is instead evaluated along
+// the pressure profile of the segment by a substance.Provider, and the
+// pressure-squared driving force is divided by the effective Z that makes the
+// integral exact:
+//
+//	(P₁² - P₂²)/(2 Z_eff) = ∫ P/Z dP (P₂ → P₁)
+//
+// Units: T in K, P in bar (absolute), lengths and diameters in m and flows at
+// the base conditions meter.TBase and meter.PBase in m³/s.
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/meter"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// mwAir is the molar mass of dry air (g/mol), the reference of the gas gravity.
+const mwAir = 28.9647
+
+// profileIntervals is the number of pressure intervals of the Z profile, even
+// for Simpson's rule.
+const profileIntervals = 20
+
+// Equation is a gas pipeline flow equation.
+type Equation int
+
+const (
+	// Weymouth is the Weymouth equation, for high pressure, fully turbulent flow
+	// in large pipes:
+	//
+	//	Q = 3.7435e-3 E (Tb/Pb) [(P₁² - P₂²)/(G Tf L Z)]^0.5 D^2.667
+	Weymouth Equation = iota
+	// PanhandleA is the Panhandle A equation, for partially turbulent flow in
+	// large pipes:
+	//
+	//	Q = 4.5965e-3 E (Tb/Pb)^1.0788 [(P₁² - P₂²)/(G^0.8539 Tf L Z)]^0.5394 D^2.6182
+	PanhandleA
+	// PanhandleB is the Panhandle B equation, for fully turbulent flow in large
+	// pipes:
+	//
+	//	Q = 1.002e-2 E (Tb/Pb)^1.02 [(P₁² - P₂²)/(G^0.961 Tf L Z)]^0.51 D^2.53
+	PanhandleB
+)
+
+// String implements fmt.Stringer for Equation.
+func (e Equation) String() string {
+	switch e {
+	case Weymouth:
+		return "Weymouth"
+	case PanhandleA:
+		return "Panhandle A"
+	case PanhandleB:
+		return "Panhandle B"
+	default:
+		return fmt.Sprintf("Equation(%d)", int(e))
+	}
+}
+
+// constants returns the constant, the exponents of Tb/Pb, of the driving
+// force, of the gas gravity and of the diameter of equation e, in the SI form
+// with Q in m³/day, P in kPa, L in km and D in mm (Menon, Gas Pipeline
+// Hydraulics, 2005).
+func (e Equation) constants() (c, base, drive, gravity, diameter float64, err error) {
+	switch e {
+	case Weymouth:
+		return 3.7435e-3, 1, 0.5, 1, 2.667, nil
+	case PanhandleA:
+		return 4.5965e-3, 1.0788, 0.5394, 0.8539, 2.6182, nil
+	case PanhandleB:
+		return 1.002e-2, 1.02, 0.51, 0.961, 2.53, nil
+	default:
+		return 0, 0, 0, 0, 0, fmt.Errorf("unknown pipeline equation %v", e)
+	}
+}
+
+// ProfilePoint is the compressibility factor of the gas at a pressure along a
+// segment.
+type ProfilePoint struct {
+	P float64 // Pressure (bar)
+	Z float64 // Compressibility factor
+}
+
+// ZProfile returns the compressibility factors of the gas of inlet, at its
+// temperature, at n+1 evenly spaced pressures from the outlet pressure P2 to the
+// inlet pressure inlet.P.
+func ZProfile(p substance.Provider, inlet *flowsheet.Stream, P2 float64, n int) ([]ProfilePoint, error) {
+	if n < 1 {
+		return nil, errors.New("profile must have at least one interval")
+	}
+	profile := make([]ProfilePoint, n+1)
+	for k := range profile {
+		P := P2 + (inlet.P-P2)*float64(k)/float64(n)
+		z, err := inlet.At(inlet.T, P).Z(p)
+		if err != nil {
+			return nil, fmt.Errorf("Z at %g bar: %w", P, err)
+		}
+		profile[k] = ProfilePoint{P: P, Z: z}
+	}
+	return profile, nil
+}
+
+// AverageZ returns the effective compressibility factor of the gas of inlet
+// between the inlet pressure inlet.P and the outlet pressure P2,
+//
+//	Z_eff = (P₁² - P₂²) / (2 ∫ P/Z dP)
+//
+// with the integral evaluated by Simpson's rule on the Z profile, which is also
+// returned.
+func AverageZ(p substance.Provider, inlet *flowsheet.Stream, P2 float64) (float64, []ProfilePoint, error) {
+	if P2 <= 0 {
+		return 0, nil, zfactor.ErrPressure
+	}
+	if P2 >= inlet.P {
+		return 0, nil, fmt.Errorf("outlet pressure %g bar must be below the inlet pressure %g bar", P2, inlet.P)
+	}
+	profile, err := ZProfile(p, inlet, P2, profileIntervals)
+	if err != nil {
+		return 0, nil, err
+	}
+	h := (inlet.P - P2) / profileIntervals
+	var integral float64
+	for k, pt := range profile {
+		w := 2.0
+		switch {
+		case k == 0 || k == profileIntervals:
+			w = 1
+		case k%2 == 1:
+			w = 4
+		}
+		integral += w * pt.P / pt.Z
+	}
+	integral *= h / 3
+	return (inlet.P*inlet.P - P2*P2) / (2 * integral), profile, nil
+}
+
+// MeanPressure returns the mean pressure of a segment between P1 and P2 used
+// for single-point property evaluations,
+//
+//	Pm = 2/3 (P₁ + P₂ - P₁P₂/(P₁ + P₂))
+func MeanPressure(P1, P2 float64) float64 {
+	return 2.0 / 3 * (P1 + P2 - P1*P2/(P1+P2))
+}
+
+// Segment is a horizontal pipe segment.
+type Segment struct {
+	Length float64 // Length (m)
+	D      float64 // Internal diameter (m)
+	// Efficiency is the pipeline efficiency factor E in (0, 1], which absorbs
+	// the deviation of the pipe from the equation. Defaults to 1 if 0.
+	Efficiency float64
+}
+
+// Capacity is the flow through a segment between given inlet and outlet
+// pressures.
+type Capacity struct {
+	Equation Equation
+	// Z is the effective compressibility factor of the segment (see AverageZ),
+	// used in the flow equation.
+	Z float64
+	// ZMean is the compressibility factor at the mean pressure PMean, the
+	// single-point assumption the effective Z replaces.
+	ZMean float64
+	PMean float64 // Mean pressure (bar), see MeanPressure
+	// Profile is the compressibility factor along the segment.
+	Profile []ProfilePoint
+	// StandardFlow is the ideal-gas volumetric flow rate at meter.TBase and
+	// meter.PBase (m³/s), the standard volumes of the flow equations (see
+	// meter.MolarVolumeBase).
+	StandardFlow float64
+	MolarFlow    float64 // Molar flow rate (mol/s)
+	MassFlow     float64 // Mass flow rate (kg/s)
+}
+
+// Flow returns the capacity of s with equation eq for the gas inlet, at the
+// flowing temperature and inlet pressure of inlet, and the outlet pressure P2
+// (bar). inlet.Flow is ignored.
+func (s *Segment) Flow(eq Equation, p substance.Provider, inlet *flowsheet.Stream, P2 float64) (*Capacity, error) {
+	if s.Length <= 0 || s.D <= 0 {
+		return nil, errors.New("segment length and diameter must be greater than 0")
+	}
+	eff := s.Efficiency
+	if eff == 0 {
+		eff = 1
+	}
+	if eff < 0 || eff > 1 {
+		return nil, errors.New("pipeline efficiency must be in (0, 1]")
+	}
+	c, base, drive, gravity, diameter, err := eq.constants()
+	if err != nil {
+		return nil, err
+	}
+	mw := inlet.MW()
+	if mw <= 0 {
+		return nil, zfactor.ErrMolarMass
+	}
+
+	z, profile, err := AverageZ(p, inlet, P2)
+	if err != nil {
+		return nil, err
+	}
+	pm := MeanPressure(inlet.P, P2)
+	zm, err := inlet.At(inlet.T, pm).Z(p)
+	if err != nil {
+		return nil, fmt.Errorf("Z at the mean pressure: %w", err)
+	}
+
+	// The SI form of the equations: P in kPa, L in km, D in mm and Q in m³/day.
+	p1, p2 := inlet.P*100, P2*100
+	g := mw / mwAir
+	q := c * eff * math.Pow(meter.TBase/(meter.PBase*100), base) *
+		math.Pow((p1*p1-p2*p2)/(math.Pow(g, gravity)*inlet.T*s.Length/1000*z), drive) *
+		math.Pow(s.D*1000, diameter) / 86400
+
+	molar := q / meter.MolarVolumeBase
+	return &Capacity{
+		Equation:     eq,
+		Z:            z,
+		ZMean:        zm,
+		PMean:        pm,
+		Profile:      profile,
+		StandardFlow: q,
+		MolarFlow:    molar,
+		MassFlow:     molar * mw * 1e-3,
+	}, nil
+}
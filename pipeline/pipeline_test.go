@@ -0,0 +1,90 @@
+package pipeline_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/meter"
+	"github.com/rickykimani/zfactor/pipeline"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestAverageZ(t *testing.T) {
+	gas := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 288, 70, 0)
+	p := flowsheet.LeeKesler{}
+
+	z, profile, err := pipeline.AverageZ(p, gas, 50)
+	if err != nil {
+		t.Fatalf("AverageZ() unexpected error: %v", err)
+	}
+	first, last := profile[0], profile[len(profile)-1]
+	if first.P != 50 || last.P != 70 {
+		t.Errorf("AverageZ() profile from %v to %v bar, want 50 to 70 bar", first.P, last.P)
+	}
+	// Z falls with pressure, so the effective Z lies between the end values,
+	// close to Z at the mean pressure.
+	if z >= first.Z || z <= last.Z {
+		t.Errorf("AverageZ() = %v, want between %v and %v", z, last.Z, first.Z)
+	}
+	zm, _ := gas.At(288, pipeline.MeanPressure(70, 50)).Z(p)
+	if math.Abs(z-zm) > 2e-3 {
+		t.Errorf("AverageZ() = %v, want close to Z at the mean pressure %v", z, zm)
+	}
+
+	if ideal, _, err := pipeline.AverageZ(flowsheet.IdealGas{}, gas, 50); err != nil || math.Abs(ideal-1) > 1e-12 {
+		t.Errorf("AverageZ() ideal gas = %v, %v, want 1", ideal, err)
+	}
+	if _, _, err := pipeline.AverageZ(p, gas, 80); err == nil {
+		t.Error("AverageZ() with outlet above inlet pressure expected error, got nil")
+	}
+}
+
+func TestSegmentFlow(t *testing.T) {
+	gas := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 288, 70, 0)
+	seg := &pipeline.Segment{Length: 100e3, D: 0.5}
+
+	ideal, err := seg.Flow(pipeline.Weymouth, flowsheet.IdealGas{}, gas, 50)
+	if err != nil {
+		t.Fatalf("Flow() unexpected error: %v", err)
+	}
+	// Weymouth, SI units: kPa, km, mm and m³/day.
+	g := substance.Methane.MW / 28.9647
+	want := 3.7435e-3 * meter.TBase / (meter.PBase * 100) *
+		math.Sqrt((7000.0*7000-5000*5000)/(g*288*100)) * math.Pow(500, 2.667) / 86400
+	if math.Abs(ideal.StandardFlow-want) > 1e-9*want {
+		t.Errorf("Flow() ideal = %v m³/s, want %v", ideal.StandardFlow, want)
+	}
+	molar := ideal.StandardFlow * meter.PBase * 1e5 / (zfactor.RSI * meter.TBase)
+	if math.Abs(ideal.MolarFlow-molar) > 1e-9*molar || math.Abs(ideal.MassFlow-molar*substance.Methane.MW*1e-3) > 1e-9*ideal.MassFlow {
+		t.Errorf("Flow() molar, mass flow = %v, %v, want %v mol/s", ideal.MolarFlow, ideal.MassFlow, molar)
+	}
+
+	// The real gas carries more, by Z^(-exponent of the driving force).
+	exponents := map[pipeline.Equation]float64{pipeline.Weymouth: 0.5, pipeline.PanhandleA: 0.5394, pipeline.PanhandleB: 0.51}
+	for eq, exp := range exponents {
+		id, err := seg.Flow(eq, flowsheet.IdealGas{}, gas, 50)
+		if err != nil {
+			t.Fatalf("Flow(%v) unexpected error: %v", eq, err)
+		}
+		rg, err := seg.Flow(eq, flowsheet.LeeKesler{}, gas, 50)
+		if err != nil {
+			t.Fatalf("Flow(%v) unexpected error: %v", eq, err)
+		}
+		if ratio := rg.StandardFlow / id.StandardFlow; math.Abs(ratio-math.Pow(rg.Z, -exp)) > 1e-9 || ratio <= 1 {
+			t.Errorf("Flow(%v) real/ideal = %v, want Z^-%v = %v", eq, ratio, exp, math.Pow(rg.Z, -exp))
+		}
+	}
+
+	if _, err := seg.Flow(pipeline.Equation(7), flowsheet.IdealGas{}, gas, 50); err == nil {
+		t.Error("Flow() with unknown equation expected error, got nil")
+	}
+	if _, err := (&pipeline.Segment{D: 0.5}).Flow(pipeline.Weymouth, flowsheet.IdealGas{}, gas, 50); err == nil {
+		t.Error("Flow() with zero length expected error, got nil")
+	}
+	if _, err := (&pipeline.Segment{Length: 1, D: 0.5, Efficiency: 1.2}).Flow(pipeline.Weymouth, flowsheet.IdealGas{}, gas, 50); err == nil {
+		t.Error("Flow() with efficiency above 1 expected error, got nil")
+	}
+}
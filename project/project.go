@@ -0,0 +1,359 @@
+// Package project stores user-extended property data in a single project
+// file, so that a team can share a curated property basis across programs:
+// custom substances, Antoine sets, fitted binary interaction parameters and
+// fitted EOS alpha constants and volume shifts.
+//
+// A File is read from and written to JSON. Apply merges it into the registries
+// of the library: the substances of substance.Lookup, the sets of
+// antoine.Lookup, the interaction parameters of substance.LookupKij and the
+// recommendations of substance.LookupEOSRecommendation. Entries of the file replace
+// the built-in entries of the same name and the rest are added, so a file only
+// needs to hold what differs from the built-in data.
+//
+//	f, _ := project.Load("basis.json")
+//	_ = f.Apply()
+//	s, _ := substance.Lookup("my solvent")
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/rickykimani/zfactor/antoine"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Version is the version of the file format written by File.Write.
+const Version = 1
+
+// File is the content of a project file. The zero value is an empty project.
+type File struct {
+	Version    int            `json:"version"`
+	Substances []Substance    `json:"substances,omitempty"`
+	Antoine    []Antoine      `json:"antoine,omitempty"`
+	Kij        []Kij          `json:"kij,omitempty"`
+	EOS        map[string]EOS `json:"eos,omitempty"` // Keyed by substance name
+}
+
+// Substance is a substance.Substance in a project file. A nil Acentric is a
+// missing acentric factor (NaN).
+type Substance struct {
+	Name        string   `json:"name"`
+	MW          float64  `json:"mw"`
+	Acentric    *float64 `json:"acentric"`
+	Tn          float64  `json:"tn,omitempty"`
+	Dipole      float64  `json:"dipole,omitempty"`
+	Associating bool     `json:"associating,omitempty"`
+	Tc          float64  `json:"tc"`
+	Pc          float64  `json:"pc"`
+	Vc          float64  `json:"vc,omitempty"`
+	Zc          float64  `json:"zc,omitempty"`
+}
+
+// Antoine is an antoine.Antoine in a project file. Temperatures are in °C.
+type Antoine struct {
+	Name    string  `json:"name"`
+	Formula string  `json:"formula,omitempty"`
+	A       float64 `json:"a"`
+	B       float64 `json:"b"`
+	C       float64 `json:"c"`
+	H       float64 `json:"h,omitempty"`
+	TMin    float64 `json:"tmin"`
+	TMax    float64 `json:"tmax"`
+	Tn      float64 `json:"tn,omitempty"`
+}
+
+// Kij is a binary interaction parameter of the substances A and B for the
+// cubic EOS named EOS.
+type Kij struct {
+	EOS string  `json:"eos"`
+	A   string  `json:"a"`
+	B   string  `json:"b"`
+	Kij float64 `json:"kij"`
+}
+
+// EOS is a substance.EOSRecommendation in a project file.
+type EOS struct {
+	EOS         string  `json:"eos"`
+	Kappa1      float64 `json:"kappa1,omitempty"`
+	Twu         *Twu    `json:"twu,omitempty"`
+	VolumeShift float64 `json:"volume_shift,omitempty"`
+	Source      string  `json:"source,omitempty"`
+}
+
+// Twu holds the constants of a cubic.Twu alpha function.
+type Twu struct {
+	L float64 `json:"l"`
+	M float64 `json:"m"`
+	N float64 `json:"n"`
+}
+
+// Load reads the project file at path.
+func Load(path string) (*File, error) {
+	r, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	f, err := Read(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Read reads a project file from r. It returns an error for unknown fields and
+// for files written by a newer version of the format.
+func Read(r io.Reader) (*File, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var f File
+	if err := dec.Decode(&f); err != nil {
+		return nil, err
+	}
+	if f.Version > Version {
+		return nil, fmt.Errorf("project file version %d is newer than the supported version %d", f.Version, Version)
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Save writes f to the file at path, replacing it.
+func (f *File) Save(path string) error {
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := f.Write(out); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Write writes f to w as indented JSON, with the current Version.
+func (f *File) Write(w io.Writer) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	c := *f
+	c.Version = Version
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&c)
+}
+
+// validate checks that the entries of f have names and valid constants: the
+// substances positive critical temperatures and pressures and an acentric
+// factor, if given, between -1 and 2, the Antoine sets ordered temperature
+// ranges, and the EOS records known equations of state.
+func (f *File) validate() error {
+	for i, s := range f.Substances {
+		if strings.TrimSpace(s.Name) == "" {
+			return fmt.Errorf("substance %d has no name", i+1)
+		}
+		if s.Tc <= 0 || s.Pc <= 0 {
+			return fmt.Errorf("substance %s: tc and pc must be positive, got %g K and %g bar", s.Name, s.Tc, s.Pc)
+		}
+		if s.Acentric != nil && (*s.Acentric <= -1 || *s.Acentric >= 2) {
+			return fmt.Errorf("substance %s: acentric factor %g outside (-1, 2)", s.Name, *s.Acentric)
+		}
+	}
+	for i, a := range f.Antoine {
+		if strings.TrimSpace(a.Name) == "" {
+			return fmt.Errorf("Antoine set %d has no name", i+1)
+		}
+		if a.TMax < a.TMin {
+			return fmt.Errorf("Antoine set %s: tmax %g is below tmin %g", a.Name, a.TMax, a.TMin)
+		}
+	}
+	for i, k := range f.Kij {
+		if k.EOS == "" || k.A == "" || k.B == "" {
+			return fmt.Errorf("kij %d requires eos, a and b", i+1)
+		}
+	}
+	for name, e := range f.EOS {
+		if _, err := cubic.ByName(e.EOS); err != nil {
+			return fmt.Errorf("eos of %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// AddSubstance adds s to f, replacing a substance of the same name.
+func (f *File) AddSubstance(s *substance.Substance) {
+	rec := Substance{
+		Name:        s.Name,
+		MW:          s.MW,
+		Tn:          s.Tn,
+		Dipole:      s.Dipole,
+		Associating: s.Associating,
+		Tc:          s.Critical.Tc,
+		Pc:          s.Critical.Pc,
+		Vc:          s.Critical.Vc,
+		Zc:          s.Critical.Zc,
+	}
+	if !math.IsNaN(s.Acentric) {
+		w := s.Acentric
+		rec.Acentric = &w
+	}
+	f.Substances = replace(f.Substances, rec, func(e Substance) string { return e.Name })
+}
+
+// AddAntoine adds a to f, replacing a set of the same name.
+func (f *File) AddAntoine(a *antoine.Antoine) {
+	rec := Antoine{
+		Name: a.Name, Formula: a.Formula, A: a.A, B: a.B, C: a.C, H: a.H,
+		TMin: a.Range.Low, TMax: a.Range.High, Tn: a.Tn,
+	}
+	f.Antoine = replace(f.Antoine, rec, func(e Antoine) string { return e.Name })
+}
+
+// SetKij sets the interaction parameter of the substances named a and b for
+// the EOS named eos, replacing a value of the same pair and EOS.
+func (f *File) SetKij(eos, a, b string, kij float64) {
+	key := func(k Kij) string {
+		x, y := strings.ToLower(k.A), strings.ToLower(k.B)
+		if y < x {
+			x, y = y, x
+		}
+		return strings.ToUpper(k.EOS) + "\x00" + x + "\x00" + y
+	}
+	f.Kij = replace(f.Kij, Kij{EOS: eos, A: a, B: b, Kij: kij}, key)
+}
+
+// SetEOS sets the recommended EOS of the substance named name.
+func (f *File) SetEOS(name string, r substance.EOSRecommendation) {
+	rec := EOS{EOS: r.EOS, Kappa1: r.Kappa1, VolumeShift: r.VolumeShift, Source: r.Source}
+	if r.Twu != nil {
+		rec.Twu = &Twu{L: r.Twu.L, M: r.Twu.M, N: r.Twu.N}
+	}
+	f.setEOS(name, rec)
+}
+
+// setEOS sets the EOS record of the substance named name, replacing the record
+// of a name that differs only in case.
+func (f *File) setEOS(name string, rec EOS) {
+	if f.EOS == nil {
+		f.EOS = map[string]EOS{}
+	}
+	for k := range f.EOS {
+		if strings.EqualFold(k, name) {
+			delete(f.EOS, k)
+		}
+	}
+	f.EOS[name] = rec
+}
+
+// Merge merges g into f. Entries of g replace the entries of f with the same
+// name, or the same pair and EOS for Kij, and the other entries are added.
+func (f *File) Merge(g *File) {
+	for _, s := range g.Substances {
+		f.Substances = replace(f.Substances, s, func(e Substance) string { return e.Name })
+	}
+	for _, a := range g.Antoine {
+		f.Antoine = replace(f.Antoine, a, func(e Antoine) string { return e.Name })
+	}
+	for _, k := range g.Kij {
+		f.SetKij(k.EOS, k.A, k.B, k.Kij)
+	}
+	for name, e := range g.EOS {
+		f.setEOS(name, e)
+	}
+}
+
+// Apply merges f into the registries of the library (see the package
+// documentation). It checks the whole file first and changes nothing if an
+// entry is invalid.
+func (f *File) Apply() error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	for _, s := range f.Substances {
+		if err := substance.Register(s.substance()); err != nil {
+			return err
+		}
+	}
+	for _, a := range f.Antoine {
+		if err := antoine.Register(a.antoine()); err != nil {
+			return err
+		}
+	}
+	for _, k := range f.Kij {
+		substance.RegisterKij(k.EOS, k.A, k.B, k.Kij)
+	}
+	for name, e := range f.EOS {
+		r := substance.EOSRecommendation{EOS: e.EOS, Kappa1: e.Kappa1, VolumeShift: e.VolumeShift, Source: e.Source}
+		if e.Twu != nil {
+			r.Twu = &cubic.Twu{L: e.Twu.L, M: e.Twu.M, N: e.Twu.N}
+		}
+		substance.RegisterEOSRecommendation(name, r)
+	}
+	return nil
+}
+
+// substance converts the record to a *substance.Substance.
+func (s Substance) substance() *substance.Substance {
+	w := math.NaN()
+	if s.Acentric != nil {
+		w = *s.Acentric
+	}
+	return &substance.Substance{
+		Name:        s.Name,
+		MW:          s.MW,
+		Acentric:    w,
+		Tn:          s.Tn,
+		Dipole:      s.Dipole,
+		Associating: s.Associating,
+		Critical:    substance.CriticalProps{Tc: s.Tc, Pc: s.Pc, Vc: s.Vc, Zc: s.Zc},
+	}
+}
+
+// antoine converts the record to an *antoine.Antoine.
+func (a Antoine) antoine() *antoine.Antoine {
+	return &antoine.Antoine{
+		Name: a.Name, Formula: a.Formula, A: a.A, B: a.B, C: a.C, H: a.H,
+		Range: antoine.TempRange{Low: a.TMin, High: a.TMax}, Tn: a.Tn,
+	}
+}
+
+// replace returns list with e in place of the entry with the same key,
+// compared case-insensitively, or with e appended.
+func replace[T any](list []T, e T, key func(T) string) []T {
+	k := key(e)
+	if i := slices.IndexFunc(list, func(x T) bool { return strings.EqualFold(key(x), k) }); i >= 0 {
+		list[i] = e
+		return list
+	}
+	return append(list, e)
+}
+
+// errNoFile is returned by LoadAll when no path is given.
+var errNoFile = errors.New("no project file given")
+
+// LoadAll loads the project files at paths and merges them in order, so that
+// later files override earlier ones, e.g. a personal file over a team file.
+func LoadAll(paths ...string) (*File, error) {
+	if len(paths) == 0 {
+		return nil, errNoFile
+	}
+	var f File
+	for _, p := range paths {
+		g, err := Load(p)
+		if err != nil {
+			return nil, err
+		}
+		f.Merge(g)
+	}
+	f.Version = Version
+	return &f, nil
+}
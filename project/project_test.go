@@ -0,0 +1,124 @@
+package project_test
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor/antoine"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/project"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var f project.File
+	f.AddSubstance(&substance.Substance{Name: "Solvent X", MW: 120, Acentric: math.NaN(),
+		Critical: substance.CriticalProps{Tc: 600, Pc: 30}})
+	f.AddAntoine(antoine.Acetone)
+	f.SetKij("PR", "Methane", "Solvent X", 0.02)
+	f.SetEOS("Solvent X", substance.EOSRecommendation{EOS: "PR", Twu: &cubic.Twu{L: 0.3, M: 0.9, N: 2}})
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		t.Fatalf("Write() unexpected error: %v", err)
+	}
+	g, err := project.Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() unexpected error: %v", err)
+	}
+	f.Version = project.Version
+	if !reflect.DeepEqual(&f, g) {
+		t.Errorf("Read(Write()) = %+v, want %+v", g, &f)
+	}
+	if g.Substances[0].Acentric != nil {
+		t.Errorf("Read() Acentric = %v, want nil for a missing acentric factor", *g.Substances[0].Acentric)
+	}
+}
+
+func TestApply(t *testing.T) {
+	const src = `{
+  "version": 1,
+  "substances": [
+    {"name": "Solvent Y", "mw": 88.1, "acentric": 0.36, "tc": 523.3, "pc": 38.3},
+    {"name": "Methane", "mw": 16.043, "acentric": 0.011, "tc": 190.56, "pc": 45.99, "vc": 98.6, "zc": 0.286}
+  ],
+  "antoine": [{"name": "Solvent Y", "a": 14.1, "b": 2900, "c": 220, "tmin": 0, "tmax": 100}],
+  "kij": [{"eos": "SRK", "a": "Solvent Y", "b": "Methane", "kij": 0.05}],
+  "eos": {"Solvent Y": {"eos": "PR", "kappa1": 0.05}}
+}`
+	f, err := project.Read(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("Read() unexpected error: %v", err)
+	}
+	if err := f.Apply(); err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+
+	s, ok := substance.Lookup("solvent y")
+	if !ok || s.Critical.Tc != 523.3 {
+		t.Errorf("Lookup(solvent y) = %+v, %v, want the project substance", s, ok)
+	}
+	if m, _ := substance.Lookup("Methane"); m.Critical.Tc != 190.56 {
+		t.Errorf("Lookup(Methane) Tc = %v, want the project value 190.56", m.Critical.Tc)
+	}
+	if a, ok := antoine.Lookup("Solvent Y"); !ok || a.A != 14.1 {
+		t.Errorf("antoine.Lookup() = %+v, %v, want the project set", a, ok)
+	}
+	if k, ok := substance.LookupKij("SRK", "methane", "solvent y"); !ok || k != 0.05 {
+		t.Errorf("LookupKij() = %v, %v, want 0.05", k, ok)
+	}
+	if r := s.RecommendedEOS(); r.Kappa1 != 0.05 {
+		t.Errorf("RecommendedEOS() = %+v, want the project recommendation", r)
+	}
+}
+
+func TestLoadAll(t *testing.T) {
+	dir := t.TempDir()
+	team, personal := filepath.Join(dir, "team.json"), filepath.Join(dir, "personal.json")
+
+	var a, b project.File
+	a.SetKij("PR", "Methane", "Ethane", 0.003)
+	a.SetKij("PR", "Methane", "Propane", 0.012)
+	b.SetKij("PR", "ethane", "methane", -0.002)
+	if err := a.Save(team); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Save(personal); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := project.LoadAll(team, personal)
+	if err != nil {
+		t.Fatalf("LoadAll() unexpected error: %v", err)
+	}
+	if len(f.Kij) != 2 || f.Kij[0].Kij != -0.002 || f.Kij[1].Kij != 0.012 {
+		t.Errorf("LoadAll() Kij = %+v, want the personal value to replace the team value", f.Kij)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name, src string
+	}{
+		{"newer version", `{"version": 2}`},
+		{"unknown field", `{"version": 1, "colour": "blue"}`},
+		{"unnamed substance", `{"version": 1, "substances": [{"mw": 10, "tc": 100, "pc": 10}]}`},
+		{"no Tc", `{"version": 1, "substances": [{"name": "X", "mw": 10, "pc": 10}]}`},
+		{"negative Pc", `{"version": 1, "substances": [{"name": "X", "mw": 10, "tc": 100, "pc": -1}]}`},
+		{"acentric factor", `{"version": 1, "substances": [{"name": "X", "mw": 10, "tc": 100, "pc": 10, "acentric": 3}]}`},
+		{"unknown EOS", `{"version": 1, "eos": {"X": {"eos": "nope"}}}`},
+	}
+	for _, tt := range tests {
+		if _, err := project.Read(strings.NewReader(tt.src)); err == nil {
+			t.Errorf("Read() %s expected error, got nil", tt.name)
+		}
+	}
+	if _, err := project.Load(filepath.Join(t.TempDir(), "missing.json")); !os.IsNotExist(err) {
+		t.Errorf("Load() of a missing file error = %v, want not exist", err)
+	}
+}
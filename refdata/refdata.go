@@ -0,0 +1,157 @@
+// Package refdata provides a small corpus of published reference values for
+// compressibility factors, vapor pressures and saturated liquid volumes.
+//
+// The dataset is used by the regression tests of this module to assert that each
+// correlation stays within a stated tolerance, and is exported so that users can
+// run the same checks against custom EOS implementations:
+//
+//	results := refdata.CheckPsat(func(s *substance.Substance, T float64) (float64, error) {
+//	    cfg := s.CubicConfig(&MyEOS{}, zfactor.Args{T: T, P: s.Critical.Pc, R: 83.14})
+//	    return cubic.SaturationPressure(cfg, T)
+//	})
+//
+// Units follow the rest of the module: T in Kelvin, P in bar and V in cm³/mol.
+package refdata
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor/substance"
+)
+
+const (
+	// SourceNIST refers to the NIST Chemistry WebBook, SRD 69 (saturation and
+	// isothermal property tables).
+	SourceNIST = "NIST Chemistry WebBook, SRD 69"
+	// SourceSVNA refers to Smith, Van Ness and Abbott, Introduction to Chemical
+	// Engineering Thermodynamics, 7th ed.
+	SourceSVNA = "Smith, Van Ness & Abbott, 7th ed."
+)
+
+// ZPoint is a reference compressibility factor at temperature T and pressure P.
+type ZPoint struct {
+	Substance *substance.Substance
+	T         float64 // Temperature (K)
+	P         float64 // Pressure (bar)
+	Z         float64 // Compressibility factor
+	Source    string
+}
+
+// PsatPoint is a reference saturation pressure at temperature T.
+type PsatPoint struct {
+	Substance *substance.Substance
+	T         float64 // Temperature (K)
+	Psat      float64 // Saturation pressure (bar)
+	Source    string
+}
+
+// VsatPoint is a reference saturated liquid molar volume at temperature T.
+type VsatPoint struct {
+	Substance *substance.Substance
+	T         float64 // Temperature (K)
+	V         float64 // Saturated liquid molar volume (cm³/mol)
+	Source    string
+}
+
+// Z contains reference compressibility factors for the vapor and supercritical regions.
+var Z = []ZPoint{
+	// Saturated n-butane vapor, V = 2482 cm³/mol.
+	{Substance: substance.NButane, T: 350, P: 9.4573, Z: 0.8067, Source: SourceSVNA + ", Example 3.9"},
+	{Substance: substance.Methane, T: 300, P: 1, Z: 0.9982, Source: SourceNIST},
+	{Substance: substance.Nitrogen, T: 300, P: 1, Z: 0.9998, Source: SourceNIST},
+	{Substance: substance.CarbonDioxide, T: 300, P: 1, Z: 0.9950, Source: SourceNIST},
+}
+
+// Psat contains reference vapor pressures.
+var Psat = []PsatPoint{
+	{Substance: substance.NButane, T: 350, Psat: 9.4573, Source: SourceSVNA + ", Example 3.9"},
+	{Substance: substance.Ethane, T: 299, Psat: 42.7, Source: SourceSVNA},
+	{Substance: substance.Methane, T: 150, Psat: 10.41, Source: SourceNIST},
+	{Substance: substance.Nitrogen, T: 100, Psat: 7.789, Source: SourceNIST},
+	{Substance: substance.CarbonDioxide, T: 250, Psat: 17.85, Source: SourceNIST},
+	{Substance: substance.Propane, T: 300, Psat: 9.976, Source: SourceNIST},
+	{Substance: substance.Ammonia, T: 300, Psat: 10.62, Source: SourceNIST},
+	{Substance: substance.Water, T: 373.15, Psat: 1.0142, Source: SourceNIST},
+}
+
+// Vsat contains reference saturated liquid molar volumes.
+var Vsat = []VsatPoint{
+	{Substance: substance.NButane, T: 350, V: 115.0, Source: SourceSVNA + ", Example 3.9"},
+	{Substance: substance.Ammonia, T: 310, V: 29.14, Source: SourceSVNA + ", Example 3.16"},
+	{Substance: substance.Methane, T: 111.67, V: 37.98, Source: SourceNIST},
+	{Substance: substance.Nitrogen, T: 77.355, V: 34.75, Source: SourceNIST},
+	{Substance: substance.Propane, T: 231.04, V: 75.91, Source: SourceNIST},
+	{Substance: substance.Water, T: 373.15, V: 18.80, Source: SourceNIST},
+}
+
+// Result is the outcome of evaluating a method at a single reference point.
+type Result struct {
+	Substance string
+	T         float64 // Temperature (K)
+	P         float64 // Pressure (bar), zero for saturation properties
+	Want      float64 // Reference value
+	Got       float64 // Value returned by the method
+	Deviation float64 // 100 * (Got - Want) / Want
+	Source    string
+	Err       error // Error returned by the method, if any
+}
+
+// String implements fmt.Stringer for Result.
+func (r Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s T=%g P=%g: error: %v", r.Substance, r.T, r.P, r.Err)
+	}
+	return fmt.Sprintf("%s T=%g P=%g: got %g, want %g (%+.2f%%)", r.Substance, r.T, r.P, r.Got, r.Want, r.Deviation)
+}
+
+// Within reports whether the method succeeded and deviates by no more than tol percent.
+func (r Result) Within(tol float64) bool {
+	return r.Err == nil && math.Abs(r.Deviation) <= tol
+}
+
+// CheckZ evaluates fn at every point in Z.
+func CheckZ(fn func(s *substance.Substance, T, P float64) (float64, error)) []Result {
+	res := make([]Result, len(Z))
+	for i, pt := range Z {
+		got, err := fn(pt.Substance, pt.T, pt.P)
+		res[i] = newResult(pt.Substance, pt.T, pt.P, pt.Z, got, pt.Source, err)
+	}
+	return res
+}
+
+// CheckPsat evaluates fn at every point in Psat.
+func CheckPsat(fn func(s *substance.Substance, T float64) (float64, error)) []Result {
+	res := make([]Result, len(Psat))
+	for i, pt := range Psat {
+		got, err := fn(pt.Substance, pt.T)
+		res[i] = newResult(pt.Substance, pt.T, 0, pt.Psat, got, pt.Source, err)
+	}
+	return res
+}
+
+// CheckVsat evaluates fn at every point in Vsat.
+func CheckVsat(fn func(s *substance.Substance, T float64) (float64, error)) []Result {
+	res := make([]Result, len(Vsat))
+	for i, pt := range Vsat {
+		got, err := fn(pt.Substance, pt.T)
+		res[i] = newResult(pt.Substance, pt.T, 0, pt.V, got, pt.Source, err)
+	}
+	return res
+}
+
+func newResult(s *substance.Substance, T, P, want, got float64, source string, err error) Result {
+	r := Result{
+		Substance: s.Name,
+		T:         T,
+		P:         P,
+		Want:      want,
+		Source:    source,
+		Err:       err,
+	}
+	if err == nil {
+		r.Got = got
+		r.Deviation = 100 * (got - want) / want
+	}
+	return r
+}
@@ -0,0 +1,94 @@
+package refdata_test
+
+import (
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/refdata"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+
+func cubicZ(eos cubic.EOSType) func(s *substance.Substance, T, P float64) (float64, error) {
+	return func(s *substance.Substance, T, P float64) (float64, error) {
+		cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: P, R: R})
+		volRes, err := cubic.SolveForVolume(cfg)
+		if err != nil {
+			return 0, err
+		}
+		roots := volRes.Clean()
+		// All reference Z points are vapor or supercritical.
+		return P * roots[len(roots)-1] / (R * T), nil
+	}
+}
+
+func cubicPsat(eos cubic.EOSType) func(s *substance.Substance, T float64) (float64, error) {
+	return func(s *substance.Substance, T float64) (float64, error) {
+		cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: s.Critical.Pc, R: R})
+		return cubic.SaturationPressure(cfg, T)
+	}
+}
+
+func cubicVsat(eos cubic.EOSType) func(s *substance.Substance, T float64) (float64, error) {
+	return func(s *substance.Substance, T float64) (float64, error) {
+		cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: s.Critical.Pc, R: R})
+		sat, err := cubic.Saturation(cfg, T)
+		if err != nil {
+			return 0, err
+		}
+		return sat.Vl, nil
+	}
+}
+
+func lkZ(s *substance.Substance, T, P float64) (float64, error) {
+	return s.LeeKesler(zfactor.Args{T: T, P: P}, leekesler.CompressibilityFactor)
+}
+
+func lkPsat(s *substance.Substance, T float64) (float64, error) {
+	return s.LeeKeslerVaporPressure(T)
+}
+
+func TestReferenceData(t *testing.T) {
+	// Points outside the known scope of a method are excluded with a reason
+	// rather than loosening the tolerance for every fluid.
+	polar := map[string]string{"Water": "corresponding states with ω does not capture strongly polar fluids"}
+
+	tests := []struct {
+		name    string
+		results []refdata.Result
+		tol     float64           // maximum deviation (%)
+		exclude map[string]string // substance name -> reason
+	}{
+		{"Z/SRK", refdata.CheckZ(cubicZ(&cubic.SRK{})), 2, nil},
+		{"Z/PR", refdata.CheckZ(cubicZ(&cubic.PR{})), 2, nil},
+		{"Z/LeeKesler", refdata.CheckZ(lkZ), 2, map[string]string{
+			"n-Butane": "saturated vapor: bilinear interpolation straddles the saturation boundary",
+		}},
+		{"Psat/SRK", refdata.CheckPsat(cubicPsat(&cubic.SRK{})), 5, polar},
+		{"Psat/PR", refdata.CheckPsat(cubicPsat(&cubic.PR{})), 5, polar},
+		{"Psat/RKPR", refdata.CheckPsat(cubicPsat(&cubic.RKPR{})), 5, polar},
+		{"Psat/LeeKesler", refdata.CheckPsat(lkPsat), 5, map[string]string{
+			"Carbon dioxide": "no normal boiling point (sublimes at 1 atm)",
+		}},
+		{"Vsat/Rackett", refdata.CheckVsat((*substance.Substance).Vsat), 5, polar},
+		{"Vsat/PR", refdata.CheckVsat(cubicVsat(&cubic.PR{})), 15, polar},
+		{"Vsat/RKPR", refdata.CheckVsat(cubicVsat(&cubic.RKPR{})), 5, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, r := range tt.results {
+				if reason, ok := tt.exclude[r.Substance]; ok {
+					t.Logf("skipping %s: %s", r.Substance, reason)
+					continue
+				}
+				if !r.Within(tt.tol) {
+					t.Errorf("%v exceeds tolerance of %g%%", r, tt.tol)
+				}
+			}
+		})
+	}
+}
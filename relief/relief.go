@@ -0,0 +1,202 @@
+// Package relief provides the gas properties needed to size pressure relief
+// valves in gas or vapor service by API 520 Part I: the compressibility factor,
+// the ratio of specific heats, the molar mass and the relieving temperature and
+// pressure, evaluated together at the relieving conditions and in the units of
+// the sizing equations.
+//
+// The properties of the gas are evaluated by a substance.Provider on a
+// flowsheet.Stream, so that pure gases and mixtures are handled alike and the
+// real-gas behavior follows the selected correlation or equation of state.
+//
+// Units: T in K, P in bar (absolute) and MW in g/mol.
+package relief
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// step is the relative step of the numerical derivatives of the real-gas heat
+// capacities.
+const step = 1e-3
+
+// RelievingPressure returns the absolute relieving pressure (bar) of a valve
+// with set pressure setGauge (bar gauge) and the allowable overpressure, as a
+// fraction of the set pressure (0.10 for a single valve, 0.21 for fire cases):
+//
+//	P₁ = Pset (1 + overpressure) + Patm
+func RelievingPressure(setGauge, overpressure float64) float64 {
+	return setGauge*(1+overpressure) + zfactor.AtmBar
+}
+
+// Properties are the gas properties at the relieving conditions of a pressure
+// relief valve.
+type Properties struct {
+	T  float64 // Relieving temperature (K)
+	P  float64 // Relieving pressure (bar, absolute)
+	Z  float64 // Compressibility factor
+	MW float64 // Molar mass (g/mol)
+	// K is the ideal-gas ratio of specific heats Cp/Cv at the relieving
+	// temperature, the k of the API 520 sizing equations.
+	K float64
+	// KReal is the real-gas ratio of specific heats Cp/Cv at the relieving
+	// conditions, which differs from K at high reduced pressures.
+	KReal float64
+	// N is the real-gas isentropic exponent -(v/P)(∂P/∂v)_s, used instead of K
+	// for the sizing of gases far from ideal behavior (API 520 Annex B).
+	N float64
+	// CriticalRatio is the critical flow pressure ratio (2/(k+1))^(k/(k-1)): the
+	// flow is critical if the back pressure ratio is below it.
+	CriticalRatio float64
+	// C is the coefficient of the SI sizing equation for critical flow,
+	//
+	//	C = 0.03948 √{k (2/(k+1))^((k+1)/(k-1))}
+	//
+	// for a required area in mm² from a flow in kg/h and P₁ in kPa.
+	C float64
+	// Provider names the provider of Z and the real-gas properties.
+	Provider string
+}
+
+// String implements fmt.Stringer for Properties, with the units of the values.
+func (p *Properties) String() string {
+	return fmt.Sprintf("T = %.2f K, P = %.4g bar(a), Z = %.4f, MW = %.3f g/mol, k = %.4f (real %.4f, n = %.4f), C = %.5f",
+		p.T, p.P, p.Z, p.MW, p.K, p.KReal, p.N, p.C)
+}
+
+// GasProperties returns the properties of the gas with the composition of gas
+// at the relieving temperature T (K) and absolute pressure P (bar), with Z and
+// the real-gas heat capacities from p. The flow of gas is ignored.
+//
+// It returns an error if the state is invalid, the molar mass is unknown, the
+// heat capacities of gas do not cover T, or the gas is liquid at the relieving
+// conditions, as the gas sizing equations do not apply.
+func GasProperties(p substance.Provider, gas *flowsheet.Stream, T, P float64) (*Properties, error) {
+	if p == nil {
+		return nil, errors.New("configuration error: provider cannot be nil")
+	}
+	if gas == nil || len(gas.Components) == 0 {
+		return nil, errors.New("configuration error: gas must have at least one component")
+	}
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	mw := gas.MW()
+	if mw <= 0 {
+		return nil, zfactor.ErrMolarMass
+	}
+	s := gas.At(T, P)
+	sub, err := s.Substance()
+	if err != nil {
+		return nil, err
+	}
+	if sub.PhaseAt(T, P) == substance.Liquid {
+		return nil, fmt.Errorf("%s is liquid at %g K and %g bar, gas relief sizing does not apply", sub.Name, T, P)
+	}
+
+	z, err := s.Z(p)
+	if err != nil {
+		return nil, err
+	}
+	cp0, err := idealCp(s)
+	if err != nil {
+		return nil, err
+	}
+	cp, cv, err := realHeatCapacities(p, s)
+	if err != nil {
+		return nil, err
+	}
+	n, err := flowsheet.IsentropicExponent(p, s)
+	if err != nil {
+		return nil, err
+	}
+
+	k := cp0 / (cp0 - zfactor.RSI)
+	res := &Properties{
+		T:             T,
+		P:             P,
+		Z:             z,
+		MW:            mw,
+		K:             k,
+		KReal:         cp / cv,
+		N:             n,
+		CriticalRatio: math.Pow(2/(k+1), k/(k-1)),
+		C:             0.03948 * math.Sqrt(k*math.Pow(2/(k+1), (k+1)/(k-1))),
+		Provider:      p.Name(),
+	}
+	return res, nil
+}
+
+// idealCp returns the ideal-gas heat capacity of s at its temperature,
+// Σ yi Cp,i (J/(mol·K)).
+func idealCp(s *flowsheet.Stream) (float64, error) {
+	var cp float64
+	for _, c := range s.Components {
+		h := c.Cp
+		if h == nil {
+			return 0, fmt.Errorf("%s: heat capacity cannot be nil", c.Substance.Name)
+		}
+		if s.T < h.TMin || s.T > h.TMax {
+			return 0, fmt.Errorf("%s: temperature %v K is out of range [%v - %v]", c.Substance.Name, s.T, h.TMin, h.TMax)
+		}
+		cp += c.Fraction * (h.A + h.B*s.T + h.C*s.T*s.T + h.D/(s.T*s.T))
+	}
+	return cp * zfactor.RSI, nil
+}
+
+// realHeatCapacities returns the real-gas heat capacities Cp and Cv of s
+// (J/(mol·K)), Cp from the enthalpy of s and Cv from
+//
+//	Cp - Cv = -T (∂v/∂T)²_P / (∂v/∂P)_T
+//
+// with v = ZRT/P and Z from p, all by central differences.
+func realHeatCapacities(p substance.Provider, s *flowsheet.Stream) (cp, cv float64, err error) {
+	T, P := s.T, s.P
+	dT, dP := step*T, step*P
+	volume := func(T, P float64) (float64, error) {
+		z, err := s.At(T, P).Z(p)
+		if err != nil {
+			return 0, err
+		}
+		return z * zfactor.RSI * T / P, nil
+	}
+
+	hUp, err := s.At(T+dT, P).Enthalpy(p)
+	if err != nil {
+		return 0, 0, err
+	}
+	hDown, err := s.At(T-dT, P).Enthalpy(p)
+	if err != nil {
+		return 0, 0, err
+	}
+	cp = (hUp - hDown) / (2 * dT)
+
+	vTUp, err := volume(T+dT, P)
+	if err != nil {
+		return 0, 0, err
+	}
+	vTDown, err := volume(T-dT, P)
+	if err != nil {
+		return 0, 0, err
+	}
+	vPUp, err := volume(T, P+dP)
+	if err != nil {
+		return 0, 0, err
+	}
+	vPDown, err := volume(T, P-dP)
+	if err != nil {
+		return 0, 0, err
+	}
+	dvdT := (vTUp - vTDown) / (2 * dT)
+	dvdP := (vPUp - vPDown) / (2 * dP)
+	cv = cp + T*dvdT*dvdT/dvdP
+	return cp, cv, nil
+}
@@ -0,0 +1,72 @@
+package relief_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/relief"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestRelievingPressure(t *testing.T) {
+	if got, want := relief.RelievingPressure(10, 0.1), 11+zfactor.AtmBar; math.Abs(got-want) > 1e-12 {
+		t.Errorf("RelievingPressure() = %v, want %v", got, want)
+	}
+}
+
+func TestGasProperties(t *testing.T) {
+	gas := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 1, 0)
+
+	// For an ideal gas all ratios of specific heats coincide.
+	ideal, err := relief.GasProperties(flowsheet.IdealGas{}, gas, 320, 20)
+	if err != nil {
+		t.Fatalf("GasProperties() unexpected error: %v", err)
+	}
+	if ideal.T != 320 || ideal.P != 20 || ideal.Z != 1 || ideal.MW != substance.Methane.MW {
+		t.Errorf("GasProperties() ideal = %v, want T = 320, P = 20, Z = 1 and the MW of methane", ideal)
+	}
+	if math.Abs(ideal.KReal-ideal.K) > 1e-4 || math.Abs(ideal.N-ideal.K) > 1e-3 {
+		t.Errorf("GasProperties() ideal k = %v, real %v, n = %v, want equal", ideal.K, ideal.KReal, ideal.N)
+	}
+	// Methane, k ≈ 1.30: C ≈ 0.0263 and a critical ratio ≈ 0.546.
+	k := ideal.K
+	wantC := 0.03948 * math.Sqrt(k*math.Pow(2/(k+1), (k+1)/(k-1)))
+	if k < 1.28 || k > 1.32 || math.Abs(ideal.C-wantC) > 1e-12 || math.Abs(ideal.CriticalRatio-0.546) > 5e-3 {
+		t.Errorf("GasProperties() k = %v, C = %v, critical ratio = %v, want about 1.30, %v, 0.546", k, ideal.C, ideal.CriticalRatio, wantC)
+	}
+
+	// At high pressure the real-gas Cp/Cv exceeds the ideal-gas value. The ideal
+	// value, used by API 520, depends on T only.
+	pr, err := relief.GasProperties(substance.CubicProvider{EOS: &cubic.PR{}}, gas, 320, 100)
+	if err != nil {
+		t.Fatalf("GasProperties() unexpected error: %v", err)
+	}
+	if pr.Z >= 1 || pr.KReal <= pr.K || pr.K != ideal.K || pr.Provider != (substance.CubicProvider{EOS: &cubic.PR{}}).Name() {
+		t.Errorf("GasProperties() PR = %v, want Z < 1 and k real > k = %v", pr, ideal.K)
+	}
+}
+
+func TestGasPropertiesErrors(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	methane := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 1, 0)
+	propane := flowsheet.NewStream(substance.Propane, cp.PropaneGas, 300, 1, 0)
+
+	if _, err := relief.GasProperties(p, methane, 0, 10); !errors.Is(err, zfactor.ErrTemp) {
+		t.Errorf("GasProperties() error = %v, want %v", err, zfactor.ErrTemp)
+	}
+	if _, err := relief.GasProperties(p, methane, 300, 0); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("GasProperties() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	if _, err := relief.GasProperties(nil, methane, 300, 10); err == nil {
+		t.Error("GasProperties() with nil provider expected error, got nil")
+	}
+	// Propane is liquid at 300 K above its vapor pressure of about 10 bar.
+	if _, err := relief.GasProperties(p, propane, 300, 20); err == nil {
+		t.Error("GasProperties() of a liquid expected error, got nil")
+	}
+}
@@ -0,0 +1,299 @@
+//go:build !noplot
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/state"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/font"
+	"gonum.org/v1/plot/text"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgpdf"
+)
+
+// Figure is a plot included in a generated report.
+type Figure struct {
+	Title string
+	Plot  *plot.Plot
+}
+
+// Spec describes the content of a report generated by Generate.
+type Spec struct {
+	// Title is printed at the top of the first page.
+	Title string
+	// Assumptions are listed on the first page, after the units and methods used.
+	Assumptions []string
+	// Table is the results table. It may be nil if the report only contains figures.
+	Table *Table
+	// PV, if set, adds a PV diagram of the states of Table (see state.NewPVPlot).
+	// The state package draws no TS diagram, so there is no TS counterpart; a TS
+	// diagram built by the caller can be added to Figures.
+	PV *state.PVConfig
+	// Figures are additional plots, each drawn on its own page.
+	Figures []Figure
+}
+
+// Page layout of generated reports (A4 portrait).
+const (
+	pageWidth  = 210 * vg.Millimeter
+	pageHeight = 297 * vg.Millimeter
+	pageMargin = 20 * vg.Millimeter
+)
+
+// Generate writes a paginated PDF report to output combining the input
+// assumptions, the results table and the diagrams described by spec.
+func Generate(spec *Spec, output string) error {
+	if spec == nil {
+		return errors.New("configuration error: spec cannot be nil")
+	}
+	if filepath.Ext(output) != ".pdf" {
+		return fmt.Errorf("invalid file extension: %s. Reports are written as .pdf", output)
+	}
+
+	figures := spec.Figures
+	if spec.PV != nil {
+		if spec.Table == nil || len(spec.Table.States) == 0 {
+			return errors.New("configuration error: a PV diagram requires a table with states")
+		}
+		p, err := state.NewPVPlot(spec.PV, spec.Table.States...)
+		if err != nil {
+			return fmt.Errorf("pv diagram: %w", err)
+		}
+		figures = append([]Figure{{Title: "PV diagram", Plot: p}}, figures...)
+	}
+
+	doc := newDocument()
+
+	title := spec.Title
+	if title == "" {
+		title = "Thermodynamic Properties Report"
+	}
+	doc.text(title, 18, "Sans", 0)
+	doc.space(4 * vg.Millimeter)
+
+	doc.text("Inputs and assumptions", 13, "Sans", 0)
+	for _, a := range spec.assumptions() {
+		doc.paragraph("• "+a, 10, 4*vg.Millimeter)
+	}
+
+	if spec.Table != nil {
+		doc.space(6 * vg.Millimeter)
+		doc.text("Results", 13, "Sans", 0)
+		if err := doc.table(spec.Table); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range figures {
+		if f.Plot == nil {
+			continue
+		}
+		doc.newPage()
+		if f.Title != "" {
+			doc.text(f.Title, 13, "Sans", 0)
+		}
+		doc.figure(f.Plot)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if _, err := doc.canvas.WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// assumptions returns the assumption lines of the report.
+func (spec *Spec) assumptions() []string {
+	b := zfactor.MolarBasis
+	if spec.Table != nil {
+		b = spec.Table.Basis
+	}
+	lines := []string{fmt.Sprintf("Units: T in K, P in bar, V in %s, H in %s and S in %s.",
+		b.VolumeUnit(), b.EnergyUnit(), b.EntropyUnit())}
+	if spec.Table != nil && len(spec.Table.Methods) > 0 {
+		names := make([]string, len(spec.Table.Methods))
+		for i, m := range spec.Table.Methods {
+			names[i] = m.Name
+		}
+		lines = append(lines, "Methods: "+strings.Join(names, ", ")+".")
+	}
+	if spec.Table != nil {
+		for _, p := range spec.Table.Properties {
+			if p == PropPhase {
+				lines = append(lines, "Phases below Tc are assigned by comparing P with the Lee-Kesler vapor pressure.")
+				break
+			}
+		}
+	}
+	if spec.PV != nil && spec.PV.Type != nil {
+		lines = append(lines, fmt.Sprintf("The PV diagram is computed with the %T equation of state.", spec.PV.Type))
+	}
+	return append(lines, spec.Assumptions...)
+}
+
+// document is a PDF being laid out top to bottom.
+type document struct {
+	canvas *vgpdf.Canvas
+	dc     draw.Canvas
+	y      vg.Length // Current top of free space
+}
+
+func newDocument() *document {
+	c := vgpdf.New(pageWidth, pageHeight)
+	d := &document{canvas: c, dc: draw.New(c)}
+	d.y = pageHeight - pageMargin
+	return d
+}
+
+// newPage starts a new page.
+func (d *document) newPage() {
+	d.canvas.NextPage()
+	d.y = pageHeight - pageMargin
+}
+
+// space adds vertical space, starting a new page if needed.
+func (d *document) space(h vg.Length) {
+	d.y -= h
+	if d.y < pageMargin {
+		d.newPage()
+	}
+}
+
+// style returns a text style of the given size and font variant.
+func style(size vg.Length, variant string) text.Style {
+	return text.Style{
+		Color:   color.Black,
+		Font:    font.From(font.Font{Typeface: "Liberation", Variant: font.Variant(variant)}, size),
+		XAlign:  draw.XLeft,
+		YAlign:  draw.YTop,
+		Handler: plot.DefaultTextHandler,
+	}
+}
+
+// text draws a single line of text at indent from the left margin.
+func (d *document) text(s string, size vg.Length, variant string, indent vg.Length) {
+	sty := style(size, variant)
+	h := sty.Height(s) * 1.3
+	if d.y-h < pageMargin {
+		d.newPage()
+	}
+	d.dc.FillText(sty, vg.Point{X: pageMargin + indent, Y: d.y}, s)
+	d.y -= h
+}
+
+// paragraph draws word-wrapped text at indent from the left margin.
+func (d *document) paragraph(s string, size vg.Length, indent vg.Length) {
+	sty := style(size, "Serif")
+	width := pageWidth - 2*pageMargin - indent
+	var line string
+	for _, word := range strings.Fields(s) {
+		next := word
+		if line != "" {
+			next = line + " " + word
+		}
+		if line != "" && sty.Width(next) > width {
+			d.text(line, size, "Serif", indent)
+			next = word
+		}
+		line = next
+	}
+	if line != "" {
+		d.text(line, size, "Serif", indent)
+	}
+}
+
+// table draws t with a header row repeated on every page.
+func (d *document) table(t *Table) error {
+	header, rows, err := t.cells(false)
+	if err != nil {
+		return err
+	}
+
+	// Shrink the font until the table fits the page width.
+	avail := pageWidth - 2*pageMargin
+	const pad = 2 * vg.Millimeter
+	var (
+		size   vg.Length = 9
+		widths []vg.Length
+	)
+	for ; ; size -= 0.5 {
+		sty := style(size, "Sans")
+		widths = make([]vg.Length, len(header))
+		var total vg.Length
+		for j := range header {
+			w := sty.Width(header[j])
+			for _, r := range rows {
+				w = max(w, sty.Width(r[j]))
+			}
+			widths[j] = w + pad
+			total += widths[j]
+		}
+		if total <= avail || size <= 4 {
+			break
+		}
+	}
+
+	sty := style(size, "Sans")
+	rowH := sty.Height("M") * 1.6
+	line := func() {
+		d.dc.StrokeLine2(draw.LineStyle{Color: color.Black, Width: vg.Points(0.5)},
+			pageMargin, d.y, pageWidth-pageMargin, d.y)
+	}
+	drawRow := func(cells []string) {
+		x := pageMargin
+		for j, c := range cells {
+			d.dc.FillText(sty, vg.Point{X: x, Y: d.y - rowH*0.2}, c)
+			x += widths[j]
+		}
+		d.y -= rowH
+	}
+
+	d.space(2 * vg.Millimeter)
+	line()
+	drawRow(header)
+	line()
+	for _, r := range rows {
+		if d.y-rowH < pageMargin {
+			line()
+			d.newPage()
+			line()
+			drawRow(header)
+			line()
+		}
+		drawRow(r)
+	}
+	line()
+	d.y -= rowH / 2
+	return nil
+}
+
+// figure draws p across the page width below the current position.
+func (d *document) figure(p *plot.Plot) {
+	w := pageWidth - 2*pageMargin
+	h := w * 3 / 4
+	if d.y-h < pageMargin {
+		d.newPage()
+	}
+	c := draw.Canvas{
+		Canvas: d.canvas,
+		Rectangle: vg.Rectangle{
+			Min: vg.Point{X: pageMargin, Y: d.y - h},
+			Max: vg.Point{X: pageMargin + w, Y: d.y},
+		},
+	}
+	p.Draw(c)
+	d.y -= h
+}
@@ -0,0 +1,54 @@
+//go:build !noplot
+
+package report_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/report"
+	"github.com/rickykimani/zfactor/state"
+)
+
+func TestGenerate(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "report.pdf")
+	spec := &report.Spec{
+		Title:       "n-Butane states",
+		Assumptions: []string{"Pure n-butane, no heat losses."},
+		Table:       newTable(t),
+		PV:          &state.PVConfig{Type: &cubic.PR{}},
+	}
+	if err := report.Generate(spec, out); err != nil {
+		t.Fatalf("Generate() unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(b, []byte("%PDF")) {
+		t.Errorf("Generate() output is not a PDF: %q", b[:min(len(b), 8)])
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name   string
+		spec   *report.Spec
+		output string
+	}{
+		{"nil spec", nil, "report.pdf"},
+		{"extension", &report.Spec{}, "report.png"},
+		{"pv without states", &report.Spec{PV: &state.PVConfig{Type: &cubic.PR{}}}, "report.pdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := report.Generate(tt.spec, filepath.Join(dir, tt.output)); err == nil {
+				t.Errorf("Generate() expected error, got nil")
+			}
+		})
+	}
+}
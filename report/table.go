@@ -0,0 +1,331 @@
+// Package report formats computed thermodynamic results for inclusion in
+// documents, such as Markdown or LaTeX tables of state properties.
+//
+// Generate, which renders PDF reports with gonum/plot, is left out of builds
+// with the noplot tag.
+package report
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/state"
+)
+
+// Property selects a computed column of a Table.
+type Property int
+
+const (
+	PropZ     Property = iota // Compressibility factor
+	PropV                     // Molar volume (cm³/mol), or specific volume (m³/kg) on a mass basis
+	PropPhase                 // Phase of the state (see state.State.Phase)
+	PropHR                    // Residual enthalpy H^R (J/mol or kJ/kg)
+	PropSR                    // Residual entropy S^R (J/(mol·K) or kJ/(kg·K))
+)
+
+// Method computes the properties of a state for a Table.
+//
+// Z is required for PropZ and PropV; Residual for PropHR and PropSR. Either may
+// be nil, in which case the corresponding cells are left blank.
+type Method struct {
+	Name     string
+	Z        func(s *state.State) (float64, error)
+	Residual flowsheet.Provider
+}
+
+// LeeKesler returns the Lee-Kesler method, with Z and residual properties.
+func LeeKesler() Method {
+	return fromState(state.LeeKeslerMethod(), flowsheet.LeeKesler{})
+}
+
+// Virial returns the two-term virial equation with Abbott coefficients and the
+// Abbott residual properties.
+func Virial() Method {
+	return fromState(state.VirialMethod(), flowsheet.Abbott{})
+}
+
+// Cubic returns a method backed by a cubic equation of state. It provides Z and V only.
+func Cubic(name string, eos cubic.EOSType) Method {
+	return fromState(state.CubicMethod(name, eos), nil)
+}
+
+// fromState adapts a state.Method to a table Method.
+func fromState(m state.Method, res flowsheet.Provider) Method {
+	return Method{
+		Name: m.Name,
+		Z: func(s *state.State) (float64, error) {
+			return m.Z(s.Substance, s.Temperature, s.Pressure)
+		},
+		Residual: res,
+	}
+}
+
+// Table is a table of states and their properties computed by one or more methods.
+//
+// Each row is a state; after the state number, substance, T and P, every
+// property in Properties is given for each method in Methods (PropPhase only
+// once, as it does not depend on the method).
+type Table struct {
+	States     []*state.State
+	Methods    []Method
+	Properties []Property
+	// Precision is the number of significant digits of computed values. Defaults to 4.
+	Precision int
+	// Basis selects molar (the default) or mass units for PropV, PropHR and
+	// PropSR. Mass-basis cells of substances without a molar mass are left blank.
+	Basis zfactor.Basis
+}
+
+// column is one column of the formatted table.
+type column struct {
+	markdown string
+	latex    string
+	cell     func(i int, s *state.State) (string, error) // i is the zero-based row index
+}
+
+// errBlank marks a cell that cannot be computed by a method.
+var errBlank = errors.New("not available")
+
+// Markdown writes the table as a GitHub-flavored Markdown table.
+func (t *Table) Markdown(w io.Writer) error {
+	header, rows, err := t.cells(false)
+	if err != nil {
+		return err
+	}
+	sep := make([]string, len(header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	lines := []string{mdRow(header), mdRow(sep)}
+	for _, r := range rows {
+		lines = append(lines, mdRow(r))
+	}
+	_, err = io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// LaTeX writes the table as a LaTeX tabular environment.
+func (t *Table) LaTeX(w io.Writer) error {
+	header, rows, err := t.cells(true)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\\begin{tabular}{l%s}\n", strings.Repeat("r", len(header)-1))
+	b.WriteString("\\hline\n")
+	b.WriteString(strings.Join(header, " & ") + " \\\\\n")
+	b.WriteString("\\hline\n")
+	for _, r := range rows {
+		b.WriteString(strings.Join(r, " & ") + " \\\\\n")
+	}
+	b.WriteString("\\hline\n")
+	b.WriteString("\\end{tabular}\n")
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// String returns the table in Markdown.
+func (t *Table) String() string {
+	var b strings.Builder
+	if err := t.Markdown(&b); err != nil {
+		return fmt.Sprintf("report: %v", err)
+	}
+	return b.String()
+}
+
+// cells computes the header and rows of the table.
+func (t *Table) cells(latex bool) ([]string, [][]string, error) {
+	if len(t.States) == 0 {
+		return nil, nil, errors.New("table has no states")
+	}
+	for i, s := range t.States {
+		if s == nil || s.Substance == nil {
+			return nil, nil, fmt.Errorf("state %d has no substance", i+1)
+		}
+	}
+
+	cols := t.columns()
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = c.markdown
+		if latex {
+			header[i] = c.latex
+		}
+	}
+
+	blank := "—"
+	if latex {
+		blank = "--"
+	}
+	rows := make([][]string, len(t.States))
+	for i, s := range t.States {
+		row := make([]string, len(cols))
+		for j, c := range cols {
+			v, err := c.cell(i, s)
+			switch {
+			case err != nil:
+				// Methods outside their validity range leave the cell blank.
+				v = blank
+			case latex && j == 1:
+				v = escapeLaTeX(v)
+			}
+			row[j] = v
+		}
+		rows[i] = row
+	}
+	return header, rows, nil
+}
+
+// columns returns the columns of the table in order.
+func (t *Table) columns() []column {
+	prec := t.Precision
+	if prec <= 0 {
+		prec = 4
+	}
+	num := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', prec, 64)
+	}
+
+	cols := []column{
+		{"#", "\\#", func(i int, _ *state.State) (string, error) { return strconv.Itoa(i + 1), nil }},
+		{"Substance", "Substance", func(_ int, s *state.State) (string, error) { return s.Substance.Name, nil }},
+		{"T (K)", "$T$ (K)", func(_ int, s *state.State) (string, error) { return num(s.Temperature), nil }},
+		{"P (bar)", "$P$ (bar)", func(_ int, s *state.State) (string, error) { return num(s.Pressure), nil }},
+	}
+
+	for _, p := range t.Properties {
+		if p == PropPhase {
+			cols = append(cols, column{"Phase", "Phase", func(_ int, s *state.State) (string, error) {
+				return s.Phase().String(), nil
+			}})
+		}
+	}
+
+	for _, m := range t.Methods {
+		for _, p := range t.Properties {
+			if c, ok := methodColumn(m, p, t.Basis, num); ok {
+				cols = append(cols, c)
+			}
+		}
+	}
+	return cols
+}
+
+// methodColumn returns the column of property p computed by method m, in basis b.
+func methodColumn(m Method, p Property, b zfactor.Basis, num func(float64) string) (column, bool) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	name := m.Name
+	texName := escapeLaTeX(m.Name)
+
+	switch p {
+	case PropZ:
+		return column{
+			fmt.Sprintf("Z (%s)", name),
+			fmt.Sprintf("$Z$ (%s)", texName),
+			func(_ int, s *state.State) (string, error) {
+				if m.Z == nil {
+					return "", errBlank
+				}
+				z, err := m.Z(s)
+				return num(z), err
+			},
+		}, true
+	case PropV:
+		return column{
+			fmt.Sprintf("%s (%s, %s)", symbol(b, "V"), name, b.VolumeUnit()),
+			fmt.Sprintf("$%s$ (%s, %s)", symbol(b, "V"), texName, texUnit(b.VolumeUnit())),
+			func(_ int, s *state.State) (string, error) {
+				if m.Z == nil {
+					return "", errBlank
+				}
+				z, err := m.Z(s)
+				if err != nil {
+					return "", err
+				}
+				v, err := zfactor.MolarVolume(z, s.Pressure, s.Temperature, R)
+				if err != nil {
+					return "", err
+				}
+				v, err = b.Volume(v, s.Substance.MW)
+				return num(v), err
+			},
+		}, true
+	case PropHR:
+		return column{
+			fmt.Sprintf("%s^R (%s, %s)", symbol(b, "H"), name, b.EnergyUnit()),
+			fmt.Sprintf("$%s^R$ (%s, %s)", symbol(b, "H"), texName, texUnit(b.EnergyUnit())),
+			func(_ int, s *state.State) (string, error) {
+				if m.Residual == nil {
+					return "", errBlank
+				}
+				hr, _, err := m.Residual.Residual(s.Substance, s.Temperature, s.Pressure)
+				if err != nil {
+					return "", err
+				}
+				hr, err = b.Energy(hr, s.Substance.MW)
+				return num(hr), err
+			},
+		}, true
+	case PropSR:
+		return column{
+			fmt.Sprintf("%s^R (%s, %s)", symbol(b, "S"), name, b.EntropyUnit()),
+			fmt.Sprintf("$%s^R$ (%s, %s)", symbol(b, "S"), texName, texUnit(b.EntropyUnit())),
+			func(_ int, s *state.State) (string, error) {
+				if m.Residual == nil {
+					return "", errBlank
+				}
+				_, sr, err := m.Residual.Residual(s.Substance, s.Temperature, s.Pressure)
+				if err != nil {
+					return "", err
+				}
+				sr, err = b.Entropy(sr, s.Substance.MW)
+				return num(sr), err
+			},
+		}, true
+	default:
+		return column{}, false
+	}
+}
+
+// symbol returns the symbol of an extensive property in basis b, lowercase for
+// specific (mass-basis) properties.
+func symbol(b zfactor.Basis, sym string) string {
+	if b == zfactor.MassBasis {
+		return strings.ToLower(sym)
+	}
+	return sym
+}
+
+// texUnit writes a unit in LaTeX text.
+func texUnit(unit string) string {
+	return strings.NewReplacer("³", "$^3$", "·", " ").Replace(unit)
+}
+
+// mdRow formats a Markdown table row.
+func mdRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, c := range cells {
+		escaped[i] = strings.ReplaceAll(c, "|", "\\|")
+	}
+	return "| " + strings.Join(escaped, " | ") + " |"
+}
+
+// escapeLaTeX escapes characters with a special meaning in LaTeX text.
+func escapeLaTeX(s string) string {
+	return strings.NewReplacer(
+		`\`, `\textbackslash{}`,
+		`&`, `\&`,
+		`%`, `\%`,
+		`$`, `\$`,
+		`#`, `\#`,
+		`_`, `\_`,
+		`{`, `\{`,
+		`}`, `\}`,
+	).Replace(s)
+}
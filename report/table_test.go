@@ -0,0 +1,102 @@
+package report_test
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/report"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func newTable(t *testing.T) *report.Table {
+	t.Helper()
+	s1, err := state.NewState(substance.NButane, 350, 9.4573)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := state.NewState(substance.NButane, 450, 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &report.Table{
+		States:     []*state.State{s1, s2},
+		Methods:    []report.Method{report.Cubic("PR", &cubic.PR{}), report.LeeKesler()},
+		Properties: []report.Property{report.PropZ, report.PropPhase, report.PropHR},
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	var b strings.Builder
+	if err := newTable(t).Markdown(&b); err != nil {
+		t.Fatalf("Markdown() unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Markdown() got %d lines, want 4:\n%s", len(lines), b.String())
+	}
+
+	want := "| # | Substance | T (K) | P (bar) | Phase | Z (PR) | H^R (PR, J/mol) | Z (Lee-Kesler) | H^R (Lee-Kesler, J/mol) |"
+	if lines[0] != want {
+		t.Errorf("Markdown() header = %q, want %q", lines[0], want)
+	}
+	// PR provides no residual enthalpy.
+	if cells := strings.Split(lines[2], " | "); cells[6] != "—" {
+		t.Errorf("Markdown() PR H^R cell = %q, want blank", cells[6])
+	}
+	if !strings.Contains(lines[3], "supercritical") {
+		t.Errorf("Markdown() row 2 = %q, want supercritical phase", lines[3])
+	}
+}
+
+func TestLaTeX(t *testing.T) {
+	var b strings.Builder
+	if err := newTable(t).LaTeX(&b); err != nil {
+		t.Fatalf("LaTeX() unexpected error: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{`\begin{tabular}{lrrrrrrrr}`, `\# & Substance`, `$Z$ (PR)`, `\end{tabular}`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("LaTeX() output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestMarkdownMassBasis(t *testing.T) {
+	tbl := newTable(t)
+	tbl.Properties = []report.Property{report.PropV, report.PropHR}
+	tbl.Methods = []report.Method{report.LeeKesler()}
+	tbl.Basis = zfactor.MassBasis
+
+	var b strings.Builder
+	if err := tbl.Markdown(&b); err != nil {
+		t.Fatalf("Markdown() unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	want := "| # | Substance | T (K) | P (bar) | v (Lee-Kesler, m³/kg) | h^R (Lee-Kesler, kJ/kg) |"
+	if lines[0] != want {
+		t.Errorf("Markdown() header = %q, want %q", lines[0], want)
+	}
+
+	tbl.Basis = zfactor.MolarBasis
+	b.Reset()
+	if err := tbl.Markdown(&b); err != nil {
+		t.Fatalf("Markdown() unexpected error: %v", err)
+	}
+	molar := strings.Split(strings.Split(strings.TrimSpace(b.String()), "\n")[2], " | ")
+	mass := strings.Split(lines[2], " | ")
+	for i, conv := range map[int]func(float64) float64{
+		4: func(v float64) float64 { return v / substance.NButane.MW * 1e-3 },
+		5: func(h float64) float64 { return h / substance.NButane.MW },
+	} {
+		m, _ := strconv.ParseFloat(molar[i], 64)
+		g, _ := strconv.ParseFloat(mass[i], 64)
+		if want := conv(m); math.Abs(g-want) > 1e-3*math.Abs(want) {
+			t.Errorf("Markdown() mass cell %d = %v, want %v", i, g, want)
+		}
+	}
+}
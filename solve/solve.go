@@ -0,0 +1,245 @@
+// Package solve inverts property correlations: it finds the temperature or
+// pressure at which a property of a substance takes a given value, e.g. the
+// temperature at which V = 500 cm³/mol at 30 bar.
+//
+// The solvers work with any substance.Provider. The root is bracketed by
+// scanning a search interval, then refined by bisection.
+package solve
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Property selects the property to match.
+type Property int
+
+const (
+	PropZ       Property = iota // Compressibility factor
+	PropV                       // Molar volume (cm³/mol)
+	PropDensity                 // Mass density (kg/m³)
+	PropHR                      // Residual enthalpy H^R (J/mol)
+	PropSR                      // Residual entropy S^R (J/(mol·K))
+)
+
+// String implements fmt.Stringer for Property.
+func (p Property) String() string {
+	switch p {
+	case PropZ:
+		return "Z"
+	case PropV:
+		return "V"
+	case PropDensity:
+		return "density"
+	case PropHR:
+		return "H^R"
+	case PropSR:
+		return "S^R"
+	default:
+		return fmt.Sprintf("Property(%d)", int(p))
+	}
+}
+
+// value returns property p of props.
+func (p Property) value(props *substance.Properties) (float64, error) {
+	switch p {
+	case PropZ:
+		return props.Z, nil
+	case PropV:
+		return props.V, nil
+	case PropDensity:
+		if props.Density == 0 {
+			return 0, zfactor.ErrMolarMass
+		}
+		return props.Density, nil
+	case PropHR:
+		return props.HR, nil
+	case PropSR:
+		return props.SR, nil
+	default:
+		return 0, fmt.Errorf("unknown property %d", int(p))
+	}
+}
+
+// lkPrMax is the highest reduced pressure of the Lee-Kesler tables.
+var lkPrMax = leekesler.Z0Table.Pr[len(leekesler.Z0Table.Pr)-1]
+
+// Options configures the search of a solver. The zero value selects the defaults.
+type Options struct {
+	// Min and Max bound the search interval. They default to the range of the
+	// Lee-Kesler tables: 0.3 Tc to 4 Tc for temperatures and 0.01 Pc to the
+	// highest reduced pressure of the tables (14 Pc) for pressures.
+	Min, Max float64
+	// Tolerance is the relative tolerance on the solution. Defaults to 1e-8.
+	Tolerance float64
+	// Points is the number of points scanned to bracket the root. Defaults to 60.
+	Points int
+}
+
+// defaults returns the options with unset fields filled in from lo and hi.
+func (o *Options) defaults(lo, hi float64) Options {
+	var opts Options
+	if o != nil {
+		opts = *o
+	}
+	if opts.Min <= 0 {
+		opts.Min = lo
+	}
+	if opts.Max <= 0 {
+		opts.Max = hi
+	}
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = 1e-8
+	}
+	if opts.Points < 2 {
+		opts.Points = 60
+	}
+	return opts
+}
+
+// TemperatureFor returns the temperature (K) at which property target of s,
+// evaluated by provider p at pressure P (bar), equals value. opts may be nil.
+//
+// If several temperatures match, the lowest in the search interval is returned.
+func TemperatureFor(s *substance.Substance, p substance.Provider, P float64, target Property, value float64, opts *Options) (float64, error) {
+	if err := check(s, p); err != nil {
+		return 0, err
+	}
+	if P <= 0 {
+		return 0, zfactor.ErrPressure
+	}
+	o := opts.defaults(0.3*s.Critical.Tc, 4*s.Critical.Tc)
+	f := func(T float64) (float64, error) {
+		props, err := s.PropertiesAt(T, P, p)
+		if err != nil {
+			return 0, err
+		}
+		return target.value(props)
+	}
+	T, err := root(f, value, o)
+	if err != nil {
+		return 0, fmt.Errorf("no temperature in [%g, %g] K gives %s = %g at P = %g bar: %w", o.Min, o.Max, target, value, P, err)
+	}
+	return T, nil
+}
+
+// PressureFor returns the pressure (bar) at which property target of s,
+// evaluated by provider p at temperature T (K), equals value. opts may be nil.
+//
+// If several pressures match, the lowest in the search interval is returned.
+func PressureFor(s *substance.Substance, p substance.Provider, T float64, target Property, value float64, opts *Options) (float64, error) {
+	if err := check(s, p); err != nil {
+		return 0, err
+	}
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	o := opts.defaults(0.01*s.Critical.Pc, lkPrMax*s.Critical.Pc)
+	f := func(P float64) (float64, error) {
+		props, err := s.PropertiesAt(T, P, p)
+		if err != nil {
+			return 0, err
+		}
+		return target.value(props)
+	}
+	P, err := root(f, value, o)
+	if err != nil {
+		return 0, fmt.Errorf("no pressure in [%g, %g] bar gives %s = %g at T = %g K: %w", o.Min, o.Max, target, value, T, err)
+	}
+	return P, nil
+}
+
+// check validates the substance and provider of a solver.
+func check(s *substance.Substance, p substance.Provider) error {
+	if s == nil {
+		return errors.New("substance cannot be nil")
+	}
+	if p == nil {
+		return errors.New("provider cannot be nil")
+	}
+	return s.Require("solve", substance.PropTc, substance.PropPc)
+}
+
+// errNoBracket is returned when the scan finds no sign change.
+var errNoBracket = errors.New("target not bracketed")
+
+// root finds x in [o.Min, o.Max] with f(x) = target.
+//
+// The interval is scanned on a logarithmic grid, skipping points where f fails
+// (e.g. outside the validity range of a correlation), until f - target changes
+// sign between neighbouring points. The bracket is then bisected. A sign change
+// across a discontinuity of f, such as the jump of Z across the saturation
+// curve, is rejected.
+func root(f func(float64) (float64, error), target float64, o Options) (float64, error) {
+	if o.Max <= o.Min {
+		return 0, errors.New("search interval is empty")
+	}
+	ratio := math.Pow(o.Max/o.Min, 1/float64(o.Points-1))
+
+	var (
+		lastErr    error
+		prev, fPrv float64
+		found      bool
+	)
+	for i := range o.Points {
+		x := o.Min * math.Pow(ratio, float64(i))
+		if i == o.Points-1 {
+			x = o.Max
+		}
+		fx, err := f(x)
+		if err != nil {
+			lastErr = err
+			found = false
+			continue
+		}
+		g := fx - target
+		if g == 0 {
+			return x, nil
+		}
+		if found && math.Signbit(g) != math.Signbit(fPrv) {
+			r, err := bisect(f, target, prev, x, fPrv, o.Tolerance)
+			if err == nil {
+				return r, nil
+			}
+			lastErr = err
+		}
+		prev, fPrv, found = x, g, true
+	}
+	if lastErr != nil {
+		return 0, fmt.Errorf("%w (last error: %v)", errNoBracket, lastErr)
+	}
+	return 0, errNoBracket
+}
+
+// bisect refines the root of f - target in [lo, hi], where gLo = f(lo) - target.
+func bisect(f func(float64) (float64, error), target, lo, hi, gLo, tol float64) (float64, error) {
+	fLo := gLo + target
+	fHi, err := f(hi)
+	if err != nil {
+		return 0, err
+	}
+	for hi-lo > tol*hi {
+		mid := (lo + hi) / 2
+		fm, err := f(mid)
+		if err != nil {
+			return 0, err
+		}
+		if math.Signbit(fm-target) == math.Signbit(gLo) {
+			lo, fLo = mid, fm
+		} else {
+			hi, fHi = mid, fm
+		}
+	}
+	// A continuous function has nearly equal values at both ends of the final
+	// bracket; a jump means the sign change is a discontinuity, not a root.
+	scale := math.Max(math.Abs(target), math.Max(math.Abs(fLo), math.Abs(fHi)))
+	if math.Abs(fHi-fLo) > 1e-3*scale {
+		return 0, fmt.Errorf("property is discontinuous at %g", (lo+hi)/2)
+	}
+	return (lo + hi) / 2, nil
+}
@@ -0,0 +1,95 @@
+package solve_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/solve"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestTemperatureFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        *substance.Substance
+		provider substance.Provider
+		P        float64
+		target   solve.Property
+		value    float64
+	}{
+		{"ethane V", substance.Ethane, substance.LeeKeslerProvider{}, 30, solve.PropV, 500},
+		{"ethane density", substance.Ethane, substance.LeeKeslerProvider{}, 30, solve.PropDensity, 100},
+		{"methane Z", substance.Methane, substance.AbbottProvider{}, 10, solve.PropZ, 0.98},
+		{"ideal gas V", substance.Methane, substance.IdealGasProvider{}, 1, solve.PropV, 24942},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			T, err := solve.TemperatureFor(tt.s, tt.provider, tt.P, tt.target, tt.value, nil)
+			if err != nil {
+				t.Fatalf("TemperatureFor() unexpected error: %v", err)
+			}
+			checkRoot(t, tt.s, tt.provider, T, tt.P, tt.target, tt.value)
+		})
+	}
+
+	// R = 83.14: T = PV/R exactly for an ideal gas.
+	T, _ := solve.TemperatureFor(substance.Methane, substance.IdealGasProvider{}, 1, solve.PropV, 24942, nil)
+	if math.Abs(T-300) > 1e-4 {
+		t.Errorf("TemperatureFor() ideal gas = %v, want 300", T)
+	}
+}
+
+func TestPressureFor(t *testing.T) {
+	lk := substance.LeeKeslerProvider{}
+	P, err := solve.PressureFor(substance.Ethane, lk, 300, solve.PropZ, 0.8, nil)
+	if err != nil {
+		t.Fatalf("PressureFor() unexpected error: %v", err)
+	}
+	checkRoot(t, substance.Ethane, lk, 300, P, solve.PropZ, 0.8)
+
+	// The two-term virial equation cannot reach Z = 0.9 below 15 bar.
+	_, err = solve.PressureFor(substance.Methane, substance.AbbottProvider{}, 300, solve.PropZ, 0.9, nil)
+	if err == nil {
+		t.Error("PressureFor() beyond the Abbott validity range, want error")
+	}
+
+	// A narrower search interval excludes the root.
+	_, err = solve.PressureFor(substance.Ethane, lk, 300, solve.PropZ, 0.8, &solve.Options{Min: 1, Max: 5})
+	if err == nil {
+		t.Error("PressureFor() with root outside the interval, want error")
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	lk := substance.LeeKeslerProvider{}
+	if _, err := solve.TemperatureFor(substance.Ethane, lk, 0, solve.PropZ, 0.9, nil); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("TemperatureFor() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	if _, err := solve.PressureFor(substance.Ethane, nil, 300, solve.PropZ, 0.9, nil); err == nil {
+		t.Error("PressureFor() with nil provider, want error")
+	}
+	custom := &substance.Substance{Name: "Custom"}
+	var missing *substance.MissingDataError
+	if _, err := solve.PressureFor(custom, lk, 300, solve.PropZ, 0.9, nil); !errors.As(err, &missing) {
+		t.Errorf("PressureFor() error = %v, want *MissingDataError", err)
+	}
+}
+
+// checkRoot checks that the property evaluated at (T, P) matches value.
+func checkRoot(t *testing.T, s *substance.Substance, p substance.Provider, T, P float64, target solve.Property, value float64) {
+	t.Helper()
+	props, err := s.PropertiesAt(T, P, p)
+	if err != nil {
+		t.Fatalf("PropertiesAt(%v, %v) unexpected error: %v", T, P, err)
+	}
+	got := map[solve.Property]float64{
+		solve.PropZ:       props.Z,
+		solve.PropV:       props.V,
+		solve.PropDensity: props.Density,
+	}[target]
+	if math.Abs(got-value) > 1e-6*math.Abs(value) {
+		t.Errorf("%v at T = %v K, P = %v bar = %v, want %v", target, T, P, got, value)
+	}
+}
@@ -3,61 +3,61 @@ package zfactor
 import (
 	"errors"
 	"math"
-	"math/cmplx"
 )
 
 // SolveCubic solves ax^3 + bx^2 + cx + d = 0
 // Returns all 3 roots (possibly complex).
+//
+// Real roots are returned with an imaginary part of exactly zero. When the cubic
+// has a single real root (see ClassifyCubic), it is the first element.
 func SolveCubic(a, b, c, d float64) ([3]complex128, error) {
 	if a == 0 {
 		return [3]complex128{}, errors.New("equation provided is not cubic (a = 0)")
 	}
 
-	// 1. Normalize coefficients
-	b /= a
-	c /= a
-	d /= a
+	// 1. Depressed cubic: y^3 + py + q = 0
+	p, q := depress(a, b, c, d)
+	shift := complex(b/(3*a), 0)
 
-	// 2. Depressed cubic: y^3 + py + q = 0
-	p := c - b*b/3
-	q := 2*b*b*b/27 - b*c/3 + d
-
-	// 3. Discriminant
-	delta := (q*q)/4 + (p*p*p)/27
-
-	// 4. Cube roots of unity
-	omega := complex(-0.5, math.Sqrt(3)/2)
-	omega2 := complex(-0.5, -math.Sqrt(3)/2)
+	// 2. Classify the roots from the discriminant
+	class := classifyDepressed(p, q, b/a, c/a)
 
 	var roots [3]complex128
 
-	if delta >= 0 {
-		// One real root and two complex
-		u := cmplx.Pow(complex(-q/2+math.Sqrt(delta), 0), 1.0/3)
-		v := cmplx.Pow(complex(-q/2-math.Sqrt(delta), 0), 1.0/3)
+	switch class {
+	case OneRealRoot:
+		// One real root and two complex (Cardano). Use real cube roots, since
+		// -q/2 - sqrt(delta) may be negative.
+		delta := (q*q)/4 + (p*p*p)/27
+		sd := math.Sqrt(math.Max(delta, 0))
+		u := math.Cbrt(-q/2 + sd)
+		v := math.Cbrt(-q/2 - sd)
 
-		y1 := u + v
-		y2 := u*omega + v*omega2
-		y3 := u*omega2 + v*omega
+		re := -(u + v) / 2
+		im := (u - v) * math.Sqrt(3) / 2
 
-		shift := complex(b/3, 0)
-		roots[0] = y1 - shift
-		roots[1] = y2 - shift
-		roots[2] = y3 - shift
-	} else {
-		// Three real roots
+		roots[0] = complex(u+v, 0) - shift
+		roots[1] = complex(re, im) - shift
+		roots[2] = complex(re, -im) - shift
+	case DoubleRoot, TripleRoot:
+		// Multiple root: delta = 0, so u = v = cbrt(-q/2).
+		u := math.Cbrt(-q / 2)
+		if class == TripleRoot {
+			u = 0
+		}
+
+		roots[0] = complex(2*u, 0) - shift
+		roots[1] = complex(-u, 0) - shift
+		roots[2] = complex(-u, 0) - shift
+	default:
+		// Three real roots (trigonometric method)
 		r := math.Sqrt(-p * p * p / 27)
-		phi := math.Acos(-q / (2 * math.Sqrt(-(p*p*p)/27)))
+		phi := math.Acos(math.Max(-1, math.Min(1, -q/(2*r))))
 		t := 2 * math.Cbrt(r)
 
-		y1 := complex(t*math.Cos(phi/3), 0)
-		y2 := complex(t*math.Cos((phi+2*math.Pi)/3), 0)
-		y3 := complex(t*math.Cos((phi+4*math.Pi)/3), 0)
-
-		shift := complex(b/3, 0)
-		roots[0] = y1 - shift
-		roots[1] = y2 - shift
-		roots[2] = y3 - shift
+		roots[0] = complex(t*math.Cos(phi/3), 0) - shift
+		roots[1] = complex(t*math.Cos((phi+2*math.Pi)/3), 0) - shift
+		roots[2] = complex(t*math.Cos((phi+4*math.Pi)/3), 0) - shift
 	}
 
 	return roots, nil
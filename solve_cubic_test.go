@@ -35,6 +35,18 @@ func TestSolveCubic(t *testing.T) {
 			wantRoots: []complex128{1, 2, 3},
 			wantErr:   false,
 		},
+		{
+			name: "x^3 + x - 10 = 0 (root 2, -1±2i)",
+			a:    1, b: 0, c: 1, d: -10,
+			wantRoots: []complex128{2, complex(-1, 2), complex(-1, -2)},
+			wantErr:   false,
+		},
+		{
+			name: "x^3 - 3x + 2 = 0 (double root 1, root -2)",
+			a:    1, b: 0, c: -3, d: 2,
+			wantRoots: []complex128{1, 1, -2},
+			wantErr:   false,
+		},
 		{
 			name: "x^3 + 3x^2 + 3x + 1 = 0 (triple root -1)",
 			a:    1, b: 3, c: 3, d: 1,
@@ -74,3 +86,51 @@ func TestSolveCubic(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyCubic(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b, c, d float64
+		want       RootClass
+		wantDisc   float64
+	}{
+		{"one real root", 1, 0, 1, -10, OneRealRoot, -2704},
+		{"three real roots", 1, -6, 11, -6, ThreeRealRoots, 4},
+		{"double root", 1, 0, -3, 2, DoubleRoot, 0},
+		{"triple root", 1, 3, 3, 1, TripleRoot, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ClassifyCubic(tt.a, tt.b, tt.c, tt.d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ClassifyCubic() = %v, want %v", got, tt.want)
+			}
+			if disc := CubicDiscriminant(tt.a, tt.b, tt.c, tt.d); math.Abs(disc-tt.wantDisc) > 1e-9 {
+				t.Errorf("CubicDiscriminant() = %v, want %v", disc, tt.wantDisc)
+			}
+
+			roots, _ := SolveCubic(tt.a, tt.b, tt.c, tt.d)
+			real := 0
+			for _, r := range roots {
+				if imag(r) == 0 {
+					real++
+				}
+			}
+			sum := 0
+			for _, m := range got.Multiplicities() {
+				sum += m
+			}
+			if real != sum {
+				t.Errorf("SolveCubic() returned %d real roots, classification implies %d", real, sum)
+			}
+		})
+	}
+
+	if _, err := ClassifyCubic(0, 1, 2, 3); err == nil {
+		t.Errorf("expected error for a = 0")
+	}
+}
@@ -0,0 +1,170 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+)
+
+// BlendConfig holds configuration options for DrawBlend.
+type BlendConfig struct {
+	// Type is the cubic equation of state of the mixture. This field is required.
+	Type cubic.EOSType
+	// T is the temperature (K) and P the pressure (bar) of the blend.
+	T, P float64
+	// Kij is the binary interaction parameter of the van der Waals mixing rules.
+	Kij float64
+	// Root selects the volume root at each composition: phase.Liquid for the
+	// smallest, phase.Vapor or phase.Supercritical for the largest. If
+	// phase.Unknown, the root with the lowest Gibbs energy is used.
+	Root phase.Phase
+	// Density adds a second panel with the mass density (kg/m³) of the blend.
+	Density bool
+	// Points is the number of compositions evaluated. Defaults to 101.
+	Points int
+	// Color is the color of the curves. Defaults to blue if nil.
+	Color Color
+	// Title is the title of the plot. If empty, a default title is generated.
+	Title string
+	// XLabel is the label of the shared x axis and YLabel that of the y axis
+	// of the Z panel. They default to "Mole Fraction of <a>" and "Z" if empty.
+	XLabel, YLabel string
+	// Grid configures grid lines and tick labels of every panel. No grid is drawn
+	// if nil.
+	Grid *GridConfig
+	// Numbers formats the numbers of the tick labels of every panel, e.g. with a decimal comma.
+	// Defaults to English formatting if nil.
+	Numbers *NumberFormat
+	// Output sets the size, resolution and handling of the saved image. Height
+	// defaults to 6 inches, instead of 4, with Density.
+	Output
+}
+
+// DrawBlend plots the compressibility factor of binary blends of a and b at fixed
+// temperature and pressure versus the mole fraction of a, e.g. to see how far a
+// blend departs from the linear mixing of its components. The legend names the
+// EOS, the mixing rules and kij. Compositions where the EOS has no root are
+// omitted.
+func DrawBlend(cfg *BlendConfig, output string, a, b *substance.Substance) error {
+	if err := checkExt(output); err != nil {
+		return err
+	}
+	plots, err := NewBlendPlots(cfg, a, b)
+	if err != nil {
+		return err
+	}
+
+	if len(plots) == 1 {
+		return savePlot(plots[0], output, cfg.Output)
+	}
+	opts := cfg.Output.sized(6 * vg.Inch)
+	return saveStacked(output, opts, plots...)
+}
+
+// NewBlendPlots builds the panels drawn by DrawBlend without saving them: the Z
+// plot, followed by the density plot if cfg.Density is set.
+// cfg.Output is ignored.
+func NewBlendPlots(cfg *BlendConfig, a, b *substance.Substance) ([]*plot.Plot, error) {
+	if cfg == nil {
+		return nil, errors.New("configuration error: config cannot be nil")
+	}
+	if cfg.Type == nil {
+		return nil, errors.New("configuration error: 'Type' field (EOS model) is required")
+	}
+	if a == nil || b == nil {
+		return nil, errors.New("configuration error: substances cannot be nil")
+	}
+	if cfg.T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if cfg.P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	for _, s := range []*substance.Substance{a, b} {
+		if err := s.Require("blend chart", substance.PropTc, substance.PropPc, substance.PropAcentric); err != nil {
+			return nil, err
+		}
+		if cfg.Density && s.MW <= 0 {
+			return nil, fmt.Errorf("blend chart: %s has no molecular weight", s.Name)
+		}
+	}
+	points := cfg.Points
+	if points <= 1 {
+		points = 101
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	comps := []cubic.Component{a.CubicComponent(), b.CubicComponent()}
+	kij := [][]float64{{0, cfg.Kij}, {cfg.Kij, 0}}
+
+	zs := make(plotter.XYs, 0, points)
+	rhos := make(plotter.XYs, 0, points)
+	for i := range points {
+		x := float64(i) / float64(points-1)
+		mix := cubic.NewMixtureCfg(cfg.Type, cfg.T, cfg.P, []float64{x, 1 - x}, comps, R)
+		mix.Kij = kij
+		Z, _, err := cubic.PhaseLogPhi(mix, cfg.Root)
+		if err != nil {
+			continue
+		}
+		zs = append(zs, plotter.XY{X: x, Y: Z})
+		// g/cm³ to kg/m³.
+		mw := x*a.MW + (1-x)*b.MW
+		rhos = append(rhos, plotter.XY{X: x, Y: 1000 * cfg.P * mw / (Z * R * cfg.T)})
+	}
+	if len(zs) == 0 {
+		return nil, errors.New("blend chart: the EOS has no root at any composition")
+	}
+
+	xLabel := orDefaultText(cfg.XLabel, fmt.Sprintf("Mole Fraction of %s", a.Name))
+	legend := fmt.Sprintf("%s, van der Waals mixing rules, kij = %.3g", cubic.Name(cfg.Type), cfg.Kij)
+	color := orDefault(cfg.Color, Blue)
+
+	top := plot.New()
+	cfg.Grid.apply(top)
+	cfg.Numbers.apply(top)
+	if cfg.Title == "" {
+		top.Title.Text = fmt.Sprintf("Z of %s + %s at T = %.1f K, P = %.4g bar", a.Name, b.Name, cfg.T, cfg.P)
+	} else {
+		top.Title.Text = cfg.Title
+	}
+	top.Y.Label.Text = orDefaultText(cfg.YLabel, "Z")
+	top.Legend.Top, top.Legend.Left = true, true
+	line, err := plotter.NewLine(zs)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = color
+	line.LineStyle.Width = vg.Points(1.5)
+	top.Add(line)
+	top.Legend.Add(legend, line)
+	top.X.Min, top.X.Max = 0, 1
+	if !cfg.Density {
+		top.X.Label.Text = xLabel
+		return []*plot.Plot{top}, nil
+	}
+
+	bottom := plot.New()
+	cfg.Grid.apply(bottom)
+	cfg.Numbers.apply(bottom)
+	bottom.X.Label.Text = xLabel
+	bottom.Y.Label.Text = "Density (kg/m³)"
+	densLine, err := plotter.NewLine(rhos)
+	if err != nil {
+		return nil, err
+	}
+	densLine.Color = color
+	densLine.LineStyle.Width = vg.Points(1.5)
+	bottom.Add(densLine)
+	bottom.X.Min, bottom.X.Max = 0, 1
+	return []*plot.Plot{top, bottom}, nil
+}
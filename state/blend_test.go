@@ -0,0 +1,79 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+)
+
+func TestNewBlendPlots(t *testing.T) {
+	const T, P = 300.0, 50.0
+	a, b := substance.Methane, substance.Ethane
+	plots, err := state.NewBlendPlots(&state.BlendConfig{Type: &cubic.PR{}, T: T, P: P, Density: true}, a, b)
+	if err != nil {
+		t.Fatalf("NewBlendPlots() unexpected error: %v", err)
+	}
+	if len(plots) != 2 {
+		t.Fatalf("NewBlendPlots() returned %d panels, want 2", len(plots))
+	}
+
+	// Z rises towards pure methane, the more ideal component, which ends the sweep.
+	cfg := a.CubicConfig(&cubic.PR{}, zfactor.Args{T: T, P: P, R: zfactor.RSI * 10})
+	vr, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := vr.Root(phase.Vapor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := P * v / (zfactor.RSI * 10 * T); math.Abs(plots[0].Y.Max-want) > 1e-9 {
+		t.Errorf("NewBlendPlots() max Z = %v, want pure %s Z = %v", plots[0].Y.Max, a.Name, want)
+	}
+	if plots[0].X.Min != 0 || plots[0].X.Max != 1 {
+		t.Errorf("NewBlendPlots() X range = [%v, %v], want [0, 1]", plots[0].X.Min, plots[0].X.Max)
+	}
+
+	out := filepath.Join(t.TempDir(), "blend.svg")
+	if err := state.DrawBlend(&state.BlendConfig{Type: &cubic.SRK{}, T: T, P: P, Kij: 0.01, Root: phase.Vapor}, out, a, b); err != nil {
+		t.Errorf("DrawBlend() unexpected error: %v", err)
+	}
+
+	// Customize is called once per panel.
+	var panels int
+	custom := &state.BlendConfig{Type: &cubic.PR{}, T: T, P: P, Density: true, Output: state.Output{Customize: func(*plot.Plot) { panels++ }}}
+	if err := state.DrawBlend(custom, out, a, b); err != nil {
+		t.Errorf("DrawBlend() unexpected error: %v", err)
+	}
+	if panels != 2 {
+		t.Errorf("DrawBlend() called Customize %d times, want 2", panels)
+	}
+
+	tests := []struct {
+		name string
+		cfg  *state.BlendConfig
+		a, b *substance.Substance
+	}{
+		{"nil config", nil, a, b},
+		{"no EOS", &state.BlendConfig{T: T, P: P}, a, b},
+		{"nil substance", &state.BlendConfig{Type: &cubic.PR{}, T: T, P: P}, a, nil},
+		{"zero temperature", &state.BlendConfig{Type: &cubic.PR{}, P: P}, a, b},
+		{"zero pressure", &state.BlendConfig{Type: &cubic.PR{}, T: T}, a, b},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := state.NewBlendPlots(tt.cfg, tt.a, tt.b); err == nil {
+				t.Errorf("NewBlendPlots() expected error, got nil")
+			}
+		})
+	}
+}
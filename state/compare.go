@@ -0,0 +1,290 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Quantity selects the property plotted by DrawComparison.
+type Quantity int
+
+const (
+	QuantityZ    Quantity = iota // Compressibility factor versus pressure at fixed T
+	QuantityV                    // Molar volume (cm³/mol) versus pressure at fixed T
+	QuantityPsat                 // Saturation pressure (bar) versus temperature
+)
+
+// CompareConfig holds configuration options for DrawComparison.
+type CompareConfig struct {
+	// Quantity is the property to compare. Defaults to QuantityZ.
+	Quantity Quantity
+	// T is the fixed temperature (K) for QuantityZ and QuantityV.
+	T float64
+	// PMin and PMax bound the pressure axis (bar) for QuantityZ and QuantityV.
+	// PMin defaults to 0.1 bar and PMax to 1.5 Pc.
+	PMin, PMax float64
+	// TMin and TMax bound the temperature axis (K) for QuantityPsat.
+	// They default to 0.5 Tc and 0.99 Tc.
+	TMin, TMax float64
+	// Points is the number of points evaluated per method. Defaults to 100.
+	Points int
+	// Methods are the methods to compare. Defaults to DefaultMethods().
+	Methods []Method
+	// Reference is the index in Methods that the deviation subplot is relative to.
+	// Defaults to 0.
+	Reference int
+	// Colors are cycled through for the method curves. Defaults to a built-in palette.
+	Colors []Color
+	// Title is the title of the plot. If empty, a default title is generated.
+	Title string
+	// XLabel is the label of the shared x axis and YLabel that of the y axis
+	// of the property panel. They default to the quantity and its unit if
+	// empty.
+	XLabel, YLabel string
+	// Grid configures grid lines and tick labels of both panels. If nil, only the
+	// deviation panel has major grid lines.
+	Grid *GridConfig
+	// Numbers formats the numbers of the tick labels of both panels, e.g. with a decimal comma.
+	// Defaults to English formatting if nil.
+	Numbers *NumberFormat
+	// Output sets the size, resolution and handling of the saved image. Height
+	// defaults to 6 inches, instead of 4.
+	Output
+}
+
+var defaultCompareColors = []Color{Blue, Red, Green, Orange, Purple, Magenta, Cyan, Black}
+
+// DrawComparison plots a property of a substance as computed by several methods on one
+// figure, with a second panel showing the percentage deviation of each method from the
+// reference method. Points where a method returns an error (e.g. outside its validity
+// range) are omitted from its curve; it returns an error if no method gives a point.
+func DrawComparison(cfg *CompareConfig, output string, s *substance.Substance) error {
+	if cfg == nil {
+		return errors.New("configuration error: config cannot be nil")
+	}
+	if s == nil {
+		return errors.New("configuration error: substance cannot be nil")
+	}
+	if err := checkExt(output); err != nil {
+		return err
+	}
+
+	methods := cfg.Methods
+	if len(methods) == 0 {
+		methods = DefaultMethods()
+	}
+	if cfg.Reference < 0 || cfg.Reference >= len(methods) {
+		return fmt.Errorf("configuration error: reference index %d out of range", cfg.Reference)
+	}
+	points := cfg.Points
+	if points <= 1 {
+		points = 100
+	}
+	colors := cfg.Colors
+	if len(colors) == 0 {
+		colors = defaultCompareColors
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	Tc := s.Critical.Tc
+	Pc := s.Critical.Pc
+
+	var (
+		lo, hi       float64
+		xLabel       string
+		yLabel       string
+		defaultTitle string
+		eval         func(m Method, x float64) (float64, error)
+	)
+
+	switch cfg.Quantity {
+	case QuantityZ, QuantityV:
+		if cfg.T <= 0 {
+			return zfactor.ErrTemp
+		}
+		lo, hi = cfg.PMin, cfg.PMax
+		if lo <= 0 {
+			lo = 0.1
+		}
+		if hi <= 0 {
+			hi = 1.5 * Pc
+		}
+		xLabel = "Pressure (bar)"
+		if cfg.Quantity == QuantityZ {
+			yLabel = "Z"
+			defaultTitle = fmt.Sprintf("Z vs P for %s at T = %.1f K", s.Name, cfg.T)
+		} else {
+			yLabel = "Molar Volume (cm³/mol)"
+			defaultTitle = fmt.Sprintf("V vs P for %s at T = %.1f K", s.Name, cfg.T)
+		}
+		eval = func(m Method, P float64) (float64, error) {
+			if m.Z == nil {
+				return 0, errors.New("method does not provide Z")
+			}
+			z, err := m.Z(s, cfg.T, P)
+			if err != nil {
+				return 0, err
+			}
+			if cfg.Quantity == QuantityV {
+				return zfactor.MolarVolume(z, P, cfg.T, R)
+			}
+			return z, nil
+		}
+	case QuantityPsat:
+		lo, hi = cfg.TMin, cfg.TMax
+		if lo <= 0 {
+			lo = 0.5 * Tc
+		}
+		if hi <= 0 {
+			hi = 0.99 * Tc
+		}
+		xLabel = "Temperature (K)"
+		yLabel = "Saturation Pressure (bar)"
+		defaultTitle = fmt.Sprintf("Psat vs T for %s", s.Name)
+		eval = func(m Method, T float64) (float64, error) {
+			if m.Psat == nil {
+				return 0, errors.New("method does not provide Psat")
+			}
+			return m.Psat(s, T)
+		}
+	default:
+		return fmt.Errorf("configuration error: unknown quantity %d", cfg.Quantity)
+	}
+	if hi <= lo {
+		return errors.New("configuration error: upper bound must be greater than lower bound")
+	}
+
+	// Evaluate all methods on a shared grid so deviations line up.
+	xs := make([]float64, points)
+	for i := range points {
+		xs[i] = lo + (hi-lo)*float64(i)/float64(points-1)
+	}
+	values := make([][]float64, len(methods))
+	ok := make([][]bool, len(methods))
+	for k, m := range methods {
+		values[k] = make([]float64, points)
+		ok[k] = make([]bool, points)
+		for i, x := range xs {
+			v, err := eval(m, x)
+			if err == nil {
+				values[k][i] = v
+				ok[k][i] = true
+			}
+		}
+	}
+
+	top := plot.New()
+	cfg.Grid.apply(top)
+	cfg.Numbers.apply(top)
+	if cfg.Title == "" {
+		top.Title.Text = defaultTitle
+	} else {
+		top.Title.Text = cfg.Title
+	}
+	top.Y.Label.Text = orDefaultText(cfg.YLabel, yLabel)
+	top.Legend.Top = true
+
+	bottom := plot.New()
+	if cfg.Grid != nil {
+		cfg.Grid.apply(bottom)
+	} else {
+		bottom.Add(plotter.NewGrid())
+	}
+	cfg.Numbers.apply(bottom)
+	bottom.X.Label.Text = orDefaultText(cfg.XLabel, xLabel)
+	bottom.Y.Label.Text = fmt.Sprintf("Deviation from %s (%%)", methods[cfg.Reference].Name)
+
+	ref := cfg.Reference
+	var curves int
+	for k, m := range methods {
+		c := colors[k%len(colors)]
+
+		pts := make(plotter.XYs, 0, points)
+		devPts := make(plotter.XYs, 0, points)
+		for i, x := range xs {
+			if !ok[k][i] {
+				continue
+			}
+			pts = append(pts, plotter.XY{X: x, Y: values[k][i]})
+			if ok[ref][i] && values[ref][i] != 0 {
+				dev := 100 * (values[k][i] - values[ref][i]) / values[ref][i]
+				devPts = append(devPts, plotter.XY{X: x, Y: dev})
+			}
+		}
+		if len(pts) == 0 {
+			continue
+		}
+		curves++
+
+		line, err := plotter.NewLine(pts)
+		if err != nil {
+			return err
+		}
+		line.Color = c
+		line.LineStyle.Width = vg.Points(1.5)
+		top.Add(line)
+		top.Legend.Add(m.Name, line)
+
+		if len(devPts) > 0 {
+			devLine, err := plotter.NewLine(devPts)
+			if err != nil {
+				return err
+			}
+			devLine.Color = c
+			if k == ref {
+				devLine.LineStyle.Dashes = []vg.Length{vg.Points(5), vg.Points(5)}
+			}
+			bottom.Add(devLine)
+		}
+	}
+
+	if curves == 0 {
+		return fmt.Errorf("no method could be evaluated between %g and %g", lo, hi)
+	}
+
+	// Share the x-range between panels.
+	top.X.Min, top.X.Max = lo, hi
+	bottom.X.Min, bottom.X.Max = lo, hi
+
+	opts := cfg.Output.sized(6 * vg.Inch)
+	return saveStacked(output, opts, top, bottom)
+}
+
+// saveStacked draws the plots stacked vertically with aligned axes and saves them
+// to a single file whose format is taken from the file extension.
+func saveStacked(output string, opts Output, plots ...*plot.Plot) error {
+	if opts.Customize != nil {
+		for _, p := range plots {
+			opts.Customize(p)
+		}
+	}
+	return render(output, opts, func(dc draw.Canvas) {
+		// Fill the background as plot.Save does.
+		dc.SetColor(color.White)
+		dc.Fill(dc.Rectangle.Path())
+
+		grid := make([][]*plot.Plot, len(plots))
+		for i, p := range plots {
+			grid[i] = []*plot.Plot{p}
+		}
+		tiles := draw.Tiles{
+			Rows: len(plots),
+			Cols: 1,
+			PadY: vg.Points(10),
+		}
+		canvases := plot.Align(grid, tiles, dc)
+		for i, p := range plots {
+			p.Draw(canvases[i][0])
+		}
+	})
+}
@@ -0,0 +1,70 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestDrawComparison(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	dir := t.TempDir()
+	cfgs := map[string]*state.CompareConfig{
+		"z":    {T: 300, Points: 20, Output: state.Output{Reproducible: true}},
+		"psat": {Quantity: state.QuantityPsat, Points: 20, Output: state.Output{Reproducible: true}},
+	}
+	for name, cfg := range cfgs {
+		t.Run(name, func(t *testing.T) {
+			var outputs [2][]byte
+			for i := range outputs {
+				out := filepath.Join(dir, fmt.Sprintf("%s%d.pdf", name, i))
+				if err := state.DrawComparison(cfg, out, substance.Ethane); err != nil {
+					t.Fatalf("DrawComparison() unexpected error: %v", err)
+				}
+				var err error
+				if outputs[i], err = os.ReadFile(out); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if len(outputs[0]) == 0 {
+				t.Fatal("DrawComparison() wrote an empty file")
+			}
+			if !bytes.Equal(outputs[0], outputs[1]) {
+				t.Error("DrawComparison() output differs between runs")
+			}
+		})
+	}
+
+	out := filepath.Join(dir, "err.png")
+	tests := []struct {
+		name string
+		cfg  *state.CompareConfig
+		s    *substance.Substance
+		out  string
+	}{
+		{"nil config", nil, substance.Ethane, out},
+		{"nil substance", &state.CompareConfig{T: 300}, nil, out},
+		{"no temperature", &state.CompareConfig{}, substance.Ethane, out},
+		{"reference out of range", &state.CompareConfig{T: 300, Reference: 9}, substance.Ethane, out},
+		{"inverted axis", &state.CompareConfig{T: 300, PMin: 10, PMax: 5}, substance.Ethane, out},
+		{"no values", &state.CompareConfig{T: 300, Methods: []state.Method{{Name: "empty"}}}, substance.Ethane, out},
+		{"bad extension", &state.CompareConfig{T: 300}, substance.Ethane, filepath.Join(dir, "err.bmp")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := state.DrawComparison(tt.cfg, tt.out, tt.s); err == nil {
+				t.Error("DrawComparison() expected error, got nil")
+			}
+			if _, err := os.Stat(tt.out); err == nil {
+				t.Errorf("DrawComparison() wrote %s despite the error", tt.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// NewSaturatedState creates the saturated liquid or saturated vapor state of s
+// at temperature T (K). phase must be Liquid or Vapor.
+//
+// The pressure is the Lee-Kesler vapor pressure, as used by Phase, and requires
+// the normal boiling point of the substance. The liquid volume is given by the
+// Rackett equation and the vapor volume by the vapor root of the Peng-Robinson
+// EOS at that pressure; the generalized Z correlations are discontinuous at the
+// saturation curve and cannot be evaluated on it.
+func NewSaturatedState(s *substance.Substance, T float64, phase Phase) (*State, error) {
+	if s == nil {
+		return nil, errors.New("substance cannot be nil")
+	}
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if phase != Liquid && phase != Vapor {
+		return nil, fmt.Errorf("saturated state must be liquid or vapor, got %v", phase)
+	}
+	if T >= s.Critical.Tc {
+		return nil, fmt.Errorf("no saturated state of %s at %g K, at or above Tc = %g K", s.Name, T, s.Critical.Tc)
+	}
+
+	pSat, err := s.LeeKeslerVaporPressure(T)
+	if err != nil {
+		return nil, err
+	}
+
+	var v float64
+	if phase == Liquid {
+		if v, err = s.Vsat(T); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.Require("Cubic EOS", substance.PropAcentric); err != nil {
+			return nil, err
+		}
+		cfg := s.CubicConfig(&cubic.PR{}, zfactor.Args{T: T, P: pSat, R: zfactor.RSI * 10})
+		volRes, err := cubic.SolveForVolume(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if v, err = volRes.Root(Vapor); err != nil {
+			return nil, err
+		}
+	}
+
+	return &State{
+		Substance:   s,
+		Temperature: T,
+		Pressure:    pSat,
+		Volume:      v,
+		Saturated:   true,
+		phase:       phase,
+	}, nil
+}
+
+// NewStateAuto creates the state of s at temperature T (K) and pressure P (bar),
+// resolving its phase with Substance.PhaseAt and its molar volume from the Z of
+// provider p. The phase is UnknownPhase if the substance has no normal boiling
+// point.
+func NewStateAuto(s *substance.Substance, T, P float64, p substance.Provider) (*State, error) {
+	if s == nil {
+		return nil, errors.New("substance cannot be nil")
+	}
+	if p == nil {
+		return nil, errors.New("provider cannot be nil")
+	}
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+
+	v, err := s.MolarVolume(T, P, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &State{
+		Substance:   s,
+		Temperature: T,
+		Pressure:    P,
+		Volume:      v,
+		phase:       s.PhaseAt(T, P),
+	}, nil
+}
+
+// root selects the volume root of the state from the real roots of an EOS,
+// sorted ascending. The phase resolved at construction decides between the
+// liquid and vapor roots; otherwise the EOS saturation pressure does.
+func (s *State) root(cfg *cubic.EOSCfg, roots []float64) (float64, error) {
+	if s.phase.Fluid() {
+		return phase.Root(roots, s.phase)
+	}
+	return stateVolume(cfg, roots, s.Substance.Critical.Tc)
+}
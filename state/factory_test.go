@@ -0,0 +1,103 @@
+package state_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestNewSaturatedState(t *testing.T) {
+	eth := substance.Ethane
+	pSat, err := eth.LeeKeslerVaporPressure(250)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	liq, err := state.NewSaturatedState(eth, 250, state.Liquid)
+	if err != nil {
+		t.Fatalf("NewSaturatedState(Liquid) unexpected error: %v", err)
+	}
+	vap, err := state.NewSaturatedState(eth, 250, state.Vapor)
+	if err != nil {
+		t.Fatalf("NewSaturatedState(Vapor) unexpected error: %v", err)
+	}
+
+	for _, st := range []*state.State{liq, vap} {
+		if st.Pressure != pSat {
+			t.Errorf("Pressure = %v, want %v", st.Pressure, pSat)
+		}
+		if !st.Saturated {
+			t.Errorf("Saturated = false, want true")
+		}
+	}
+	if liq.Phase() != state.Liquid || vap.Phase() != state.Vapor {
+		t.Errorf("Phase() = %v, %v, want liquid, vapor", liq.Phase(), vap.Phase())
+	}
+	if want, _ := eth.Vsat(250); liq.Volume != want {
+		t.Errorf("liquid Volume = %v, want %v", liq.Volume, want)
+	}
+	// The saturated vapor is a moderately non-ideal gas.
+	z := pSat * vap.Volume / (zfactor.RSI * 10 * 250)
+	if z < 0.7 || z > 0.95 {
+		t.Errorf("vapor Z = %v, want between 0.7 and 0.95", z)
+	}
+	if liq.Key() == vap.Key() {
+		t.Errorf("saturated liquid and vapor share the key %v", liq.Key())
+	}
+
+	tests := []struct {
+		name  string
+		s     *substance.Substance
+		T     float64
+		phase state.Phase
+	}{
+		{"supercritical", eth, 320, state.Vapor},
+		{"two-phase", eth, 250, state.Supercritical},
+		{"zero T", eth, 0, state.Liquid},
+		{"nil substance", nil, 250, state.Liquid},
+		{"no Tn", &substance.Substance{Name: "X", Critical: substance.CriticalProps{Tc: 400, Pc: 40}}, 300, state.Vapor},
+	}
+	for _, tt := range tests {
+		if _, err := state.NewSaturatedState(tt.s, tt.T, tt.phase); err == nil {
+			t.Errorf("NewSaturatedState() %s expected an error", tt.name)
+		}
+	}
+}
+
+func TestNewStateAuto(t *testing.T) {
+	tests := []struct {
+		name      string
+		T, P      float64
+		wantPhase state.Phase
+	}{
+		{"vapor", 299, 10, state.Vapor},
+		{"liquid", 250, 50, state.Liquid},
+		{"supercritical", 400, 50, state.Supercritical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := state.NewStateAuto(substance.Ethane, tt.T, tt.P, substance.LeeKeslerProvider{})
+			if err != nil {
+				t.Fatalf("NewStateAuto() unexpected error: %v", err)
+			}
+			if got := st.Phase(); got != tt.wantPhase {
+				t.Errorf("Phase() = %v, want %v", got, tt.wantPhase)
+			}
+			want, _ := substance.Ethane.MolarVolume(tt.T, tt.P, substance.LeeKeslerProvider{})
+			if math.Abs(st.Volume-want) > 1e-9*want {
+				t.Errorf("Volume = %v, want %v", st.Volume, want)
+			}
+		})
+	}
+
+	if _, err := state.NewStateAuto(substance.Ethane, 299, 0, substance.IdealGasProvider{}); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("NewStateAuto() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	if _, err := state.NewStateAuto(substance.Ethane, 299, 10, nil); err == nil {
+		t.Error("NewStateAuto() with nil provider expected an error")
+	}
+}
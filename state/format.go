@@ -0,0 +1,133 @@
+//go:build !noplot
+
+package state
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/plot"
+)
+
+// NumberFormat holds the number formatting of the tick labels and annotations
+// of a diagram, for figures in locales that write numbers differently from
+// English, e.g. {Decimal: ",", Thousands: "."} for German. A nil NumberFormat
+// keeps the default formatting.
+type NumberFormat struct {
+	// Decimal is the decimal separator. Defaults to "." if empty.
+	Decimal string
+	// Thousands separates the digits of integer parts longer than three digits
+	// in groups of three, e.g. "," or the thin space "\u2009". No grouping if
+	// empty.
+	Thousands string
+	// SigFigs rounds the numbers to this many significant figures. If 0, the
+	// default precision of each label is kept.
+	SigFigs int
+}
+
+// Format formats v with f: to SigFigs significant figures, or with the fewest
+// digits that represent v exactly if SigFigs is 0. Magnitudes below 1e-4 or
+// from 1e15 are written with an exponent.
+func (f *NumberFormat) Format(v float64) string {
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return f.localize(strconv.FormatFloat(v, 'g', -1, 64))
+	}
+	prec := -1
+	if f != nil && f.SigFigs > 0 {
+		// Round first, so that the exponent is that of the rounded number.
+		v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'e', f.SigFigs-1, 64), 64)
+		prec = f.SigFigs - 1
+	}
+	exp := int(math.Floor(math.Log10(math.Abs(v))))
+	if exp < -4 || exp >= 15 {
+		return f.localize(strconv.FormatFloat(v, 'e', prec, 64))
+	}
+	if prec >= 0 {
+		prec = max(0, prec-exp)
+	}
+	return f.localize(strconv.FormatFloat(v, 'f', prec, 64))
+}
+
+// sprintf formats v with the fmt verb of a default label, or to SigFigs
+// significant figures if set, and localizes the result.
+func (f *NumberFormat) sprintf(verb string, v float64) string {
+	if f != nil && f.SigFigs > 0 {
+		return f.Format(v)
+	}
+	return f.localize(fmt.Sprintf(verb, v))
+}
+
+// localize replaces the decimal point and groups the integer digits of the
+// number that s starts with. The rest of s, such as a unit, is kept.
+func (f *NumberFormat) localize(s string) string {
+	if f == nil || (f.Decimal == "" && f.Thousands == "") {
+		return s
+	}
+	i := 0
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+	start := i
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	intPart := s[start:i]
+	if intPart == "" {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteString(s[:start])
+	if f.Thousands != "" && len(intPart) > 3 {
+		for k, c := range intPart {
+			if k > 0 && (len(intPart)-k)%3 == 0 {
+				b.WriteString(f.Thousands)
+			}
+			b.WriteRune(c)
+		}
+	} else {
+		b.WriteString(intPart)
+	}
+	if i < len(s) && s[i] == '.' && f.Decimal != "" {
+		b.WriteString(f.Decimal)
+		i++
+	}
+	b.WriteString(s[i:])
+	return b.String()
+}
+
+// apply wraps the tick markers of p to format their labels with f.
+func (f *NumberFormat) apply(p *plot.Plot) {
+	if f == nil {
+		return
+	}
+	p.X.Tick.Marker = f.ticker(p.X.Tick.Marker)
+	p.Y.Tick.Marker = f.ticker(p.Y.Tick.Marker)
+}
+
+// ticker wraps base to format the labels of its major ticks with f.
+func (f *NumberFormat) ticker(base plot.Ticker) plot.Ticker {
+	return plot.TickerFunc(func(lo, hi float64) []plot.Tick {
+		ticks := base.Ticks(lo, hi)
+		for i, tk := range ticks {
+			switch {
+			case tk.Label == "":
+			case f.SigFigs > 0:
+				ticks[i].Label = f.Format(tk.Value)
+			default:
+				ticks[i].Label = f.localize(tk.Label)
+			}
+		}
+		return ticks
+	})
+}
+
+// orDefaultText returns s, or def if s is empty.
+func orDefaultText(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
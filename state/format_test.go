@@ -0,0 +1,108 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestNumberFormat(t *testing.T) {
+	german := &state.NumberFormat{Decimal: ",", Thousands: "."}
+	tests := []struct {
+		f    *state.NumberFormat
+		v    float64
+		want string
+	}{
+		{nil, 1234.5, "1234.5"},
+		{german, 1234.5, "1.234,5"},
+		{german, -1234567, "-1.234.567"},
+		{german, 0.25, "0,25"},
+		{german, 250, "250"},
+		{&state.NumberFormat{Decimal: ","}, 1.5e-7, "1,5e-07"},
+		{&state.NumberFormat{SigFigs: 3}, 305.32, "305"},
+		{&state.NumberFormat{SigFigs: 3}, 0.012345, "0.0123"},
+		{&state.NumberFormat{SigFigs: 3, Thousands: " "}, -12345.678, "-12 300"},
+		{&state.NumberFormat{SigFigs: 2, Decimal: ","}, 9.96, "10"},
+		{&state.NumberFormat{SigFigs: 2}, 0, "0"},
+	}
+	for _, tt := range tests {
+		if got := tt.f.Format(tt.v); got != tt.want {
+			t.Errorf("Format(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestNewPVPlotLocale(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &state.PVConfig{
+		Type:           &cubic.PR{},
+		XLabel:         "Molvolumen (cm³/mol)",
+		YLabel:         "Druck (bar)",
+		Numbers:        &state.NumberFormat{Decimal: ",", Thousands: "."},
+		Labels:         &state.PVLabels{Isotherm: "T = %s K"},
+		LabelIsotherms: true,
+		Isobars:        []float64{12.5},
+	}
+	p, err := state.NewPVPlot(cfg, st)
+	if err != nil {
+		t.Fatalf("NewPVPlot() unexpected error: %v", err)
+	}
+	if p.X.Label.Text != cfg.XLabel || p.Y.Label.Text != cfg.YLabel {
+		t.Errorf("NewPVPlot() axis labels = %q, %q, want %q, %q", p.X.Label.Text, p.Y.Label.Text, cfg.XLabel, cfg.YLabel)
+	}
+	for _, tk := range p.Y.Tick.Marker.Ticks(0, 2.5) {
+		if strings.Contains(tk.Label, ".") {
+			t.Errorf("NewPVPlot() tick label %q has a decimal point, want a decimal comma", tk.Label)
+		}
+	}
+
+	out := filepath.Join(t.TempDir(), "pv.svg")
+	if err := state.DrawPV(cfg, out, st); err != nil {
+		t.Fatalf("DrawPV() unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"T = 299,0 K", "P=12,5 bar", "Tc=305,3 K"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("DrawPV() output does not contain %q", want)
+		}
+	}
+}
+
+func TestDrawPVEPSLatin1(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "pv.eps")
+	if err := state.DrawPV(&state.PVConfig{Type: &cubic.PR{}}, out, st); err != nil {
+		t.Fatalf("DrawPV() unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eps := string(b)
+	// cm³ is written as a Latin-1 escape in a re-encoded font, not as UTF-8.
+	if !strings.Contains(eps, `(Molar Volume \(cm\263/mol\)) show`) {
+		t.Errorf("DrawPV() EPS axis label is not Latin-1 encoded")
+	}
+	if strings.Contains(eps, "³") {
+		t.Errorf("DrawPV() EPS output contains UTF-8 text")
+	}
+	if !strings.Contains(eps, "ISOLatin1Encoding") || strings.Contains(eps, "/LiberationSerif-Regular findfont") {
+		t.Errorf("DrawPV() EPS fonts are not re-encoded")
+	}
+}
@@ -0,0 +1,326 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/rickykimani/zfactor"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// GeneralizedChart selects the Lee-Kesler table drawn by DrawGeneralizedChart.
+type GeneralizedChart int
+
+const (
+	ChartZ0   GeneralizedChart = iota // Base compressibility factor Z^0
+	ChartZ1                           // Departure compressibility factor Z^1
+	ChartH0                           // Base residual enthalpy (H^R)^0/RTc
+	ChartH1                           // Departure residual enthalpy (H^R)^1/RTc
+	ChartS0                           // Base residual entropy (S^R)^0/R
+	ChartS1                           // Departure residual entropy (S^R)^1/R
+	ChartPhi0                         // Base fugacity coefficient phi^0
+	ChartPhi1                         // Departure fugacity coefficient phi^1
+)
+
+// lookup is the interface of the Lee-Kesler tables used by the charts.
+type lookup interface {
+	At(Tr, Pr float64) (float64, error)
+	AtBicubic(Tr, Pr float64) (float64, error)
+}
+
+// table returns the table of c and the label of its values.
+func (c GeneralizedChart) table() (lookup, string, error) {
+	switch c {
+	case ChartZ0:
+		return leekesler.Z0Table, "Z^0", nil
+	case ChartZ1:
+		return leekesler.Z1Table, "Z^1", nil
+	case ChartH0:
+		return leekesler.H0Table, "(H^R)^0/RTc", nil
+	case ChartH1:
+		return leekesler.H1Table, "(H^R)^1/RTc", nil
+	case ChartS0:
+		return leekesler.S0Table, "(S^R)^0/R", nil
+	case ChartS1:
+		return leekesler.S1Table, "(S^R)^1/R", nil
+	case ChartPhi0:
+		return leekesler.PHI0Table, "phi^0", nil
+	case ChartPhi1:
+		return leekesler.PHI1Table, "phi^1", nil
+	default:
+		return nil, "", fmt.Errorf("configuration error: unknown generalized chart %d", int(c))
+	}
+}
+
+// DefaultIsotherms are the reduced temperatures drawn on the generalized charts
+// unless GeneralizedChartConfig.Tr is set, the isotherms of the usual textbook
+// figures.
+var DefaultIsotherms = []float64{0.7, 0.8, 0.9, 1.0, 1.1, 1.2, 1.5, 2.0, 3.0, 4.0}
+
+// GeneralizedChartConfig holds configuration options for DrawGeneralizedChart.
+type GeneralizedChartConfig struct {
+	// Chart is the table to draw. Defaults to ChartZ0.
+	Chart GeneralizedChart
+	// Tr are the reduced temperatures of the isotherms. Defaults to
+	// DefaultIsotherms.
+	Tr []float64
+	// PrMin and PrMax bound the reduced pressure axis. They default to the range
+	// of the tables, 0.01 to 14.
+	PrMin, PrMax float64
+	// LogPr plots Pr on a logarithmic axis, which spreads out the low-pressure
+	// part of the isotherms.
+	LogPr bool
+	// Points is the number of points evaluated per isotherm. Defaults to 200.
+	Points int
+	// Interpolation of the tables between their nodes, zfactor.Bilinear (the
+	// zero value) or zfactor.Bicubic for smooth isotherms.
+	Interpolation zfactor.Interpolation
+	// MarkNodes marks the tabulated values on isotherms that lie on the table
+	// grid.
+	MarkNodes bool
+	// Colors are cycled through for the isotherms. Defaults to a built-in palette.
+	Colors []Color
+	// Title is the title of the plot. If empty, a default title is generated.
+	Title string
+	// XLabel and YLabel are the axis labels. They default to
+	// "Reduced Pressure Pr" and the name of the property if empty.
+	XLabel, YLabel string
+	// Grid configures grid lines and tick labels. No grid is drawn if nil.
+	Grid *GridConfig
+	// Numbers formats the numbers of the tick and isotherm labels, e.g. with a
+	// decimal comma. Defaults to English formatting if nil.
+	Numbers *NumberFormat
+	// Output sets the size, resolution and handling of the saved image.
+	Output
+}
+
+// DrawGeneralizedChart plots a Lee-Kesler table as isotherms versus reduced
+// pressure, such as the Z^0 chart or the residual enthalpy chart, from the
+// tables embedded in the lee-kesler package. Subcritical isotherms break at the
+// vapor pressure of the simple fluid (leekesler.SimpleFluidVaporPressure), into
+// a vapor branch that ends at the last table node below it and a liquid branch
+// that starts at the first node above it, instead of a ramp across the
+// saturation curve; they also break where the tables have no values. Each
+// isotherm is labelled with its Tr at its high-pressure end, as on the printed
+// charts.
+func DrawGeneralizedChart(cfg *GeneralizedChartConfig, output string) error {
+	if err := checkExt(output); err != nil {
+		return err
+	}
+	p, err := NewGeneralizedChartPlot(cfg)
+	if err != nil {
+		return err
+	}
+	return savePlot(p, output, cfg.Output)
+}
+
+// NewGeneralizedChartPlot builds the plot drawn by DrawGeneralizedChart without
+// saving it. cfg.Output is ignored.
+func NewGeneralizedChartPlot(cfg *GeneralizedChartConfig) (*plot.Plot, error) {
+	if cfg == nil {
+		return nil, errors.New("configuration error: config cannot be nil")
+	}
+	t, label, err := cfg.Chart.table()
+	if err != nil {
+		return nil, err
+	}
+	at := t.At
+	switch cfg.Interpolation {
+	case zfactor.Bilinear:
+	case zfactor.Bicubic:
+		at = t.AtBicubic
+	default:
+		return nil, fmt.Errorf("configuration error: unknown interpolation %v", cfg.Interpolation)
+	}
+	isotherms := cfg.Tr
+	if len(isotherms) == 0 {
+		isotherms = DefaultIsotherms
+	}
+	points := cfg.Points
+	if points == 0 {
+		points = 200
+	}
+	if points < 2 {
+		return nil, errors.New("configuration error: at least two points per isotherm are required")
+	}
+	colors := cfg.Colors
+	if len(colors) == 0 {
+		colors = defaultCompareColors
+	}
+
+	lo, hi := cfg.PrMin, cfg.PrMax
+	if lo == 0 {
+		lo = leekesler.Z0Table.Pr[0]
+	}
+	if hi == 0 {
+		hi = leekesler.Z0Table.Pr[len(leekesler.Z0Table.Pr)-1]
+	}
+	if lo <= 0 || hi <= lo {
+		return nil, fmt.Errorf("configuration error: invalid Pr range [%g, %g]", lo, hi)
+	}
+	prAt := func(i int) float64 {
+		f := float64(i) / float64(points-1)
+		if cfg.LogPr {
+			return lo * math.Pow(hi/lo, f)
+		}
+		return lo + (hi-lo)*f
+	}
+
+	p := plot.New()
+	if cfg.LogPr {
+		p.X.Scale = plot.LogScale{}
+		p.X.Tick.Marker = plot.LogTicks{Prec: -1}
+	}
+	cfg.Grid.apply(p)
+	cfg.Numbers.apply(p)
+	if cfg.Title == "" {
+		p.Title.Text = "Lee-Kesler Generalized Chart: " + label + " (isotherms of Tr)"
+	} else {
+		p.Title.Text = cfg.Title
+	}
+	p.X.Label.Text = orDefaultText(cfg.XLabel, "Reduced Pressure Pr")
+	p.Y.Label.Text = orDefaultText(cfg.YLabel, label)
+
+	labels := plotter.XYLabels{}
+	for k, tr := range isotherms {
+		col := colors[k%len(colors)]
+		prs := make([]float64, points)
+		for i := range prs {
+			prs[i] = prAt(i)
+		}
+		gapLo, gapHi := saturationCell(tr)
+		for _, pr := range []float64{gapLo, gapHi} {
+			if pr >= lo && pr <= hi {
+				prs = append(prs, pr)
+			}
+		}
+		slices.Sort(prs)
+
+		// Split the isotherm across the saturation cell and where the table has no
+		// values.
+		var parts []plotter.XYs
+		var cur plotter.XYs
+		liquid := false
+		for _, pr := range prs {
+			y, err := at(tr, pr)
+			inGap := pr > gapLo && pr < gapHi
+			if err != nil || math.IsNaN(y) || inGap || (pr >= gapHi) != liquid {
+				if len(cur) > 0 {
+					parts = append(parts, cur)
+					cur = nil
+				}
+				liquid = pr >= gapHi
+				if err != nil || math.IsNaN(y) || inGap {
+					continue
+				}
+			}
+			cur = append(cur, plotter.XY{X: pr, Y: y})
+		}
+		if len(cur) > 0 {
+			parts = append(parts, cur)
+		}
+
+		var last plotter.XYs
+		for _, pts := range parts {
+			if len(pts) < 2 {
+				continue
+			}
+			line, err := plotter.NewLine(pts)
+			if err != nil {
+				return nil, err
+			}
+			line.Color = col
+			line.LineStyle.Width = vg.Points(1.5)
+			p.Add(line)
+			last = pts
+		}
+		if last == nil {
+			continue
+		}
+		labels.XYs = append(labels.XYs, last[len(last)-1])
+		labels.Labels = append(labels.Labels, cfg.Numbers.sprintf("%g", tr))
+
+		if cfg.MarkNodes {
+			if nodes := tableNodes(at, tr, lo, hi); len(nodes) > 0 {
+				sc, err := plotter.NewScatter(nodes)
+				if err != nil {
+					return nil, err
+				}
+				sc.GlyphStyle.Shape = draw.CircleGlyph{}
+				sc.GlyphStyle.Radius = vg.Points(2)
+				sc.Color = col
+				p.Add(sc)
+			}
+		}
+	}
+	if len(labels.Labels) == 0 {
+		return nil, errors.New("no isotherm could be evaluated in the Pr range")
+	}
+	tl, err := plotter.NewLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	tl.Offset = vg.Point{X: vg.Points(3)}
+	for i := range tl.TextStyle {
+		tl.TextStyle[i].YAlign = draw.YCenter
+	}
+	p.Add(tl)
+
+	// Room on the right for the labels.
+	p.X.Min, p.X.Max = lo, hi+0.1*(hi-lo)
+	if cfg.LogPr {
+		p.X.Max = hi * math.Pow(hi/lo, 0.1)
+	}
+	return p, nil
+}
+
+// saturationCell returns the table nodes of Pr around the vapor pressure of the
+// simple fluid at tr, between which the tables change from vapor to liquid
+// values, or (+Inf, +Inf) if the isotherm does not cross the saturation curve of
+// the tables. A vapor pressure within rounding of a node belongs to the vapor
+// side, as the tables were generated.
+func saturationCell(tr float64) (lo, hi float64) {
+	ps, err := leekesler.SimpleFluidVaporPressure(tr)
+	prs := leekesler.Z0Table.Pr
+	if err != nil || ps < prs[0] {
+		return math.Inf(1), math.Inf(1)
+	}
+	i, _ := slices.BinarySearch(prs, ps*(1+1e-3))
+	if i == len(prs) {
+		return math.Inf(1), math.Inf(1)
+	}
+	return prs[i-1], prs[i]
+}
+
+// tableNodes returns the tabulated values of the isotherm tr between Pr lo and
+// hi, or nil if tr is not a reduced temperature of the tables.
+func tableNodes(at func(Tr, Pr float64) (float64, error), tr, lo, hi float64) plotter.XYs {
+	onGrid := false
+	for _, v := range leekesler.Z0Table.Tr {
+		if math.Abs(v-tr) < 1e-9 {
+			onGrid = true
+			break
+		}
+	}
+	if !onGrid {
+		return nil
+	}
+	var pts plotter.XYs
+	for _, pr := range leekesler.Z0Table.Pr {
+		if pr < lo || pr > hi {
+			continue
+		}
+		if y, err := at(tr, pr); err == nil && !math.IsNaN(y) {
+			pts = append(pts, plotter.XY{X: pr, Y: y})
+		}
+	}
+	return pts
+}
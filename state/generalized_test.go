@@ -0,0 +1,55 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/state"
+)
+
+func TestNewGeneralizedChartPlot(t *testing.T) {
+	p, err := state.NewGeneralizedChartPlot(&state.GeneralizedChartConfig{Chart: state.ChartH0, LogPr: true, MarkNodes: true})
+	if err != nil {
+		t.Fatalf("NewGeneralizedChartPlot() unexpected error: %v", err)
+	}
+	if p.X.Min != 0.01 || p.X.Max < 14 {
+		t.Errorf("NewGeneralizedChartPlot() X range = [%v, %v], want to contain [0.01, 14]", p.X.Min, p.X.Max)
+	}
+	if p.Y.Label.Text != "(H^R)^0/RTc" {
+		t.Errorf("NewGeneralizedChartPlot() Y label = %q, want %q", p.Y.Label.Text, "(H^R)^0/RTc")
+	}
+
+	tests := []struct {
+		name string
+		cfg  *state.GeneralizedChartConfig
+	}{
+		{"nil config", nil},
+		{"unknown chart", &state.GeneralizedChartConfig{Chart: 99}},
+		{"unknown interpolation", &state.GeneralizedChartConfig{Interpolation: 99}},
+		{"one point", &state.GeneralizedChartConfig{Points: 1}},
+		{"inverted axis", &state.GeneralizedChartConfig{PrMin: 5, PrMax: 1}},
+		{"outside the tables", &state.GeneralizedChartConfig{Tr: []float64{10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := state.NewGeneralizedChartPlot(tt.cfg); err == nil {
+				t.Errorf("NewGeneralizedChartPlot() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestDrawGeneralizedChart(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "z0.png")
+	cfg := &state.GeneralizedChartConfig{Interpolation: zfactor.Bicubic, Tr: []float64{0.9, 1.0, 1.05, 1.5}}
+	if err := state.DrawGeneralizedChart(cfg, output); err != nil {
+		t.Fatalf("DrawGeneralizedChart() unexpected error: %v", err)
+	}
+	if fi, err := os.Stat(output); err != nil || fi.Size() == 0 {
+		t.Errorf("DrawGeneralizedChart() wrote no image: %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+//go:build !noplot
+
+package state
+
+import (
+	"fmt"
+	"image/color"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// GridConfig holds grid line and tick options shared by the diagram configs.
+type GridConfig struct {
+	// Major draws grid lines at the labelled (major) ticks.
+	Major bool
+	// Minor draws grid lines at the minor ticks.
+	Minor bool
+	// MajorColor is the color of the major grid lines. Defaults to light grey if nil.
+	MajorColor Color
+	// MinorColor is the color of the minor grid lines. Defaults to a lighter grey if nil.
+	MinorColor Color
+	// MajorWidth is the width of the major grid lines. Defaults to 0.5 points if 0.
+	MajorWidth Length
+	// MinorWidth is the width of the minor grid lines. Defaults to 0.25 points if 0.
+	MinorWidth Length
+	// MajorDashes is the dash pattern of the major grid lines. Solid if nil.
+	MajorDashes []Length
+	// MinorDashes is the dash pattern of the minor grid lines. Solid if nil.
+	MinorDashes []Length
+	// MinorTicks is the number of minor ticks between consecutive major ticks.
+	// If 0, gonum's default minor ticks are kept.
+	MinorTicks int
+	// XTickFormat and YTickFormat are fmt verbs for the tick labels of each axis,
+	// e.g. "%.0f" or "%.1e". If empty, gonum's default labels are kept.
+	XTickFormat, YTickFormat string
+}
+
+// Default grid line colors.
+var (
+	defaultMajorGridColor Color = color.Gray{Y: 0xc0}
+	defaultMinorGridColor Color = color.Gray{Y: 0xe4}
+)
+
+// apply configures the ticks of p and adds the grid lines to it. Called before any
+// other plotter is added, it draws the grid beneath the curves.
+func (g *GridConfig) apply(p *plot.Plot) {
+	if g == nil {
+		return
+	}
+	p.X.Tick.Marker = g.ticker(p.X.Tick.Marker, g.XTickFormat)
+	p.Y.Tick.Marker = g.ticker(p.Y.Tick.Marker, g.YTickFormat)
+	if !g.Major && !g.Minor {
+		return
+	}
+
+	grid := &gridLines{}
+	if g.Major {
+		grid.major = draw.LineStyle{
+			Color:  orDefault(g.MajorColor, defaultMajorGridColor),
+			Width:  orDefaultLength(g.MajorWidth, vg.Points(0.5)),
+			Dashes: g.MajorDashes,
+		}
+	}
+	if g.Minor {
+		grid.minor = draw.LineStyle{
+			Color:  orDefault(g.MinorColor, defaultMinorGridColor),
+			Width:  orDefaultLength(g.MinorWidth, vg.Points(0.25)),
+			Dashes: g.MinorDashes,
+		}
+	}
+	p.Add(grid)
+}
+
+// ticker wraps base with the tick label format and number of minor ticks of g.
+func (g *GridConfig) ticker(base plot.Ticker, format string) plot.Ticker {
+	if format == "" && g.MinorTicks <= 0 {
+		return base
+	}
+	return plot.TickerFunc(func(lo, hi float64) []plot.Tick {
+		ticks := base.Ticks(lo, hi)
+		var major []plot.Tick
+		for _, tk := range ticks {
+			if tk.IsMinor() {
+				continue
+			}
+			if format != "" {
+				tk.Label = fmt.Sprintf(format, tk.Value)
+			}
+			major = append(major, tk)
+		}
+		if g.MinorTicks <= 0 || len(major) < 2 {
+			if format == "" {
+				return ticks
+			}
+			// Keep the default minor ticks with the relabelled major ones.
+			for _, tk := range ticks {
+				if tk.IsMinor() {
+					major = append(major, tk)
+				}
+			}
+			return major
+		}
+
+		// Subdivide the major spacing, extending beyond the first and last major
+		// ticks up to the axis limits.
+		step := (major[1].Value - major[0].Value) / float64(g.MinorTicks+1)
+		out := major
+		for v := major[0].Value - step; v >= lo; v -= step {
+			out = append(out, plot.Tick{Value: v})
+		}
+		for i := range major {
+			for k := 1; k <= g.MinorTicks; k++ {
+				v := major[i].Value + float64(k)*step
+				if i == len(major)-1 && v > hi {
+					break
+				}
+				out = append(out, plot.Tick{Value: v})
+			}
+		}
+		return out
+	})
+}
+
+// gridLines draws grid lines at the major and minor ticks of a plot. A line style
+// with a nil color is not drawn.
+type gridLines struct {
+	major, minor draw.LineStyle
+}
+
+// Plot implements the plot.Plotter interface.
+func (g *gridLines) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	style := func(tk plot.Tick) (draw.LineStyle, bool) {
+		if tk.IsMinor() {
+			return g.minor, g.minor.Color != nil
+		}
+		return g.major, g.major.Color != nil
+	}
+
+	for _, tk := range plt.X.Tick.Marker.Ticks(plt.X.Min, plt.X.Max) {
+		ls, ok := style(tk)
+		x := trX(tk.Value)
+		if !ok || x < c.Min.X || x > c.Max.X {
+			continue
+		}
+		c.StrokeLine2(ls, x, c.Min.Y, x, c.Max.Y)
+	}
+	for _, tk := range plt.Y.Tick.Marker.Ticks(plt.Y.Min, plt.Y.Max) {
+		ls, ok := style(tk)
+		y := trY(tk.Value)
+		if !ok || y < c.Min.Y || y > c.Max.Y {
+			continue
+		}
+		c.StrokeLine2(ls, c.Min.X, y, c.Max.X, y)
+	}
+}
+
+// orDefault returns c, or def if c is nil.
+func orDefault(c, def Color) Color {
+	if c == nil {
+		return def
+	}
+	return c
+}
+
+// orDefaultLength returns l, or def if l is 0.
+func orDefaultLength(l, def Length) Length {
+	if l == 0 {
+		return def
+	}
+	return l
+}
@@ -0,0 +1,73 @@
+package state
+
+import (
+	"math"
+
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Phase describes the region of the phase diagram a State falls in.
+type Phase = phase.Phase
+
+const (
+	UnknownPhase  = phase.Unknown
+	Liquid        = phase.Liquid
+	Vapor         = phase.Vapor
+	Supercritical = phase.Supercritical
+)
+
+// Phase classifies the state as liquid, vapor or supercritical.
+//
+// States created by NewSaturatedState or NewStateAuto return the phase resolved
+// at construction. Otherwise, below Tc the pressure is compared against the
+// Lee-Kesler vapor pressure, which requires the normal boiling point of the
+// substance. UnknownPhase is returned if the substance is nil or has no Tn.
+func (s *State) Phase() Phase {
+	if s.phase != UnknownPhase {
+		return s.phase
+	}
+	if s.Substance == nil {
+		return UnknownPhase
+	}
+	return s.Substance.PhaseAt(s.Temperature, s.Pressure)
+}
+
+// Resolutions used to round temperature and pressure in a Key.
+const (
+	KeyTempResolution     = 1e-3 // K
+	KeyPressureResolution = 1e-4 // bar
+)
+
+// Key is a canonical, comparable representation of a State, suitable for use as
+// a map key in caches or for deduplicating states.
+type Key struct {
+	Substance string
+	T         int64 // Temperature in units of KeyTempResolution
+	P         int64 // Pressure in units of KeyPressureResolution
+	Phase     Phase
+}
+
+// Key returns the canonical key of the state. Temperature and pressure are
+// rounded to KeyTempResolution and KeyPressureResolution, so states that differ
+// only by floating point noise share a key.
+func (s *State) Key() Key {
+	var name string
+	if s.Substance != nil {
+		name = s.Substance.Name
+	}
+	return Key{
+		Substance: name,
+		T:         int64(math.Round(s.Temperature / KeyTempResolution)),
+		P:         int64(math.Round(s.Pressure / KeyPressureResolution)),
+		Phase:     s.Phase(),
+	}
+}
+
+// Equal reports whether s and other describe the same state, i.e. whether they
+// have the same Key. Two nil states are equal.
+func (s *State) Equal(other *State) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.Key() == other.Key()
+}
@@ -0,0 +1,55 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *state.State
+		want state.Key
+	}{
+		{"rounded", &state.State{Substance: substance.Methane, Temperature: 300.0004, Pressure: 10.00004},
+			state.Key{Substance: "Methane", T: 300000, P: 100000, Phase: state.Supercritical}},
+		{"rounded up", &state.State{Substance: substance.Methane, Temperature: 299.9996, Pressure: 9.99996},
+			state.Key{Substance: "Methane", T: 300000, P: 100000, Phase: state.Supercritical}},
+		{"no substance", &state.State{Temperature: 300, Pressure: 1},
+			state.Key{T: 300000, P: 10000, Phase: state.UnknownPhase}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Key(); got != tt.want {
+				t.Errorf("Key() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateEqual(t *testing.T) {
+	methane := &state.State{Substance: substance.Methane, Temperature: 300, Pressure: 10}
+	tests := []struct {
+		name string
+		a, b *state.State
+		want bool
+	}{
+		{"floating point noise", methane, &state.State{Substance: substance.Methane, Temperature: 300 + 1e-9, Pressure: 10 - 1e-9}, true},
+		{"same values", methane, &state.State{Substance: substance.Methane, Temperature: 300, Pressure: 10, Volume: 2400}, true},
+		{"temperature", methane, &state.State{Substance: substance.Methane, Temperature: 300.01, Pressure: 10}, false},
+		{"pressure", methane, &state.State{Substance: substance.Methane, Temperature: 300, Pressure: 10.001}, false},
+		{"substance", methane, &state.State{Substance: substance.Ethane, Temperature: 300, Pressure: 10}, false},
+		{"nil and nil", nil, nil, true},
+		{"nil and state", nil, methane, false},
+		{"state and nil", methane, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
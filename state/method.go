@@ -0,0 +1,82 @@
+package state
+
+import (
+	"errors"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Method is a named property estimation method that can be compared on a chart.
+//
+// Z evaluates the compressibility factor of a substance at temperature T (K) and
+// pressure P (bar). Psat evaluates the saturation pressure (bar) at temperature T (K).
+// Either may be nil if the method does not provide that property.
+type Method struct {
+	Name string
+	Z    func(s *substance.Substance, T, P float64) (float64, error)
+	Psat func(s *substance.Substance, T float64) (float64, error)
+}
+
+// VirialMethod returns the two-term virial equation with the second virial
+// coefficient from the Abbott correlations. Pressures above 15 bar are rejected.
+func VirialMethod() Method {
+	return Method{
+		Name: "Virial (2-term)",
+		Z:    substance.AbbottProvider{}.Z,
+	}
+}
+
+// LeeKeslerMethod returns the Lee-Kesler generalized correlation.
+func LeeKeslerMethod() Method {
+	return Method{
+		Name: "Lee-Kesler",
+		Z: func(s *substance.Substance, T, P float64) (float64, error) {
+			return s.LeeKesler(zfactor.Args{T: T, P: P}, leekesler.CompressibilityFactor)
+		},
+		Psat: func(s *substance.Substance, T float64) (float64, error) {
+			return s.LeeKeslerVaporPressure(T)
+		},
+	}
+}
+
+// CubicMethod returns a method backed by the given cubic equation of state.
+// Below Tc the liquid or vapor root is selected by comparing P with the EOS
+// saturation pressure.
+func CubicMethod(name string, eos cubic.EOSType) Method {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	return Method{
+		Name: name,
+		Z: func(s *substance.Substance, T, P float64) (float64, error) {
+			cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: P, R: R})
+			volRes, err := cubic.SolveForVolume(cfg)
+			if err != nil {
+				return 0, err
+			}
+			v, err := stateVolume(cfg, volRes.Clean(), s.Critical.Tc)
+			if err != nil {
+				return 0, err
+			}
+			return P * v / (R * T), nil
+		},
+		Psat: func(s *substance.Substance, T float64) (float64, error) {
+			if T >= s.Critical.Tc {
+				return 0, errors.New("no saturation pressure above the critical temperature")
+			}
+			cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: s.Critical.Pc, R: R})
+			return cubic.SaturationPressure(cfg, T)
+		},
+	}
+}
+
+// DefaultMethods returns the two-term virial, Lee-Kesler, SRK and PR methods.
+func DefaultMethods() []Method {
+	return []Method{
+		VirialMethod(),
+		LeeKeslerMethod(),
+		CubicMethod("SRK", &cubic.SRK{}),
+		CubicMethod("PR", &cubic.PR{}),
+	}
+}
@@ -0,0 +1,239 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// The plotters below implement gonum's plot.Plotter, plot.DataRanger and
+// plot.Thumbnailer interfaces through the embedded plotter types, so the
+// curves of a PV diagram can be added to any *plot.Plot:
+//
+//	iso, err := state.NewIsothermPlotter(&cubic.PR{}, substance.NButane, 400, 100, 2000)
+//	if err != nil {
+//		return err
+//	}
+//	iso.Color = state.Green
+//	p.Add(iso)
+//	p.Legend.Add("400 K", iso)
+
+// IsothermPlotter is a PV isotherm of a substance computed with a cubic EOS.
+type IsothermPlotter struct {
+	*plotter.Line
+	Substance *substance.Substance
+	T         float64 // Temperature of the isotherm (K)
+}
+
+// NewIsothermPlotter returns the isotherm at temperature T (K) between the molar
+// volumes vMin and vMax (cm³/mol). Points are spaced logarithmically and only
+// positive pressures are kept. The line is blue by default.
+func NewIsothermPlotter(eos cubic.EOSType, s *substance.Substance, T, vMin, vMax float64) (*IsothermPlotter, error) {
+	if eos == nil {
+		return nil, errors.New("configuration error: EOS model is required")
+	}
+	if s == nil {
+		return nil, errors.New("configuration error: substance is required")
+	}
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if vMin <= 0 || vMax <= vMin {
+		return nil, fmt.Errorf("invalid volume range [%g, %g]", vMin, vMax)
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	// P is irrelevant when evaluating P(V), but the config requires a value.
+	cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: s.Critical.Pc, R: R})
+
+	pts := make(plotter.XYs, 0)
+	for v := vMin; v <= vMax; v *= 1.05 {
+		res, err := cubic.Pressure(cfg, v)
+		if err == nil && res.P > 0 {
+			pts = append(pts, plotter.XY{X: v, Y: res.P})
+		}
+	}
+	if len(pts) == 0 {
+		return nil, fmt.Errorf("isotherm at %g K has no positive pressures in [%g, %g]", T, vMin, vMax)
+	}
+	line, err := plotter.NewLine(pts)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = Blue
+	return &IsothermPlotter{Line: line, Substance: s, T: T}, nil
+}
+
+// DomePlotter is the saturation dome of a substance computed with a cubic EOS.
+//
+// The curve runs along the saturated liquid volumes from 0.6 Tc towards the
+// critical point and back along the saturated vapor volumes.
+type DomePlotter struct {
+	*plotter.Line
+	Substance *substance.Substance
+}
+
+// NewDomePlotter returns the saturation dome of s. The line is black by default.
+func NewDomePlotter(eos cubic.EOSType, s *substance.Substance) (*DomePlotter, error) {
+	if eos == nil {
+		return nil, errors.New("configuration error: EOS model is required")
+	}
+	if s == nil {
+		return nil, errors.New("configuration error: substance is required")
+	}
+
+	sat := saturationCurve(eos, s)
+	var liquidPts plotter.XYs
+	for _, pt := range sat {
+		liquidPts = append(liquidPts, plotter.XY{X: pt.vl, Y: pt.p})
+	}
+
+	// Add Critical Point to close the dome
+	if Vc := s.Critical.Vc; Vc > 0 {
+		liquidPts = append(liquidPts, plotter.XY{X: Vc, Y: s.Critical.Pc})
+	}
+
+	// Connect vapor points back to liquid (reverse order)
+	for i := len(sat) - 1; i >= 0; i-- {
+		liquidPts = append(liquidPts, plotter.XY{X: sat[i].vv, Y: sat[i].p})
+	}
+
+	if len(liquidPts) == 0 {
+		return nil, fmt.Errorf("no saturation points found for %s", s.Name)
+	}
+	line, err := plotter.NewLine(liquidPts)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = Black
+	line.LineStyle.Width = vg.Points(1.5)
+	return &DomePlotter{Line: line, Substance: s}, nil
+}
+
+// QualityPlotter is a line of constant vapor quality inside the saturation dome.
+type QualityPlotter struct {
+	*plotter.Line
+	Substance *substance.Substance
+	Quality   float64 // Vapor mole fraction x, between 0 and 1
+}
+
+// NewQualityPlotter returns the line of vapor quality x inside the saturation
+// dome, with V = Vl + x(Vv - Vl) at each saturation pressure (lever rule). The
+// line ends at the critical point. It is grey and dotted by default.
+func NewQualityPlotter(eos cubic.EOSType, s *substance.Substance, x float64) (*QualityPlotter, error) {
+	if eos == nil {
+		return nil, errors.New("configuration error: EOS model is required")
+	}
+	if s == nil {
+		return nil, errors.New("configuration error: substance is required")
+	}
+	if x <= 0 || x >= 1 {
+		return nil, fmt.Errorf("quality %g must be between 0 and 1", x)
+	}
+
+	var pts plotter.XYs
+	for _, pt := range saturationCurve(eos, s) {
+		pts = append(pts, plotter.XY{X: pt.vl + x*(pt.vv-pt.vl), Y: pt.p})
+	}
+	if len(pts) == 0 {
+		return nil, fmt.Errorf("no saturation points found for %s", s.Name)
+	}
+	if Vc := s.Critical.Vc; Vc > 0 {
+		pts = append(pts, plotter.XY{X: Vc, Y: s.Critical.Pc})
+	}
+	line, err := plotter.NewLine(pts)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = Grey
+	line.LineStyle.Dashes = []vg.Length{vg.Points(1), vg.Points(2)}
+	return &QualityPlotter{Line: line, Substance: s, Quality: x}, nil
+}
+
+// saturationPoint is a point of the saturation curve computed with a cubic EOS.
+type saturationPoint struct {
+	t, p   float64 // Saturation temperature (K) and pressure (bar)
+	vl, vv float64 // Saturated liquid and vapor molar volumes (cm³/mol)
+}
+
+// saturationCurve returns the saturation curve of s from 0.6 Tc to 0.99 Tc, in
+// order of increasing temperature. Temperatures where the EOS does not converge
+// are skipped.
+func saturationCurve(eos cubic.EOSType, s *substance.Substance) []saturationPoint {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	Tc := s.Critical.Tc
+	cfg := s.CubicConfig(eos, zfactor.Args{T: Tc, P: s.Critical.Pc, R: R})
+	var pts []saturationPoint
+
+	// Range from 0.6 Tc to 0.99 Tc
+	// Closer to Tc is harder to converge
+	startT := Tc * 0.6
+	endT := Tc * 0.99
+	stepT := (endT - startT) / 100
+
+	for t := startT; t <= endT; t += stepT {
+		pSat, err := cubic.SaturationPressure(cfg, t)
+		if err != nil {
+			continue
+		}
+		cfg.T = t
+		cfg.P = pSat
+		volRes, err := cubic.SolveForVolume(cfg)
+		if err != nil {
+			continue
+		}
+		if volRes.Class.Distinct() >= 2 {
+			vl, _ := volRes.Root(Liquid)
+			vv, _ := volRes.Root(Vapor)
+			pts = append(pts, saturationPoint{t: t, p: pSat, vl: vl, vv: vv})
+		}
+	}
+	return pts
+}
+
+// StatePointPlotter marks a state on a PV diagram.
+type StatePointPlotter struct {
+	*plotter.Scatter
+	State *State
+	V     float64 // Molar volume of the state (cm³/mol)
+}
+
+// NewStatePointPlotter returns the point of state st, at the molar volume given
+// by the EOS. Below Tc the liquid or vapor root is chosen by comparing P with
+// the EOS saturation pressure. The marker is a red circle by default.
+func NewStatePointPlotter(eos cubic.EOSType, st *State) (*StatePointPlotter, error) {
+	if eos == nil {
+		return nil, errors.New("configuration error: EOS model is required")
+	}
+	if st == nil || st.Substance == nil {
+		return nil, errors.New("configuration error: state has no substance")
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	cfg := st.Substance.CubicConfig(eos, zfactor.Args{T: st.Temperature, P: st.Pressure, R: R})
+	volRes, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		return nil, err
+	}
+	v, err := st.root(cfg, volRes.Clean())
+	if err != nil {
+		return nil, err
+	}
+
+	scatter, err := plotter.NewScatter(plotter.XYs{{X: v, Y: st.Pressure}})
+	if err != nil {
+		return nil, err
+	}
+	scatter.GlyphStyle.Shape = draw.CircleGlyph{}
+	scatter.GlyphStyle.Radius = vg.Points(4)
+	scatter.Color = Red
+	return &StatePointPlotter{Scatter: scatter, State: st, V: v}, nil
+}
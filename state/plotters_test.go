@@ -0,0 +1,81 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+)
+
+var (
+	_ plot.Plotter     = (*state.IsothermPlotter)(nil)
+	_ plot.DataRanger  = (*state.DomePlotter)(nil)
+	_ plot.Thumbnailer = (*state.StatePointPlotter)(nil)
+)
+
+func TestPlotters(t *testing.T) {
+	s := substance.NButane
+	iso, err := state.NewIsothermPlotter(&cubic.PR{}, s, 350, 100, 5000)
+	if err != nil {
+		t.Fatalf("NewIsothermPlotter() unexpected error: %v", err)
+	}
+	if len(iso.XYs) == 0 {
+		t.Errorf("NewIsothermPlotter() has no points")
+	}
+
+	dome, err := state.NewDomePlotter(&cubic.PR{}, s)
+	if err != nil {
+		t.Fatalf("NewDomePlotter() unexpected error: %v", err)
+	}
+	// The dome peaks at the critical point.
+	_, _, _, yMax := dome.DataRange()
+	if yMax != s.Critical.Pc {
+		t.Errorf("DomePlotter max P = %v, want %v", yMax, s.Critical.Pc)
+	}
+
+	st, err := state.NewState(s, 350, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pt, err := state.NewStatePointPlotter(&cubic.PR{}, st)
+	if err != nil {
+		t.Fatalf("NewStatePointPlotter() unexpected error: %v", err)
+	}
+	// Low pressure vapor is close to ideal gas.
+	if ideal := 83.14 * 350; pt.V < 0.9*ideal || pt.V > ideal {
+		t.Errorf("StatePointPlotter V = %v, want near %v", pt.V, ideal)
+	}
+
+	if _, err := state.NewIsothermPlotter(&cubic.PR{}, s, 350, 100, 50); err == nil {
+		t.Errorf("NewIsothermPlotter() expected error for an empty volume range")
+	}
+}
+
+func TestQualityPlotter(t *testing.T) {
+	s := substance.NButane
+	dome, err := state.NewDomePlotter(&cubic.PR{}, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := state.NewQualityPlotter(&cubic.PR{}, s, 0.5)
+	if err != nil {
+		t.Fatalf("NewQualityPlotter() unexpected error: %v", err)
+	}
+	// The dome starts with the liquid volume and ends with the vapor volume at
+	// the lowest saturation pressure.
+	vl, vv := dome.XYs[0].X, dome.XYs[len(dome.XYs)-1].X
+	if got, want := q.XYs[0].X, (vl+vv)/2; math.Abs(got-want) > 1e-9*want {
+		t.Errorf("QualityPlotter V at x=0.5 = %v, want %v", got, want)
+	}
+
+	for _, x := range []float64{0, 1, -0.1} {
+		if _, err := state.NewQualityPlotter(&cubic.PR{}, s, x); err == nil {
+			t.Errorf("NewQualityPlotter(%v) expected error", x)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/rickykimani/zfactor/antoine"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// normalPressure is the pressure of the normal boiling point in kPa.
+const normalPressure = 101.325
+
+// PsatCurve is a vapor pressure model plotted by DrawPsat.
+//
+// Temperatures are in °C and pressures in kPa, the units of the antoine package.
+type PsatCurve struct {
+	// Name labels the curve in the legend.
+	Name string
+	// Model is the vapor pressure correlation.
+	Model antoine.Model
+	// Range is the temperature interval where Model is valid.
+	Range antoine.TempRange
+	// Tn is the normal boiling point, marked on the curve. Not marked if NaN.
+	Tn float64
+}
+
+// AntoineCurve returns the curve of an Antoine coefficient set, with its valid
+// range and normal boiling point.
+func AntoineCurve(a *antoine.Antoine) PsatCurve {
+	return PsatCurve{Name: a.Name, Model: a, Range: a.Range, Tn: a.Tn}
+}
+
+// PsatConfig holds configuration options for DrawPsat.
+type PsatConfig struct {
+	// TMin and TMax bound the temperature axis (°C). They default to the union of
+	// the valid ranges of the curves, widened by 10% on each side so that the
+	// extrapolated parts are visible.
+	TMin, TMax float64
+	// Log plots ln(Psat) instead of Psat.
+	Log bool
+	// ShadeRange shades the valid temperature range of each curve.
+	ShadeRange bool
+	// MarkTn marks the normal boiling point of each curve and the line P = 101.325 kPa.
+	MarkTn bool
+	// Colors are cycled through for the curves. Defaults to a built-in palette.
+	Colors []Color
+	// Title is the title of the plot. If empty, a default title is generated.
+	Title string
+	// XLabel and YLabel are the axis labels. They default to "Temperature (°C)"
+	// and "Saturation Pressure (kPa)", or "ln(Psat / kPa)" with Log, if empty.
+	XLabel, YLabel string
+	// Grid configures grid lines and tick labels. No grid is drawn if nil.
+	Grid *GridConfig
+	// Numbers formats the numbers of the tick labels, e.g. with a decimal comma.
+	// Defaults to English formatting if nil.
+	Numbers *NumberFormat
+	// Output sets the size, resolution and handling of the saved image.
+	Output
+}
+
+// DrawPsat plots the saturation pressure versus temperature of one or more vapor
+// pressure models, e.g. to compare coefficient sets before trusting them. Each
+// curve is solid inside its valid range and dashed where it is extrapolated.
+func DrawPsat(cfg *PsatConfig, output string, curves ...PsatCurve) error {
+	if err := checkExt(output); err != nil {
+		return err
+	}
+	p, err := NewPsatPlot(cfg, curves...)
+	if err != nil {
+		return err
+	}
+	return savePlot(p, output, cfg.Output)
+}
+
+// NewPsatPlot builds the plot drawn by DrawPsat without saving it.
+// cfg.Output is ignored.
+func NewPsatPlot(cfg *PsatConfig, curves ...PsatCurve) (*plot.Plot, error) {
+	if cfg == nil {
+		return nil, errors.New("configuration error: config cannot be nil")
+	}
+	if len(curves) == 0 {
+		return nil, errors.New("configuration error: at least one curve is required")
+	}
+	for i, c := range curves {
+		if c.Model == nil {
+			return nil, fmt.Errorf("configuration error: curve %d has no model", i+1)
+		}
+		if c.Range.High <= c.Range.Low {
+			return nil, fmt.Errorf("configuration error: curve %d has an empty valid range", i+1)
+		}
+	}
+	colors := cfg.Colors
+	if len(colors) == 0 {
+		colors = defaultCompareColors
+	}
+
+	lo, hi := cfg.TMin, cfg.TMax
+	if lo == 0 && hi == 0 {
+		lo, hi = curves[0].Range.Low, curves[0].Range.High
+		for _, c := range curves[1:] {
+			lo = min(lo, c.Range.Low)
+			hi = max(hi, c.Range.High)
+		}
+		pad := 0.1 * (hi - lo)
+		lo, hi = lo-pad, hi+pad
+	}
+	if hi <= lo {
+		return nil, errors.New("configuration error: upper bound must be greater than lower bound")
+	}
+
+	yOf := func(c PsatCurve, t float64) (float64, bool) {
+		lnP, err := c.Model.LnPSat(t)
+		var rangeErr *antoine.RangeError
+		if err != nil && !errors.As(err, &rangeErr) {
+			return 0, false
+		}
+		if math.IsNaN(lnP) || math.IsInf(lnP, 0) {
+			return 0, false
+		}
+		if cfg.Log {
+			return lnP, true
+		}
+		return math.Exp(lnP), true
+	}
+
+	p := plot.New()
+	cfg.Grid.apply(p)
+	cfg.Numbers.apply(p)
+	if cfg.Title == "" {
+		p.Title.Text = "Vapor Pressure"
+	} else {
+		p.Title.Text = cfg.Title
+	}
+	p.X.Label.Text = orDefaultText(cfg.XLabel, "Temperature (°C)")
+	yLabel := "Saturation Pressure (kPa)"
+	if cfg.Log {
+		yLabel = "ln(Psat / kPa)"
+	}
+	p.Y.Label.Text = orDefaultText(cfg.YLabel, yLabel)
+	p.Legend.Top = true
+	p.Legend.Left = true
+
+	const points = 200
+	type segments struct {
+		valid, low, high plotter.XYs
+	}
+	curvePts := make([]segments, len(curves))
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	for k, c := range curves {
+		for i := range points {
+			t := lo + (hi-lo)*float64(i)/float64(points-1)
+			y, ok := yOf(c, t)
+			if !ok {
+				continue
+			}
+			pt := plotter.XY{X: t, Y: y}
+			switch {
+			case t < c.Range.Low:
+				curvePts[k].low = append(curvePts[k].low, pt)
+			case t > c.Range.High:
+				curvePts[k].high = append(curvePts[k].high, pt)
+			default:
+				curvePts[k].valid = append(curvePts[k].valid, pt)
+			}
+			yMin = min(yMin, y)
+			yMax = max(yMax, y)
+		}
+	}
+	if math.IsInf(yMin, 1) {
+		return nil, errors.New("no curve could be evaluated in the temperature range")
+	}
+
+	if cfg.ShadeRange {
+		for k, c := range curves {
+			r, g, b, _ := colors[k%len(colors)].RGBA()
+			band, err := plotter.NewPolygon(plotter.XYs{
+				{X: max(c.Range.Low, lo), Y: yMin},
+				{X: min(c.Range.High, hi), Y: yMin},
+				{X: min(c.Range.High, hi), Y: yMax},
+				{X: max(c.Range.Low, lo), Y: yMax},
+			})
+			if err != nil {
+				continue
+			}
+			band.Color = color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 0x20}
+			band.LineStyle.Width = 0
+			p.Add(band)
+		}
+	}
+
+	if cfg.MarkTn {
+		y := normalPressure
+		if cfg.Log {
+			y = math.Log(normalPressure)
+		}
+		atm, _ := plotter.NewLine(plotter.XYs{{X: lo, Y: y}, {X: hi, Y: y}})
+		atm.Color = Grey
+		atm.LineStyle.Dashes = []vg.Length{vg.Points(3), vg.Points(3)}
+		p.Add(atm)
+	}
+
+	for k, c := range curves {
+		col := colors[k%len(colors)]
+		seg := curvePts[k]
+		var legend plot.Thumbnailer
+		for _, part := range []struct {
+			pts          plotter.XYs
+			extrapolated bool
+		}{{seg.low, true}, {seg.valid, false}, {seg.high, true}} {
+			if len(part.pts) < 2 {
+				continue
+			}
+			line, err := plotter.NewLine(part.pts)
+			if err != nil {
+				return nil, err
+			}
+			line.Color = col
+			line.LineStyle.Width = vg.Points(1.5)
+			if part.extrapolated {
+				line.LineStyle.Dashes = []vg.Length{vg.Points(4), vg.Points(3)}
+				line.LineStyle.Width = vg.Points(1)
+			} else {
+				legend = line
+			}
+			p.Add(line)
+		}
+
+		if cfg.MarkTn && !math.IsNaN(c.Tn) {
+			if y, ok := yOf(c, c.Tn); ok {
+				tn, _ := plotter.NewScatter(plotter.XYs{{X: c.Tn, Y: y}})
+				tn.GlyphStyle.Shape = draw.CircleGlyph{}
+				tn.GlyphStyle.Radius = vg.Points(3)
+				tn.Color = col
+				p.Add(tn)
+			}
+		}
+
+		if legend != nil {
+			name := c.Name
+			if name == "" {
+				name = fmt.Sprintf("Curve %d", k+1)
+			}
+			p.Legend.Add(name, legend)
+		}
+	}
+
+	p.X.Min, p.X.Max = lo, hi
+	return p, nil
+}
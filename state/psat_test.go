@@ -0,0 +1,45 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/rickykimani/zfactor/antoine"
+	"github.com/rickykimani/zfactor/state"
+)
+
+func TestNewPsatPlot(t *testing.T) {
+	benzene := state.AntoineCurve(antoine.Benzene)
+	toluene := state.AntoineCurve(antoine.Toluene)
+
+	p, err := state.NewPsatPlot(&state.PsatConfig{Log: true, ShadeRange: true, MarkTn: true}, benzene, toluene)
+	if err != nil {
+		t.Fatalf("NewPsatPlot() unexpected error: %v", err)
+	}
+	// The default axis covers both valid ranges.
+	lo := min(benzene.Range.Low, toluene.Range.Low)
+	hi := max(benzene.Range.High, toluene.Range.High)
+	if p.X.Min > lo || p.X.Max < hi {
+		t.Errorf("NewPsatPlot() X range = [%v, %v], want to contain [%v, %v]", p.X.Min, p.X.Max, lo, hi)
+	}
+
+	tests := []struct {
+		name   string
+		cfg    *state.PsatConfig
+		curves []state.PsatCurve
+	}{
+		{"nil config", nil, []state.PsatCurve{benzene}},
+		{"no curves", &state.PsatConfig{}, nil},
+		{"no model", &state.PsatConfig{}, []state.PsatCurve{{Name: "empty", Range: antoine.TempRange{Low: 0, High: 1}}}},
+		{"empty range", &state.PsatConfig{}, []state.PsatCurve{{Model: antoine.Benzene}}},
+		{"inverted axis", &state.PsatConfig{TMin: 100, TMax: 50}, []state.PsatCurve{benzene}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := state.NewPsatPlot(tt.cfg, tt.curves...); err == nil {
+				t.Errorf("NewPsatPlot() expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,549 @@
+//go:build !noplot
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"path/filepath"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+var validExts = map[string]bool{
+	".eps":  true,
+	".jpg":  true,
+	".jpeg": true,
+	".pdf":  true,
+	".png":  true,
+	".svg":  true,
+	".tex":  true,
+	".tif":  true,
+	".tiff": true,
+}
+
+// Color is an alias for image/color.Color, representing colors in the plot.
+type Color = color.Color
+
+// Standard colors provided for convenience.
+var (
+	Red     Color = color.RGBA{R: 255, G: 0, B: 0, A: 255}
+	Green   Color = color.RGBA{R: 0, G: 255, B: 0, A: 255}
+	Blue    Color = color.RGBA{R: 0, G: 0, B: 255, A: 255}
+	Yellow  Color = color.RGBA{R: 255, G: 255, B: 0, A: 255}
+	Cyan    Color = color.RGBA{R: 0, G: 255, B: 255, A: 255}
+	Magenta Color = color.RGBA{R: 255, G: 0, B: 255, A: 255}
+	White   Color = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	Black   Color = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	Pink    Color = color.RGBA{R: 255, G: 192, B: 203, A: 255}
+	Orange  Color = color.RGBA{R: 255, G: 165, B: 0, A: 255}
+	Purple  Color = color.RGBA{R: 128, G: 0, B: 128, A: 255}
+	Grey    Color = color.RGBA{R: 128, G: 128, B: 128, A: 255}
+)
+
+// Length is an alias for vg.Length, representing physical length units for plotting.
+type Length = vg.Length
+
+// Common length units for specifying plot dimensions.
+const (
+	Inch       Length = vg.Inch
+	Centimeter Length = vg.Centimeter
+	Millimeter Length = vg.Millimeter
+)
+
+// DefaultQualities are the vapor qualities 0.1, 0.2, ..., 0.9, for PVConfig.QualityLines.
+var DefaultQualities = []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+// Glyph is an alias for draw.GlyphDrawer, the shape of a state point marker.
+type Glyph = draw.GlyphDrawer
+
+// Standard marker shapes provided for convenience.
+var (
+	Circle   Glyph = draw.CircleGlyph{}
+	Ring     Glyph = draw.RingGlyph{}
+	Square   Glyph = draw.SquareGlyph{}
+	Box      Glyph = draw.BoxGlyph{}
+	Triangle Glyph = draw.TriangleGlyph{}
+	Pyramid  Glyph = draw.PyramidGlyph{}
+	Cross    Glyph = draw.CrossGlyph{}
+	Plus     Glyph = draw.PlusGlyph{}
+)
+
+// StateStyle overrides the appearance of a single state in a PV diagram.
+// Zero fields fall back to the corresponding PVConfig settings.
+type StateStyle struct {
+	// Color is the color of the state point.
+	Color Color
+	// Glyph is the shape of the state point. Defaults to a circle.
+	Glyph Glyph
+	// Radius is the radius of the state point. Defaults to 4 points.
+	Radius Length
+	// Label is placed alongside the state point instead of its number. It is shown
+	// even if NumberStates is false.
+	Label string
+	// LabelColor is the color of the label or number of the state.
+	LabelColor Color
+	// IsothermColor is the color of the isotherm of the state.
+	IsothermColor Color
+	// IsothermWidth is the line width of the isotherm of the state.
+	IsothermWidth Length
+	// IsothermDashes is the dash pattern of the isotherm of the state, e.g.
+	// []Length{5, 5}. Solid if nil.
+	IsothermDashes []Length
+}
+
+// PVLabels holds the texts of the line labels of a PV diagram. Each is a fmt
+// format with one %s verb for the number, formatted with PVConfig.Numbers.
+// Empty fields keep the default text.
+type PVLabels struct {
+	CriticalIsotherm string // Defaults to "Tc=%s K"
+	Isotherm         string // Defaults to "T=%s K"
+	Isobar           string // Defaults to "P=%s bar"
+	Isochore         string // Defaults to "V=%s cm³/mol"
+	Quality          string // Defaults to "x=%s"
+}
+
+// PVConfig holds configuration options for customizing the appearance of the PV diagram.
+type PVConfig struct {
+	// Type specifies the cubic Equation of State (EOS) model to use for generating the PV diagram.
+	// This field is required; DrawPV will return an error if it is nil.
+	Type cubic.EOSType
+	// Title is the title of the plot. If empty, a default title is generated.
+	Title string
+	// TitleColor is the color of the title text. Defaults to black if nil.
+	TitleColor Color
+	// XLabelColor is the color of the X axis label text. Defaults to black if nil
+	XLabelColor Color
+	// YLabelColor is the color of the Y axis label text. Defaults to black if nil
+	YLabelColor Color
+	// XLabel and YLabel are the axis labels. They default to
+	// "Molar Volume (cm³/mol)" and "Pressure (bar)" if empty.
+	XLabel, YLabel string
+	// IsothermsColor is the color of the isotherm lines. Defaults to blue if nil.
+	IsothermsColor Color
+	// CriticalIsothermColor is the color of the critical isotherm (T=Tc). Defaults to magenta if nil.
+	CriticalIsothermColor Color
+	// DomeColor is the color of the saturation dome. Defaults to black if nil.
+	DomeColor Color
+	// StatePointColor is the color of the point representing the state. Defaults to red if nil.
+	StatePointColor Color
+	// NumberStates places a number on alongside the state point in the order they occur in states ...*State
+	NumberStates bool
+	// StatePointNumberColor is the color of the number of the state. Defaults to black if nil.
+	StatePointNumberColor Color
+	// LabelIsotherms places a label alongside the isotherm with the numerical value of the temperature
+	LabelIsotherms bool
+	// IsothermLabelColor is the color of the isotherm label. Defaults to black if nil.
+	IsothermLabelColor Color
+	// VolumeScaleFactor determines the maximum volume shown on the X-axis as a multiple of the critical volume (Vc).
+	// If 0, it defaults to 7.0.
+	VolumeScaleFactor float64
+	// Isobars are pressures (bar) drawn as labelled horizontal lines.
+	Isobars []float64
+	// IsobarColor is the color of the isobars and their labels. Defaults to grey if nil.
+	IsobarColor Color
+	// Isochores are molar volumes (cm³/mol) drawn as labelled vertical lines, e.g.
+	// the specific volume of a closed rigid vessel.
+	Isochores []float64
+	// IsochoreColor is the color of the isochores and their labels. Defaults to grey if nil.
+	IsochoreColor Color
+	// QualityLines are vapor qualities x, between 0 and 1, drawn as labelled lines
+	// of constant quality inside the saturation dome, e.g. DefaultQualities. The
+	// package has no TS or PH diagram to draw them on; NewQualityPlotter gives
+	// the PV line.
+	QualityLines []float64
+	// QualityLineColor is the color of the quality lines and their labels. Defaults to grey if nil.
+	QualityLineColor Color
+	// ShadeRegions fills the liquid, vapor, two-phase and supercritical regions,
+	// bounded by the saturation dome and the critical isotherm. The regions of a
+	// PT diagram are not drawn, as the package has no PT diagram.
+	ShadeRegions bool
+	// LabelRegions places the name of each phase region inside it.
+	LabelRegions bool
+	// RegionColors are the fill colors used by ShadeRegions. Defaults to DefaultRegionColors if nil.
+	RegionColors *RegionColors
+	// Grid configures grid lines and tick labels. No grid is drawn if nil.
+	Grid *GridConfig
+	// Numbers formats the numbers of the tick labels and of the isotherm,
+	// isobar, isochore and quality labels, e.g. with a decimal comma. Defaults
+	// to English formatting if nil.
+	Numbers *NumberFormat
+	// Labels overrides the texts of the isotherm, isobar, isochore and quality
+	// labels, e.g. to translate them. The default texts are kept if nil.
+	Labels *PVLabels
+	// StateStyles overrides the style of individual states, keyed by their
+	// zero-based index in states ...*State. This allows, for example, the initial
+	// and final states of a process to be told apart.
+	StateStyles map[int]StateStyle
+	// Output sets the size, resolution and handling of the saved image.
+	Output
+}
+
+// DrawPV generates a Pressure-Volume (PV) diagram for the provided states.
+// It plots the critical isotherm, the saturation dome (two-phase region), and the
+// specific isotherms for each state provided. The resulting plot is saved to the
+// file specified by 'output'.
+func DrawPV(cfg *PVConfig, output string, states ...*State) error {
+	if err := checkExt(output); err != nil {
+		return err
+	}
+	p, err := NewPVPlot(cfg, states...)
+	if err != nil {
+		return err
+	}
+
+	return savePlot(p, output, cfg.Output)
+}
+
+// NewPVPlot builds the PV diagram drawn by DrawPV without saving it, so that it
+// can be customized further or embedded in other documents.
+// cfg.Output is ignored.
+func NewPVPlot(cfg *PVConfig, states ...*State) (*plot.Plot, error) {
+	if cfg == nil {
+		return nil, errors.New("configuration error: config cannot be nil")
+	}
+	if cfg.Type == nil {
+		return nil, errors.New("configuration error: 'Type' field (EOS model) is required")
+	}
+	if len(states) == 0 {
+		return nil, errors.New("configuration error: at least one state is required")
+	}
+	name, err := verifySubstances(states...)
+	if err != nil {
+		return nil, fmt.Errorf("oops, something went wrong: %w", err)
+	}
+	if err := states[0].Substance.Require("PV diagram", substance.PropTc, substance.PropPc, substance.PropVc, substance.PropAcentric); err != nil {
+		return nil, err
+	}
+	p := plot.New()
+	cfg.Grid.apply(p)
+	cfg.Numbers.apply(p)
+	texts := cfg.Labels
+	if texts == nil {
+		texts = &PVLabels{}
+	}
+
+	if cfg.Title == "" {
+		p.Title.Text = fmt.Sprintf("PV Diagram for %s", name)
+	} else {
+		p.Title.Text = cfg.Title
+	}
+
+	if cfg.TitleColor != nil {
+		p.Title.TextStyle.Color = cfg.TitleColor
+	}
+
+	p.X.Label.Text = orDefaultText(cfg.XLabel, "Molar Volume (cm³/mol)")
+	if cfg.XLabelColor != nil {
+		p.X.Label.TextStyle.Color = cfg.XLabelColor
+	}
+	p.Y.Label.Text = orDefaultText(cfg.YLabel, "Pressure (bar)")
+	if cfg.YLabelColor != nil {
+		p.Y.Label.TextStyle.Color = cfg.YLabelColor
+	}
+
+	// Use Linear Scale but be smart about limits
+	// p.X.Scale = plot.LogScale{}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+
+	s0 := states[0]
+	Tc := s0.Substance.Critical.Tc
+	Pc := s0.Substance.Critical.Pc
+	Vc := s0.Substance.Critical.Vc
+
+	// 1. Draw Critical Isotherm (T = Tc)
+	// This defines the boundary between subcritical and supercritical
+	critCfg := s0.Substance.CubicConfig(cfg.Type, zfactor.Args{T: Tc, P: Pc, R: R})
+	params, err := critCfg.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	b := params.Omega * R * Tc / Pc
+
+	// Define V range based on Vc
+	// Start near b, go up to a reasonable multiple of Vc
+	minV := b * 1.1
+	// Default max view: if Vc is known, use it. Else guess.
+	maxViewV := minV * 15
+	if Vc > 0 {
+		factor := cfg.VolumeScaleFactor
+		if factor <= 0 {
+			factor = 7.0
+		}
+		maxViewV = Vc * factor
+	}
+
+	// Check if any state is outside this view
+	for _, s := range states {
+		// Estimate V for state
+		estV := R * s.Temperature / s.Pressure
+		if estV > maxViewV {
+			maxViewV = estV * 1.1
+		}
+	}
+	for _, v := range cfg.Isochores {
+		if v > maxViewV {
+			maxViewV = v * 1.1
+		}
+	}
+	maxP := Pc * 1.5
+	if states[0].Pressure > maxP {
+		maxP = states[0].Pressure * 1.1
+	}
+	for _, P := range cfg.Isobars {
+		if P > maxP {
+			maxP = P * 1.1
+		}
+	}
+
+	if cfg.ShadeRegions || cfg.LabelRegions {
+		pvRegions(p, cfg.Type, s0.Substance, minV, maxViewV, maxP, cfg.RegionColors, cfg.ShadeRegions, cfg.LabelRegions)
+	}
+
+	critLine, err := NewIsothermPlotter(cfg.Type, s0.Substance, Tc, minV, maxViewV)
+	if err != nil {
+		return nil, fmt.Errorf("critical isotherm: %w", err)
+	}
+	if cfg.CriticalIsothermColor == nil {
+		critLine.Color = Magenta
+	} else {
+		critLine.Color = cfg.CriticalIsothermColor
+	}
+	critLine.LineStyle.Dashes = []vg.Length{vg.Points(5), vg.Points(5)}
+	critLine.LineStyle.Width = vg.Points(1)
+	p.Add(critLine)
+
+	if cfg.LabelIsotherms {
+		lastPt := critLine.XYs[len(critLine.XYs)-1]
+		labels, _ := plotter.NewLabels(plotter.XYLabels{
+			XYs:    []plotter.XY{lastPt},
+			Labels: []string{fmt.Sprintf(orDefaultText(texts.CriticalIsotherm, "Tc=%s K"), cfg.Numbers.sprintf("%.1f", Tc))},
+		})
+		labels.Offset.X = vg.Points(2)
+		if cfg.IsothermLabelColor != nil {
+			labels.TextStyle[0].Color = cfg.IsothermLabelColor
+		}
+		p.Add(labels)
+	}
+
+	// 2. Draw Saturation Dome
+	if dome, err := NewDomePlotter(cfg.Type, s0.Substance); err == nil {
+		if cfg.DomeColor != nil {
+			dome.Color = cfg.DomeColor
+		}
+		p.Add(dome)
+	}
+
+	// Quality lines inside the dome
+	for _, x := range cfg.QualityLines {
+		q, err := NewQualityPlotter(cfg.Type, s0.Substance, x)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.QualityLineColor != nil {
+			q.Color = cfg.QualityLineColor
+		}
+		p.Add(q)
+
+		// Label the low pressure end, where the lines are furthest apart.
+		labels, _ := plotter.NewLabels(plotter.XYLabels{
+			XYs:    []plotter.XY{q.XYs[0]},
+			Labels: []string{fmt.Sprintf(orDefaultText(texts.Quality, "x=%s"), cfg.Numbers.sprintf("%.2g", x))},
+		})
+		labels.Offset.Y = vg.Points(2)
+		labels.TextStyle[0].Color = q.Color
+		labels.TextStyle[0].Font.Size = vg.Points(7)
+		p.Add(labels)
+	}
+
+	// 3. Mark Critical Point
+	if Vc > 0 {
+		cp, _ := plotter.NewScatter(plotter.XYs{{X: Vc, Y: Pc}})
+		cp.GlyphStyle.Shape = draw.CrossGlyph{}
+		cp.Color = color.RGBA{R: 0, A: 255}
+		p.Add(cp)
+	}
+
+	// Isobars and Isochores
+	isobarColor := orDefault(cfg.IsobarColor, Grey)
+	for _, P := range cfg.Isobars {
+		if P <= 0 {
+			return nil, fmt.Errorf("isobar: %w", zfactor.ErrPressure)
+		}
+		line, _ := plotter.NewLine(plotter.XYs{{X: 0, Y: P}, {X: maxViewV, Y: P}})
+		line.Color = isobarColor
+		line.LineStyle.Dashes = []vg.Length{vg.Points(3), vg.Points(3)}
+		p.Add(line)
+
+		labels, _ := plotter.NewLabels(plotter.XYLabels{
+			XYs:    []plotter.XY{{X: maxViewV, Y: P}},
+			Labels: []string{fmt.Sprintf(orDefaultText(texts.Isobar, "P=%s bar"), cfg.Numbers.sprintf("%.4g", P))},
+		})
+		labels.Offset.X = vg.Points(2)
+		labels.TextStyle[0].Color = isobarColor
+		p.Add(labels)
+	}
+	isochoreColor := orDefault(cfg.IsochoreColor, Grey)
+	for _, v := range cfg.Isochores {
+		if v <= 0 {
+			return nil, errors.New("isochore: molar volume must be greater than 0")
+		}
+		line, _ := plotter.NewLine(plotter.XYs{{X: v, Y: 0}, {X: v, Y: maxP}})
+		line.Color = isochoreColor
+		line.LineStyle.Dashes = []vg.Length{vg.Points(1), vg.Points(3)}
+		p.Add(line)
+
+		labels, _ := plotter.NewLabels(plotter.XYLabels{
+			XYs:    []plotter.XY{{X: v, Y: maxP}},
+			Labels: []string{fmt.Sprintf(orDefaultText(texts.Isochore, "V=%s cm³/mol"), cfg.Numbers.sprintf("%.4g", v))},
+		})
+		labels.Offset.X = vg.Points(2)
+		labels.Offset.Y = vg.Points(-10)
+		labels.TextStyle[0].Color = isochoreColor
+		p.Add(labels)
+	}
+
+	// 4. Draw States and their Isotherms
+	for i, state := range states {
+		// Draw Isotherm
+		isoLine, err := NewIsothermPlotter(cfg.Type, state.Substance, state.Temperature, minV, maxViewV)
+		if err != nil {
+			return nil, fmt.Errorf("isotherm of state %d: %w", i+1, err)
+		}
+		style := cfg.StateStyles[i]
+		if cfg.IsothermsColor != nil {
+			isoLine.Color = cfg.IsothermsColor
+		}
+		if style.IsothermColor != nil {
+			isoLine.Color = style.IsothermColor
+		}
+		if style.IsothermWidth > 0 {
+			isoLine.LineStyle.Width = style.IsothermWidth
+		}
+		isoLine.LineStyle.Dashes = style.IsothermDashes
+		p.Add(isoLine)
+
+		if cfg.LabelIsotherms {
+			lastPt := isoLine.XYs[len(isoLine.XYs)-1]
+			labels, _ := plotter.NewLabels(plotter.XYLabels{
+				XYs:    []plotter.XY{lastPt},
+				Labels: []string{fmt.Sprintf(orDefaultText(texts.Isotherm, "T=%s K"), cfg.Numbers.sprintf("%.1f", state.Temperature))},
+			})
+			labels.Offset.X = vg.Points(2)
+			// Shift label to avoid overlap with Critical Isotherm
+			if state.Temperature < Tc {
+				labels.Offset.Y = vg.Points(-10)
+			} else {
+				labels.Offset.Y = vg.Points(10)
+			}
+			if cfg.IsothermLabelColor != nil {
+				labels.TextStyle[0].Color = cfg.IsothermLabelColor
+			}
+			p.Add(labels)
+		}
+
+		// Plot State Marker
+		scatter, err := NewStatePointPlotter(cfg.Type, state)
+		if err != nil {
+			continue
+		}
+		if cfg.StatePointColor != nil {
+			scatter.Color = cfg.StatePointColor
+		}
+		if style.Color != nil {
+			scatter.Color = style.Color
+		}
+		if style.Glyph != nil {
+			scatter.GlyphStyle.Shape = style.Glyph
+		}
+		if style.Radius > 0 {
+			scatter.GlyphStyle.Radius = style.Radius
+		}
+		p.Add(scatter)
+
+		if cfg.NumberStates || style.Label != "" {
+			text := style.Label
+			if text == "" {
+				text = fmt.Sprintf("%d", i+1)
+			}
+			labels, _ := plotter.NewLabels(plotter.XYLabels{
+				XYs:    []plotter.XY{{X: scatter.V, Y: state.Pressure}},
+				Labels: []string{text},
+			})
+			labels.Offset.X = vg.Points(5)
+			labels.Offset.Y = vg.Points(5)
+			if cfg.StatePointNumberColor != nil {
+				labels.TextStyle[0].Color = cfg.StatePointNumberColor
+			}
+			if style.LabelColor != nil {
+				labels.TextStyle[0].Color = style.LabelColor
+			}
+			p.Add(labels)
+		}
+	}
+
+	// Set Axes Limits
+	p.X.Min = 0
+	p.X.Max = maxViewV
+	p.Y.Min = 0
+	p.Y.Max = maxP
+
+	return p, nil
+}
+
+// checkExt verifies that the output file has an extension supported by gonum/plot.
+// If it does not, the error suggests the closest valid extension.
+func checkExt(output string) error {
+	ext := filepath.Ext(output)
+	if ok := validExts[ext]; !ok {
+		closest := ""
+		minDist := int(^uint(0) >> 1)
+		for valid := range validExts {
+			dist := levenshtein(ext, valid)
+			if dist < minDist {
+				minDist = dist
+				closest = valid
+			}
+		}
+		suggestion := output[:len(output)-len(ext)] + closest
+		return fmt.Errorf("invalid file extension: %s. Did you mean %q instead?", output, suggestion)
+	}
+	return nil
+}
+
+func levenshtein(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	n, m := len(r1), len(r2)
+	if n == 0 {
+		return m
+	}
+	if m == 0 {
+		return n
+	}
+	row := make([]int, n+1)
+	for i := 0; i <= n; i++ {
+		row[i] = i
+	}
+	for j := 1; j <= m; j++ {
+		prev := j
+		for i := 1; i <= n; i++ {
+			cost := 0
+			if r1[i-1] != r2[j-1] {
+				cost = 1
+			}
+			current := min(row[i]+1, prev+1, row[i-1]+cost)
+			row[i-1] = prev
+			prev = current
+		}
+		row[n] = prev
+	}
+	return row[n]
+}
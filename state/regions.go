@@ -0,0 +1,171 @@
+//go:build !noplot
+
+package state
+
+import (
+	"image/color"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// RegionColors holds the fill colors of the phase regions of a diagram.
+// Colors should be translucent so that grid lines remain visible.
+type RegionColors struct {
+	Liquid        Color
+	Vapor         Color
+	TwoPhase      Color
+	Supercritical Color
+}
+
+// DefaultRegionColors are the region colors used when none are given.
+var DefaultRegionColors = RegionColors{
+	Liquid:        color.NRGBA{R: 0x4f, G: 0x81, B: 0xbd, A: 0x40},
+	Vapor:         color.NRGBA{R: 0xc0, G: 0x50, B: 0x4d, A: 0x30},
+	TwoPhase:      color.NRGBA{R: 0x9b, G: 0xbb, B: 0x59, A: 0x40},
+	Supercritical: color.NRGBA{R: 0xf7, G: 0x96, B: 0x46, A: 0x30},
+}
+
+// pvRegions shades and/or labels the phase regions of a PV diagram of s, bounded by
+// the saturation dome and the critical isotherm, for volumes up to maxV and
+// pressures up to maxP.
+//
+// Supercritical is the region above the critical isotherm (T > Tc). Below it,
+// the dome separates the liquid on the left from the vapor on the right.
+func pvRegions(p *plot.Plot, eos cubic.EOSType, s *substance.Substance, minV, maxV, maxP float64, colors *RegionColors, shade, label bool) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if colors == nil {
+		colors = &DefaultRegionColors
+	}
+	Tc := s.Critical.Tc
+	Pc := s.Critical.Pc
+	Vc := s.Critical.Vc
+	sat := saturationCurve(eos, s)
+	if len(sat) == 0 || Vc <= 0 {
+		return
+	}
+
+	critCfg := s.CubicConfig(eos, zfactor.Args{T: Tc, P: Pc, R: R})
+	isoP := func(v float64) float64 {
+		res, err := cubic.Pressure(critCfg, v)
+		if err != nil || res.P <= 0 {
+			return 0
+		}
+		return min(res.P, maxP)
+	}
+	// Critical isotherm between v0 and v1, in the direction of travel.
+	isotherm := func(v0, v1 float64) plotter.XYs {
+		var pts plotter.XYs
+		if v0 < v1 {
+			for v := v0; v < v1; v *= 1.05 {
+				pts = append(pts, plotter.XY{X: v, Y: isoP(v)})
+			}
+		} else {
+			for v := v0; v > v1; v /= 1.05 {
+				pts = append(pts, plotter.XY{X: v, Y: isoP(v)})
+			}
+		}
+		return append(pts, plotter.XY{X: v1, Y: isoP(v1)})
+	}
+	low := sat[0]
+
+	// Two-phase: inside the dome
+	twoPhase := plotter.XYs{}
+	for _, pt := range sat {
+		twoPhase = append(twoPhase, plotter.XY{X: pt.vl, Y: pt.p})
+	}
+	twoPhase = append(twoPhase, plotter.XY{X: Vc, Y: Pc})
+	for i := len(sat) - 1; i >= 0; i-- {
+		twoPhase = append(twoPhase, plotter.XY{X: sat[i].vv, Y: sat[i].p})
+	}
+
+	// Liquid: below the critical isotherm, left of the liquid branch of the dome
+	liquid := isotherm(minV, Vc)
+	for i := len(sat) - 1; i >= 0; i-- {
+		liquid = append(liquid, plotter.XY{X: sat[i].vl, Y: sat[i].p})
+	}
+	liquid = append(liquid, plotter.XY{X: low.vl, Y: 0}, plotter.XY{X: minV, Y: 0})
+
+	// Vapor: below the critical isotherm, right of the vapor branch of the dome
+	vapor := isotherm(maxV, Vc)
+	for i := len(sat) - 1; i >= 0; i-- {
+		vapor = append(vapor, plotter.XY{X: sat[i].vv, Y: sat[i].p})
+	}
+	vapor = append(vapor, plotter.XY{X: low.vv, Y: 0}, plotter.XY{X: maxV, Y: 0})
+
+	// Supercritical: above the critical isotherm
+	super := isotherm(minV, maxV)
+	super = append(super, plotter.XY{X: maxV, Y: maxP}, plotter.XY{X: minV, Y: maxP})
+
+	if shade {
+		for _, r := range []struct {
+			pts plotter.XYs
+			c   Color
+		}{
+			{liquid, colors.Liquid},
+			{vapor, colors.Vapor},
+			{twoPhase, colors.TwoPhase},
+			{super, colors.Supercritical},
+		} {
+			if r.c == nil {
+				continue
+			}
+			poly, err := plotter.NewPolygon(r.pts)
+			if err != nil {
+				continue
+			}
+			poly.Color = r.c
+			poly.LineStyle.Width = 0
+			p.Add(poly)
+		}
+	}
+
+	if label {
+		// Pressure of the vapor branch of the dome at volume v, 0 if below the dome.
+		domeP := func(v float64) float64 {
+			for i := 0; i+1 < len(sat); i++ {
+				a, b := sat[i], sat[i+1]
+				if a.vv >= v && v >= b.vv {
+					return a.p + (b.p-a.p)*(v-a.vv)/(b.vv-a.vv)
+				}
+			}
+			if v < sat[len(sat)-1].vv {
+				return Pc
+			}
+			return 0
+		}
+		// Two-phase label halfway between the branches at about half of Pc
+		mid := sat[0]
+		for _, pt := range sat {
+			if pt.p <= Pc/2 {
+				mid = pt
+			}
+		}
+		vLiquid := minV + 0.3*(Vc-minV)
+		vVapor := 0.75 * maxV
+		vSuper := 0.6 * maxV
+		labels, err := plotter.NewLabels(plotter.XYLabels{
+			XYs: []plotter.XY{
+				{X: vLiquid, Y: 0.4 * isoP(vLiquid)},
+				{X: vVapor, Y: (domeP(vVapor) + isoP(vVapor)) / 2},
+				{X: (mid.vl + min(mid.vv, maxV)) / 2, Y: 0.7 * mid.p},
+				{X: vSuper, Y: (isoP(vSuper) + maxP) / 2},
+			},
+			Labels: []string{"Liquid", "Vapor", "Liquid + Vapor", "Supercritical"},
+		})
+		if err != nil {
+			return
+		}
+		for i := range labels.TextStyle {
+			labels.TextStyle[i].Font.Size = vg.Points(9)
+			labels.TextStyle[i].XAlign = draw.XCenter
+			labels.TextStyle[i].YAlign = draw.YCenter
+		}
+		p.Add(labels)
+	}
+}
@@ -0,0 +1,206 @@
+//go:build !noplot
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// Output holds the output settings shared by the diagram configs, which embed
+// it. The New*Plot functions, which do not save, ignore it.
+type Output struct {
+	// Width is the width of the output image. Defaults to 6 inches if 0.
+	Width Length
+	// Height is the height of the output image. Defaults to 4 inches if 0,
+	// unless the embedding config documents another default.
+	Height Length
+	// DPI is the resolution of PNG, JPEG and TIFF output. Defaults to 96 if 0.
+	DPI int
+	// Reproducible makes identical inputs produce byte-identical files, for golden
+	// file tests and reproducible publications. The creation date that EPS and PDF
+	// files otherwise take from the clock is set from SOURCE_DATE_EPOCH, or to the
+	// Unix epoch if unset. Other formats are always reproducible, as fonts are
+	// embedded in the module and layout involves no randomness.
+	Reproducible bool
+	// ShowOutputPath determines whether to print the full path of the saved image to stdout upon success.
+	ShowOutputPath bool
+	// Customize, if set, is called with the finished plot, or with each panel
+	// from the top for stacked figures, just before it is saved, to add
+	// gonum/plot elements that the config has no option for, such as extra
+	// curves, annotations or a watermark.
+	Customize func(*plot.Plot)
+}
+
+// sized returns o with Width defaulted to 6 inches and Height to height.
+func (o Output) sized(height Length) Output {
+	if o.Width == 0 {
+		o.Width = 6 * vg.Inch
+	}
+	if o.Height == 0 {
+		o.Height = height
+	}
+	return o
+}
+
+// savePlot saves p to output, 6 by 4 inches unless Width or Height are set.
+func savePlot(p *plot.Plot, output string, opts Output) error {
+	opts = opts.sized(4 * vg.Inch)
+	if opts.Customize != nil {
+		opts.Customize(p)
+	}
+	return render(output, opts, p.Draw)
+}
+
+// render draws a figure with fn and writes it to output, in the format given by
+// the file extension.
+func render(output string, opts Output, fn func(c draw.Canvas)) error {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(output), "."))
+	dpi := opts.DPI
+	if dpi <= 0 {
+		dpi = vgimg.DefaultDPI
+	}
+
+	var c vg.CanvasWriterTo
+	switch format {
+	case "png", "jpg", "jpeg", "tif", "tiff":
+		img := vgimg.NewWith(vgimg.UseWH(opts.Width, opts.Height), vgimg.UseDPI(dpi))
+		switch format {
+		case "png":
+			c = vgimg.PngCanvas{Canvas: img}
+		case "jpg", "jpeg":
+			c = vgimg.JpegCanvas{Canvas: img}
+		default:
+			c = vgimg.TiffCanvas{Canvas: img}
+		}
+	default:
+		var err error
+		c, err = draw.NewFormattedCanvas(opts.Width, opts.Height, format)
+		if err != nil {
+			return err
+		}
+	}
+	fn(draw.New(c))
+
+	var buf bytes.Buffer
+	if _, err := c.WriteTo(&buf); err != nil {
+		return err
+	}
+	b := buf.Bytes()
+	if format == "eps" {
+		b = latin1EPS(b)
+	}
+	if opts.Reproducible {
+		b = fixDates(format, b, sourceDate())
+	}
+	if err := os.WriteFile(output, b, 0o666); err != nil {
+		return err
+	}
+
+	if opts.ShowOutputPath {
+		wd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get working directory: %w", err)
+		}
+		fmt.Printf("image saved to %s\n", filepath.Join(wd, output))
+	}
+	return nil
+}
+
+var (
+	epsDate = regexp.MustCompile(`(?m)^%%CreationDate: .*$`)
+	pdfDate = regexp.MustCompile(`/(CreationDate|ModDate) \(D:\d{14}\)`)
+)
+
+// fixDates replaces the creation dates that the EPS and PDF backends take from
+// the clock with t. Other formats carry no date and are returned unchanged.
+func fixDates(format string, b []byte, t time.Time) []byte {
+	switch format {
+	case "eps":
+		return epsDate.ReplaceAll(b, []byte("%%CreationDate: "+t.Format(time.RFC3339)))
+	case "pdf":
+		// Same length as the original, so the cross-reference offsets stay valid.
+		return pdfDate.ReplaceAll(b, []byte("/$1 (D:"+t.Format("20060102150405")+")"))
+	default:
+		return b
+	}
+}
+
+var (
+	epsFont = regexp.MustCompile(`/(\S+) findfont`)
+	epsShow = regexp.MustCompile(`(?m)^\((.*)\) show$`)
+)
+
+// latin1EPS makes the text of an EPS file from the EPS backend render as
+// written. The backend writes strings as UTF-8 bytes, which PostScript fonts
+// show as one character per byte, so that "cm³" reads "cmÂ³". latin1EPS
+// re-encodes the fonts with ISOLatin1Encoding and writes the characters of
+// Latin-1 (°, ², ³, µ and the accented letters) as octal escapes; characters
+// outside Latin-1 become "?". It also escapes the backslashes and parentheses
+// of the strings.
+func latin1EPS(b []byte) []byte {
+	var fonts []string
+	for _, m := range epsFont.FindAllSubmatch(b, -1) {
+		if name := string(m[1]); !slices.Contains(fonts, name) {
+			fonts = append(fonts, name)
+		}
+	}
+	b = epsFont.ReplaceAll(b, []byte("/$1-Latin1 findfont"))
+	b = epsShow.ReplaceAllFunc(b, func(m []byte) []byte {
+		text := string(epsShow.FindSubmatch(m)[1])
+		var out bytes.Buffer
+		out.WriteByte('(')
+		for _, r := range text {
+			switch {
+			case r == '\\' || r == '(' || r == ')':
+				out.WriteByte('\\')
+				out.WriteRune(r)
+			case r < 0x80:
+				out.WriteRune(r)
+			case r <= 0xff:
+				fmt.Fprintf(&out, "\\%03o", r)
+			default:
+				out.WriteByte('?')
+			}
+		}
+		out.WriteString(") show")
+		return out.Bytes()
+	})
+
+	var prolog bytes.Buffer
+	prolog.WriteString("/reencode { findfont dup length dict begin { 1 index /FID ne { def } { pop pop } ifelse } forall " +
+		"/Encoding ISOLatin1Encoding def currentdict end definefont pop } bind def\n")
+	for _, f := range fonts {
+		fmt.Fprintf(&prolog, "/%s-Latin1 /%s reencode\n", f, f)
+	}
+	const end = "%%EndComments\n"
+	i := bytes.Index(b, []byte(end))
+	if i < 0 {
+		return b
+	}
+	i += len(end)
+	return slices.Concat(b[:i], prolog.Bytes(), b[i:])
+}
+
+// sourceDate returns the time in the SOURCE_DATE_EPOCH environment variable
+// (reproducible-builds.org), or the Unix epoch if it is not set.
+func sourceDate() time.Time {
+	if s, ok := os.LookupEnv("SOURCE_DATE_EPOCH"); ok {
+		if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Unix(sec, 0).UTC()
+		}
+	}
+	return time.Unix(0, 0).UTC()
+}
@@ -0,0 +1,187 @@
+//go:build !noplot
+
+package state_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/state"
+	"github.com/rickykimani/zfactor/substance"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+)
+
+func TestDrawPVStateStyles(t *testing.T) {
+	s1, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := state.NewState(substance.Ethane, 490, 70)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &state.PVConfig{
+		Type:         &cubic.PR{},
+		NumberStates: true,
+		StateStyles: map[int]state.StateStyle{
+			0: {Color: state.Green, Label: "initial"},
+			1: {Color: state.Orange, Glyph: state.Square, Label: "final", IsothermDashes: []state.Length{4, 2}},
+		},
+	}
+	out := filepath.Join(t.TempDir(), "pv.svg")
+	if err := state.DrawPV(cfg, out, s1, s2); err != nil {
+		t.Fatalf("DrawPV() unexpected error: %v", err)
+	}
+	if _, err := os.Stat(out); err != nil {
+		t.Errorf("DrawPV() did not write %s: %v", out, err)
+	}
+}
+
+func TestNewPVPlotGrid(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &state.PVConfig{
+		Type: &cubic.PR{},
+		Grid: &state.GridConfig{Major: true, Minor: true, MinorTicks: 4, YTickFormat: "%.0f"},
+	}
+	p, err := state.NewPVPlot(cfg, st)
+	if err != nil {
+		t.Fatalf("NewPVPlot() unexpected error: %v", err)
+	}
+
+	ticks := p.Y.Tick.Marker.Ticks(p.Y.Min, p.Y.Max)
+	var major, minor int
+	for _, tk := range ticks {
+		if tk.IsMinor() {
+			minor++
+			continue
+		}
+		major++
+		if want := fmt.Sprintf("%.0f", tk.Value); tk.Label != want {
+			t.Errorf("tick label = %q, want %q", tk.Label, want)
+		}
+	}
+	if major < 2 || minor < 4*(major-1) {
+		t.Errorf("got %d major and %d minor ticks, want 4 minor ticks per major interval", major, minor)
+	}
+}
+
+func TestNewPVPlotIsolines(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		cfg     *state.PVConfig
+		wantErr bool
+	}{
+		{"isobar above view", &state.PVConfig{Type: &cubic.PR{}, Isobars: []float64{200}}, false},
+		{"isochore", &state.PVConfig{Type: &cubic.PR{}, Isochores: []float64{500}}, false},
+		{"negative isobar", &state.PVConfig{Type: &cubic.PR{}, Isobars: []float64{-1}}, true},
+		{"zero isochore", &state.PVConfig{Type: &cubic.PR{}, Isochores: []float64{0}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := state.NewPVPlot(tt.cfg, st)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPVPlot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			for _, P := range tt.cfg.Isobars {
+				if p.Y.Max < P {
+					t.Errorf("Y.Max = %v, want at least %v", p.Y.Max, P)
+				}
+			}
+		})
+	}
+}
+
+func TestDrawPVRegions(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &state.PVConfig{Type: &cubic.PR{}, ShadeRegions: true, LabelRegions: true}
+	out := filepath.Join(t.TempDir(), "pv.svg")
+	if err := state.DrawPV(cfg, out, st); err != nil {
+		t.Fatalf("DrawPV() unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Liquid", "Vapor", "Supercritical"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("DrawPV() output has no %q region label", want)
+		}
+	}
+}
+
+func TestDrawPVReproducible(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	cfg := &state.PVConfig{Type: &cubic.PR{}, Output: state.Output{Reproducible: true}}
+	dir := t.TempDir()
+	for _, ext := range []string{".eps", ".pdf", ".svg", ".png"} {
+		t.Run(ext, func(t *testing.T) {
+			var outputs [2][]byte
+			for i := range outputs {
+				out := filepath.Join(dir, fmt.Sprintf("pv%d%s", i, ext))
+				if err := state.DrawPV(cfg, out, st); err != nil {
+					t.Fatalf("DrawPV() unexpected error: %v", err)
+				}
+				if outputs[i], err = os.ReadFile(out); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !bytes.Equal(outputs[0], outputs[1]) {
+				t.Errorf("DrawPV() %s output differs between runs", ext)
+			}
+		})
+	}
+}
+
+func TestDrawPVCustomize(t *testing.T) {
+	st, err := state.NewState(substance.Ethane, 299, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &state.PVConfig{
+		Type: &cubic.PR{},
+		Output: state.Output{Customize: func(p *plot.Plot) {
+			labels, err := plotter.NewLabels(plotter.XYLabels{
+				XYs:    []plotter.XY{{X: p.X.Min, Y: p.Y.Min}},
+				Labels: []string{"DRAFT-WATERMARK"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.Add(labels)
+		}},
+	}
+	out := filepath.Join(t.TempDir(), "pv.svg")
+	if err := state.DrawPV(cfg, out, st); err != nil {
+		t.Fatalf("DrawPV() unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "DRAFT-WATERMARK") {
+		t.Errorf("DrawPV() output does not contain the element added by Customize")
+	}
+}
@@ -0,0 +1,17 @@
+package substance
+
+// Air is treated as a pseudo-pure substance with the constants of the equation
+// of state for air of Lemmon et al. (2000): its molar mass, acentric factor,
+// critical point and bubble-point temperature at 1 atm. The two-phase region of
+// air is narrow (the dew point at 1 atm is 81.7 K), so away from it air behaves
+// as a pure fluid for Z, density and residual properties.
+
+// AirComposition is the composition of dry air of Lemmon et al. (2000), for the
+// mixture calculations that need its components, e.g.
+//
+//	air, err := substance.NewLinearMixture("Air", substance.AirComposition)
+var AirComposition = []Component{
+	{Substance: Nitrogen, Fraction: 0.7812},
+	{Substance: Oxygen, Fraction: 0.2096},
+	{Substance: Argon, Fraction: 0.0092},
+}
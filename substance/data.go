@@ -0,0 +1,196 @@
+package substance
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// Property identifies a stored datum of a Substance.
+type Property int
+
+const (
+	PropMW       Property = iota // Molar mass
+	PropAcentric                 // Acentric factor, missing if NaN
+	PropTn                       // Normal boiling point
+	PropTc                       // Critical temperature
+	PropPc                       // Critical pressure
+	PropVc                       // Critical volume
+	PropZc                       // Critical compressibility factor
+)
+
+// String implements fmt.Stringer for Property.
+func (p Property) String() string {
+	switch p {
+	case PropMW:
+		return "MW"
+	case PropAcentric:
+		return "acentric factor"
+	case PropTn:
+		return "Tn"
+	case PropTc:
+		return "Tc"
+	case PropPc:
+		return "Pc"
+	case PropVc:
+		return "Vc"
+	case PropZc:
+		return "Zc"
+	default:
+		return fmt.Sprintf("Property(%d)", int(p))
+	}
+}
+
+// critical reports whether p is one of the critical properties.
+func (p Property) critical() bool {
+	return p >= PropTc && p <= PropZc
+}
+
+// Has reports whether the substance has a usable value of p. Values must be
+// positive, except the acentric factor, which may be zero or negative and is
+// missing only when NaN.
+func (s *Substance) Has(p Property) bool {
+	var v float64
+	switch p {
+	case PropMW:
+		v = s.MW
+	case PropAcentric:
+		return !math.IsNaN(s.Acentric)
+	case PropTn:
+		v = s.Tn
+	case PropTc:
+		v = s.Critical.Tc
+	case PropPc:
+		v = s.Critical.Pc
+	case PropVc:
+		v = s.Critical.Vc
+	case PropZc:
+		v = s.Critical.Zc
+	default:
+		return false
+	}
+	return v > 0 && !math.IsInf(v, 0)
+}
+
+// MissingDataError is returned when a correlation needs a property the substance
+// does not have. For critical properties it wraps zfactor.ErrCriticalProp.
+type MissingDataError struct {
+	Substance   string   // Name of the substance
+	Property    Property // Missing property
+	Correlation string   // Correlation that requires it
+}
+
+func (e *MissingDataError) Error() string {
+	return fmt.Sprintf("%s: %s requires %s, which is not defined", e.Substance, e.Correlation, e.Property)
+}
+
+// Unwrap returns zfactor.ErrCriticalProp for missing critical properties.
+func (e *MissingDataError) Unwrap() error {
+	if e.Property.critical() {
+		return zfactor.ErrCriticalProp
+	}
+	return nil
+}
+
+// Require returns a *MissingDataError for the first of props the substance does
+// not have, naming correlation as the consumer, or nil if all are defined. As
+// the correlations call it before they use the data, it also records the use
+// of the built-in substances for zfactor.PinDataVersion.
+func (s *Substance) Require(correlation string, props ...Property) error {
+	s.useData()
+	for _, p := range props {
+		if !s.Has(p) {
+			return &MissingDataError{Substance: s.Name, Property: p, Correlation: correlation}
+		}
+	}
+	return nil
+}
+
+// Requirement is a correlation of the package together with the properties it needs.
+type Requirement struct {
+	Correlation string
+	Properties  []Property
+}
+
+// Requirements lists the correlations that depend on the stored data of a
+// substance, in the order they are reported by Completeness.
+var Requirements = []Requirement{
+	{"Lee-Kesler", []Property{PropTc, PropPc, PropAcentric}},
+	{"Abbott virial", []Property{PropTc, PropPc, PropAcentric}},
+	{"Cubic EOS", []Property{PropTc, PropPc, PropAcentric}},
+	{"Lee-Kesler vapor pressure", []Property{PropTn, PropTc, PropPc}},
+	{"Rackett", []Property{PropTc, PropVc, PropZc}},
+	{"Tait", []Property{PropTn, PropTc, PropPc, PropAcentric}},
+	{"Lydersen", []Property{PropTc, PropPc}},
+	{"Mass basis", []Property{PropMW}},
+}
+
+// Completeness describes which data of a substance are defined and which
+// correlations can be used with them.
+type Completeness struct {
+	Substance string
+	Missing   []Property // Properties without a usable value
+	Usable    []string   // Correlations whose data are all defined
+	// Unusable maps each remaining correlation to the properties it lacks.
+	Unusable map[string][]Property
+}
+
+// Completeness reports the data available for the substance and the
+// correlations they allow.
+func (s *Substance) Completeness() *Completeness {
+	c := &Completeness{Substance: s.Name, Unusable: map[string][]Property{}}
+	for p := PropMW; p <= PropZc; p++ {
+		if !s.Has(p) {
+			c.Missing = append(c.Missing, p)
+		}
+	}
+	for _, r := range Requirements {
+		var missing []Property
+		for _, p := range r.Properties {
+			if !s.Has(p) {
+				missing = append(missing, p)
+			}
+		}
+		if len(missing) == 0 {
+			c.Usable = append(c.Usable, r.Correlation)
+		} else {
+			c.Unusable[r.Correlation] = missing
+		}
+	}
+	return c
+}
+
+// Complete reports whether every property is defined.
+func (c *Completeness) Complete() bool {
+	return len(c.Missing) == 0
+}
+
+// String implements fmt.Stringer for Completeness.
+func (c *Completeness) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:\n", c.Substance)
+	if c.Complete() {
+		b.WriteString("  all properties defined\n")
+	} else {
+		fmt.Fprintf(&b, "  missing: %s\n", joinProps(c.Missing))
+	}
+	for _, r := range Requirements {
+		if missing, ok := c.Unusable[r.Correlation]; ok {
+			fmt.Fprintf(&b, "  %-26s unavailable (no %s)\n", r.Correlation, joinProps(missing))
+		} else {
+			fmt.Fprintf(&b, "  %-26s available\n", r.Correlation)
+		}
+	}
+	return b.String()
+}
+
+// joinProps joins the names of props with commas.
+func joinProps(props []Property) string {
+	names := make([]string, len(props))
+	for i, p := range props {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
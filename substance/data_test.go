@@ -0,0 +1,90 @@
+package substance_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestHas(t *testing.T) {
+	custom := &substance.Substance{
+		Name:     "Custom",
+		MW:       50,
+		Acentric: math.NaN(),
+		Critical: substance.CriticalProps{Tc: 400, Pc: 40},
+	}
+	tests := []struct {
+		s    *substance.Substance
+		p    substance.Property
+		want bool
+	}{
+		{substance.Methane, substance.PropVc, true},
+		{custom, substance.PropTc, true},
+		{custom, substance.PropVc, false},
+		{custom, substance.PropTn, false},
+		{custom, substance.PropAcentric, false},
+		{&substance.Substance{Acentric: 0}, substance.PropAcentric, true},
+	}
+	for _, tt := range tests {
+		if got := tt.s.Has(tt.p); got != tt.want {
+			t.Errorf("%s.Has(%v) = %v, want %v", tt.s.Name, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestCompleteness(t *testing.T) {
+	if c := substance.Methane.Completeness(); !c.Complete() || len(c.Unusable) != 0 {
+		t.Errorf("Completeness() of methane = %+v, want complete", c)
+	}
+
+	custom := &substance.Substance{
+		Name:     "Custom",
+		MW:       50,
+		Critical: substance.CriticalProps{Tc: 400, Pc: 40},
+	}
+	c := custom.Completeness()
+	if got, want := c.Unusable["Rackett"], []substance.Property{substance.PropVc, substance.PropZc}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Completeness().Unusable[Rackett] = %v, want %v", got, want)
+	}
+	if _, ok := c.Unusable["Lee-Kesler"]; ok {
+		t.Error("Completeness() reports Lee-Kesler unusable, want usable")
+	}
+	if s := c.String(); !strings.Contains(s, "Rackett") || !strings.Contains(s, "no Vc, Zc") {
+		t.Errorf("Completeness().String() = %q, want Rackett unavailable", s)
+	}
+}
+
+func TestMissingDataError(t *testing.T) {
+	custom := &substance.Substance{
+		Name:     "Custom",
+		Critical: substance.CriticalProps{Tc: 400, Pc: 40},
+	}
+
+	_, err := custom.Vsat(300)
+	var missing *substance.MissingDataError
+	if !errors.As(err, &missing) {
+		t.Fatalf("Vsat() error = %v, want *MissingDataError", err)
+	}
+	if missing.Property != substance.PropVc || missing.Correlation != "Rackett" {
+		t.Errorf("Vsat() error = %+v, want missing Vc for Rackett", missing)
+	}
+	if !errors.Is(err, zfactor.ErrCriticalProp) {
+		t.Errorf("Vsat() error = %v, want wrapping %v", err, zfactor.ErrCriticalProp)
+	}
+
+	_, err = custom.LeeKeslerVaporPressure(300)
+	if !errors.As(err, &missing) || missing.Property != substance.PropTn {
+		t.Errorf("LeeKeslerVaporPressure() error = %v, want missing Tn", err)
+	}
+	if errors.Is(err, zfactor.ErrCriticalProp) {
+		t.Errorf("LeeKeslerVaporPressure() error = %v, want not critical", err)
+	}
+
+	if _, err := custom.Density(500, 1, substance.IdealGasProvider{}); !errors.As(err, &missing) || missing.Property != substance.PropMW {
+		t.Errorf("Density() error = %v, want missing MW", err)
+	}
+}
@@ -0,0 +1,104 @@
+package substance
+
+import (
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+)
+
+// EOSRecommendation is the cubic equation of state recommended for a substance,
+// with the parameters tuned to it. The Auto EOS (cubic.Auto) evaluates each
+// substance with its recommendation.
+type EOSRecommendation struct {
+	// EOS names the base equation, as accepted by cubic.ByName.
+	EOS string
+	// Kappa1 is the substance constant κ1 of the PRSV alpha function (see
+	// cubic.PRSV), which replaces the alpha function of a Peng-Robinson base if
+	// it is not 0.
+	Kappa1 float64
+	// Twu holds fitted constants of the Twu alpha function (see cubic.Twu),
+	// which take precedence over Kappa1 if set.
+	Twu *cubic.Twu
+	// VolumeShift is the ratio c/b of the Péneloux volume translation, with
+	// V = V_EOS - c. It corrects the liquid densities of the EOS without
+	// changing the vapor pressures and phase equilibria.
+	VolumeShift float64
+	// Source cites the parameters.
+	Source string
+}
+
+// Parameter sources of the built-in EOS recommendations.
+const (
+	sourcePRSV     = "Stryjek and Vera (1986)"
+	sourcePRSVJY   = "Stryjek and Vera (1986); Jhaveri and Youngren (1988)"
+	sourceJhaveriY = "Jhaveri and Youngren (1988)"
+)
+
+// eosRecommendations holds the built-in recommended equations of state keyed
+// by lowercase substance name: Peng-Robinson with the PRSV alpha function for
+// accurate vapor pressures, and for the light hydrocarbons and gases the volume
+// shifts fitted to liquid densities. Substances that are not listed get plain
+// Peng-Robinson.
+var eosRecommendations = map[string]EOSRecommendation{
+	"methane":          {EOS: "PR", Kappa1: -0.00159, VolumeShift: -0.1540, Source: sourcePRSVJY},
+	"ethane":           {EOS: "PR", Kappa1: 0.02669, VolumeShift: -0.1002, Source: sourcePRSVJY},
+	"propane":          {EOS: "PR", Kappa1: 0.03136, VolumeShift: -0.08501, Source: sourcePRSVJY},
+	"isobutane":        {EOS: "PR", VolumeShift: -0.07935, Source: sourceJhaveriY},
+	"n-butane":         {EOS: "PR", Kappa1: 0.03443, VolumeShift: -0.06413, Source: sourcePRSVJY},
+	"n-pentane":        {EOS: "PR", Kappa1: 0.03946, VolumeShift: -0.04183, Source: sourcePRSVJY},
+	"n-hexane":         {EOS: "PR", Kappa1: 0.05104, VolumeShift: -0.01478, Source: sourcePRSVJY},
+	"n-heptane":        {EOS: "PR", Kappa1: 0.04648, Source: sourcePRSV},
+	"n-octane":         {EOS: "PR", Kappa1: 0.04464, Source: sourcePRSV},
+	"benzene":          {EOS: "PR", Kappa1: 0.07019, Source: sourcePRSV},
+	"toluene":          {EOS: "PR", Kappa1: 0.03849, Source: sourcePRSV},
+	"nitrogen":         {EOS: "PR", Kappa1: 0.01996, VolumeShift: -0.1927, Source: sourcePRSVJY},
+	"carbon dioxide":   {EOS: "PR", Kappa1: 0.04285, VolumeShift: -0.0817, Source: sourcePRSVJY},
+	"hydrogen sulfide": {EOS: "PR", VolumeShift: -0.1288, Source: sourceJhaveriY},
+	"water":            {EOS: "PR", Kappa1: -0.06635, Source: sourcePRSV},
+	"methanol":         {EOS: "PR", Kappa1: -0.16816, Source: sourcePRSV},
+	"ethanol":          {EOS: "PR", Kappa1: -0.03374, Source: sourcePRSV},
+}
+
+// RecommendedEOS returns the recommended equation of state of s from
+// LookupEOSRecommendation, or plain Peng-Robinson if s is not listed.
+func (s *Substance) RecommendedEOS() EOSRecommendation {
+	if s != nil {
+		if r, ok := LookupEOSRecommendation(s.Name); ok {
+			return r
+		}
+	}
+	return EOSRecommendation{EOS: "PR"}
+}
+
+// Type returns the equation of state of r: the base equation, with the Twu or
+// PRSV alpha function if r has their constants.
+func (r EOSRecommendation) Type() (cubic.EOSType, error) {
+	base, err := cubic.ByName(r.EOS)
+	if err != nil {
+		return nil, err
+	}
+	if _, auto := base.(*cubic.Auto); auto {
+		base = &cubic.PR{}
+	}
+	switch {
+	case r.Twu != nil:
+		return &cubic.CustomAlpha{Base: base, Func: r.Twu}, nil
+	case r.Kappa1 != 0:
+		if _, pr := base.(*cubic.PR); pr {
+			return &cubic.CustomAlpha{Base: base, Func: &cubic.PRSV{Kappa1: r.Kappa1}}, nil
+		}
+	}
+	return base, nil
+}
+
+// shift returns the volume translation c (cm³/mol) of r for s, c = (c/b) Ω R Tc/Pc.
+func (r EOSRecommendation) shift(s *Substance) float64 {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if r.VolumeShift == 0 || !s.Has(PropTc) || !s.Has(PropPc) {
+		return 0
+	}
+	eos, err := r.Type()
+	if err != nil {
+		return 0
+	}
+	return r.VolumeShift * eos.Params().Omega * R * s.Critical.Tc / s.Critical.Pc
+}
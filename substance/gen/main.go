@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/rickykimani/zfactor/internal/dataversion"
 )
 
 type criticalProps struct {
@@ -18,11 +20,13 @@ type criticalProps struct {
 }
 
 type substance struct {
-	Name     string        `json:"name"`
-	MW       float64       `json:"mw"`
-	Acentric float64       `json:"acentric"`
-	Tn       float64       `json:"tn"`
-	Critical criticalProps `json:"critical"`
+	Name        string        `json:"name"`
+	MW          float64       `json:"mw"`
+	Acentric    float64       `json:"acentric"`
+	Tn          float64       `json:"tn"`
+	Dipole      float64       `json:"dipole"`
+	Associating bool          `json:"associating"`
+	Critical    criticalProps `json:"critical"`
 }
 
 func main() {
@@ -49,8 +53,15 @@ func main() {
 	fmt.Fprintln(f)
 	fmt.Fprintln(f, "package substance")
 	fmt.Fprintln(f)
+	fmt.Fprintln(f, "// DataVersion is the version of the built-in substances, from data/versions.json.")
+	version, err := dataversion.Read("../data", "substance")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(f, "const DataVersion = %d\n\n", version)
 
 	var count int
+	var ids []string
 	fmt.Println("#------------------------------------------------------#")
 
 	// Emit variables
@@ -64,6 +75,12 @@ func main() {
 		fmt.Fprintf(f, "\tMW: %.5f,\n", s.MW)
 		fmt.Fprintf(f, "\tAcentric: %.5f,\n", s.Acentric)
 		fmt.Fprintf(f, "\tTn: %.5f,\n", s.Tn)
+		if s.Dipole != 0 {
+			fmt.Fprintf(f, "\tDipole: %.5f,\n", s.Dipole)
+		}
+		if s.Associating {
+			fmt.Fprintf(f, "\tAssociating: true,\n")
+		}
 		fmt.Fprintf(f, "\tCritical: CriticalProps{\n")
 		fmt.Fprintf(f, "\t\tTc: %.5f,\n", s.Critical.Tc)
 		fmt.Fprintf(f, "\t\tPc: %.5f,\n", s.Critical.Pc)
@@ -72,9 +89,18 @@ func main() {
 		fmt.Fprintf(f, "\t},\n")
 		fmt.Fprintf(f, "}\n\n")
 
+		ids = append(ids, id)
 		count++
 	}
 
+	// Emit the built-in registry
+	fmt.Fprintln(f, "// builtins holds the generated substances, in the order of the data file.")
+	fmt.Fprintln(f, "var builtins = []*Substance{")
+	for _, id := range ids {
+		fmt.Fprintf(f, "\t%s,\n", id)
+	}
+	fmt.Fprintf(f, "}\n")
+
 	fmt.Printf("Processed %d substances\n", count)
 	fmt.Println("#------------------------------------------------------#")
 }
@@ -0,0 +1,56 @@
+package substance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rickykimani/zfactor/virial"
+)
+
+// HOCParameter holds the parameters of the Hayden-O'Connell correlation that
+// are not properties of Substance.
+type HOCParameter struct {
+	RD  float64 // Mean radius of gyration (Å)
+	Eta float64 // Association parameter η
+}
+
+// HOCParameters holds the Hayden-O'Connell parameters keyed by lowercase
+// substance name (Prausnitz et al., Computer Calculations for Multicomponent
+// Vapor-Liquid and Liquid-Liquid Equilibria, 1980). Entries may be added or
+// replaced before the calculations run.
+var HOCParameters = map[string]HOCParameter{
+	"methane":     {RD: 1.118},
+	"ethane":      {RD: 1.821},
+	"propane":     {RD: 2.431},
+	"n-butane":    {RD: 2.889},
+	"nitrogen":    {RD: 0.398},
+	"water":       {RD: 0.615, Eta: 1.7},
+	"methanol":    {RD: 1.536, Eta: 1.63},
+	"ethanol":     {RD: 2.250, Eta: 1.4},
+	"acetone":     {RD: 2.740, Eta: 0.9},
+	"acetic acid": {RD: 2.595, Eta: 4.5},
+}
+
+// HOCComponent returns the Hayden-O'Connell parameters of s, with the critical
+// constants and dipole moment of s and RD and η from HOCParameters.
+func (s *Substance) HOCComponent() (virial.HOCComponent, error) {
+	if err := s.Require("Hayden-O'Connell", PropTc, PropPc); err != nil {
+		return virial.HOCComponent{}, err
+	}
+	p, ok := HOCParameters[strings.ToLower(s.Name)]
+	if !ok {
+		return virial.HOCComponent{}, fmt.Errorf("%s: no Hayden-O'Connell parameters in HOCParameters", s.Name)
+	}
+	return virial.HOCComponent{Tc: s.Critical.Tc, Pc: s.Critical.Pc, RD: p.RD, Dipole: s.Dipole, Eta: p.Eta}, nil
+}
+
+// HaydenOConnellB returns the second virial coefficient (cm³/mol) of s at
+// temperature T (K) from the Hayden-O'Connell correlation (see
+// virial.HaydenOConnell), split into its physical and chemical contributions.
+func (s *Substance) HaydenOConnellB(T float64) (virial.HOCB, error) {
+	c, err := s.HOCComponent()
+	if err != nil {
+		return virial.HOCB{}, err
+	}
+	return virial.HaydenOConnell(T, c)
+}
@@ -0,0 +1,161 @@
+package substance
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Reference state for enthalpy and entropy: ideal gas at TRef and PRef.
+const (
+	TRef = 298.15 // K
+	PRef = 1.0    // bar
+)
+
+// Phase describes the region of the phase diagram a state falls in.
+type Phase = phase.Phase
+
+const (
+	UnknownPhase  = phase.Unknown       // Phase could not be determined
+	Liquid        = phase.Liquid        // T < Tc and P > Psat
+	Vapor         = phase.Vapor         // T < Tc and P <= Psat
+	Supercritical = phase.Supercritical // T >= Tc
+)
+
+// PhaseAt classifies the substance at temperature T (K) and pressure P (bar) as
+// liquid, vapor or supercritical.
+//
+// Below Tc the pressure is compared against the Lee-Kesler vapor pressure, which
+// requires the normal boiling point of the substance. UnknownPhase is returned
+// if the substance has no Tn.
+func (s *Substance) PhaseAt(T, P float64) Phase {
+	if T >= s.Critical.Tc {
+		return Supercritical
+	}
+	pSat, err := s.LeeKeslerVaporPressure(T)
+	if err != nil {
+		return UnknownPhase
+	}
+	if P > pSat {
+		return Liquid
+	}
+	return Vapor
+}
+
+// Correlations names the methods behind the values of a Properties.
+type Correlations struct {
+	Z        string // Compressibility factor and volume
+	Residual string // Residual enthalpy and entropy
+	Phase    string // Phase classification
+}
+
+// Properties bundles the thermodynamic properties of a substance at one state.
+type Properties struct {
+	T       float64 // Temperature (K)
+	P       float64 // Pressure (bar)
+	Z       float64 // Compressibility factor
+	V       float64 // Molar volume (cm^3/mol)
+	MW      float64 // Molar mass (g/mol)
+	Density float64 // Mass density (kg/m^3), 0 if the molar mass is unknown
+	// SpecificVolume is the volume per unit mass (m^3/kg), 0 if the molar mass is unknown.
+	SpecificVolume float64
+	HR             float64 // Residual enthalpy H^R (J/mol)
+	SR             float64 // Residual entropy S^R (J/(mol·K))
+	Phase          Phase
+	// Correlations records the methods used to compute the properties.
+	Correlations Correlations
+}
+
+// PropertiesAt evaluates the properties of the substance at temperature T (K) and
+// pressure P (bar) with the given provider. It is a single entry point for Z, V,
+// density, residual enthalpy and entropy and phase; absolute enthalpy and entropy
+// need a heat capacity, see Properties.Enthalpy and Properties.Entropy.
+func (s *Substance) PropertiesAt(T, P float64, p Provider) (*Properties, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if p == nil {
+		return nil, errors.New("provider cannot be nil")
+	}
+	z, err := p.Z(s, T, P)
+	if err != nil {
+		return nil, fmt.Errorf("%s: compressibility factor: %w", p.Name(), err)
+	}
+	hr, sr, err := p.Residual(s, T, P)
+	if err != nil {
+		return nil, fmt.Errorf("%s: residual properties: %w", p.Name(), err)
+	}
+	v, err := zfactor.MolarVolume(z, P, T, R)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	props := &Properties{
+		T:     T,
+		P:     P,
+		Z:     z,
+		V:     v,
+		MW:    s.MW,
+		HR:    hr,
+		SR:    sr,
+		Phase: s.PhaseAt(T, P),
+		Correlations: Correlations{
+			Z:        p.Name(),
+			Residual: p.Name(),
+			Phase:    "Lee-Kesler vapor pressure",
+		},
+	}
+	if s.MW > 0 {
+		props.Density = s.MW / v * 1000 // g/cm^3 -> kg/m^3
+		props.SpecificVolume = 1 / props.Density
+	}
+	return props, nil
+}
+
+// Enthalpy returns the molar enthalpy in J/mol, measured from the ideal gas at
+// TRef and PRef, using c for the ideal-gas part:
+//
+//	H = ∫Cp dT (TRef → T) + H^R(T, P)
+func (p *Properties) Enthalpy(c *cp.HeatCapacity) (float64, error) {
+	dh, err := c.IdealGasEnthalpyChange(
+		zfactor.Args{T: TRef, P: PRef, R: zfactor.RSI},
+		zfactor.Args{T: p.T, P: p.P, R: zfactor.RSI},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return dh + p.HR, nil
+}
+
+// Entropy returns the molar entropy in J/(mol·K), measured from the ideal gas at
+// TRef and PRef, using c for the ideal-gas part:
+//
+//	S = ∫Cp/T dT (TRef → T) - R ln(P/PRef) + S^R(T, P)
+func (p *Properties) Entropy(c *cp.HeatCapacity) (float64, error) {
+	ds, err := c.IdealGasEntropyChange(
+		zfactor.Args{T: TRef, P: PRef, R: zfactor.RSI},
+		zfactor.Args{T: p.T, P: p.P, R: zfactor.RSI},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return ds + p.SR, nil
+}
+
+// SpecificEnthalpy returns the enthalpy per unit mass in kJ/kg, see Enthalpy.
+func (p *Properties) SpecificEnthalpy(c *cp.HeatCapacity) (float64, error) {
+	h, err := p.Enthalpy(c)
+	if err != nil {
+		return 0, err
+	}
+	return zfactor.MassBasis.Energy(h, p.MW)
+}
+
+// SpecificEntropy returns the entropy per unit mass in kJ/(kg·K), see Entropy.
+func (p *Properties) SpecificEntropy(c *cp.HeatCapacity) (float64, error) {
+	s, err := p.Entropy(c)
+	if err != nil {
+		return 0, err
+	}
+	return zfactor.MassBasis.Entropy(s, p.MW)
+}
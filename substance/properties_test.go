@@ -0,0 +1,139 @@
+package substance_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestPropertiesAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider substance.Provider
+		T, P     float64
+		wantZ    float64
+		wantPh   substance.Phase
+	}{
+		{"ideal gas", substance.IdealGasProvider{}, 300, 10, 1, substance.Supercritical},
+		{"abbott", substance.AbbottProvider{}, 300, 10, 0.9834, substance.Supercritical},
+		{"lee-kesler", substance.LeeKeslerProvider{}, 300, 10, 0.9835, substance.Supercritical},
+		{"peng-robinson", substance.CubicProvider{EOS: &cubic.PR{}}, 300, 10, 0.9786, substance.Supercritical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := substance.Methane.PropertiesAt(tt.T, tt.P, tt.provider)
+			if err != nil {
+				t.Fatalf("PropertiesAt() error = %v", err)
+			}
+			if math.Abs(got.Z-tt.wantZ) > 1e-3 {
+				t.Errorf("PropertiesAt().Z = %v, want %v", got.Z, tt.wantZ)
+			}
+			if got.Phase != tt.wantPh {
+				t.Errorf("PropertiesAt().Phase = %v, want %v", got.Phase, tt.wantPh)
+			}
+			wantV := got.Z * zfactor.RSI * 10 * tt.T / tt.P
+			if math.Abs(got.V-wantV) > 1e-9 {
+				t.Errorf("PropertiesAt().V = %v, want %v", got.V, wantV)
+			}
+			wantRho := substance.Methane.MW / got.V * 1000
+			if math.Abs(got.Density-wantRho) > 1e-9 {
+				t.Errorf("PropertiesAt().Density = %v, want %v", got.Density, wantRho)
+			}
+			if math.Abs(got.SpecificVolume*got.Density-1) > 1e-12 {
+				t.Errorf("PropertiesAt().SpecificVolume = %v, want %v", got.SpecificVolume, 1/got.Density)
+			}
+			if got.Correlations.Z != tt.provider.Name() {
+				t.Errorf("PropertiesAt().Correlations.Z = %q, want %q", got.Correlations.Z, tt.provider.Name())
+			}
+			h, err := got.Enthalpy(cp.MethaneGas)
+			if err != nil {
+				t.Fatalf("Enthalpy() error = %v", err)
+			}
+			hig, _ := cp.MethaneGas.IdealGasEnthalpyChange(
+				zfactor.Args{T: substance.TRef, P: substance.PRef, R: zfactor.RSI},
+				zfactor.Args{T: tt.T, P: tt.P, R: zfactor.RSI},
+			)
+			if math.Abs(h-(hig+got.HR)) > 1e-9 {
+				t.Errorf("Enthalpy() = %v, want %v", h, hig+got.HR)
+			}
+			hm, err := got.SpecificEnthalpy(cp.MethaneGas)
+			if err != nil {
+				t.Fatalf("SpecificEnthalpy() error = %v", err)
+			}
+			if want := h / substance.Methane.MW; math.Abs(hm-want) > 1e-9 {
+				t.Errorf("SpecificEnthalpy() = %v, want %v", hm, want)
+			}
+		})
+	}
+}
+
+func TestPropertiesAtErrors(t *testing.T) {
+	if _, err := substance.Methane.PropertiesAt(300, 10, nil); err == nil {
+		t.Error("PropertiesAt() with nil provider, want error")
+	}
+	if _, err := substance.Methane.PropertiesAt(300, -1, substance.LeeKeslerProvider{}); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("PropertiesAt() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+	// The two-term virial equation is limited to low pressures.
+	if _, err := substance.Methane.PropertiesAt(300, 50, substance.AbbottProvider{}); err == nil {
+		t.Error("PropertiesAt() above 15 bar with Abbott, want error")
+	}
+}
+
+func TestPhaseAt(t *testing.T) {
+	tests := []struct {
+		T, P float64
+		want substance.Phase
+	}{
+		{250, 1, substance.Vapor},
+		{250, 50, substance.Liquid},
+		{400, 50, substance.Supercritical},
+	}
+	for _, tt := range tests {
+		if got := substance.Propane.PhaseAt(tt.T, tt.P); got != tt.want {
+			t.Errorf("PhaseAt(%v, %v) = %v, want %v", tt.T, tt.P, got, tt.want)
+		}
+	}
+}
+
+func TestMixtureResidual(t *testing.T) {
+	p := substance.CubicProvider{EOS: &cubic.PR{}}
+	const T, P = 300.0, 20.0
+
+	// A single component has the pure-fluid departures.
+	hr, sr, err := p.MixtureResidual([]substance.Component{{Substance: substance.Ethane, Fraction: 1}}, T, P)
+	if err != nil {
+		t.Fatalf("MixtureResidual() unexpected error: %v", err)
+	}
+	wantH, wantS, err := p.Residual(substance.Ethane, T, P)
+	if err != nil {
+		t.Fatalf("Residual() unexpected error: %v", err)
+	}
+	if math.Abs(hr-wantH) > 1e-6 || math.Abs(sr-wantS) > 1e-9 {
+		t.Errorf("MixtureResidual() = %v, %v, want %v, %v", hr, sr, wantH, wantS)
+	}
+
+	// Unlike attraction (kij > 0) makes the mixture more ideal.
+	comps := []substance.Component{{Substance: substance.Methane, Fraction: 0.5}, {Substance: substance.Ethane, Fraction: 0.5}}
+	h0, _, err := p.MixtureResidual(comps, T, P)
+	if err != nil {
+		t.Fatalf("MixtureResidual() unexpected error: %v", err)
+	}
+	p.Kij = [][]float64{{0, 0.1}, {0.1, 0}}
+	h1, _, err := p.MixtureResidual(comps, T, P)
+	if err != nil {
+		t.Fatalf("MixtureResidual() unexpected error: %v", err)
+	}
+	if !(h0 < h1 && h1 < 0) {
+		t.Errorf("MixtureResidual() H^R = %v with kij = 0.1, want between %v and 0", h1, h0)
+	}
+
+	if _, _, err := p.MixtureResidual([]substance.Component{{Fraction: 1}}, T, P); err == nil {
+		t.Error("MixtureResidual() with a nil substance expected an error")
+	}
+}
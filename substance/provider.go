@@ -0,0 +1,287 @@
+package substance
+
+import (
+	"errors"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/phase"
+)
+
+// Provider computes the compressibility factor and residual properties of a
+// substance. It is the correlation behind PropertiesAt.
+type Provider interface {
+	// Name identifies the correlation, e.g. "Lee-Kesler".
+	Name() string
+	// Z returns the compressibility factor of s at temperature T (K) and pressure P (bar).
+	Z(s *Substance, T, P float64) (float64, error)
+	// Residual returns the residual enthalpy H^R (J/mol) and residual entropy
+	// S^R (J/(mol·K)) of s at temperature T (K) and pressure P (bar).
+	Residual(s *Substance, T, P float64) (hr, sr float64, err error)
+}
+
+// IdealGasProvider treats the substance as an ideal gas (Z = 1, H^R = S^R = 0).
+type IdealGasProvider struct{}
+
+func (IdealGasProvider) Name() string { return "Ideal gas" }
+
+func (IdealGasProvider) Z(s *Substance, T, P float64) (float64, error) {
+	if err := validateTP(T, P); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func (IdealGasProvider) Residual(s *Substance, T, P float64) (float64, float64, error) {
+	if err := validateTP(T, P); err != nil {
+		return 0, 0, err
+	}
+	return 0, 0, nil
+}
+
+// AbbottProvider uses the two-term virial equation with the Abbott generalized
+// second virial coefficient. It is suited to gases at low to moderate pressures;
+// Z is that of Substance.VirialZ, and is also rejected above 15 bar.
+type AbbottProvider struct{}
+
+func (AbbottProvider) Name() string { return "Virial (Abbott)" }
+
+// check returns the error of AbbottProvider for a state it does not cover:
+// invalid T or P, P above 15 bar, or outside the range of VirialZ.
+func (AbbottProvider) check(s *Substance, T, P float64) error {
+	if err := validateTP(T, P); err != nil {
+		return err
+	}
+	if P > 15 {
+		return zfactor.ErrHighPressureTwoTerm
+	}
+	_, _, err := s.QuantumCorrected(T).virialRange(T, P)
+	return err
+}
+
+func (p AbbottProvider) Z(s *Substance, T, P float64) (float64, error) {
+	if err := p.check(s, T, P); err != nil {
+		return 0, err
+	}
+	return s.VirialZ(T, P)
+}
+
+func (p AbbottProvider) Residual(s *Substance, T, P float64) (float64, float64, error) {
+	if err := p.check(s, T, P); err != nil {
+		return 0, 0, err
+	}
+	s = s.QuantumCorrected(T)
+	args := zfactor.Args{T: T, P: P}
+	hr, err := s.AbbottResidualEnthalpy(args)
+	if err != nil {
+		return 0, 0, err
+	}
+	sr, err := s.AbbottResidualEntropy(args)
+	if err != nil {
+		return 0, 0, err
+	}
+	return hr * zfactor.RSI * s.Critical.Tc, sr * zfactor.RSI, nil
+}
+
+// LeeKeslerProvider uses the Lee-Kesler generalized correlation tables.
+//
+// Like AbbottProvider and CubicProvider, it evaluates quantum gases such as
+// hydrogen with their effective critical constants (see QuantumCorrected).
+type LeeKeslerProvider struct{}
+
+func (LeeKeslerProvider) Name() string { return "Lee-Kesler" }
+
+func (LeeKeslerProvider) Z(s *Substance, T, P float64) (float64, error) {
+	if err := validateTP(T, P); err != nil {
+		return 0, err
+	}
+	return s.QuantumCorrected(T).LeeKesler(zfactor.Args{T: T, P: P}, leekesler.CompressibilityFactor)
+}
+
+func (LeeKeslerProvider) Residual(s *Substance, T, P float64) (float64, float64, error) {
+	if err := validateTP(T, P); err != nil {
+		return 0, 0, err
+	}
+	s = s.QuantumCorrected(T)
+	args := zfactor.Args{T: T, P: P}
+	hr, err := s.LeeKesler(args, leekesler.ResidualEnthalpy)
+	if err != nil {
+		return 0, 0, err
+	}
+	sr, err := s.LeeKesler(args, leekesler.ResidualEntropy)
+	if err != nil {
+		return 0, 0, err
+	}
+	return hr * zfactor.RSI * s.Critical.Tc, sr * zfactor.RSI, nil
+}
+
+// CubicProvider uses a cubic equation of state. Where the EOS has several real
+// volume roots, the stable one, with the lowest fugacity, is used. With the Auto
+// EOS (cubic.Auto), each substance is evaluated with its recommended EOS,
+// including the volume shift of the recommendation.
+type CubicProvider struct {
+	// EOS is the equation of state. If nil, cubic.DefaultEOS is used.
+	EOS cubic.EOSType
+	// Kij is the symmetric matrix of binary interaction parameters used by
+	// MixtureResidual, indexed like its components. If nil, all kij are 0.
+	Kij [][]float64
+}
+
+func (p CubicProvider) Name() string { return cubic.Name(p.eos()) }
+
+func (p CubicProvider) Z(s *Substance, T, P float64) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	h, err := p.helmholtz(s, T, P)
+	if err != nil {
+		return 0, err
+	}
+	return h.Z() - p.shift(s, T)*P/(R*T), nil
+}
+
+func (p CubicProvider) Residual(s *Substance, T, P float64) (float64, float64, error) {
+	h, err := p.helmholtz(s, T, P)
+	if err != nil {
+		return 0, 0, err
+	}
+	// The volume translation lowers H^R by cP and leaves S^R unchanged.
+	hr := h.ResidualEnthalpy() - p.shift(s, T)*P
+	return 0.1 * hr, 0.1 * h.ResidualEntropy(), nil // bar*cm^3/mol -> J/mol
+}
+
+// shift returns the volume translation (cm³/mol) of s at temperature T, which
+// only the Auto EOS applies.
+func (p CubicProvider) shift(s *Substance, T float64) float64 {
+	if _, auto := p.eos().(*cubic.Auto); !auto {
+		return 0
+	}
+	s = s.QuantumCorrected(T)
+	return s.RecommendedEOS().shift(s)
+}
+
+// MixtureResidual returns the residual enthalpy (J/mol) and entropy (J/(mol·K))
+// of the mixture of comps at temperature T (K) and pressure P (bar) with the van
+// der Waals mixing rules (see cubic.MixtureCfg), on the root with the lowest
+// Gibbs energy. Unlike Residual on a pseudo-component, the temperature
+// derivative of the mixed a(T) includes the cross terms of the mixing rules, so
+// the enthalpy and entropy are consistent with the fugacity coefficients used in
+// phase equilibrium.
+func (p CubicProvider) MixtureResidual(comps []Component, T, P float64) (float64, float64, error) {
+	mix, Z, err := p.mixture(comps, T, P)
+	if err != nil {
+		return 0, 0, err
+	}
+	hr, err := cubic.ResidualEnthalpy(mix, Z)
+	if err != nil {
+		return 0, 0, err
+	}
+	sr, err := cubic.ResidualEntropy(mix, Z)
+	if err != nil {
+		return 0, 0, err
+	}
+	return 0.1 * hr, 0.1 * sr, nil // bar*cm^3/mol -> J/mol
+}
+
+// MixtureZ returns the compressibility factor of the mixture of comps at
+// temperature T (K) and pressure P (bar) on the root used by MixtureResidual.
+func (p CubicProvider) MixtureZ(comps []Component, T, P float64) (float64, error) {
+	_, Z, err := p.mixture(comps, T, P)
+	return Z, err
+}
+
+// mixture returns the mixture configuration of comps and its stable root.
+func (p CubicProvider) mixture(comps []Component, T, P float64) (*cubic.MixtureCfg, float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if err := validateTP(T, P); err != nil {
+		return nil, 0, err
+	}
+	y := make([]float64, len(comps))
+	cc := make([]cubic.Component, len(comps))
+	for i, c := range comps {
+		if c.Substance == nil {
+			return nil, 0, errors.New("component substance cannot be nil")
+		}
+		if err := c.Substance.Require(p.Name(), PropTc, PropPc); err != nil {
+			return nil, 0, err
+		}
+		y[i], cc[i] = c.Fraction, c.Substance.QuantumCorrected(T).CubicComponent()
+	}
+	mix := cubic.NewMixtureCfg(p.eos(), T, P, y, cc, R)
+	mix.Kij = p.Kij
+	Z, _, err := cubic.PhaseLogPhi(mix, phase.Unknown)
+	if err != nil {
+		return nil, 0, err
+	}
+	return mix, Z, nil
+}
+
+// eos returns the equation of state of the provider.
+func (p CubicProvider) eos() cubic.EOSType {
+	if p.EOS == nil {
+		eos, _ := cubic.DefaultEOS()
+		return eos
+	}
+	return p.EOS
+}
+
+// helmholtz evaluates the residual Helmholtz energy of s at the stable root.
+func (p CubicProvider) helmholtz(s *Substance, T, P float64) (*cubic.Helmholtz, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if err := validateTP(T, P); err != nil {
+		return nil, err
+	}
+	if err := s.Require(p.Name(), PropTc, PropPc); err != nil {
+		return nil, err
+	}
+	s = s.QuantumCorrected(T)
+	cfg := s.CubicConfig(p.eos(), zfactor.Args{T: T, P: P, R: R})
+	volRes, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		return nil, err
+	}
+	v, err := volRes.Stable(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return cubic.ResidualHelmholtz(cfg, v)
+}
+
+// MolarVolume returns the molar volume of the substance in cm³/mol at temperature
+// T (K) and pressure P (bar), V = ZRT/P with Z from the provider.
+func (s *Substance) MolarVolume(T, P float64, p Provider) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	z, err := p.Z(s, T, P)
+	if err != nil {
+		return 0, err
+	}
+	return zfactor.MolarVolume(z, P, T, R)
+}
+
+// Density returns the mass density of the substance in kg/m³ at temperature T (K)
+// and pressure P (bar), with Z from the provider.
+func (s *Substance) Density(T, P float64, p Provider) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if err := s.Require("Mass basis", PropMW); err != nil {
+		return 0, err
+	}
+	z, err := p.Z(s, T, P)
+	if err != nil {
+		return 0, err
+	}
+	rho, err := zfactor.Density(z, P, T, R, s.MW)
+	if err != nil {
+		return 0, err
+	}
+	return rho * 1000, nil // g/cm^3 -> kg/m^3
+}
+
+// validateTP checks that temperature and pressure are positive.
+func validateTP(T, P float64) error {
+	if T <= 0 {
+		return zfactor.ErrTemp
+	}
+	if P <= 0 {
+		return zfactor.ErrPressure
+	}
+	return nil
+}
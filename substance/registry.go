@@ -0,0 +1,202 @@
+package substance
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/tsonopoulos"
+)
+
+func init() {
+	zfactor.RegisterDataset(zfactor.DatasetSubstance, DataVersion)
+}
+
+// useData records that a result is computed from s, if it is a built-in
+// substance or a copy of one made by QuantumCorrected (see zfactor.UseDataset).
+func (s *Substance) useData() {
+	if !zfactor.DataPinned() {
+		return
+	}
+	for s.correctedFrom != nil {
+		s = s.correctedFrom
+	}
+	if slices.Contains(builtins, s) {
+		zfactor.UseDataset(zfactor.DatasetSubstance)
+	}
+}
+
+// registry holds the substances, interaction parameters, EOS recommendations
+// and Tsonopoulos classes added at run time, keyed by lowercase name, over the
+// built-in tables.
+var registry = struct {
+	sync.RWMutex
+	subs    map[string]*Substance
+	order   []string // Names of subs not in the built-in table, in registration order
+	kij     map[kijKey]float64
+	eos     map[string]EOSRecommendation
+	classes map[string]tsonopoulos.Class
+}{subs: map[string]*Substance{}, kij: map[kijKey]float64{}, eos: map[string]EOSRecommendation{}, classes: map[string]tsonopoulos.Class{}}
+
+// kijKey identifies a binary interaction parameter by EOS and ordered pair of
+// lowercase substance names.
+type kijKey struct{ eos, a, b string }
+
+func newKijKey(eos, a, b string) kijKey {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if b < a {
+		a, b = b, a
+	}
+	return kijKey{eos: strings.ToUpper(eos), a: a, b: b}
+}
+
+// builtin returns the built-in substance named name, compared case-insensitively.
+func builtin(name string) (*Substance, bool) {
+	for _, s := range builtins {
+		if strings.EqualFold(s.Name, name) {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Lookup returns the substance named name, compared case-insensitively. A
+// substance added with Register takes precedence over the built-in substance
+// of the same name.
+func Lookup(name string) (*Substance, bool) {
+	registry.RLock()
+	s, ok := registry.subs[strings.ToLower(name)]
+	registry.RUnlock()
+	if ok {
+		return s, true
+	}
+	return builtin(name)
+}
+
+// Register adds s to the substances found by Lookup and Substances, replacing
+// a registered or built-in substance of the same name. The package variables
+// of the built-in substances, such as Methane, are not changed.
+func Register(s *Substance) error {
+	if s == nil || strings.TrimSpace(s.Name) == "" {
+		return errors.New("substance must have a name")
+	}
+	key := strings.ToLower(s.Name)
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.subs[key]; !ok {
+		if _, isBuiltin := builtin(s.Name); !isBuiltin {
+			registry.order = append(registry.order, key)
+		}
+	}
+	registry.subs[key] = s
+	return nil
+}
+
+// Substances returns the built-in substances, with those replaced by Register
+// in their place, followed by the registered substances in the order they were
+// added.
+func Substances() []*Substance {
+	registry.RLock()
+	defer registry.RUnlock()
+	out := make([]*Substance, 0, len(builtins)+len(registry.order))
+	for _, s := range builtins {
+		if r, ok := registry.subs[strings.ToLower(s.Name)]; ok {
+			s = r
+		}
+		out = append(out, s)
+	}
+	for _, key := range registry.order {
+		out = append(out, registry.subs[key])
+	}
+	return out
+}
+
+// RegisterKij sets the binary interaction parameter of the substances named a
+// and b for the cubic EOS named eos (as returned by cubic.Name), e.g. a value
+// fitted to VLE data. Names are compared case-insensitively and kij = kji.
+func RegisterKij(eos, a, b string, kij float64) {
+	registry.Lock()
+	registry.kij[newKijKey(eos, a, b)] = kij
+	registry.Unlock()
+}
+
+// LookupKij returns the binary interaction parameter set by RegisterKij for
+// the substances named a and b and the EOS named eos.
+func LookupKij(eos, a, b string) (float64, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	k, ok := registry.kij[newKijKey(eos, a, b)]
+	return k, ok
+}
+
+// KijMatrix returns the symmetric matrix of the registered binary interaction
+// parameters of subs for eos, for cubic.MixtureCfg.Kij, with 0 for the pairs
+// that have none.
+func KijMatrix(eos cubic.EOSType, subs []*Substance) [][]float64 {
+	name := cubic.Name(eos)
+	m := make([][]float64, len(subs))
+	for i := range m {
+		m[i] = make([]float64, len(subs))
+	}
+	for i := range subs {
+		for j := range i {
+			k, _ := LookupKij(name, subs[i].Name, subs[j].Name)
+			m[i][j], m[j][i] = k, k
+		}
+	}
+	return m
+}
+
+// LookupEOSRecommendation returns the recommended equation of state of the
+// substance named name, compared case-insensitively. A recommendation added
+// with RegisterEOSRecommendation takes precedence over the built-in one.
+func LookupEOSRecommendation(name string) (EOSRecommendation, bool) {
+	key := strings.ToLower(name)
+	registry.RLock()
+	r, ok := registry.eos[key]
+	registry.RUnlock()
+	if ok {
+		return r, true
+	}
+	r, ok = eosRecommendations[key]
+	return r, ok
+}
+
+// RegisterEOSRecommendation sets the recommended equation of state of the
+// substance named name, used by RecommendedEOS and the Auto EOS, e.g. with
+// alpha constants fitted to vapor pressures. It replaces a registered or
+// built-in recommendation of the same name.
+func RegisterEOSRecommendation(name string, r EOSRecommendation) {
+	registry.Lock()
+	registry.eos[strings.ToLower(name)] = r
+	registry.Unlock()
+}
+
+// LookupTsonopoulosClass returns the compound class of the polar term of the
+// Tsonopoulos correlation of the substance named name, compared
+// case-insensitively. A class added with RegisterTsonopoulosClass takes
+// precedence over the built-in one.
+func LookupTsonopoulosClass(name string) (tsonopoulos.Class, bool) {
+	key := strings.ToLower(name)
+	registry.RLock()
+	c, ok := registry.classes[key]
+	registry.RUnlock()
+	if ok {
+		return c, true
+	}
+	c, ok = tsonopoulosClasses[key]
+	return c, ok
+}
+
+// RegisterTsonopoulosClass sets the compound class of the polar term of the
+// Tsonopoulos correlation of the substance named name, used by
+// TsonopoulosParams. It replaces a registered or built-in class of the same
+// name.
+func RegisterTsonopoulosClass(name string, c tsonopoulos.Class) {
+	registry.Lock()
+	registry.classes[strings.ToLower(name)] = c
+	registry.Unlock()
+}
@@ -0,0 +1,135 @@
+package substance_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/tsonopoulos"
+)
+
+func TestRegistry(t *testing.T) {
+	if s, ok := substance.Lookup("n-BUTANE"); !ok || s != substance.NButane {
+		t.Errorf("Lookup(n-BUTANE) = %v, %v, want NButane", s, ok)
+	}
+	if _, ok := substance.Lookup("unobtainium"); ok {
+		t.Error("Lookup() of an unknown substance = true, want false")
+	}
+
+	custom := &substance.Substance{Name: "Test solvent", MW: 100, Acentric: 0.3,
+		Critical: substance.CriticalProps{Tc: 550, Pc: 35}}
+	override := *substance.Biphenyl
+	override.Acentric = 0.4
+	for _, s := range []*substance.Substance{custom, &override} {
+		if err := substance.Register(s); err != nil {
+			t.Fatalf("Register(%s) unexpected error: %v", s.Name, err)
+		}
+	}
+	if s, _ := substance.Lookup("test SOLVENT"); s != custom {
+		t.Errorf("Lookup() of a registered substance = %v, want %v", s, custom)
+	}
+	if s, _ := substance.Lookup("Biphenyl"); s != &override {
+		t.Errorf("Lookup() of a replaced substance = %v, want the registered one", s)
+	}
+	all := substance.Substances()
+	if all[len(all)-1] != custom {
+		t.Errorf("Substances() ends with %v, want the registered substance", all[len(all)-1].Name)
+	}
+	for _, s := range all {
+		if s == substance.Biphenyl {
+			t.Error("Substances() holds the replaced built-in Biphenyl")
+		}
+	}
+	if err := substance.Register(&substance.Substance{}); err == nil {
+		t.Error("Register() without a name expected error, got nil")
+	}
+
+	substance.RegisterKij("PR", "Methane", "Ethane", 0.003)
+	if k, ok := substance.LookupKij("pr", "ethane", "methane"); !ok || k != 0.003 {
+		t.Errorf("LookupKij() = %v, %v, want 0.003", k, ok)
+	}
+	m := substance.KijMatrix(&cubic.PR{}, []*substance.Substance{substance.Methane, substance.Propane, substance.Ethane})
+	if m[0][2] != 0.003 || m[2][0] != 0.003 || m[0][1] != 0 {
+		t.Errorf("KijMatrix() = %v, want k13 = k31 = 0.003 and 0 elsewhere", m)
+	}
+}
+
+func TestBuiltinDataVersion(t *testing.T) {
+	var got []error
+	prev := zfactor.SetWarningHandler(func(err error) { got = append(got, err) })
+	t.Cleanup(func() {
+		zfactor.UnpinDataVersion(zfactor.DatasetSubstance)
+		zfactor.SetWarningHandler(prev)
+	})
+	if err := zfactor.PinDataVersion(zfactor.DatasetSubstance, substance.DataVersion+1); err != nil {
+		t.Fatalf("zfactor.PinDataVersion() unexpected error: %v", err)
+	}
+
+	// Substances that are not built in do not use the dataset.
+	custom := *substance.Methane
+	if _, err := custom.TsonopoulosB(300); err != nil {
+		t.Fatalf("TsonopoulosB() unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("custom substance warned: %v", got)
+	}
+
+	if _, err := substance.Methane.TsonopoulosB(300); err != nil {
+		t.Fatalf("TsonopoulosB() unexpected error: %v", err)
+	}
+	var w *zfactor.DataVersionWarning
+	if len(got) != 1 || !errors.As(got[0], &w) || w.Dataset != zfactor.DatasetSubstance || w.Newer() {
+		t.Errorf("Methane warnings = %v, want one older *DataVersionWarning of %s", got, zfactor.DatasetSubstance)
+	}
+
+	// The copies made by QuantumCorrected use the dataset of the original.
+	got = nil
+	if err := zfactor.PinDataVersion(zfactor.DatasetSubstance, substance.DataVersion+1); err != nil {
+		t.Fatalf("zfactor.PinDataVersion() unexpected error: %v", err)
+	}
+	h := substance.Hydrogen.QuantumCorrected(30)
+	if h == substance.Hydrogen {
+		t.Fatal("QuantumCorrected() returned the original")
+	}
+	if err := h.Require("test", substance.PropTc); err != nil {
+		t.Fatalf("Require() unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("QuantumCorrected(Hydrogen) warnings = %v, want one *DataVersionWarning", got)
+	}
+}
+
+func TestRegisterEOSRecommendation(t *testing.T) {
+	if r, ok := substance.LookupEOSRecommendation("METHANE"); !ok || r.Kappa1 == 0 {
+		t.Errorf("LookupEOSRecommendation(METHANE) = %+v, %v, want the built-in PRSV recommendation", r, ok)
+	}
+	if _, ok := substance.LookupEOSRecommendation("unobtainium"); ok {
+		t.Error("LookupEOSRecommendation() of an unknown substance = true, want false")
+	}
+
+	substance.RegisterEOSRecommendation("Test Solvent EOS", substance.EOSRecommendation{EOS: "SRK"})
+	custom := &substance.Substance{Name: "test solvent eos"}
+	if r := custom.RecommendedEOS(); r.EOS != "SRK" {
+		t.Errorf("RecommendedEOS() = %+v, want the registered SRK recommendation", r)
+	}
+}
+
+func TestRegisterTsonopoulosClass(t *testing.T) {
+	if c, ok := substance.LookupTsonopoulosClass("WATER"); !ok || c != tsonopoulos.Water {
+		t.Errorf("LookupTsonopoulosClass(WATER) = %v, %v, want %v, true", c, ok, tsonopoulos.Water)
+	}
+	if _, ok := substance.LookupTsonopoulosClass("acetone"); ok {
+		t.Error("LookupTsonopoulosClass() of a substance of the default class = true, want false")
+	}
+
+	custom := &substance.Substance{Name: "Test Alcohol", Critical: substance.CriticalProps{Tc: 500, Pc: 50}, Acentric: 0.6, Dipole: 1.7, Associating: true}
+	if _, _, err := custom.TsonopoulosParams(); err == nil {
+		t.Fatal("TsonopoulosParams() of an unknown associating substance expected error, got nil")
+	}
+	substance.RegisterTsonopoulosClass("test alcohol", tsonopoulos.Alcohol)
+	if _, _, err := custom.TsonopoulosParams(); err != nil {
+		t.Errorf("TsonopoulosParams() of a registered alcohol unexpected error: %v", err)
+	}
+}
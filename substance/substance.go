@@ -3,6 +3,7 @@
 package substance
 
 import (
+	"errors"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/rickykimani/zfactor/cubic"
 	leekesler "github.com/rickykimani/zfactor/lee-kesler"
 	"github.com/rickykimani/zfactor/liquids"
+	"github.com/rickykimani/zfactor/virial"
 )
 
 type CriticalProps struct {
@@ -25,21 +27,63 @@ type Substance struct {
 	MW       float64 //Molar mass
 	Acentric float64 //Acentric factor
 	Tn       float64 //Normal boiling point (K)
-	Critical CriticalProps
+	Dipole   float64 //Dipole moment (debye), 0 if nonpolar or unknown
+	// Associating reports whether the molecules hydrogen-bond with each other, as
+	// in water, ammonia, alcohols, amines and carboxylic acids.
+	Associating bool
+	Critical    CriticalProps
+	// correctedFrom is the substance QuantumCorrected copied s from, if any.
+	correctedFrom *Substance
 }
 
-// LeeKesler evaluates a thermodynamic property using the Lee-Kesler correlation.
+// polarDipole is the reduced dipole moment below which a substance is treated
+// as nonpolar, the limit of the polarity correction of the Lucas method.
+const polarDipole = 0.022
+
+// ReducedDipole returns the reduced dipole moment
+//
+//	μr = 52.46 μ² Pc / Tc²
+//
+// with μ in debye, Pc in bar and Tc in K, as used by the Lucas and Chung
+// correlations. It is 0 if Tc is not set.
+func (s *Substance) ReducedDipole() float64 {
+	if !s.Has(PropTc) {
+		return 0
+	}
+	return 52.46 * s.Dipole * s.Dipole * s.Critical.Pc / (s.Critical.Tc * s.Critical.Tc)
+}
+
+// Polar reports whether the substance is polar or associating, so that
+// correlations developed for nonpolar fluids (e.g. Pitzer-type generalized
+// correlations) do not apply. A substance is polar if it associates or its
+// reduced dipole moment is at least 0.022.
+func (s *Substance) Polar() bool {
+	return s.Associating || s.ReducedDipole() >= polarDipole
+}
+
+// LeeKesler evaluates a thermodynamic property using the Lee-Kesler correlation,
+// from the tables, interpolated as set by zfactor.Defaults().Interpolation, or,
+// if zfactor.Defaults().LeeKesler is zfactor.LKAnalytic, from the modified
+// Benedict-Webb-Rubin equations (see leekesler.Analytic).
 //
 // Required Args:
 //   - T: Temperature in Kelvin
 //   - P: Pressure in bar
 func (s *Substance) LeeKesler(args zfactor.Args, property leekesler.Property) (float64, error) {
-	pr := args.P / s.Critical.Pc
-	tr := args.T / s.Critical.Tc
-
-	c := leekesler.Correlation(property)
+	tr, pr, err := s.reduced("Lee-Kesler", args.T, args.P)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Lee-Kesler", PropAcentric); err != nil {
+		return 0, err
+	}
 
-	m0, m1, err := c.At(tr, pr)
+	var m0, m1 float64
+	if zfactor.Defaults().LeeKesler == zfactor.LKAnalytic {
+		m0, m1, err = leekesler.Analytic(property, tr, pr)
+	} else {
+		m0, m1, err = leekesler.Correlation(property).At(tr, pr)
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -57,13 +101,54 @@ func (s *Substance) LeeKesler(args zfactor.Args, property leekesler.Property) (f
 	return m, nil
 }
 
+// Reduced returns the reduced temperature Tr = T/Tc and reduced pressure Pr = P/Pc
+// at temperature T (K) and pressure P (bar).
+//
+// It returns zfactor.ErrTemp if T is non-positive, zfactor.ErrPressure if P is
+// negative and a *MissingDataError, which wraps zfactor.ErrCriticalProp, if Tc or
+// Pc is missing, rather than letting the division produce Inf or NaN.
+func (s *Substance) Reduced(T, P float64) (Tr, Pr float64, err error) {
+	return s.reduced("Reduced properties", T, P)
+}
+
+// reduced is Reduced with correlation named in a MissingDataError.
+func (s *Substance) reduced(correlation string, T, P float64) (float64, float64, error) {
+	tr, err := s.reducedT(correlation, T)
+	if err != nil {
+		return 0, 0, err
+	}
+	if P < 0 {
+		return 0, 0, zfactor.ErrPressure
+	}
+	if err := s.Require(correlation, PropPc); err != nil {
+		return 0, 0, err
+	}
+	return tr, P / s.Critical.Pc, nil
+}
+
+// reducedT returns the reduced temperature T/Tc, see Reduced.
+func (s *Substance) reducedT(correlation string, T float64) (float64, error) {
+	if T <= 0 {
+		return 0, zfactor.ErrTemp
+	}
+	if err := s.Require(correlation, PropTc); err != nil {
+		return 0, err
+	}
+	return T / s.Critical.Tc, nil
+}
+
 // CubicConfig creates a configuration for a cubic equation of state (EOS) solver.
 // It initializes the EOS parameters based on the substance's critical properties and acentric factor.
 //
-// Supported standard types (VdW, RK, SRK, PR) are initialized with their specific constructors.
+// Supported standard types (VdW, RK, SRK, PR, RKPR, PatelTeja) are initialized with their specific constructors.
 // Custom implementations of cubic.EOSType are handled by the default case, which populates
 // the configuration with the substance's properties.
 //
+// If Type is nil, the default EOS of zfactor.Defaults is used; if it does not name
+// a built-in EOS, the configuration has no type and the solvers return an error.
+// A *cubic.Auto type is replaced by the recommended EOS of s (RecommendedEOS).
+// If args.R is 0, the default gas constant is used.
+//
 // Required Args:
 //   - T: Temperature
 //   - P: Pressure
@@ -71,6 +156,17 @@ func (s *Substance) LeeKesler(args zfactor.Args, property leekesler.Property) (f
 func (s *Substance) CubicConfig(Type cubic.EOSType, args zfactor.Args) *cubic.EOSCfg {
 	tc := s.Critical.Tc
 	pc := s.Critical.Pc
+	if Type == nil {
+		Type, _ = cubic.DefaultEOS()
+	}
+	if _, auto := Type.(*cubic.Auto); auto {
+		if t, err := s.RecommendedEOS().Type(); err == nil {
+			Type = t
+		}
+	}
+	if args.R == 0 {
+		args.R = zfactor.Defaults().R
+	}
 	switch Type.(type) {
 	case *cubic.VdW:
 		return cubic.NewvdWCfg(args.T, args.P, tc, pc, args.R)
@@ -80,6 +176,10 @@ func (s *Substance) CubicConfig(Type cubic.EOSType, args zfactor.Args) *cubic.EO
 		return cubic.NewSRKCfg(args.T, args.P, tc, pc, s.Acentric, args.R)
 	case *cubic.PR:
 		return cubic.NewPRCfg(args.T, args.P, tc, pc, s.Acentric, args.R)
+	case *cubic.RKPR:
+		return cubic.NewRKPRCfg(args.T, args.P, tc, pc, s.Critical.Zc, s.Acentric, args.R)
+	case *cubic.PatelTeja:
+		return cubic.NewPTCfg(args.T, args.P, tc, pc, s.Critical.Zc, s.Acentric, args.R)
 	default:
 		return &cubic.EOSCfg{
 			Type:     Type,
@@ -88,23 +188,73 @@ func (s *Substance) CubicConfig(Type cubic.EOSType, args zfactor.Args) *cubic.EO
 			Tc:       tc,
 			Pc:       pc,
 			Acentric: s.Acentric,
+			Zc:       s.Critical.Zc,
 			R:        args.R,
 		}
 	}
 }
 
+// CubicComponent returns the critical properties and acentric factor of s as a
+// component of a cubic.MixtureCfg, e.g. for cubic.WilsonK.
+func (s *Substance) CubicComponent() cubic.Component {
+	return cubic.Component{Tc: s.Critical.Tc, Pc: s.Critical.Pc, Acentric: s.Acentric}
+}
+
+// VirialComponent returns the critical properties and acentric factor of s as a
+// component of a gas mixture for virial.CrossCoefficients.
+func (s *Substance) VirialComponent() virial.Component {
+	c := s.Critical
+	return virial.Component{Tc: c.Tc, Pc: c.Pc, Vc: c.Vc, Zc: c.Zc, Acentric: s.Acentric}
+}
+
 // Vsat calculates the saturated liquid molar volume at the given temperature using the Rackett equation.
 // Temperature must be in Kelvin.
 func (s *Substance) Vsat(T float64) (float64, error) {
-	if T <= 0 {
-		return 0, zfactor.ErrTemp
+	tr, err := s.reducedT("Rackett", T)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Rackett", PropVc, PropZc); err != nil {
+		return 0, err
 	}
-
-	tr := T / s.Critical.Tc
 
 	return liquids.Vsat(s.Critical.Vc, s.Critical.Zc, tr)
 }
 
+// LiquidExpansion estimates the volume expansivity β = (1/V)(dV/dT) of the liquid
+// at temperature T (K) from the Rackett equation, in 1/K. See liquids.RackettExpansion.
+func (s *Substance) LiquidExpansion(T float64) (float64, error) {
+	tr, err := s.reducedT("Rackett", T)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Rackett", PropZc); err != nil {
+		return 0, err
+	}
+
+	return liquids.RackettExpansion(s.Critical.Zc, tr, s.Critical.Tc)
+}
+
+// LiquidCompressibility estimates the isothermal compressibility κ = -(1/V)(dV/dP)
+// of the liquid at temperature T (K) and pressure P (bar) from the Tait equation,
+// in 1/bar. The vapor pressure is taken from the Lee-Kesler correlation, and P
+// must not be below it. See liquids.TaitCompressibility.
+func (s *Substance) LiquidCompressibility(T, P float64) (float64, error) {
+	tr, _, err := s.reduced("Tait", T, P)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Tait", PropTn, PropAcentric); err != nil {
+		return 0, err
+	}
+	pSat, err := s.LeeKeslerVaporPressure(T)
+	if err != nil {
+		return 0, err
+	}
+
+	return liquids.TaitCompressibility(tr, s.Critical.Pc, s.Acentric, P, pSat)
+}
+
 // ReducedDensity calculates the reduced density (rho_r) of the substance at the given
 // temperature (K) and pressure (bar) using the Lydersen chart correlation.
 //
@@ -115,19 +265,57 @@ func (s *Substance) Vsat(T float64) (float64, error) {
 //   - T: Temperature in Kelvin
 //   - P: Pressure in bar
 func (s *Substance) ReducedDensity(args zfactor.Args) (float64, error) {
-	if args.T <= 0 {
-		return 0, zfactor.ErrTemp
-	}
-	if args.P < 0 {
-		return 0, zfactor.ErrPressure
+	tr, pr, err := s.reduced("Lydersen", args.T, args.P)
+	if err != nil {
+		return 0, err
 	}
 
-	tr := args.T / s.Critical.Tc
-	pr := args.P / s.Critical.Pc
-
 	return liquids.ReducedDensity(tr, pr)
 }
 
+// LiquidReference is a known state of the liquid, used by LiquidDensity.
+type LiquidReference struct {
+	T       float64 // Temperature (K)
+	P       float64 // Pressure (bar)
+	Density float64 // Measured density (kg/m^3)
+}
+
+// LiquidDensity estimates the density of the liquid in kg/m³ at temperature T (K)
+// and pressure P (bar) from the Lydersen chart, scaled from a reference state:
+//
+//	ρ2 = ρ1 · ρr2/ρr1
+//
+// where ρr1 and ρr2 are the reduced densities of the reference and of the
+// requested state. If ref is nil, the critical point is the reference (ρr1 = 1,
+// ρ1 = MW/Vc), which requires MW and Vc; a measured reference, usually the
+// saturated liquid at a nearby temperature, is more accurate. A reference below
+// the saturation curve of the chart is read from the curve itself.
+func (s *Substance) LiquidDensity(T, P float64, ref *LiquidReference) (float64, error) {
+	rhoR2, err := s.ReducedDensity(zfactor.Args{T: T, P: P})
+	if err != nil {
+		return 0, err
+	}
+	if ref == nil {
+		if err := s.Require("Lydersen", PropMW, PropVc); err != nil {
+			return 0, err
+		}
+		return rhoR2 * s.MW / s.Critical.Vc * 1000, nil // g/cm^3 -> kg/m^3
+	}
+	if ref.Density <= 0 {
+		return 0, errors.New("reference density must be positive")
+	}
+	rhoR1, err := s.ReducedDensity(zfactor.Args{T: ref.T, P: ref.P})
+	if errors.Is(err, liquids.ErrBelowSaturation) {
+		// A saturated reference at its measured vapor pressure may fall just
+		// below the generalized saturation curve of the chart.
+		rhoR1, err = liquids.SaturatedReducedDensity(ref.T / s.Critical.Tc)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reference state: %w", err)
+	}
+	return ref.Density * rhoR2 / rhoR1, nil
+}
+
 // AbbottResidualEnthalpy calculates the dimensionless residual enthalpy H^R / (R * Tc)
 // at the given temperature (K) and pressure (bar) using the Abbott (Virial) correlations.
 //
@@ -143,8 +331,13 @@ func (s *Substance) AbbottResidualEnthalpy(args zfactor.Args) (float64, error) {
 	if args.P <= 0 {
 		return 0, zfactor.ErrPressure
 	}
-	Tr := args.T / s.Critical.Tc
-	Pr := args.P / s.Critical.Pc
+	Tr, Pr, err := s.reduced("Abbott virial", args.T, args.P)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Abbott virial", PropAcentric); err != nil {
+		return 0, err
+	}
 
 	return abbott.ResidualEnthalpy(Tr, Pr, s.Acentric)
 }
@@ -164,17 +357,69 @@ func (s *Substance) AbbottResidualEntropy(args zfactor.Args) (float64, error) {
 	if args.P <= 0 {
 		return 0, zfactor.ErrPressure
 	}
-	Tr := args.T / s.Critical.Tc
-	Pr := args.P / s.Critical.Pc
+	Tr, Pr, err := s.reduced("Abbott virial", args.T, args.P)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Abbott virial", PropAcentric); err != nil {
+		return 0, err
+	}
 
 	return abbott.ResidualEntropy(Tr, Pr, s.Acentric)
 }
 
+// VirialZ returns the compressibility factor at temperature T (K) and pressure
+// P (bar) from the two-term virial equation with the generalized second virial
+// coefficient of Pitzer and Abbott,
+//
+//	Z = 1 + (B0 + ω B1) Pr/Tr
+//
+// The correlation holds for gases at reduced volumes Vr ≥ 2, approximately
+// where Tr > 0.686 + 0.439 Pr (Smith, Van Ness and Abbott); outside it VirialZ
+// returns an error wrapping zfactor.ErrVirialRange. Quantum gases are evaluated
+// with their effective critical constants (see QuantumCorrected).
+func (s *Substance) VirialZ(T, P float64) (float64, error) {
+	if err := validateTP(T, P); err != nil {
+		return 0, err
+	}
+	s = s.QuantumCorrected(T)
+	Tr, Pr, err := s.virialRange(T, P)
+	if err != nil {
+		return 0, err
+	}
+	b0, err := abbott.B0(Tr)
+	if err != nil {
+		return 0, err
+	}
+	b1, err := abbott.B1(Tr)
+	if err != nil {
+		return 0, err
+	}
+	return 1 + (b0+s.Acentric*b1)*Pr/Tr, nil
+}
+
+// virialRange returns the reduced temperature and pressure of s at T (K) and P
+// (bar), or an error wrapping zfactor.ErrVirialRange outside the range of the
+// two-term virial equation (see VirialZ).
+func (s *Substance) virialRange(T, P float64) (Tr, Pr float64, err error) {
+	Tr, Pr, err = s.reduced("Abbott virial", T, P)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := s.Require("Abbott virial", PropAcentric); err != nil {
+		return 0, 0, err
+	}
+	if Tr <= 0.686+0.439*Pr {
+		return 0, 0, fmt.Errorf("%w: Tr = %.4g, Pr = %.4g; Tr must exceed 0.686 + 0.439 Pr = %.4g", zfactor.ErrVirialRange, Tr, Pr, 0.686+0.439*Pr)
+	}
+	return Tr, Pr, nil
+}
+
 // LeeKeslerAcentric estimates the acentric factor using the Lee-Kesler correlation.
 // Use this if the substance has no defined acentric factor but has a known Normal Boiling Point (Tn).
 func (s *Substance) LeeKeslerAcentric() (float64, error) {
-	if s.Tn == 0 {
-		return 0, fmt.Errorf("%s has no defined normal boiling point", s.Name)
+	if err := s.Require("Lee-Kesler vapor pressure", PropTn, PropTc, PropPc); err != nil {
+		return 0, err
 	}
 	return leekesler.EstimateAcentricFactor(s.Tn, s.Critical.Tc, s.Critical.Pc)
 }
@@ -182,8 +427,38 @@ func (s *Substance) LeeKeslerAcentric() (float64, error) {
 // LeeKeslerVaporPressure estimates the saturation vapor pressure (Psat) in bar at temperature T (K).
 // It uses the Lee-Kesler correlation which internally estimates the acentric factor based on Tn.
 func (s *Substance) LeeKeslerVaporPressure(T float64) (float64, error) {
-	if s.Tn == 0 {
-		return 0, fmt.Errorf("%s has no defined normal boiling point", s.Name)
+	if err := s.Require("Lee-Kesler vapor pressure", PropTn, PropTc, PropPc); err != nil {
+		return 0, err
 	}
 	return leekesler.VaporPressure(T, s.Tn, s.Critical.Tc, s.Critical.Pc)
 }
+
+// FugacityCoefficient returns the fugacity coefficient φ of the substance at
+// temperature T (K) and pressure P (bar) from the cubic EOS eos, on the volume
+// root of phase p (see cubic.FugacityCoefficient). UnknownPhase selects the
+// stable root. If eos is nil, the default EOS is used.
+func (s *Substance) FugacityCoefficient(eos cubic.EOSType, T, P float64, p Phase) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if err := validateTP(T, P); err != nil {
+		return 0, err
+	}
+	if err := s.Require("Cubic EOS", PropTc, PropPc); err != nil {
+		return 0, err
+	}
+	return cubic.FugacityCoefficient(s.CubicConfig(eos, zfactor.Args{T: T, P: P, R: R}), p)
+}
+
+// ConsistencyReport compares the properties implied by the given cubic EOS against the
+// stored data of the substance (Zc, vapor pressure at Tn, acentric factor and Rackett
+// saturated liquid volumes). See cubic.ConsistencyReport for details.
+func (s *Substance) ConsistencyReport(eos cubic.EOSType) (*cubic.Consistency, error) {
+	return cubic.ConsistencyReport(cubic.Fluid{
+		Name:     s.Name,
+		Tc:       s.Critical.Tc,
+		Pc:       s.Critical.Pc,
+		Vc:       s.Critical.Vc,
+		Zc:       s.Critical.Zc,
+		Acentric: s.Acentric,
+		Tn:       s.Tn,
+	}, eos)
+}
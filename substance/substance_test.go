@@ -0,0 +1,392 @@
+package substance_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	leekesler "github.com/rickykimani/zfactor/lee-kesler"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+func TestReduced(t *testing.T) {
+	incomplete := &substance.Substance{Name: "X", Critical: substance.CriticalProps{Tc: 400}}
+	tests := []struct {
+		name    string
+		s       *substance.Substance
+		T, P    float64
+		wantTr  float64
+		wantPr  float64
+		wantErr error
+	}{
+		{"methane", substance.Methane, 1.5 * substance.Methane.Critical.Tc, 2 * substance.Methane.Critical.Pc, 1.5, 2, nil},
+		{"zero pressure", substance.Methane, substance.Methane.Critical.Tc, 0, 1, 0, nil},
+		{"zero temperature", substance.Methane, 0, 1, 0, 0, zfactor.ErrTemp},
+		{"negative pressure", substance.Methane, 300, -1, 0, 0, zfactor.ErrPressure},
+		{"missing Pc", incomplete, 300, 1, 0, 0, zfactor.ErrCriticalProp},
+		{"missing Tc", &substance.Substance{Name: "Y"}, 300, 1, 0, 0, zfactor.ErrCriticalProp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, pr, err := tt.s.Reduced(tt.T, tt.P)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reduced() error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(tr-tt.wantTr) > 1e-12 || math.Abs(pr-tt.wantPr) > 1e-12 {
+				t.Errorf("Reduced() = (%v, %v), want (%v, %v)", tr, pr, tt.wantTr, tt.wantPr)
+			}
+		})
+	}
+
+	// Correlations report the missing data instead of evaluating at Tr = Inf.
+	if _, err := incomplete.AbbottResidualEnthalpy(zfactor.Args{T: 300, P: 1}); !errors.Is(err, zfactor.ErrCriticalProp) {
+		t.Errorf("AbbottResidualEnthalpy() error = %v, want %v", err, zfactor.ErrCriticalProp)
+	}
+}
+
+func TestLiquidExpansionCompressibility(t *testing.T) {
+	// Liquid propane at 250 K: β ≈ 2.2e-3 1/K and κ ≈ 2.9e-4 1/bar.
+	beta, err := substance.Propane.LiquidExpansion(250)
+	if err != nil {
+		t.Fatalf("LiquidExpansion() unexpected error: %v", err)
+	}
+	if beta < 1.5e-3 || beta > 3e-3 {
+		t.Errorf("LiquidExpansion() = %v, want about 2.2e-3", beta)
+	}
+
+	kappa, err := substance.Propane.LiquidCompressibility(250, 10)
+	if err != nil {
+		t.Fatalf("LiquidCompressibility() unexpected error: %v", err)
+	}
+	if kappa < 2e-4 || kappa > 4e-4 {
+		t.Errorf("LiquidCompressibility() = %v, want about 2.9e-4", kappa)
+	}
+
+	if _, err := substance.Propane.LiquidCompressibility(250, 1); err == nil {
+		t.Error("LiquidCompressibility() below the vapor pressure expected an error")
+	}
+
+	custom := &substance.Substance{Name: "Custom", Critical: substance.CriticalProps{Tc: 400, Pc: 40}}
+	var missing *substance.MissingDataError
+	if _, err := custom.LiquidExpansion(300); !errors.As(err, &missing) || missing.Property != substance.PropZc {
+		t.Errorf("LiquidExpansion() error = %v, want missing Zc", err)
+	}
+	if _, err := custom.LiquidCompressibility(300, 50); !errors.As(err, &missing) || missing.Property != substance.PropTn {
+		t.Errorf("LiquidCompressibility() error = %v, want missing Tn", err)
+	}
+}
+
+func TestLiquidDensity(t *testing.T) {
+	// Ammonia at 310 K and 100 bar from the saturated liquid at 300 K
+	// (V = 28.33 cm³/mol at 10.61 bar). The measured volume is 29.14 cm³/mol.
+	ref := &substance.LiquidReference{T: 300, P: 10.61, Density: substance.Ammonia.MW / 28.33 * 1000}
+	rho, err := substance.Ammonia.LiquidDensity(310, 100, ref)
+	if err != nil {
+		t.Fatalf("LiquidDensity() unexpected error: %v", err)
+	}
+	if v := substance.Ammonia.MW / rho * 1000; v < 28 || v > 29.5 {
+		t.Errorf("LiquidDensity() gives V = %v cm³/mol, want about 29", v)
+	}
+
+	// Without a reference the critical density is scaled instead.
+	rhoC, err := substance.Ammonia.LiquidDensity(310, 100, nil)
+	if err != nil {
+		t.Fatalf("LiquidDensity() unexpected error: %v", err)
+	}
+	rhoR, _ := substance.Ammonia.ReducedDensity(zfactor.Args{T: 310, P: 100})
+	if want := rhoR * substance.Ammonia.MW / substance.Ammonia.Critical.Vc * 1000; math.Abs(rhoC-want) > 1e-9 {
+		t.Errorf("LiquidDensity() = %v, want %v", rhoC, want)
+	}
+
+	if _, err := substance.Ammonia.LiquidDensity(310, 100, &substance.LiquidReference{T: 300, P: 10.61}); err == nil {
+		t.Error("LiquidDensity() with zero reference density expected an error")
+	}
+	custom := &substance.Substance{Name: "Custom", Critical: substance.CriticalProps{Tc: 400, Pc: 40}}
+	var missing *substance.MissingDataError
+	if _, err := custom.LiquidDensity(300, 50, nil); !errors.As(err, &missing) || missing.Property != substance.PropMW {
+		t.Errorf("LiquidDensity() error = %v, want missing MW", err)
+	}
+}
+
+func TestPolar(t *testing.T) {
+	tests := []struct {
+		s          *substance.Substance
+		wantDipole float64 // Reduced dipole moment
+		wantPolar  bool
+	}{
+		{substance.Methane, 0, false},
+		{substance.Toluene, 0.00098, false},
+		{substance.Acetone, 0.0803, true},
+		{substance.CarbonTetrachloride, 0, false},
+		{substance.Water, 0.0895, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s.Name, func(t *testing.T) {
+			if got := tt.s.ReducedDipole(); math.Abs(got-tt.wantDipole) > 1e-4 {
+				t.Errorf("ReducedDipole() = %v, want %v", got, tt.wantDipole)
+			}
+			if got := tt.s.Polar(); got != tt.wantPolar {
+				t.Errorf("Polar() = %v, want %v", got, tt.wantPolar)
+			}
+		})
+	}
+
+	// Association alone makes a substance polar.
+	s := &substance.Substance{Name: "test", Associating: true, Critical: substance.CriticalProps{Tc: 500, Pc: 50}}
+	if !s.Polar() {
+		t.Error("Polar() = false for an associating substance, want true")
+	}
+}
+
+func TestFugacityCoefficient(t *testing.T) {
+	// Low-pressure methane: ln φ ≈ BP/RT ≈ Z - 1.
+	phi, err := substance.Methane.FugacityCoefficient(&cubic.PR{}, 300, 10, substance.Vapor)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient() error = %v", err)
+	}
+	props, err := substance.Methane.PropertiesAt(300, 10, substance.CubicProvider{EOS: &cubic.PR{}})
+	if err != nil {
+		t.Fatalf("PropertiesAt() error = %v", err)
+	}
+	if want := math.Exp(props.Z - 1); math.Abs(phi-want) > 1e-3 {
+		t.Errorf("FugacityCoefficient() = %v, want about %v", phi, want)
+	}
+
+	// Compressed liquid water: φ ≈ Psat/P is far below 1.
+	liq, err := substance.Water.FugacityCoefficient(&cubic.PR{}, 300, 1, substance.Liquid)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient() error = %v", err)
+	}
+	if liq > 0.1 {
+		t.Errorf("FugacityCoefficient(liquid water) = %v, want < 0.1", liq)
+	}
+	stable, err := substance.Water.FugacityCoefficient(&cubic.PR{}, 300, 1, substance.UnknownPhase)
+	if err != nil {
+		t.Fatalf("FugacityCoefficient() error = %v", err)
+	}
+	if stable != liq {
+		t.Errorf("FugacityCoefficient(UnknownPhase) = %v, want the liquid %v", stable, liq)
+	}
+
+	if _, err := substance.Methane.FugacityCoefficient(&cubic.PR{}, 300, 0, substance.Vapor); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("FugacityCoefficient() error = %v, want %v", err, zfactor.ErrPressure)
+	}
+}
+
+func TestLeeKeslerAnalytic(t *testing.T) {
+	t.Cleanup(zfactor.ResetDefaults)
+	args := zfactor.Args{T: 400, P: 40}
+	tables, err := substance.Propane.LeeKesler(args, leekesler.CompressibilityFactor)
+	if err != nil {
+		t.Fatalf("LeeKesler() unexpected error: %v", err)
+	}
+
+	if err := zfactor.SetDefaults(zfactor.Config{LeeKesler: zfactor.LKAnalytic}); err != nil {
+		t.Fatal(err)
+	}
+	analytic, err := substance.Propane.LeeKesler(args, leekesler.CompressibilityFactor)
+	if err != nil {
+		t.Fatalf("LeeKesler() unexpected error: %v", err)
+	}
+	// Tr = 1.08 and Pr = 0.94, where bilinear interpolation of the tables is
+	// coarsest.
+	if analytic == tables || math.Abs(analytic-tables) > 1e-2 {
+		t.Errorf("LeeKesler() analytic Z = %v, want close to, but not exactly, the tables %v", analytic, tables)
+	}
+	// Beyond the tables, Pr > 14.
+	if _, err := substance.Propane.LeeKesler(zfactor.Args{T: 400, P: 700}, leekesler.CompressibilityFactor); err != nil {
+		t.Errorf("LeeKesler() analytic at Pr > 14 unexpected error: %v", err)
+	}
+}
+
+func TestQuantumCorrected(t *testing.T) {
+	h := substance.Hydrogen.QuantumCorrected(300)
+	// Tc = 43.6/(1 + 21.8/(2.016·300)), Pc = 20.5/(1 + 44.2/(2.016·300)).
+	if math.Abs(h.Critical.Tc-42.083) > 1e-3 || math.Abs(h.Critical.Pc-19.104) > 1e-3 || h.Acentric != 0 {
+		t.Errorf("QuantumCorrected(300) = Tc %v, Pc %v, ω %v, want 42.083, 19.104, 0", h.Critical.Tc, h.Critical.Pc, h.Acentric)
+	}
+	if substance.Hydrogen.Critical.Tc != 33.19 {
+		t.Errorf("QuantumCorrected() modified the substance, Tc = %v", substance.Hydrogen.Critical.Tc)
+	}
+	if got := substance.Methane.QuantumCorrected(300); got != substance.Methane {
+		t.Errorf("QuantumCorrected() of methane = %v, want the substance itself", got)
+	}
+
+	// Hydrogen at 300 K and 700 bar, storage conditions: Z = 1.443 from the
+	// reference equation of Leachman et al. (2009).
+	z, err := substance.CubicProvider{EOS: &cubic.SRK{}}.Z(substance.Hydrogen, 300, 700)
+	if err != nil {
+		t.Fatalf("Z() unexpected error: %v", err)
+	}
+	if math.Abs(z-1.443) > 5e-3 {
+		t.Errorf("Z() of hydrogen at 300 K and 700 bar = %v, want 1.443", z)
+	}
+}
+
+func TestAir(t *testing.T) {
+	// 1.1766 kg/m³ at 300 K and 1 atm from the equation of Lemmon et al. (2000).
+	rho, err := substance.Air.Density(300, zfactor.AtmBar, substance.LeeKeslerProvider{})
+	if err != nil {
+		t.Fatalf("Density() unexpected error: %v", err)
+	}
+	if math.Abs(rho-1.1766) > 1e-3 {
+		t.Errorf("Density() of air at 300 K and 1 atm = %v, want 1.1766", rho)
+	}
+	if p, err := substance.Air.LeeKeslerVaporPressure(substance.Air.Tn); err != nil || math.Abs(p-zfactor.AtmBar) > 1e-3 {
+		t.Errorf("LeeKeslerVaporPressure(Tn) = %v, %v, want %v", p, err, zfactor.AtmBar)
+	}
+
+	var sum float64
+	for _, c := range substance.AirComposition {
+		sum += c.Fraction
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("AirComposition fractions sum to %v, want 1", sum)
+	}
+	mix, err := substance.NewLinearMixture("Air", substance.AirComposition)
+	if err != nil {
+		t.Fatalf("NewLinearMixture() unexpected error: %v", err)
+	}
+	if math.Abs(mix.MW-substance.Air.MW) > 1e-3 {
+		t.Errorf("NewLinearMixture(AirComposition).MW = %v, want %v", mix.MW, substance.Air.MW)
+	}
+}
+
+func TestVirialZ(t *testing.T) {
+	// n-Butane at 510 K and 25 bar, Smith, Van Ness and Abbott, Example 3.10.
+	z, err := substance.NButane.VirialZ(510, 25)
+	if err != nil {
+		t.Fatalf("VirialZ() unexpected error: %v", err)
+	}
+	if math.Abs(z-0.879) > 2e-3 {
+		t.Errorf("VirialZ(510, 25) = %v, want 0.879", z)
+	}
+	if _, err := substance.NButane.VirialZ(300, 10); !errors.Is(err, zfactor.ErrVirialRange) {
+		t.Errorf("VirialZ(300, 10) error = %v, want %v", err, zfactor.ErrVirialRange)
+	}
+	if _, err := substance.NButane.VirialZ(510, 0); !errors.Is(err, zfactor.ErrPressure) {
+		t.Errorf("VirialZ(510, 0) error = %v, want %v", err, zfactor.ErrPressure)
+	}
+
+	// AbbottProvider evaluates the same model, including the range check and the
+	// effective constants of quantum gases.
+	want, err := substance.Hydrogen.VirialZ(30, 5)
+	if err != nil {
+		t.Fatalf("VirialZ() unexpected error: %v", err)
+	}
+	if got, err := (substance.AbbottProvider{}).Z(substance.Hydrogen, 30, 5); err != nil || got != want {
+		t.Errorf("AbbottProvider.Z(Hydrogen, 30, 5) = %v, %v, want %v", got, err, want)
+	}
+	if _, err := (substance.AbbottProvider{}).Z(substance.NButane, 300, 10); !errors.Is(err, zfactor.ErrVirialRange) {
+		t.Errorf("AbbottProvider.Z(NButane, 300, 10) error = %v, want %v", err, zfactor.ErrVirialRange)
+	}
+
+	// Residual covers the same states as Z.
+	for _, tt := range []struct {
+		T, P float64
+		want error
+	}{
+		{0, 5, zfactor.ErrTemp},
+		{510, 0, zfactor.ErrPressure},
+		{510, 20, zfactor.ErrHighPressureTwoTerm},
+		{300, 10, zfactor.ErrVirialRange},
+	} {
+		if _, _, err := (substance.AbbottProvider{}).Residual(substance.NButane, tt.T, tt.P); !errors.Is(err, tt.want) {
+			t.Errorf("AbbottProvider.Residual(NButane, %v, %v) error = %v, want %v", tt.T, tt.P, err, tt.want)
+		}
+	}
+}
+
+func TestRecommendedEOS(t *testing.T) {
+	if got := substance.Methane.RecommendedEOS(); got.Kappa1 == 0 || got.VolumeShift == 0 {
+		t.Errorf("RecommendedEOS() of methane = %+v, want PRSV κ1 and a volume shift", got)
+	}
+	eos, err := substance.Helium4.RecommendedEOS().Type()
+	if err != nil || cubic.Name(eos) != "PR" {
+		t.Errorf("RecommendedEOS().Type() of helium = %v, %v, want PR", eos, err)
+	}
+
+	// Saturated liquid methane at 111.6 K: 422.6 kg/m³. Plain PR gives 475.
+	rho, err := substance.Methane.Density(111.6, 1.2, substance.CubicProvider{EOS: &cubic.Auto{}})
+	if err != nil {
+		t.Fatalf("Density() unexpected error: %v", err)
+	}
+	if math.Abs(rho-422.6) > 0.01*422.6 {
+		t.Errorf("Density() of liquid methane with Auto = %v, want 422.6", rho)
+	}
+
+	// The PRSV vapor pressure of water at its normal boiling point.
+	const R = zfactor.RSI * 10
+	eos, _ = substance.Water.RecommendedEOS().Type()
+	ps, err := cubic.SaturationPressure(substance.Water.CubicConfig(eos, zfactor.Args{T: 373.15, P: 1, R: R}), 373.15)
+	if err != nil || math.Abs(ps-zfactor.AtmBar) > 0.01 {
+		t.Errorf("SaturationPressure() of water with PRSV = %v, %v, want %v", ps, err, zfactor.AtmBar)
+	}
+
+	// Auto through the defaults.
+	t.Cleanup(zfactor.ResetDefaults)
+	if err := zfactor.SetDefaults(zfactor.Config{EOS: "Auto"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := (substance.CubicProvider{}).Name(); got != "Auto" {
+		t.Errorf("CubicProvider.Name() with the Auto default = %q, want Auto", got)
+	}
+	want := 1.2 * substance.Methane.MW / rho * 1000 / (R * 111.6)
+	if z, _ := (substance.CubicProvider{}).Z(substance.Methane, 111.6, 1.2); math.Abs(z-want) > 1e-9 {
+		t.Errorf("Z() of liquid methane with the Auto default = %v, want %v", z, want)
+	}
+}
+
+func TestTsonopoulosB(t *testing.T) {
+	tests := []struct {
+		s    *substance.Substance
+		T    float64
+		want float64 // experimental, cm³/mol
+	}{
+		{substance.Acetone, 300, -1970},
+		{substance.Methanol, 350, -680},
+		{substance.Water, 373.15, -452},
+		{substance.Propane, 300, -382},
+	}
+	for _, tt := range tests {
+		got, err := tt.s.TsonopoulosB(tt.T)
+		if err != nil {
+			t.Fatalf("TsonopoulosB() of %s unexpected error: %v", tt.s.Name, err)
+		}
+		if math.Abs(got-tt.want) > 0.05*math.Abs(tt.want) {
+			t.Errorf("TsonopoulosB(%v) of %s = %v, want %v", tt.T, tt.s.Name, got, tt.want)
+		}
+	}
+	if _, err := substance.AceticAcid.TsonopoulosB(400); err == nil {
+		t.Error("TsonopoulosB() of acetic acid expected error, got nil")
+	}
+}
+
+func TestHaydenOConnellB(t *testing.T) {
+	b, err := substance.Propane.HaydenOConnellB(300)
+	if err != nil {
+		t.Fatalf("HaydenOConnellB() unexpected error: %v", err)
+	}
+	if math.Abs(b.Total()+382) > 0.05*382 {
+		t.Errorf("HaydenOConnellB() of propane = %v, want -382", b.Total())
+	}
+	acid, err := substance.AceticAcid.HaydenOConnellB(450)
+	if err != nil {
+		t.Fatalf("HaydenOConnellB() unexpected error: %v", err)
+	}
+	if acid.Chemical/acid.Physical < 50 {
+		t.Errorf("HaydenOConnellB() of acetic acid = %+v, want B dominated by dimerization", acid)
+	}
+	if _, err := substance.Cumene.HaydenOConnellB(400); err == nil {
+		t.Error("HaydenOConnellB() of an unlisted substance expected error, got nil")
+	}
+}
+
+func TestVirialComponent(t *testing.T) {
+	c := substance.Methane.VirialComponent()
+	if c.Tc != substance.Methane.Critical.Tc || c.Vc != substance.Methane.Critical.Vc || c.Acentric != substance.Methane.Acentric {
+		t.Errorf("VirialComponent() = %+v, want the properties of methane", c)
+	}
+}
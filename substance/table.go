@@ -2,6 +2,9 @@
 
 package substance
 
+// DataVersion is the version of the built-in substances, from data/versions.json.
+const DataVersion = 1
+
 var Methane = &Substance{
 	Name:     "Methane",
 	MW:       16.04300,
@@ -137,6 +140,7 @@ var Isobutane = &Substance{
 	MW:       58.12300,
 	Acentric: 0.18100,
 	Tn:       261.40000,
+	Dipole:   0.10000,
 	Critical: CriticalProps{
 		Tc: 408.10000,
 		Pc: 36.48000,
@@ -215,6 +219,7 @@ var Propylene = &Substance{
 	MW:       42.08100,
 	Acentric: 0.14000,
 	Tn:       225.50000,
+	Dipole:   0.40000,
 	Critical: CriticalProps{
 		Tc: 365.60000,
 		Pc: 46.65000,
@@ -228,6 +233,7 @@ var OneButene = &Substance{
 	MW:       56.10800,
 	Acentric: 0.19100,
 	Tn:       266.90000,
+	Dipole:   0.30000,
 	Critical: CriticalProps{
 		Tc: 420.00000,
 		Pc: 40.43000,
@@ -241,6 +247,7 @@ var Cis2Butene = &Substance{
 	MW:       56.10800,
 	Acentric: 0.20500,
 	Tn:       276.90000,
+	Dipole:   0.30000,
 	Critical: CriticalProps{
 		Tc: 435.60000,
 		Pc: 42.43000,
@@ -267,6 +274,7 @@ var OneHexene = &Substance{
 	MW:       84.16100,
 	Acentric: 0.28000,
 	Tn:       336.30000,
+	Dipole:   0.40000,
 	Critical: CriticalProps{
 		Tc: 504.00000,
 		Pc: 31.40000,
@@ -280,6 +288,7 @@ var Isobutylene = &Substance{
 	MW:       56.10800,
 	Acentric: 0.19400,
 	Tn:       266.30000,
+	Dipole:   0.50000,
 	Critical: CriticalProps{
 		Tc: 417.90000,
 		Pc: 40.00000,
@@ -306,6 +315,7 @@ var Cyclohexene = &Substance{
 	MW:       82.14500,
 	Acentric: 0.21200,
 	Tn:       356.10000,
+	Dipole:   0.30000,
 	Critical: CriticalProps{
 		Tc: 560.40000,
 		Pc: 43.50000,
@@ -345,6 +355,7 @@ var Toluene = &Substance{
 	MW:       92.14100,
 	Acentric: 0.26200,
 	Tn:       383.80000,
+	Dipole:   0.40000,
 	Critical: CriticalProps{
 		Tc: 591.80000,
 		Pc: 41.06000,
@@ -358,6 +369,7 @@ var Ethylbenzene = &Substance{
 	MW:       106.16700,
 	Acentric: 0.30300,
 	Tn:       409.40000,
+	Dipole:   0.40000,
 	Critical: CriticalProps{
 		Tc: 617.20000,
 		Pc: 36.06000,
@@ -371,6 +383,7 @@ var Cumene = &Substance{
 	MW:       120.19400,
 	Acentric: 0.32600,
 	Tn:       425.60000,
+	Dipole:   0.40000,
 	Critical: CriticalProps{
 		Tc: 631.10000,
 		Pc: 32.09000,
@@ -384,6 +397,7 @@ var OXylene = &Substance{
 	MW:       106.16700,
 	Acentric: 0.31000,
 	Tn:       417.60000,
+	Dipole:   0.60000,
 	Critical: CriticalProps{
 		Tc: 630.30000,
 		Pc: 37.34000,
@@ -397,6 +411,7 @@ var MXylene = &Substance{
 	MW:       106.16700,
 	Acentric: 0.32600,
 	Tn:       412.30000,
+	Dipole:   0.30000,
 	Critical: CriticalProps{
 		Tc: 617.10000,
 		Pc: 35.36000,
@@ -423,6 +438,7 @@ var Styrene = &Substance{
 	MW:       104.15200,
 	Acentric: 0.29700,
 	Tn:       418.30000,
+	Dipole:   0.10000,
 	Critical: CriticalProps{
 		Tc: 636.00000,
 		Pc: 38.40000,
@@ -462,6 +478,7 @@ var Formaldehyde = &Substance{
 	MW:       30.02600,
 	Acentric: 0.28200,
 	Tn:       254.10000,
+	Dipole:   2.30000,
 	Critical: CriticalProps{
 		Tc: 408.00000,
 		Pc: 65.90000,
@@ -475,6 +492,7 @@ var Acetaldehyde = &Substance{
 	MW:       44.05300,
 	Acentric: 0.29100,
 	Tn:       294.00000,
+	Dipole:   2.70000,
 	Critical: CriticalProps{
 		Tc: 466.00000,
 		Pc: 55.50000,
@@ -488,6 +506,7 @@ var MethylAcetate = &Substance{
 	MW:       74.07900,
 	Acentric: 0.33100,
 	Tn:       330.10000,
+	Dipole:   1.70000,
 	Critical: CriticalProps{
 		Tc: 506.60000,
 		Pc: 47.50000,
@@ -501,6 +520,7 @@ var EthylAcetate = &Substance{
 	MW:       88.10600,
 	Acentric: 0.36600,
 	Tn:       350.20000,
+	Dipole:   1.80000,
 	Critical: CriticalProps{
 		Tc: 523.30000,
 		Pc: 38.80000,
@@ -514,6 +534,7 @@ var Acetone = &Substance{
 	MW:       58.08000,
 	Acentric: 0.30700,
 	Tn:       329.40000,
+	Dipole:   2.90000,
 	Critical: CriticalProps{
 		Tc: 508.20000,
 		Pc: 47.01000,
@@ -527,6 +548,7 @@ var MethylEthylKetone = &Substance{
 	MW:       72.10700,
 	Acentric: 0.32300,
 	Tn:       352.80000,
+	Dipole:   2.80000,
 	Critical: CriticalProps{
 		Tc: 535.50000,
 		Pc: 41.50000,
@@ -540,6 +562,7 @@ var DiethylEther = &Substance{
 	MW:       74.12300,
 	Acentric: 0.28100,
 	Tn:       307.60000,
+	Dipole:   1.20000,
 	Critical: CriticalProps{
 		Tc: 466.70000,
 		Pc: 36.40000,
@@ -553,6 +576,7 @@ var MethylTButylEther = &Substance{
 	MW:       88.15000,
 	Acentric: 0.26600,
 	Tn:       328.40000,
+	Dipole:   1.40000,
 	Critical: CriticalProps{
 		Tc: 497.10000,
 		Pc: 34.30000,
@@ -562,10 +586,12 @@ var MethylTButylEther = &Substance{
 }
 
 var Methanol = &Substance{
-	Name:     "Methanol",
-	MW:       32.04200,
-	Acentric: 0.56400,
-	Tn:       337.90000,
+	Name:        "Methanol",
+	MW:          32.04200,
+	Acentric:    0.56400,
+	Tn:          337.90000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 512.60000,
 		Pc: 80.97000,
@@ -575,10 +601,12 @@ var Methanol = &Substance{
 }
 
 var Ethanol = &Substance{
-	Name:     "Ethanol",
-	MW:       46.06900,
-	Acentric: 0.64500,
-	Tn:       351.40000,
+	Name:        "Ethanol",
+	MW:          46.06900,
+	Acentric:    0.64500,
+	Tn:          351.40000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 513.90000,
 		Pc: 61.48000,
@@ -588,10 +616,12 @@ var Ethanol = &Substance{
 }
 
 var OnePropanol = &Substance{
-	Name:     "1-Propanol",
-	MW:       60.09600,
-	Acentric: 0.62200,
-	Tn:       370.40000,
+	Name:        "1-Propanol",
+	MW:          60.09600,
+	Acentric:    0.62200,
+	Tn:          370.40000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 536.80000,
 		Pc: 51.75000,
@@ -601,10 +631,12 @@ var OnePropanol = &Substance{
 }
 
 var OneButanol = &Substance{
-	Name:     "1-Butanol",
-	MW:       74.12300,
-	Acentric: 0.59400,
-	Tn:       390.80000,
+	Name:        "1-Butanol",
+	MW:          74.12300,
+	Acentric:    0.59400,
+	Tn:          390.80000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 563.10000,
 		Pc: 44.23000,
@@ -614,10 +646,12 @@ var OneButanol = &Substance{
 }
 
 var OneHexanol = &Substance{
-	Name:     "1-Hexanol",
-	MW:       102.17700,
-	Acentric: 0.57900,
-	Tn:       430.60000,
+	Name:        "1-Hexanol",
+	MW:          102.17700,
+	Acentric:    0.57900,
+	Tn:          430.60000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 611.40000,
 		Pc: 35.10000,
@@ -627,10 +661,12 @@ var OneHexanol = &Substance{
 }
 
 var TwoPropanol = &Substance{
-	Name:     "2-Propanol",
-	MW:       60.09600,
-	Acentric: 0.66800,
-	Tn:       355.40000,
+	Name:        "2-Propanol",
+	MW:          60.09600,
+	Acentric:    0.66800,
+	Tn:          355.40000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 508.30000,
 		Pc: 47.62000,
@@ -640,10 +676,12 @@ var TwoPropanol = &Substance{
 }
 
 var EthyleneGlycol = &Substance{
-	Name:     "Ethylene glycol",
-	MW:       62.06800,
-	Acentric: 0.48700,
-	Tn:       470.50000,
+	Name:        "Ethylene glycol",
+	MW:          62.06800,
+	Acentric:    0.48700,
+	Tn:          470.50000,
+	Dipole:      2.30000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 719.70000,
 		Pc: 77.00000,
@@ -653,10 +691,12 @@ var EthyleneGlycol = &Substance{
 }
 
 var AceticAcid = &Substance{
-	Name:     "Acetic acid",
-	MW:       60.05300,
-	Acentric: 0.46700,
-	Tn:       391.10000,
+	Name:        "Acetic acid",
+	MW:          60.05300,
+	Acentric:    0.46700,
+	Tn:          391.10000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 592.00000,
 		Pc: 57.86000,
@@ -666,10 +706,12 @@ var AceticAcid = &Substance{
 }
 
 var NButyricAcid = &Substance{
-	Name:     "n-Butyric acid",
-	MW:       88.10600,
-	Acentric: 0.68100,
-	Tn:       436.40000,
+	Name:        "n-Butyric acid",
+	MW:          88.10600,
+	Acentric:    0.68100,
+	Tn:          436.40000,
+	Dipole:      1.50000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 615.70000,
 		Pc: 40.64000,
@@ -679,10 +721,12 @@ var NButyricAcid = &Substance{
 }
 
 var BenzoicAcid = &Substance{
-	Name:     "Benzoic acid",
-	MW:       122.12300,
-	Acentric: 0.60300,
-	Tn:       522.40000,
+	Name:        "Benzoic acid",
+	MW:          122.12300,
+	Acentric:    0.60300,
+	Tn:          522.40000,
+	Dipole:      1.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 751.00000,
 		Pc: 44.70000,
@@ -696,6 +740,7 @@ var Acetonitrile = &Substance{
 	MW:       41.05300,
 	Acentric: 0.33800,
 	Tn:       354.80000,
+	Dipole:   3.90000,
 	Critical: CriticalProps{
 		Tc: 545.50000,
 		Pc: 48.30000,
@@ -705,10 +750,12 @@ var Acetonitrile = &Substance{
 }
 
 var Methylamine = &Substance{
-	Name:     "Methylamine",
-	MW:       31.05700,
-	Acentric: 0.28100,
-	Tn:       266.80000,
+	Name:        "Methylamine",
+	MW:          31.05700,
+	Acentric:    0.28100,
+	Tn:          266.80000,
+	Dipole:      1.30000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 430.10000,
 		Pc: 74.60000,
@@ -718,10 +765,12 @@ var Methylamine = &Substance{
 }
 
 var Ethylamine = &Substance{
-	Name:     "Ethylamine",
-	MW:       45.08400,
-	Acentric: 0.28500,
-	Tn:       289.70000,
+	Name:        "Ethylamine",
+	MW:          45.08400,
+	Acentric:    0.28500,
+	Tn:          289.70000,
+	Dipole:      1.20000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 456.20000,
 		Pc: 56.20000,
@@ -735,6 +784,7 @@ var Nitromethane = &Substance{
 	MW:       61.04000,
 	Acentric: 0.34800,
 	Tn:       374.40000,
+	Dipole:   3.50000,
 	Critical: CriticalProps{
 		Tc: 588.20000,
 		Pc: 63.10000,
@@ -761,6 +811,7 @@ var Chloroform = &Substance{
 	MW:       119.37700,
 	Acentric: 0.22200,
 	Tn:       334.30000,
+	Dipole:   1.00000,
 	Critical: CriticalProps{
 		Tc: 536.40000,
 		Pc: 54.72000,
@@ -774,6 +825,7 @@ var Dichloromethane = &Substance{
 	MW:       84.93200,
 	Acentric: 0.19900,
 	Tn:       312.90000,
+	Dipole:   1.60000,
 	Critical: CriticalProps{
 		Tc: 510.00000,
 		Pc: 60.80000,
@@ -787,6 +839,7 @@ var MethylChloride = &Substance{
 	MW:       50.48800,
 	Acentric: 0.15300,
 	Tn:       249.10000,
+	Dipole:   1.90000,
 	Critical: CriticalProps{
 		Tc: 416.30000,
 		Pc: 66.80000,
@@ -800,6 +853,7 @@ var EthylChloride = &Substance{
 	MW:       64.51400,
 	Acentric: 0.19000,
 	Tn:       285.40000,
+	Dipole:   2.10000,
 	Critical: CriticalProps{
 		Tc: 460.40000,
 		Pc: 52.70000,
@@ -813,6 +867,7 @@ var Chlorobenzene = &Substance{
 	MW:       112.55800,
 	Acentric: 0.25000,
 	Tn:       404.90000,
+	Dipole:   1.70000,
 	Critical: CriticalProps{
 		Tc: 632.40000,
 		Pc: 45.20000,
@@ -826,6 +881,7 @@ var Tetrafluoroethane = &Substance{
 	MW:       102.03000,
 	Acentric: 0.32700,
 	Tn:       247.10000,
+	Dipole:   2.10000,
 	Critical: CriticalProps{
 		Tc: 374.20000,
 		Pc: 40.60000,
@@ -927,14 +983,14 @@ var Nitrogen = &Substance{
 
 var Air = &Substance{
 	Name:     "Air",
-	MW:       28.85100,
-	Acentric: 0.03500,
-	Tn:       0.00000,
+	MW:       28.95860,
+	Acentric: 0.03350,
+	Tn:       78.90300,
 	Critical: CriticalProps{
-		Tc: 132.20000,
-		Pc: 37.45000,
-		Vc: 84.80000,
-		Zc: 0.28900,
+		Tc: 132.53060,
+		Pc: 37.85000,
+		Vc: 84.52500,
+		Zc: 0.29030,
 	},
 }
 
@@ -956,6 +1012,7 @@ var CarbonMonoxide = &Substance{
 	MW:       28.01000,
 	Acentric: 0.04800,
 	Tn:       81.70000,
+	Dipole:   0.10000,
 	Critical: CriticalProps{
 		Tc: 132.90000,
 		Pc: 34.99000,
@@ -995,6 +1052,7 @@ var HydrogenSulfide = &Substance{
 	MW:       34.08200,
 	Acentric: 0.09400,
 	Tn:       212.80000,
+	Dipole:   1.00000,
 	Critical: CriticalProps{
 		Tc: 373.50000,
 		Pc: 89.63000,
@@ -1008,6 +1066,7 @@ var SulfurDioxide = &Substance{
 	MW:       64.06500,
 	Acentric: 0.24500,
 	Tn:       263.10000,
+	Dipole:   1.60000,
 	Critical: CriticalProps{
 		Tc: 430.80000,
 		Pc: 78.84000,
@@ -1034,6 +1093,7 @@ var NitricOxide = &Substance{
 	MW:       30.00600,
 	Acentric: 0.58300,
 	Tn:       121.40000,
+	Dipole:   0.20000,
 	Critical: CriticalProps{
 		Tc: 180.20000,
 		Pc: 64.80000,
@@ -1047,6 +1107,7 @@ var NitrousOxide = &Substance{
 	MW:       44.01300,
 	Acentric: 0.14100,
 	Tn:       184.70000,
+	Dipole:   0.20000,
 	Critical: CriticalProps{
 		Tc: 309.60000,
 		Pc: 72.45000,
@@ -1060,6 +1121,7 @@ var HydrogenChloride = &Substance{
 	MW:       36.46100,
 	Acentric: 0.13200,
 	Tn:       188.20000,
+	Dipole:   1.10000,
 	Critical: CriticalProps{
 		Tc: 324.70000,
 		Pc: 83.10000,
@@ -1069,10 +1131,12 @@ var HydrogenChloride = &Substance{
 }
 
 var HydrogenCyanide = &Substance{
-	Name:     "Hydrogen cyanide",
-	MW:       27.02600,
-	Acentric: 0.41000,
-	Tn:       298.90000,
+	Name:        "Hydrogen cyanide",
+	MW:          27.02600,
+	Acentric:    0.41000,
+	Tn:          298.90000,
+	Dipole:      3.00000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 456.70000,
 		Pc: 53.90000,
@@ -1082,10 +1146,12 @@ var HydrogenCyanide = &Substance{
 }
 
 var Water = &Substance{
-	Name:     "Water",
-	MW:       18.01500,
-	Acentric: 0.34500,
-	Tn:       373.20000,
+	Name:        "Water",
+	MW:          18.01500,
+	Acentric:    0.34500,
+	Tn:          373.20000,
+	Dipole:      1.80000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 647.10000,
 		Pc: 220.55000,
@@ -1095,10 +1161,12 @@ var Water = &Substance{
 }
 
 var Ammonia = &Substance{
-	Name:     "Ammonia",
-	MW:       17.03100,
-	Acentric: 0.25300,
-	Tn:       239.70000,
+	Name:        "Ammonia",
+	MW:          17.03100,
+	Acentric:    0.25300,
+	Tn:          239.70000,
+	Dipole:      1.50000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 405.70000,
 		Pc: 112.80000,
@@ -1108,10 +1176,12 @@ var Ammonia = &Substance{
 }
 
 var NitricAcid = &Substance{
-	Name:     "Nitric acid",
-	MW:       63.01300,
-	Acentric: 0.71400,
-	Tn:       356.20000,
+	Name:        "Nitric acid",
+	MW:          63.01300,
+	Acentric:    0.71400,
+	Tn:          356.20000,
+	Dipole:      2.20000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 520.00000,
 		Pc: 68.90000,
@@ -1121,10 +1191,12 @@ var NitricAcid = &Substance{
 }
 
 var SulfuricAcid = &Substance{
-	Name:     "Sulfuric acid",
-	MW:       98.08000,
-	Acentric: 0.00000,
-	Tn:       610.00000,
+	Name:        "Sulfuric acid",
+	MW:          98.08000,
+	Acentric:    0.00000,
+	Tn:          610.00000,
+	Dipole:      2.70000,
+	Associating: true,
 	Critical: CriticalProps{
 		Tc: 924.00000,
 		Pc: 64.00000,
@@ -1132,3 +1204,94 @@ var SulfuricAcid = &Substance{
 		Zc: 0.14700,
 	},
 }
+
+// builtins holds the generated substances, in the order of the data file.
+var builtins = []*Substance{
+	Methane,
+	Ethane,
+	Propane,
+	NButane,
+	NPentane,
+	NHexane,
+	NHeptane,
+	NOctane,
+	NNonane,
+	NDecane,
+	Isobutane,
+	Cyclopentane,
+	Cyclohexane,
+	Methylcyclopentane,
+	Methylcyclohexane,
+	Ethylene,
+	Propylene,
+	OneButene,
+	Cis2Butene,
+	Trans2Butene,
+	OneHexene,
+	Isobutylene,
+	One3Butadiene,
+	Cyclohexene,
+	Acetylene,
+	Benzene,
+	Toluene,
+	Ethylbenzene,
+	Cumene,
+	OXylene,
+	MXylene,
+	PXylene,
+	Styrene,
+	Naphthalene,
+	Biphenyl,
+	Formaldehyde,
+	Acetaldehyde,
+	MethylAcetate,
+	EthylAcetate,
+	Acetone,
+	MethylEthylKetone,
+	DiethylEther,
+	MethylTButylEther,
+	Methanol,
+	Ethanol,
+	OnePropanol,
+	OneButanol,
+	OneHexanol,
+	TwoPropanol,
+	EthyleneGlycol,
+	AceticAcid,
+	NButyricAcid,
+	BenzoicAcid,
+	Acetonitrile,
+	Methylamine,
+	Ethylamine,
+	Nitromethane,
+	CarbonTetrachloride,
+	Chloroform,
+	Dichloromethane,
+	MethylChloride,
+	EthylChloride,
+	Chlorobenzene,
+	Tetrafluoroethane,
+	Argon,
+	Krypton,
+	Xenon,
+	Helium4,
+	Hydrogen,
+	Oxygen,
+	Nitrogen,
+	Air,
+	Chlorine,
+	CarbonMonoxide,
+	CarbonDioxide,
+	CarbonDisulfide,
+	HydrogenSulfide,
+	SulfurDioxide,
+	SulfurTrioxide,
+	NitricOxide,
+	NitrousOxide,
+	HydrogenChloride,
+	HydrogenCyanide,
+	Water,
+	Ammonia,
+	NitricAcid,
+	SulfuricAcid,
+}
@@ -0,0 +1,78 @@
+package substance
+
+import (
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/tsonopoulos"
+)
+
+// tsonopoulosClasses holds the built-in compound classes of the polar term of
+// the Tsonopoulos correlation, keyed by lowercase substance name, for the
+// substances that are not of the default class (see TsonopoulosParams).
+var tsonopoulosClasses = map[string]tsonopoulos.Class{
+	"water":           tsonopoulos.Water,
+	"methanol":        tsonopoulos.Methanol,
+	"ethanol":         tsonopoulos.Alcohol,
+	"1-propanol":      tsonopoulos.Alcohol,
+	"1-butanol":       tsonopoulos.Alcohol,
+	"1-hexanol":       tsonopoulos.Alcohol,
+	"2-propanol":      tsonopoulos.Alcohol,
+	"chloroform":      tsonopoulos.Halide,
+	"dichloromethane": tsonopoulos.Halide,
+	"methyl chloride": tsonopoulos.Halide,
+	"ethyl chloride":  tsonopoulos.Halide,
+}
+
+// TsonopoulosParams returns the constants a and b of the polar term of the
+// Tsonopoulos correlation for s, from its class (see LookupTsonopoulosClass)
+// and its dipole moment. Substances that are not listed are of class
+// tsonopoulos.Polar (ketones, aldehydes, ethers, esters, nitriles) if they have
+// a dipole moment, and nonpolar otherwise. Unlisted associating substances,
+// such as acids and amines, return an error, as the correlation has no
+// parameters for them.
+func (s *Substance) TsonopoulosParams() (a, b float64, err error) {
+	if err := s.Require("Tsonopoulos", PropTc, PropPc); err != nil {
+		return 0, 0, err
+	}
+	class, ok := LookupTsonopoulosClass(s.Name)
+	if !ok {
+		switch {
+		case s.Associating:
+			return 0, 0, fmt.Errorf("%s: Tsonopoulos has no parameters for associating substances of unknown class", s.Name)
+		case s.Dipole != 0:
+			class = tsonopoulos.Polar
+		default:
+			class = tsonopoulos.Nonpolar
+		}
+	}
+	mur, err := tsonopoulos.ReducedDipole(s.Dipole, s.Critical.Tc, s.Critical.Pc)
+	if err != nil {
+		return 0, 0, err
+	}
+	return tsonopoulos.Params(class, mur)
+}
+
+// TsonopoulosB returns the second virial coefficient B (cm³/mol) at
+// temperature T (K) from the Tsonopoulos correlation, which, unlike the
+// Pitzer-Abbott correlation, holds for polar gases such as ketones and
+// alcohols.
+func (s *Substance) TsonopoulosB(T float64) (float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	Tr, err := s.reducedT("Tsonopoulos", T)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.Require("Tsonopoulos", PropAcentric); err != nil {
+		return 0, err
+	}
+	a, b, err := s.TsonopoulosParams()
+	if err != nil {
+		return 0, err
+	}
+	bh, err := tsonopoulos.B(Tr, s.Acentric, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return bh * R * s.Critical.Tc / s.Critical.Pc, nil
+}
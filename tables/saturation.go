@@ -0,0 +1,249 @@
+package tables
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// SatRow is the entry of a SaturationTable at one temperature.
+type SatRow struct {
+	T float64 // Temperature (K)
+	P float64 // Saturation pressure (bar)
+	// Liquid and Vapor are the saturated phases, with the residual properties of
+	// the EOS at each saturated volume.
+	Liquid, Vapor *substance.Properties
+	Hvap          float64 // Enthalpy of vaporization (J/mol)
+	// Err is set if the EOS could not be solved at T, e.g. at or above Tc. The row
+	// is then left blank.
+	Err error
+}
+
+// SaturationTable lists the saturated liquid and vapor of a substance along the
+// vapor pressure curve of a cubic EOS, in the layout of saturated steam tables.
+type SaturationTable struct {
+	Substance *substance.Substance
+	EOS       cubic.EOSType
+	Rows      []SatRow
+	// Cp is the ideal-gas heat capacity used for S, measured from the ideal gas
+	// at substance.TRef and substance.PRef. If nil, the residual entropies S^R are
+	// written instead. Hvap does not depend on it.
+	Cp *cp.HeatCapacity
+	// Basis selects molar or mass units for V, H and S. Saturation sets it from
+	// zfactor.Defaults.
+	Basis zfactor.Basis
+	// Precision is the number of significant digits of written values. Defaults to 5.
+	Precision int
+}
+
+// Saturation evaluates the saturated states of s with the cubic EOS eos at the
+// temperatures (K) in T. Psat, Vl and Vv come from the equal-fugacity condition
+// of the EOS (see cubic.Saturation), and the enthalpy of vaporization from the
+// difference of the residual enthalpies of the two phases, so that the table is
+// consistent with the EOS throughout.
+//
+// Temperatures at which the EOS has no two-phase region, such as T >= Tc, are
+// kept as rows with an error.
+func Saturation(s *substance.Substance, eos cubic.EOSType, T Range) (*SaturationTable, error) {
+	if s == nil {
+		return nil, errors.New("substance cannot be nil")
+	}
+	if eos == nil {
+		return nil, errors.New("EOS cannot be nil")
+	}
+	if err := s.Require("saturation table", substance.PropTc, substance.PropPc); err != nil {
+		return nil, err
+	}
+	ts, err := T.Values()
+	if err != nil {
+		return nil, fmt.Errorf("temperature: %w", err)
+	}
+	if ts[0] <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+
+	rows := make([]SatRow, len(ts))
+	for i, t := range ts {
+		rows[i] = saturatedRow(s, eos, t)
+	}
+	return &SaturationTable{Substance: s, EOS: eos, Rows: rows, Basis: zfactor.Defaults().Basis}, nil
+}
+
+// saturatedRow evaluates the saturated liquid and vapor of s at temperature T.
+func saturatedRow(s *substance.Substance, eos cubic.EOSType, T float64) SatRow {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	row := SatRow{T: T}
+	cfg := s.CubicConfig(eos, zfactor.Args{T: T, P: s.Critical.Pc, R: R})
+	sat, err := cubic.Saturation(cfg, T)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	cfg.P = sat.P
+	row.P = sat.P
+
+	state := func(V float64, ph substance.Phase) (*substance.Properties, error) {
+		h, err := cubic.ResidualHelmholtz(cfg, V)
+		if err != nil {
+			return nil, err
+		}
+		props := &substance.Properties{
+			T:     T,
+			P:     sat.P,
+			Z:     sat.P * V / (R * T),
+			V:     V,
+			MW:    s.MW,
+			HR:    0.1 * h.ResidualEnthalpy(), // bar*cm^3/mol -> J/mol
+			SR:    0.1 * h.ResidualEntropy(),
+			Phase: ph,
+			Correlations: substance.Correlations{
+				Z:        cubic.Name(eos),
+				Residual: cubic.Name(eos),
+				Phase:    "saturation",
+			},
+		}
+		if s.MW > 0 {
+			props.Density = s.MW / V * 1000 // g/cm^3 -> kg/m^3
+			props.SpecificVolume = 1 / props.Density
+		}
+		return props, nil
+	}
+	if row.Liquid, row.Err = state(sat.Vl, substance.Liquid); row.Err != nil {
+		return row
+	}
+	if row.Vapor, row.Err = state(sat.Vv, substance.Vapor); row.Err != nil {
+		return row
+	}
+	row.Hvap = row.Vapor.HR - row.Liquid.HR
+	return row
+}
+
+// satColumn is a property written for every row of a SaturationTable.
+type satColumn struct {
+	name  string
+	value func(r SatRow) (string, error)
+}
+
+// columns returns the property columns of the table.
+func (t *SaturationTable) columns() []satColumn {
+	prec := t.Precision
+	if prec <= 0 {
+		prec = 5
+	}
+	num := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', prec, 64)
+	}
+	b := t.Basis
+	mw := t.Substance.MW
+
+	volume := func(p *substance.Properties) (string, error) {
+		v, err := b.Volume(p.V, mw)
+		return num(v), err
+	}
+	entropy := func(p *substance.Properties) (string, error) {
+		v := p.SR
+		if t.Cp != nil {
+			var err error
+			if v, err = p.Entropy(t.Cp); err != nil {
+				return "", err
+			}
+		}
+		v, err := b.Entropy(v, mw)
+		return num(v), err
+	}
+
+	s := "S^R"
+	if t.Cp != nil {
+		s = "S"
+	}
+	return []satColumn{
+		{"Psat (bar)", func(r SatRow) (string, error) { return num(r.P), nil }},
+		{fmt.Sprintf("Vl (%s)", b.VolumeUnit()), func(r SatRow) (string, error) { return volume(r.Liquid) }},
+		{fmt.Sprintf("Vv (%s)", b.VolumeUnit()), func(r SatRow) (string, error) { return volume(r.Vapor) }},
+		{fmt.Sprintf("Hvap (%s)", b.EnergyUnit()), func(r SatRow) (string, error) {
+			v, err := b.Energy(r.Hvap, mw)
+			return num(v), err
+		}},
+		{fmt.Sprintf("%sl (%s)", s, b.EntropyUnit()), func(r SatRow) (string, error) { return entropy(r.Liquid) }},
+		{fmt.Sprintf("%sv (%s)", s, b.EntropyUnit()), func(r SatRow) (string, error) { return entropy(r.Vapor) }},
+	}
+}
+
+// values returns the formatted properties of a row, blank where they cannot be
+// computed.
+func (r SatRow) values(cols []satColumn, blank string) []string {
+	out := make([]string, len(cols))
+	for k, col := range cols {
+		out[k] = blank
+		if r.Err != nil || r.Liquid == nil || r.Vapor == nil {
+			continue
+		}
+		if v, err := col.value(r); err == nil {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// header returns the header of the table.
+func (t *SaturationTable) header(cols []satColumn) []string {
+	header := []string{"T (K)"}
+	for _, c := range cols {
+		header = append(header, c.name)
+	}
+	return header
+}
+
+// CSV writes the table with one record per temperature.
+func (t *SaturationTable) CSV(w io.Writer) error {
+	cols := t.columns()
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.header(cols)); err != nil {
+		return err
+	}
+	for _, r := range t.Rows {
+		rec := append([]string{strconv.FormatFloat(r.T, 'g', -1, 64)}, r.values(cols, "")...)
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Markdown writes the table as a Markdown table with a row per temperature.
+func (t *SaturationTable) Markdown(w io.Writer) error {
+	cols := t.columns()
+	header := t.header(cols)
+	sep := make([]string, len(header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Saturated %s (%s)\n\n", t.Substance.Name, cubic.Name(t.EOS))
+	b.WriteString(mdRow(header) + "\n" + mdRow(sep) + "\n")
+	for _, r := range t.Rows {
+		row := append([]string{strconv.FormatFloat(r.T, 'g', -1, 64)}, r.values(cols, "—")...)
+		b.WriteString(mdRow(row) + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// String returns the table in Markdown.
+func (t *SaturationTable) String() string {
+	var b strings.Builder
+	if err := t.Markdown(&b); err != nil {
+		return fmt.Sprintf("tables: %v", err)
+	}
+	return b.String()
+}
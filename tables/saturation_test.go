@@ -0,0 +1,87 @@
+package tables_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/tables"
+)
+
+func TestSaturation(t *testing.T) {
+	tbl, err := tables.Saturation(substance.Propane, &cubic.PR{}, tables.Range{Min: 250, Max: 370, Step: 40})
+	if err != nil {
+		t.Fatalf("Saturation() unexpected error: %v", err)
+	}
+	if len(tbl.Rows) != 4 {
+		t.Fatalf("Saturation() got %d rows, want 4", len(tbl.Rows))
+	}
+	// Propane has Tc = 369.8 K.
+	if err := tbl.Rows[3].Err; err == nil {
+		t.Error("Saturation() row above Tc has no error")
+	}
+	for _, r := range tbl.Rows[:3] {
+		if r.Err != nil {
+			t.Fatalf("Saturation() row at %g K error: %v", r.T, r.Err)
+		}
+		if r.Liquid.V >= r.Vapor.V {
+			t.Errorf("Saturation() at %g K: Vl = %g, want less than Vv = %g", r.T, r.Liquid.V, r.Vapor.V)
+		}
+		// Equal Gibbs energies of the phases give Hvap = T(Sv - Sl).
+		if ds := r.Vapor.SR - r.Liquid.SR; math.Abs(r.Hvap/r.T-ds) > 1e-4*ds {
+			t.Errorf("Saturation() at %g K: Hvap/T = %g, want Sv - Sl = %g", r.T, r.Hvap/r.T, ds)
+		}
+	}
+	// Propane has Psat ≈ 7.7 bar at 290 K.
+	if p := tbl.Rows[1].P; math.Abs(p-7.7) > 0.3 {
+		t.Errorf("Saturation() Psat at 290 K = %g, want about 7.7 bar", p)
+	}
+
+	var b strings.Builder
+	if err := tbl.CSV(&b); err != nil {
+		t.Fatalf("CSV() unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV() output is not valid CSV: %v", err)
+	}
+	if len(records) != 5 {
+		t.Fatalf("CSV() got %d records, want 5", len(records))
+	}
+	if want := "T (K),Psat (bar),Vl (cm³/mol),Vv (cm³/mol),Hvap (J/mol),S^Rl (J/(mol·K)),S^Rv (J/(mol·K))"; strings.Join(records[0], ",") != want {
+		t.Errorf("CSV() header = %q, want %q", strings.Join(records[0], ","), want)
+	}
+	if last := records[4]; last[0] != "370" || last[1] != "" {
+		t.Errorf("CSV() record above Tc = %q, want blank properties", last)
+	}
+
+	b.Reset()
+	tbl.Basis = zfactor.MassBasis
+	if err := tbl.Markdown(&b); err != nil {
+		t.Fatalf("Markdown() unexpected error: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{"## Saturated Propane (PR)", "| T (K) | Psat (bar) | Vl (m³/kg) | Vv (m³/kg) | Hvap (kJ/kg) |", "| 370 | — |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Markdown() output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestSaturationErrors(t *testing.T) {
+	r := tables.Range{Min: 300}
+	if _, err := tables.Saturation(nil, &cubic.PR{}, r); err == nil {
+		t.Error("Saturation() with nil substance, want error")
+	}
+	if _, err := tables.Saturation(substance.Propane, nil, r); err == nil {
+		t.Error("Saturation() with nil EOS, want error")
+	}
+	if _, err := tables.Saturation(substance.Propane, &cubic.PR{}, tables.Range{Min: 0}); !errors.Is(err, zfactor.ErrTemp) {
+		t.Errorf("Saturation() error = %v, want %v", err, zfactor.ErrTemp)
+	}
+}
@@ -0,0 +1,47 @@
+package tables
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// ErrNotSuperheated is the error of a Superheated cell at or above the
+// saturation pressure of the EOS, i.e. in the liquid or two-phase region.
+var ErrNotSuperheated = errors.New("state is not superheated vapor")
+
+// Superheated evaluates V, H and S of s with the cubic EOS eos at every
+// combination of the temperatures (K) in T and the pressures (bar) in P, in the
+// layout of the superheated vapor tables of textbook appendices.
+//
+// Below Tc, states at or above the saturation pressure of the EOS are excluded:
+// their cells are kept with ErrNotSuperheated and left blank. Supercritical
+// temperatures are tabulated at every pressure.
+func Superheated(s *substance.Substance, eos cubic.EOSType, T, P Range) (*Table, error) {
+	if eos == nil {
+		return nil, errors.New("EOS cannot be nil")
+	}
+	tbl, err := Generate(s, substance.CubicProvider{EOS: eos}, T, P)
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range tbl.T {
+		if t >= s.Critical.Tc {
+			continue
+		}
+		cfg := s.CubicConfig(eos, zfactor.Args{T: t, P: s.Critical.Pc, R: zfactor.RSI * 10})
+		pSat, err := cubic.SaturationPressure(cfg, t)
+		for j, p := range tbl.P {
+			switch {
+			case err != nil:
+				tbl.Cells[i][j] = Cell{Err: fmt.Errorf("saturation pressure: %w", err)}
+			case p >= pSat:
+				tbl.Cells[i][j] = Cell{Err: ErrNotSuperheated}
+			}
+		}
+	}
+	return tbl, nil
+}
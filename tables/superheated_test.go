@@ -0,0 +1,52 @@
+package tables_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/tables"
+)
+
+func TestSuperheated(t *testing.T) {
+	// Propane has Psat ≈ 10 bar at 300 K and Tc = 369.8 K.
+	tbl, err := tables.Superheated(substance.Propane, &cubic.PR{},
+		tables.Range{Min: 300, Max: 400, Step: 50}, tables.Range{Min: 5, Max: 25, Step: 10})
+	if err != nil {
+		t.Fatalf("Superheated() unexpected error: %v", err)
+	}
+	tests := []struct {
+		name    string
+		i, j    int
+		wantErr error
+	}{
+		{"superheated", 0, 0, nil},
+		{"compressed liquid", 0, 1, tables.ErrNotSuperheated},
+		{"compressed liquid", 0, 2, tables.ErrNotSuperheated},
+		{"superheated", 1, 2, nil},
+		{"supercritical", 2, 2, nil},
+	}
+	for _, tt := range tests {
+		c := tbl.Cells[tt.i][tt.j]
+		if !errors.Is(c.Err, tt.wantErr) {
+			t.Errorf("Superheated() %s cell at %g K, %g bar error = %v, want %v", tt.name, tbl.T[tt.i], tbl.P[tt.j], c.Err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr == nil && c.Props.Phase == substance.Liquid {
+			t.Errorf("Superheated() %s cell at %g K, %g bar phase = %v", tt.name, tbl.T[tt.i], tbl.P[tt.j], c.Props.Phase)
+		}
+	}
+
+	out := tbl.String()
+	for _, want := range []string{"## Propane (PR)", "### P = 15 bar", "| 300 | — |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Markdown() output missing %q:\n%s", want, out)
+		}
+	}
+
+	if _, err := tables.Superheated(substance.Propane, nil, tables.Range{Min: 300}, tables.Range{Min: 1}); err == nil {
+		t.Error("Superheated() with nil EOS, want error")
+	}
+}
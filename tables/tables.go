@@ -0,0 +1,248 @@
+// Package tables generates grids of thermodynamic properties of a substance over
+// ranges of temperature and pressure, such as superheated-vapor tables in the
+// style of steam tables, and of its saturated liquid and vapor along the vapor
+// pressure curve, and writes them as CSV or Markdown.
+package tables
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Range is an evenly spaced sequence of values from Min to Max, both included.
+type Range struct {
+	Min, Max float64
+	// Step is the spacing of the values. If 0, the range holds Min only; Max
+	// may then be left out.
+	Step float64
+}
+
+// Values returns the values of the range. The last value is Max even when the
+// range is not a whole number of steps.
+func (r Range) Values() ([]float64, error) {
+	if r.Step == 0 || r.Max == r.Min {
+		return []float64{r.Min}, nil
+	}
+	if r.Step < 0 || r.Max < r.Min {
+		return nil, errors.New("range must be increasing with a non-negative step")
+	}
+	n := int(math.Floor((r.Max-r.Min)/r.Step + 1e-9))
+	values := make([]float64, 0, n+2)
+	for i := 0; i <= n; i++ {
+		values = append(values, r.Min+float64(i)*r.Step)
+	}
+	if last := values[len(values)-1]; r.Max-last > 1e-9*r.Step {
+		values = append(values, r.Max)
+	}
+	return values, nil
+}
+
+// Cell is the entry of a Table at one temperature and pressure.
+type Cell struct {
+	Props *substance.Properties
+	// Err is set if the provider could not evaluate the state, e.g. outside the
+	// validity range of its correlation. The cell is then left blank.
+	Err error
+}
+
+// Table is a grid of properties of a substance, with a row per temperature and
+// a column per pressure.
+type Table struct {
+	Substance *substance.Substance
+	Provider  substance.Provider
+	T         []float64 // Temperatures (K)
+	P         []float64 // Pressures (bar)
+	Cells     [][]Cell  // Cells[i][j] is the state at T[i] and P[j]
+	// Cp is the ideal-gas heat capacity used for H and S, measured from the ideal
+	// gas at substance.TRef and substance.PRef. If nil, the residual properties
+	// H^R and S^R are written instead.
+	Cp *cp.HeatCapacity
+	// Basis selects molar or mass units for V, H and S. Generate sets it from
+	// zfactor.Defaults.
+	Basis zfactor.Basis
+	// Precision is the number of significant digits of written values. Defaults to 5.
+	Precision int
+}
+
+// Generate evaluates the properties of s with provider p at every combination of
+// the temperatures (K) in T and the pressures (bar) in P.
+//
+// States the provider cannot evaluate are kept as cells with an error, so that a
+// table can span the validity limits of a correlation.
+func Generate(s *substance.Substance, p substance.Provider, T, P Range) (*Table, error) {
+	if s == nil {
+		return nil, errors.New("substance cannot be nil")
+	}
+	if p == nil {
+		return nil, errors.New("provider cannot be nil")
+	}
+	ts, err := T.Values()
+	if err != nil {
+		return nil, fmt.Errorf("temperature: %w", err)
+	}
+	ps, err := P.Values()
+	if err != nil {
+		return nil, fmt.Errorf("pressure: %w", err)
+	}
+	if ts[0] <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if ps[0] <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+
+	cells := make([][]Cell, len(ts))
+	for i, t := range ts {
+		cells[i] = make([]Cell, len(ps))
+		for j, pr := range ps {
+			props, err := s.PropertiesAt(t, pr, p)
+			cells[i][j] = Cell{Props: props, Err: err}
+		}
+	}
+	return &Table{Substance: s, Provider: p, T: ts, P: ps, Cells: cells, Basis: zfactor.Defaults().Basis}, nil
+}
+
+// column is a property written for every cell.
+type column struct {
+	name  string
+	value func(props *substance.Properties) (string, error)
+}
+
+// columns returns the property columns of the table.
+func (t *Table) columns() []column {
+	prec := t.Precision
+	if prec <= 0 {
+		prec = 5
+	}
+	num := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', prec, 64)
+	}
+	b := t.Basis
+	mw := t.Substance.MW
+
+	h, s := "H^R", "S^R"
+	if t.Cp != nil {
+		h, s = "H", "S"
+	}
+	return []column{
+		{"Z", func(p *substance.Properties) (string, error) { return num(p.Z), nil }},
+		{fmt.Sprintf("V (%s)", b.VolumeUnit()), func(p *substance.Properties) (string, error) {
+			v, err := b.Volume(p.V, mw)
+			return num(v), err
+		}},
+		{fmt.Sprintf("%s (%s)", h, b.EnergyUnit()), func(p *substance.Properties) (string, error) {
+			v := p.HR
+			if t.Cp != nil {
+				var err error
+				if v, err = p.Enthalpy(t.Cp); err != nil {
+					return "", err
+				}
+			}
+			v, err := b.Energy(v, mw)
+			return num(v), err
+		}},
+		{fmt.Sprintf("%s (%s)", s, b.EntropyUnit()), func(p *substance.Properties) (string, error) {
+			v := p.SR
+			if t.Cp != nil {
+				var err error
+				if v, err = p.Entropy(t.Cp); err != nil {
+					return "", err
+				}
+			}
+			v, err := b.Entropy(v, mw)
+			return num(v), err
+		}},
+		{"Phase", func(p *substance.Properties) (string, error) { return p.Phase.String(), nil }},
+	}
+}
+
+// values returns the formatted properties of a cell, blank where they cannot be
+// computed.
+func (c Cell) values(cols []column, blank string) []string {
+	out := make([]string, len(cols))
+	for k, col := range cols {
+		out[k] = blank
+		if c.Err != nil || c.Props == nil {
+			continue
+		}
+		if v, err := col.value(c.Props); err == nil {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// CSV writes the table in long form, with one record per state: T (K), P (bar)
+// and the properties.
+func (t *Table) CSV(w io.Writer) error {
+	cols := t.columns()
+	header := []string{"T (K)", "P (bar)"}
+	for _, c := range cols {
+		header = append(header, c.name)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for j, p := range t.P {
+		for i, T := range t.T {
+			rec := []string{strconv.FormatFloat(T, 'g', -1, 64), strconv.FormatFloat(p, 'g', -1, 64)}
+			rec = append(rec, t.Cells[i][j].values(cols, "")...)
+			if err := cw.Write(rec); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Markdown writes the table as one Markdown table per pressure, with a row per
+// temperature, in the layout of superheated steam tables.
+func (t *Table) Markdown(w io.Writer) error {
+	cols := t.columns()
+	header := []string{"T (K)"}
+	for _, c := range cols {
+		header = append(header, c.name)
+	}
+	sep := make([]string, len(header))
+	for i := range sep {
+		sep[i] = "---"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s (%s)\n", t.Substance.Name, t.Provider.Name())
+	for j, p := range t.P {
+		fmt.Fprintf(&b, "\n### P = %g bar\n\n", p)
+		b.WriteString(mdRow(header) + "\n" + mdRow(sep) + "\n")
+		for i, T := range t.T {
+			row := append([]string{strconv.FormatFloat(T, 'g', -1, 64)}, t.Cells[i][j].values(cols, "—")...)
+			b.WriteString(mdRow(row) + "\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// String returns the table in Markdown.
+func (t *Table) String() string {
+	var b strings.Builder
+	if err := t.Markdown(&b); err != nil {
+		return fmt.Sprintf("tables: %v", err)
+	}
+	return b.String()
+}
+
+// mdRow formats a Markdown table row.
+func mdRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
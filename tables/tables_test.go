@@ -0,0 +1,106 @@
+package tables_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/tables"
+)
+
+func TestRangeValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       tables.Range
+		want    []float64
+		wantErr bool
+	}{
+		{"single", tables.Range{Min: 300}, []float64{300}, false},
+		{"whole steps", tables.Range{Min: 300, Max: 400, Step: 50}, []float64{300, 350, 400}, false},
+		{"partial step", tables.Range{Min: 300, Max: 400, Step: 40}, []float64{300, 340, 380, 400}, false},
+		{"decreasing", tables.Range{Min: 400, Max: 300, Step: 10}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.Values()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Values() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Values() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Values() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tbl, err := tables.Generate(substance.Propane, substance.AbbottProvider{},
+		tables.Range{Min: 350, Max: 450, Step: 50}, tables.Range{Min: 5, Max: 25, Step: 10})
+	if err != nil {
+		t.Fatalf("Generate() unexpected error: %v", err)
+	}
+	if len(tbl.Cells) != 3 || len(tbl.Cells[0]) != 3 {
+		t.Fatalf("Generate() grid = %dx%d, want 3x3", len(tbl.Cells), len(tbl.Cells[0]))
+	}
+	// The two-term virial equation rejects 25 bar.
+	if err := tbl.Cells[0][2].Err; !errors.Is(err, zfactor.ErrHighPressureTwoTerm) {
+		t.Errorf("Generate() cell at 25 bar error = %v, want %v", err, zfactor.ErrHighPressureTwoTerm)
+	}
+	if c := tbl.Cells[1][0]; c.Err != nil || c.Props.T != 400 || c.Props.P != 5 {
+		t.Errorf("Generate() cell[1][0] = %+v, want 400 K and 5 bar", c)
+	}
+
+	tbl.Cp = cp.PropaneGas
+	var b strings.Builder
+	if err := tbl.CSV(&b); err != nil {
+		t.Fatalf("CSV() unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV() output is not valid CSV: %v", err)
+	}
+	if len(records) != 10 {
+		t.Fatalf("CSV() got %d records, want 10", len(records))
+	}
+	if want := "T (K),P (bar),Z,V (cm³/mol),H (J/mol),S (J/(mol·K)),Phase"; strings.Join(records[0], ",") != want {
+		t.Errorf("CSV() header = %q, want %q", strings.Join(records[0], ","), want)
+	}
+	if last := records[9]; last[1] != "25" || last[2] != "" {
+		t.Errorf("CSV() record at 25 bar = %q, want blank properties", last)
+	}
+
+	b.Reset()
+	tbl.Basis = zfactor.MassBasis
+	if err := tbl.Markdown(&b); err != nil {
+		t.Fatalf("Markdown() unexpected error: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{"## Propane (Virial (Abbott))", "### P = 15 bar", "| T (K) | Z | V (m³/kg) | H (kJ/kg) | S (kJ/(kg·K)) | Phase |", "| 350 |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Markdown() output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	r := tables.Range{Min: 300}
+	if _, err := tables.Generate(nil, substance.IdealGasProvider{}, r, r); err == nil {
+		t.Error("Generate() with nil substance, want error")
+	}
+	if _, err := tables.Generate(substance.Methane, nil, r, r); err == nil {
+		t.Error("Generate() with nil provider, want error")
+	}
+	if _, err := tables.Generate(substance.Methane, substance.IdealGasProvider{}, tables.Range{Min: 0}, r); !errors.Is(err, zfactor.ErrTemp) {
+		t.Errorf("Generate() error = %v, want %v", err, zfactor.ErrTemp)
+	}
+}
@@ -0,0 +1,266 @@
+// Package tsonopoulos provides the Tsonopoulos (1974) generalized correlation
+// for the second virial coefficient of nonpolar and polar gases.
+//
+// The second virial coefficient B is calculated as:
+//
+//	B * Pc / (R * Tc) = F0 + ω * F1 + F2
+//
+// where F0 and F1 are the simple fluid and acentric terms, which describe
+// normal fluids as the Pitzer-Abbott correlation does, and F2 = a/Tr^6 - b/Tr^8
+// is the polar term. The constant a accounts for the dipole moment and b for
+// hydrogen bonding; both are 0 for nonpolar fluids. Params gives them for the
+// common classes of polar compounds from the reduced dipole moment.
+package tsonopoulos
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// Class is a class of compounds with a common parameterization of the polar
+// term.
+type Class int
+
+const (
+	// Nonpolar fluids, with a = b = 0.
+	Nonpolar Class = iota
+	// Polar is the class of ketones, aldehydes, alkyl nitriles, ethers and
+	// esters, which do not hydrogen-bond:
+	//
+	//	a = -2.14e-4 μr - 4.308e-21 μr^8, b = 0
+	Polar
+	// Halide is the class of alkyl halides, mercaptans, sulfides and
+	// disulfides:
+	//
+	//	a = -2.188e-11 μr^4 - 7.831e-21 μr^8, b = 0
+	Halide
+	// Alcohol is the class of the 1-alkanols other than methanol:
+	//
+	//	a = 0.0878, b = 0.00908 + 0.0006957 μr
+	Alcohol
+	// Methanol, with a = 0.0878 and b = 0.0525.
+	Methanol
+	// Water, with a = -0.0109 and b = 0 (Tsonopoulos and Heidman, 1990).
+	Water
+)
+
+// String returns the name of the class.
+func (c Class) String() string {
+	switch c {
+	case Nonpolar:
+		return "nonpolar"
+	case Polar:
+		return "polar"
+	case Halide:
+		return "halide"
+	case Alcohol:
+		return "alcohol"
+	case Methanol:
+		return "methanol"
+	case Water:
+		return "water"
+	default:
+		return "unknown"
+	}
+}
+
+// ReducedDipole returns the reduced dipole moment of the correlation,
+//
+//	μr = 1e5 μ² Pc / Tc²
+//
+// with the dipole moment μ in debye, Pc in bar and Tc in K.
+//
+// It returns an error if Tc <= 0 or Pc <= 0.
+func ReducedDipole(mu, Tc, Pc float64) (float64, error) {
+	if Tc <= 0 || Pc <= 0 {
+		return 0, zfactor.ErrCriticalProp
+	}
+	return 1e5 * mu * mu * Pc / (Tc * Tc), nil
+}
+
+// Params returns the constants a and b of the polar term for a compound of
+// class c with reduced dipole moment mur (see ReducedDipole).
+//
+// It returns an error if c is not a known class.
+func Params(c Class, mur float64) (a, b float64, err error) {
+	switch c {
+	case Nonpolar:
+		return 0, 0, nil
+	case Polar:
+		return -2.14e-4*mur - 4.308e-21*math.Pow(mur, 8), 0, nil
+	case Halide:
+		return -2.188e-11*math.Pow(mur, 4) - 7.831e-21*math.Pow(mur, 8), 0, nil
+	case Alcohol:
+		return 0.0878, 0.00908 + 0.0006957*mur, nil
+	case Methanol:
+		return 0.0878, 0.0525, nil
+	case Water:
+		return -0.0109, 0, nil
+	default:
+		return 0, 0, fmt.Errorf("tsonopoulos: unknown class %d", int(c))
+	}
+}
+
+// F0 calculates the simple fluid contribution to the second virial coefficient.
+//
+//	F0 = 0.1445 - 0.330/Tr - 0.1385/Tr^2 - 0.0121/Tr^3 - 0.000607/Tr^8
+//
+// It returns an error if Tr <= 0.
+func F0(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return 0.1445 - 0.330/Tr - 0.1385/(Tr*Tr) - 0.0121/math.Pow(Tr, 3) - 0.000607/math.Pow(Tr, 8), nil
+}
+
+// F1 calculates the correction term for the second virial coefficient based on the acentric factor.
+//
+//	F1 = 0.0637 + 0.331/Tr^2 - 0.423/Tr^3 - 0.008/Tr^8
+//
+// It returns an error if Tr <= 0.
+func F1(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return 0.0637 + 0.331/(Tr*Tr) - 0.423/math.Pow(Tr, 3) - 0.008/math.Pow(Tr, 8), nil
+}
+
+// F2 calculates the polar term of the second virial coefficient.
+//
+//	F2 = a/Tr^6 - b/Tr^8
+//
+// It returns an error if Tr <= 0.
+func F2(Tr, a, b float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return a/math.Pow(Tr, 6) - b/math.Pow(Tr, 8), nil
+}
+
+// DF0 calculates the first derivative of F0 with respect to reduced temperature (Tr).
+//
+//	dF0/dTr = 0.330/Tr^2 + 0.277/Tr^3 + 0.0363/Tr^4 + 0.004856/Tr^9
+//
+// It returns an error if Tr <= 0.
+func DF0(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return 0.330/(Tr*Tr) + 0.277/math.Pow(Tr, 3) + 0.0363/math.Pow(Tr, 4) + 0.004856/math.Pow(Tr, 9), nil
+}
+
+// DF1 calculates the first derivative of F1 with respect to reduced temperature (Tr).
+//
+//	dF1/dTr = -0.662/Tr^3 + 1.269/Tr^4 + 0.064/Tr^9
+//
+// It returns an error if Tr <= 0.
+func DF1(Tr float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return -0.662/math.Pow(Tr, 3) + 1.269/math.Pow(Tr, 4) + 0.064/math.Pow(Tr, 9), nil
+}
+
+// DF2 calculates the first derivative of F2 with respect to reduced temperature (Tr).
+//
+//	dF2/dTr = -6a/Tr^7 + 8b/Tr^9
+//
+// It returns an error if Tr <= 0.
+func DF2(Tr, a, b float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+
+	return -6*a/math.Pow(Tr, 7) + 8*b/math.Pow(Tr, 9), nil
+}
+
+// B calculates the reduced second virial coefficient B Pc / (R Tc) of a fluid
+// with acentric factor ω and polar constants a and b.
+//
+// It returns an error if Tr <= 0.
+func B(Tr, acentric, a, b float64) (float64, error) {
+	f0, err := F0(Tr)
+	if err != nil {
+		return 0, err
+	}
+	f1, err := F1(Tr)
+	if err != nil {
+		return 0, err
+	}
+	f2, err := F2(Tr, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return f0 + acentric*f1 + f2, nil
+}
+
+// DB calculates the first derivative of the reduced second virial coefficient
+// with respect to reduced temperature (Tr).
+//
+// It returns an error if Tr <= 0.
+func DB(Tr, acentric, a, b float64) (float64, error) {
+	d0, err := DF0(Tr)
+	if err != nil {
+		return 0, err
+	}
+	d1, err := DF1(Tr)
+	if err != nil {
+		return 0, err
+	}
+	d2, err := DF2(Tr, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return d0 + acentric*d1 + d2, nil
+}
+
+// ResidualEnthalpy calculates the dimensionless residual enthalpy H^R / (R * Tc)
+// from the two-term virial equation with the Tsonopoulos coefficient.
+//
+//	H^R / (R * Tc) = Pr * [ B̂ - Tr * dB̂/dTr ]
+//
+// where B̂ = B Pc / (R Tc). It returns an error if Tr <= 0 or Pr <= 0.
+func ResidualEnthalpy(Tr, Pr, acentric, a, b float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Pr <= 0 {
+		return 0, zfactor.ErrInvalidPr
+	}
+	bh, err := B(Tr, acentric, a, b)
+	if err != nil {
+		return 0, err
+	}
+	db, err := DB(Tr, acentric, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return Pr * (bh - Tr*db), nil
+}
+
+// ResidualEntropy calculates the dimensionless residual entropy S^R / R
+// from the two-term virial equation with the Tsonopoulos coefficient.
+//
+//	S^R / R = -Pr * dB̂/dTr
+//
+// It returns an error if Tr <= 0 or Pr <= 0.
+func ResidualEntropy(Tr, Pr, acentric, a, b float64) (float64, error) {
+	if Tr <= 0 {
+		return 0, zfactor.ErrInvalidTr
+	}
+	if Pr <= 0 {
+		return 0, zfactor.ErrInvalidPr
+	}
+	db, err := DB(Tr, acentric, a, b)
+	if err != nil {
+		return 0, err
+	}
+	return -Pr * db, nil
+}
@@ -0,0 +1,98 @@
+package tsonopoulos
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+)
+
+func TestB(t *testing.T) {
+	tests := []struct {
+		name    string
+		tr, w   float64
+		a, b    float64
+		want    float64
+		wantErr error
+	}{
+		{"Nonpolar Tr=1", 1.0, 0, 0, 0, 0.1445 - 0.330 - 0.1385 - 0.0121 - 0.000607, nil},
+		{"Acentric Tr=1", 1.0, 0.2, 0, 0, 0.1445 - 0.330 - 0.1385 - 0.0121 - 0.000607 + 0.2*(0.0637+0.331-0.423-0.008), nil},
+		{"Polar Tr=2", 2.0, 0, 0.05, 0.01, 0.1445 - 0.165 - 0.1385/4 - 0.0121/8 - 0.000607/256 + 0.05/64 - 0.01/256, nil},
+		{"Invalid Tr=0", 0.0, 0, 0, 0, 0, zfactor.ErrInvalidTr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := B(tt.tr, tt.w, tt.a, tt.b)
+			if err != tt.wantErr {
+				t.Errorf("B() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("B() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDB(t *testing.T) {
+	const h = 1e-6
+	for _, tr := range []float64{0.6, 1.0, 2.5} {
+		want := (mustB(t, tr+h) - mustB(t, tr-h)) / (2 * h)
+		got, err := DB(tr, 0.3, -0.03, 0.05)
+		if err != nil {
+			t.Fatalf("DB() unexpected error: %v", err)
+		}
+		if math.Abs(got-want) > 1e-6*math.Max(1, math.Abs(want)) {
+			t.Errorf("DB(%v) = %v, want %v", tr, got, want)
+		}
+	}
+}
+
+func mustB(t *testing.T, tr float64) float64 {
+	t.Helper()
+	b, err := B(tr, 0.3, -0.03, 0.05)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestParams(t *testing.T) {
+	tests := []struct {
+		class Class
+		mur   float64
+		wantA float64
+		wantB float64
+	}{
+		{Nonpolar, 10, 0, 0},
+		{Polar, 100, -2.14e-2 - 4.308e-5, 0},
+		{Halide, 100, -2.188e-3 - 7.831e-5, 0},
+		{Alcohol, 100, 0.0878, 0.00908 + 0.06957},
+		{Methanol, 100, 0.0878, 0.0525},
+		{Water, 100, -0.0109, 0},
+	}
+	for _, tt := range tests {
+		a, b, err := Params(tt.class, tt.mur)
+		if err != nil {
+			t.Fatalf("Params(%v) unexpected error: %v", tt.class, err)
+		}
+		if math.Abs(a-tt.wantA) > 1e-12 || math.Abs(b-tt.wantB) > 1e-12 {
+			t.Errorf("Params(%v) = %v, %v, want %v, %v", tt.class, a, b, tt.wantA, tt.wantB)
+		}
+	}
+	if _, _, err := Params(Class(99), 0); err == nil {
+		t.Error("Params() of an unknown class expected error, got nil")
+	}
+}
+
+func TestResidualEntropy(t *testing.T) {
+	db, _ := DB(1.2, 0.1, 0, 0)
+	got, err := ResidualEntropy(1.2, 0.5, 0.1, 0, 0)
+	if err != nil || math.Abs(got+0.5*db) > 1e-12 {
+		t.Errorf("ResidualEntropy() = %v, %v, want %v", got, err, -0.5*db)
+	}
+	if _, err := ResidualEnthalpy(1.2, 0, 0.1, 0, 0); err != zfactor.ErrInvalidPr {
+		t.Errorf("ResidualEnthalpy() error = %v, want %v", err, zfactor.ErrInvalidPr)
+	}
+}
@@ -0,0 +1,75 @@
+package unitops
+
+import (
+	"fmt"
+
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/vle/flash"
+)
+
+// FlashResult contains the products of a flash drum.
+type FlashResult struct {
+	Vapor         *flowsheet.Stream // Vapor product
+	Liquid        *flowsheet.Stream // Liquid product
+	VaporFraction float64           // Molar vapor fraction V/F
+	Duty          float64           // Heat added to bring the feed to the drum T and P (W)
+}
+
+// FlashDrum brings the feed to temperature T (K) and pressure P (bar) and
+// separates it into equilibrium vapor and liquid products using a PT flash.
+//
+// The products satisfy the component material balances exactly. The duty closes
+// the energy balance,
+//
+//	Q = V·H_V + L·H_L - F·H_F
+//
+// with the phase enthalpies evaluated by p. A product that is absent has zero flow.
+func FlashDrum(p flowsheet.Provider, feed *flowsheet.Stream, T, P float64) (*FlashResult, error) {
+	n := len(feed.Components)
+	species := make([]*substance.Substance, n)
+	z := make([]float64, n)
+	for i, c := range feed.Components {
+		species[i] = c.Substance
+		z[i] = c.Fraction
+	}
+
+	fl, err := flash.PT(species, z, T, P)
+	if err != nil {
+		return nil, fmt.Errorf("flash: %w", err)
+	}
+
+	vapor := product(feed, fl.Y, T, P, fl.VaporFraction*feed.Flow, "vapor")
+	liquid := product(feed, fl.X, T, P, (1-fl.VaporFraction)*feed.Flow, "liquid")
+
+	var outlets []*flowsheet.Stream
+	for _, s := range []*flowsheet.Stream{vapor, liquid} {
+		if s.Flow > 0 {
+			outlets = append(outlets, s)
+		}
+	}
+	duty, err := flowsheet.EnthalpyBalance(p, []*flowsheet.Stream{feed}, outlets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FlashResult{
+		Vapor:         vapor,
+		Liquid:        liquid,
+		VaporFraction: fl.VaporFraction,
+		Duty:          duty,
+	}, nil
+}
+
+// product builds a flash product with the feed species and the given mole fractions.
+func product(feed *flowsheet.Stream, fractions []float64, T, P, flow float64, phase string) *flowsheet.Stream {
+	s := feed.At(T, P)
+	for i := range s.Components {
+		s.Components[i].Fraction = fractions[i]
+	}
+	s.Flow = flow
+	if feed.Name != "" {
+		s.Name = feed.Name + " " + phase
+	}
+	return s
+}
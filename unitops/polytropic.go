@@ -0,0 +1,133 @@
+package unitops
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// PolytropicResult contains the performance of a compressor evaluated from its
+// measured suction and discharge conditions. Heads are per unit mass in kJ/kg.
+type PolytropicResult struct {
+	ZSuction, ZDischarge float64 // Compressibility factors
+	// KSuction and KDischarge are the real-gas isentropic exponents
+	// k = -(v/P)(∂P/∂v)_s, which reduce to Cp/Cv for an ideal gas.
+	KSuction, KDischarge float64
+	N                    float64 // Polytropic exponent of the measured path, P vⁿ = const
+	NS                   float64 // Isentropic volume exponent from suction to the discharge pressure
+	SchultzFactor        float64 // Polytropic head correction factor f
+	TIsentropic          float64 // Isentropic discharge temperature (K)
+
+	PolytropicHead float64 // Polytropic head Hp (kJ/kg)
+	IsentropicHead float64 // Isentropic head Hs = h_2s - h_1 (kJ/kg)
+	GasHead        float64 // Enthalpy rise h_2 - h_1 imparted to the gas (kJ/kg)
+
+	PolytropicEfficiency float64 // Hp / (h_2 - h_1)
+	IsentropicEfficiency float64 // Hs / (h_2 - h_1)
+	Power                float64 // Gas power Flow (h_2 - h_1) (W)
+}
+
+// PolytropicAnalysis evaluates the polytropic head and efficiency of a
+// compressor from field data: the suction stream, which gives the gas
+// composition and flow, and the measured discharge temperature T (K) and
+// pressure P (bar). Z, enthalpies and entropies are evaluated by p.
+//
+// The polytropic exponent follows from the measured end states,
+//
+//	n = ln(P_2/P_1) / ln(v_1/v_2)
+//
+// and the head from the method of Schultz (1962) of ASME PTC 10,
+//
+//	Hp = f n/(n-1) (P_2 v_2 - P_1 v_1)
+//
+// where the factor f = (h_2s - h_1) / [n_s/(n_s-1) (P_2 v_2s - P_1 v_1)] corrects
+// for the variation of the exponent along the path, with n_s the volume exponent
+// of the isentropic compression to P_2.
+func PolytropicAnalysis(p substance.Provider, suction *flowsheet.Stream, T, P float64) (*PolytropicResult, error) {
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	if P <= suction.P {
+		return nil, fmt.Errorf("discharge pressure %g bar must be above the suction pressure %g bar", P, suction.P)
+	}
+	discharge := suction.At(T, P)
+
+	h1, err := suction.Enthalpy(p)
+	if err != nil {
+		return nil, fmt.Errorf("suction: %w", err)
+	}
+	h2, err := discharge.Enthalpy(p)
+	if err != nil {
+		return nil, fmt.Errorf("discharge: %w", err)
+	}
+	if h2 <= h1 {
+		return nil, errors.New("discharge enthalpy must be above the suction enthalpy")
+	}
+	ideal, err := flowsheet.Isentropic(p, suction, P)
+	if err != nil {
+		return nil, fmt.Errorf("isentropic discharge: %w", err)
+	}
+	h2s, err := ideal.Enthalpy(p)
+	if err != nil {
+		return nil, err
+	}
+
+	z1, err := suction.Z(p)
+	if err != nil {
+		return nil, fmt.Errorf("suction: %w", err)
+	}
+	z2, err := discharge.Z(p)
+	if err != nil {
+		return nil, fmt.Errorf("discharge: %w", err)
+	}
+	z2s, err := ideal.Z(p)
+	if err != nil {
+		return nil, err
+	}
+	k1, err := flowsheet.IsentropicExponent(p, suction)
+	if err != nil {
+		return nil, fmt.Errorf("suction: %w", err)
+	}
+	k2, err := flowsheet.IsentropicExponent(p, discharge)
+	if err != nil {
+		return nil, fmt.Errorf("discharge: %w", err)
+	}
+
+	// P v = Z R T (J/mol).
+	pv1 := z1 * zfactor.RSI * suction.T
+	pv2 := z2 * zfactor.RSI * T
+	pv2s := z2s * zfactor.RSI * ideal.T
+	lnP := math.Log(P / suction.P)
+	n := lnP / math.Log(pv1/pv2*P/suction.P)
+	ns := lnP / math.Log(pv1/pv2s*P/suction.P)
+	f := (h2s - h1) / (ns / (ns - 1) * (pv2s - pv1))
+	hp := f * n / (n - 1) * (pv2 - pv1)
+
+	// Heads per unit mass; Energy rejects streams without a molar mass.
+	var heads [3]float64
+	for i, h := range []float64{hp, h2s - h1, h2 - h1} {
+		if heads[i], err = zfactor.MassBasis.Energy(h, suction.MW()); err != nil {
+			return nil, err
+		}
+	}
+	return &PolytropicResult{
+		ZSuction:             z1,
+		ZDischarge:           z2,
+		KSuction:             k1,
+		KDischarge:           k2,
+		N:                    n,
+		NS:                   ns,
+		SchultzFactor:        f,
+		TIsentropic:          ideal.T,
+		PolytropicHead:       heads[0],
+		IsentropicHead:       heads[1],
+		GasHead:              heads[2],
+		PolytropicEfficiency: hp / (h2 - h1),
+		IsentropicEfficiency: (h2s - h1) / (h2 - h1),
+		Power:                suction.Flow * (h2 - h1),
+	}, nil
+}
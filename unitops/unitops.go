@@ -0,0 +1,119 @@
+// Package unitops provides common unit operation models for use in a flowsheet.
+//
+// Heater, Valve and Compressor implement flowsheet.Unit and can be chained with
+// flowsheet.New(...).Add(...), or evaluated on its own with flowsheet.Run,
+// which returns the outlet stream together with the heat duty and work:
+//
+//	res, err := flowsheet.Run(flowsheet.LeeKesler{}, &unitops.Compressor{P: 10, Efficiency: 0.75}, feed)
+//	fmt.Println(res.Outlet.T, res.Work)
+//
+// FlashDrum separates a feed into vapor and liquid products and is called directly.
+package unitops
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rickykimani/zfactor/flowsheet"
+)
+
+// Heater heats or cools a stream to a specified outlet temperature at constant
+// pressure, less an optional pressure drop. It does no work, so its duty equals
+// the change in enthalpy flow.
+type Heater struct {
+	Label        string
+	T            float64 // Outlet temperature (K)
+	PressureDrop float64 // Pressure drop across the heater (bar)
+}
+
+func (h *Heater) Name() string {
+	return name(h.Label, "heater")
+}
+
+func (h *Heater) Run(p flowsheet.Provider, in *flowsheet.Stream) (*flowsheet.Stream, float64, error) {
+	if h.T <= 0 {
+		return nil, 0, errors.New("heater outlet temperature must be greater than 0")
+	}
+	if h.PressureDrop < 0 || h.PressureDrop >= in.P {
+		return nil, 0, fmt.Errorf("heater pressure drop %g bar must be in [0, %g) bar", h.PressureDrop, in.P)
+	}
+	return in.At(h.T, in.P-h.PressureDrop), 0, nil
+}
+
+// Valve is an adiabatic throttling valve. The outlet has the same enthalpy as
+// the inlet, so the valve exchanges neither heat nor work.
+type Valve struct {
+	Label string
+	P     float64 // Outlet pressure (bar)
+}
+
+func (v *Valve) Name() string {
+	return name(v.Label, "valve")
+}
+
+func (v *Valve) Run(p flowsheet.Provider, in *flowsheet.Stream) (*flowsheet.Stream, float64, error) {
+	if v.P <= 0 || v.P > in.P {
+		return nil, 0, fmt.Errorf("valve outlet pressure %g bar must be in (0, %g] bar", v.P, in.P)
+	}
+	out, err := flowsheet.Isenthalpic(p, in, v.P)
+	if err != nil {
+		return nil, 0, err
+	}
+	return out, 0, nil
+}
+
+// Compressor is an adiabatic compressor with an isentropic efficiency η:
+//
+//	W = F (H_s - H_in) / η
+//
+// where H_s is the enthalpy at the outlet pressure and the inlet entropy.
+type Compressor struct {
+	Label      string
+	P          float64 // Outlet pressure (bar)
+	Efficiency float64 // Isentropic efficiency (0, 1]. Defaults to 1 if 0.
+}
+
+func (c *Compressor) Name() string {
+	return name(c.Label, "compressor")
+}
+
+func (c *Compressor) Run(p flowsheet.Provider, in *flowsheet.Stream) (*flowsheet.Stream, float64, error) {
+	if c.P < in.P {
+		return nil, 0, fmt.Errorf("compressor outlet pressure %g bar is below the inlet pressure %g bar", c.P, in.P)
+	}
+	eta := c.Efficiency
+	if eta == 0 {
+		eta = 1
+	}
+	if eta < 0 || eta > 1 {
+		return nil, 0, errors.New("compressor efficiency must be in (0, 1]")
+	}
+
+	hIn, err := in.Enthalpy(p)
+	if err != nil {
+		return nil, 0, err
+	}
+	ideal, err := flowsheet.Isentropic(p, in, c.P)
+	if err != nil {
+		return nil, 0, err
+	}
+	hIdeal, err := ideal.Enthalpy(p)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dh := (hIdeal - hIn) / eta
+	out, err := flowsheet.TemperatureAtEnthalpy(p, ideal, c.P, hIn+dh)
+	if err != nil {
+		return nil, 0, err
+	}
+	return out, in.Flow * dh, nil
+}
+
+// name returns label, or def if label is empty.
+func name(label, def string) string {
+	if label == "" {
+		return def
+	}
+	return label
+}
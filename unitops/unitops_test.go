@@ -0,0 +1,171 @@
+package unitops_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cp"
+	"github.com/rickykimani/zfactor/flowsheet"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/unitops"
+)
+
+func TestCompressionTrain(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	feed := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 5, 2)
+
+	sol, err := flowsheet.New(p, feed).Add(
+		&unitops.Compressor{Label: "C-1", P: 15, Efficiency: 0.8},
+		&unitops.Heater{Label: "E-1", T: 310, PressureDrop: 0.5},
+		&unitops.Compressor{Label: "C-2", P: 40, Efficiency: 0.8},
+		&unitops.Valve{Label: "V-1", P: 30},
+	).Solve()
+	if err != nil {
+		t.Fatalf("Solve() unexpected error: %v", err)
+	}
+
+	c1, e1, c2, v1 := sol.Results[0], sol.Results[1], sol.Results[2], sol.Results[3]
+	if c1.Work <= 0 || c2.Work <= 0 {
+		t.Errorf("compressor work = %v, %v; want > 0", c1.Work, c2.Work)
+	}
+	if math.Abs(c1.Duty) > 1e-3 || math.Abs(c2.Duty) > 1e-3 {
+		t.Errorf("compressor duty = %v, %v; want 0", c1.Duty, c2.Duty)
+	}
+	if e1.Duty >= 0 || e1.Work != 0 || e1.Outlet.P != 14.5 {
+		t.Errorf("intercooler Q = %v, W = %v, P = %v; want Q < 0, W = 0, P = 14.5", e1.Duty, e1.Work, e1.Outlet.P)
+	}
+	if math.Abs(v1.Duty) > 1e-3 || v1.Work != 0 {
+		t.Errorf("valve Q = %v, W = %v; want 0, 0", v1.Duty, v1.Work)
+	}
+	// Joule-Thomson cooling of methane near room temperature
+	if v1.Outlet.T >= v1.Inlet.T {
+		t.Errorf("valve outlet T = %v, want < %v", v1.Outlet.T, v1.Inlet.T)
+	}
+}
+
+func TestCompressorEfficiency(t *testing.T) {
+	p := flowsheet.IdealGas{}
+	feed := flowsheet.NewStream(substance.Ethane, cp.EthaneGas, 300, 1, 1)
+
+	ideal, err := flowsheet.Run(p, &unitops.Compressor{P: 4}, feed)
+	if err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	actual, err := flowsheet.Run(p, &unitops.Compressor{P: 4, Efficiency: 0.7}, feed)
+	if err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	if got, want := actual.Work, ideal.Work/0.7; math.Abs(got-want) > 1e-6*want {
+		t.Errorf("Work = %v, want %v", got, want)
+	}
+	if actual.Outlet.T <= ideal.Outlet.T {
+		t.Errorf("outlet T = %v, want > isentropic %v", actual.Outlet.T, ideal.Outlet.T)
+	}
+
+	if _, err := flowsheet.Run(p, &unitops.Compressor{P: 0.5}, feed); err == nil {
+		t.Errorf("Run() with outlet below inlet pressure expected error, got nil")
+	}
+}
+
+func TestFlashDrum(t *testing.T) {
+	p := flowsheet.LeeKesler{}
+	feed := &flowsheet.Stream{
+		Components: []flowsheet.Species{
+			{Substance: substance.Propane, Cp: cp.PropaneGas, Fraction: 0.5},
+			{Substance: substance.NPentane, Cp: cp.NPentaneGas, Fraction: 0.5},
+		},
+		T:    400,
+		P:    20,
+		Flow: 10,
+	}
+
+	res, err := unitops.FlashDrum(p, feed, 320, 5)
+	if err != nil {
+		t.Fatalf("FlashDrum() unexpected error: %v", err)
+	}
+	if res.VaporFraction <= 0 || res.VaporFraction >= 1 {
+		t.Fatalf("VaporFraction = %v, want two-phase", res.VaporFraction)
+	}
+
+	// Component material balances
+	for i, c := range feed.Components {
+		in := feed.Flow * c.Fraction
+		out := res.Vapor.Flow*res.Vapor.Components[i].Fraction + res.Liquid.Flow*res.Liquid.Components[i].Fraction
+		if math.Abs(out-in) > 1e-9 {
+			t.Errorf("%s: product flow = %v, want %v", c.Substance.Name, out, in)
+		}
+	}
+	// Propane is the light key
+	if res.Vapor.Components[0].Fraction <= 0.5 || res.Liquid.Components[0].Fraction >= 0.5 {
+		t.Errorf("propane y = %v, x = %v; want y > z > x", res.Vapor.Components[0].Fraction, res.Liquid.Components[0].Fraction)
+	}
+	// Cooling and condensing the feed removes heat
+	if res.Duty >= 0 {
+		t.Errorf("Duty = %v, want < 0", res.Duty)
+	}
+}
+
+func TestPolytropicAnalysis(t *testing.T) {
+	suction := flowsheet.NewStream(substance.Methane, cp.MethaneGas, 300, 20, 5)
+
+	for _, p := range []substance.Provider{flowsheet.IdealGas{}, flowsheet.LeeKesler{}, flowsheet.Cubic{}} {
+		// Field data from a compressor with a known isentropic efficiency.
+		run, err := flowsheet.Run(p, &unitops.Compressor{P: 60, Efficiency: 0.78}, suction)
+		if err != nil {
+			t.Fatalf("%s: Run() unexpected error: %v", p.Name(), err)
+		}
+		res, err := unitops.PolytropicAnalysis(p, suction, run.Outlet.T, run.Outlet.P)
+		if err != nil {
+			t.Fatalf("%s: PolytropicAnalysis() unexpected error: %v", p.Name(), err)
+		}
+
+		if math.Abs(res.IsentropicEfficiency-0.78) > 1e-4 {
+			t.Errorf("%s: IsentropicEfficiency = %v, want 0.78", p.Name(), res.IsentropicEfficiency)
+		}
+		if math.Abs(res.Power-run.Work) > 1e-6*run.Work {
+			t.Errorf("%s: Power = %v, want compressor work %v", p.Name(), res.Power, run.Work)
+		}
+		// Compression: ηp > ηs and n > k.
+		if res.PolytropicEfficiency <= res.IsentropicEfficiency || res.PolytropicEfficiency >= 1 {
+			t.Errorf("%s: PolytropicEfficiency = %v, want in (%v, 1)", p.Name(), res.PolytropicEfficiency, res.IsentropicEfficiency)
+		}
+		if res.N <= res.NS {
+			t.Errorf("%s: N = %v, want > NS = %v", p.Name(), res.N, res.NS)
+		}
+		if math.Abs(res.SchultzFactor-1) > 0.02 {
+			t.Errorf("%s: SchultzFactor = %v, want close to 1", p.Name(), res.SchultzFactor)
+		}
+		if res.KSuction < 1.2 || res.KSuction > 1.45 {
+			t.Errorf("%s: KSuction = %v, want about 1.3", p.Name(), res.KSuction)
+		}
+	}
+
+	// For an ideal gas, k is Cp/Cv.
+	res, err := unitops.PolytropicAnalysis(flowsheet.IdealGas{}, suction, 400, 60)
+	if err != nil {
+		t.Fatalf("PolytropicAnalysis() unexpected error: %v", err)
+	}
+	c := cp.MethaneGas
+	cpIG := zfactor.RSI * (c.A + c.B*300 + c.C*300*300 + c.D/(300*300))
+	if want := cpIG / (cpIG - zfactor.RSI); math.Abs(res.KSuction-want) > 2e-3 {
+		t.Errorf("KSuction = %v, want Cp/Cv = %v", res.KSuction, want)
+	}
+	if res.ZSuction != 1 || res.ZDischarge != 1 {
+		t.Errorf("Z = %v, %v, want 1, 1", res.ZSuction, res.ZDischarge)
+	}
+
+	if _, err := unitops.PolytropicAnalysis(flowsheet.IdealGas{}, suction, 400, 10); err == nil {
+		t.Error("PolytropicAnalysis() with discharge below suction pressure expected error, got nil")
+	}
+
+	// The heads are per unit mass, so the molar mass must be known.
+	noMW := *substance.Methane
+	noMW.MW = 0
+	unknown := flowsheet.NewStream(&noMW, cp.MethaneGas, 300, 20, 5)
+	if _, err := unitops.PolytropicAnalysis(flowsheet.IdealGas{}, unknown, 400, 60); !errors.Is(err, zfactor.ErrMolarMass) {
+		t.Errorf("PolytropicAnalysis() without molar mass error = %v, want %v", err, zfactor.ErrMolarMass)
+	}
+}
@@ -0,0 +1,114 @@
+package virial
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/abbott"
+)
+
+// Component holds the critical properties and acentric factor of a component
+// of a gas mixture, for the cross coefficients of CrossCoefficients.
+type Component struct {
+	Tc       float64 // Critical temperature (K)
+	Pc       float64 // Critical pressure (bar)
+	Vc       float64 // Critical volume (cm³/mol)
+	Zc       float64 // Critical compressibility factor
+	Acentric float64 // Acentric factor (ω)
+}
+
+// CombineCritical returns the pseudo-critical properties of the pair i-j with
+// the combining rules of Prausnitz,
+//
+//	Tcij = √(Tci Tcj) (1 - kij)
+//	ωij = (ωi + ωj)/2
+//	Zcij = (Zci + Zcj)/2
+//	Vcij = ((Vci^(1/3) + Vcj^(1/3))/2)³
+//	Pcij = Zcij R Tcij / Vcij
+//
+// where kij is an empirical interaction parameter, 0 for chemically similar
+// species.
+func CombineCritical(ci, cj Component, kij float64) Component {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	tc := math.Sqrt(ci.Tc*cj.Tc) * (1 - kij)
+	zc := (ci.Zc + cj.Zc) / 2
+	vc := math.Pow((math.Cbrt(ci.Vc)+math.Cbrt(cj.Vc))/2, 3)
+	return Component{
+		Tc:       tc,
+		Pc:       zc * R * tc / vc,
+		Vc:       vc,
+		Zc:       zc,
+		Acentric: (ci.Acentric + cj.Acentric) / 2,
+	}
+}
+
+// CrossCoefficients calculates the matrix of second virial coefficients Bij
+// (cm³/mol) of a gas mixture at temperature T (K) from the Pitzer-Abbott
+// correlation,
+//
+//	Bij Pcij / (R Tcij) = B0(T/Tcij) + ωij B1(T/Tcij)
+//
+// with the pseudo-critical properties of each pair from CombineCritical. The
+// diagonal holds the pure-component coefficients, from the properties of the
+// components. kij is the symmetric matrix of interaction parameters; if nil,
+// all kij are 0. The result is the Bij of MixtureB, MixtureZTwoTerm and
+// ComponentPhi.
+func CrossCoefficients(T float64, comps []Component, kij [][]float64) ([][]float64, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	n := len(comps)
+	if n == 0 {
+		return nil, fmt.Errorf("mixture requires at least one component")
+	}
+	for i, c := range comps {
+		if c.Tc <= 0 || c.Pc <= 0 || c.Vc <= 0 || c.Zc <= 0 {
+			return nil, fmt.Errorf("component %d: %w", i+1, zfactor.ErrCriticalProp)
+		}
+	}
+	if kij != nil {
+		if len(kij) != n {
+			return nil, fmt.Errorf("kij has %d rows, want one per component (%d)", len(kij), n)
+		}
+		for i, row := range kij {
+			if len(row) != n {
+				return nil, fmt.Errorf("kij row %d has %d columns, want %d", i, len(row), n)
+			}
+			for j := range i {
+				if row[j] != kij[j][i] {
+					return nil, fmt.Errorf("kij is not symmetric: k%d%d = %g, k%d%d = %g", i+1, j+1, row[j], j+1, i+1, kij[j][i])
+				}
+			}
+		}
+	}
+
+	Bij := make([][]float64, n)
+	for i := range Bij {
+		Bij[i] = make([]float64, n)
+	}
+	for i := range n {
+		for j := i; j < n; j++ {
+			c := comps[i]
+			if i != j {
+				var k float64
+				if kij != nil {
+					k = kij[i][j]
+				}
+				c = CombineCritical(comps[i], comps[j], k)
+			}
+			b0, err := abbott.B0(T / c.Tc)
+			if err != nil {
+				return nil, err
+			}
+			b1, err := abbott.B1(T / c.Tc)
+			if err != nil {
+				return nil, err
+			}
+			Bij[i][j] = R * c.Tc / c.Pc * (b0 + c.Acentric*b1)
+			Bij[j][i] = Bij[i][j]
+		}
+	}
+	return Bij, nil
+}
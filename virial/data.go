@@ -0,0 +1,121 @@
+package virial
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+var (
+	// ErrDataRange is wrapped by the errors of Data.BAt and Data.CAt for
+	// temperatures outside the range of the data.
+	ErrDataRange = errors.New("virial: temperature out of the range of the data")
+	// ErrNoData is returned by Data.BAt and Data.CAt if a dataset has no points
+	// for the coefficient.
+	ErrNoData = errors.New("virial: no data for the coefficient")
+)
+
+// Point is the value V of a virial coefficient at temperature T (K).
+type Point struct {
+	T, V float64
+}
+
+// Data holds measured second and third virial coefficients of a gas, B in
+// cm³/mol and C in cm⁶/mol², as points in increasing order of temperature.
+// Data.BAt and Data.CAt interpolate between the points, so measured coefficients
+// can stand in for a correlation such as GeneralizedB where they exist.
+type Data struct {
+	Name    string
+	Formula string
+	B       []Point // Second virial coefficient (cm³/mol)
+	C       []Point // Third virial coefficient (cm⁶/mol²), often empty
+}
+
+// LookupData returns the built-in dataset of the gas named name, compared
+// case-insensitively.
+//
+// The built-in B are smoothed experimental values, rounded, after the
+// compilation of Dymond and Smith (1980) and, for water, the IAPWS-95
+// formulation; they are good to about 1 to 2 cm³/mol for the simple gases and
+// a few percent for carbon dioxide, the hydrocarbons and water. C is given for
+// argon, nitrogen and methane only and is less certain, about 10%.
+func LookupData(name string) (*Data, bool) {
+	for _, d := range builtinData {
+		if strings.EqualFold(d.Name, name) {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// Datasets returns the built-in datasets, in alphabetical order of name.
+func Datasets() []*Data {
+	list := slices.Clone(builtinData)
+	slices.SortFunc(list, func(a, b *Data) int { return strings.Compare(a.Name, b.Name) })
+	return list
+}
+
+// Range returns the temperature range (K) of the B data.
+func (d *Data) Range() (lo, hi float64) {
+	if len(d.B) == 0 {
+		return 0, 0
+	}
+	return d.B[0].T, d.B[len(d.B)-1].T
+}
+
+// BAt returns the second virial coefficient B (cm³/mol) and its temperature
+// derivative dB/dT (cm³/(mol K)) at temperature T (K), by monotone cubic
+// Hermite interpolation of the data. The interpolant passes through the data
+// points and does not overshoot between them. B and dB/dT can be used as
+// Args.B of the two-term virial equation and with ResidualH and ResidualS.
+//
+// It returns an error wrapping ErrDataRange if T is outside the data; the data
+// are not extrapolated.
+func (d *Data) BAt(T float64) (B, dBdT float64, err error) {
+	return d.interpolate("B", d.B, T)
+}
+
+// CAt returns the third virial coefficient C (cm⁶/mol²) at temperature T (K), by
+// monotone cubic Hermite interpolation of the data (see Data.BAt).
+func (d *Data) CAt(T float64) (float64, error) {
+	c, _, err := d.interpolate("C", d.C, T)
+	return c, err
+}
+
+// interpolate evaluates the interpolant of pts and its derivative at T. A
+// single point is used only at its own temperature.
+func (d *Data) interpolate(coef string, pts []Point, T float64) (v, dv float64, err error) {
+	if T <= 0 {
+		return 0, 0, zfactor.ErrTemp
+	}
+	if len(pts) == 0 {
+		return 0, 0, fmt.Errorf("%s %s: %w", d.Name, coef, ErrNoData)
+	}
+	lo, hi := pts[0].T, pts[len(pts)-1].T
+	if T < lo || T > hi {
+		return 0, 0, fmt.Errorf("%w: %s %s at T = %g K, data cover [%g, %g] K", ErrDataRange, d.Name, coef, T, lo, hi)
+	}
+	if len(pts) == 1 {
+		return pts[0].V, 0, nil
+	}
+
+	x, y := make([]float64, len(pts)), make([]float64, len(pts))
+	for i, p := range pts {
+		x[i], y[i] = p.T, p.V
+	}
+	s := numeric.MonotoneSlopes(x, y)
+	i, _ := slices.BinarySearch(x, T)
+	i = max(i-1, 0)
+	i = min(i, len(x)-2)
+
+	h := x[i+1] - x[i]
+	t := (T - x[i]) / h
+	t2, t3 := t*t, t*t*t
+	v = (2*t3-3*t2+1)*y[i] + (t3-2*t2+t)*h*s[i] + (3*t2-2*t3)*y[i+1] + (t3-t2)*h*s[i+1]
+	dv = ((6*t2-6*t)*(y[i]-y[i+1]))/h + (3*t2-4*t+1)*s[i] + (3*t2-2*t)*s[i+1]
+	return v, dv, nil
+}
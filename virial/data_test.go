@@ -0,0 +1,118 @@
+package virial
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLookupData(t *testing.T) {
+	d, ok := LookupData("nitrogen")
+	if !ok || d.Formula != "N2" {
+		t.Fatalf("LookupData(nitrogen) = %v, %v, want N2", d, ok)
+	}
+	if _, ok := LookupData("unobtainium"); ok {
+		t.Errorf("LookupData(unobtainium) found a dataset")
+	}
+	list := Datasets()
+	if len(list) != len(builtinData) {
+		t.Fatalf("len(Datasets()) = %d, want %d", len(list), len(builtinData))
+	}
+	for _, d := range list {
+		for i := 1; i < len(d.B); i++ {
+			if d.B[i].T <= d.B[i-1].T {
+				t.Errorf("%s: B points are not in increasing order of temperature", d.Name)
+			}
+		}
+	}
+}
+
+func TestDataBAt(t *testing.T) {
+	d, _ := LookupData("Nitrogen")
+	tests := []struct {
+		T, want, tol float64
+	}{
+		{300, -4.2, 1e-12}, // Data point
+		{100, -160, 1e-12}, // Lower bound
+		{700, 24, 1e-12},   // Upper bound
+		{273.15, -10.0, 0.5},
+	}
+	for _, tt := range tests {
+		got, _, err := d.BAt(tt.T)
+		if err != nil {
+			t.Fatalf("BAt(%v) error: %v", tt.T, err)
+		}
+		if math.Abs(got-tt.want) > tt.tol {
+			t.Errorf("BAt(%v) = %v, want %v", tt.T, got, tt.want)
+		}
+	}
+
+	// dB/dT is the derivative of the interpolant.
+	for _, T := range []float64{130, 275, 320, 640} {
+		const h = 1e-3
+		_, db, _ := d.BAt(T)
+		lo, _, _ := d.BAt(T - h)
+		hi, _, _ := d.BAt(T + h)
+		if want := (hi - lo) / (2 * h); math.Abs(db-want) > 1e-6 {
+			t.Errorf("BAt(%v) dB/dT = %v, want %v", T, db, want)
+		}
+	}
+
+	for _, T := range []float64{99, 701} {
+		if _, _, err := d.BAt(T); !errors.Is(err, ErrDataRange) {
+			t.Errorf("BAt(%v) error = %v, want ErrDataRange", T, err)
+		}
+	}
+}
+
+func TestDataCAt(t *testing.T) {
+	ar, _ := LookupData("argon")
+	if got, err := ar.CAt(300); err != nil || got != 1100 {
+		t.Errorf("CAt(300) = %v, %v, want 1100", got, err)
+	}
+	water, _ := LookupData("water")
+	if _, err := water.CAt(400); !errors.Is(err, ErrNoData) {
+		t.Errorf("CAt(400) error = %v, want ErrNoData", err)
+	}
+}
+
+func TestDataMonotone(t *testing.T) {
+	// The interpolant must not overshoot the data between the points.
+	d := &Data{Name: "step", B: []Point{{100, 0}, {200, 0}, {210, 10}, {300, 10}}}
+	for T := 100.0; T <= 300; T += 0.5 {
+		b, _, err := d.BAt(T)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b < -1e-9 || b > 10+1e-9 {
+			t.Errorf("BAt(%v) = %v, want within [0, 10]", T, b)
+		}
+	}
+}
+
+func TestDataGeneralizedB(t *testing.T) {
+	// The data agree with the Pitzer-Abbott correlation for the simple gases.
+	const R = 83.14
+	gases := []struct {
+		name          string
+		Tc, Pc, omega float64
+		tol           float64
+	}{
+		{"Nitrogen", 126.2, 34.0, 0.038, 5},
+		{"Argon", 150.9, 48.98, 0, 5},
+		{"Methane", 190.6, 45.99, 0.012, 6},
+	}
+	for _, g := range gases {
+		d, _ := LookupData(g.name)
+		for _, T := range []float64{200, 300, 400} {
+			got, _, err := d.BAt(T)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _, _ := GeneralizedB(T, g.Tc, g.Pc, g.omega, R)
+			if math.Abs(got-want) > g.tol {
+				t.Errorf("%s: BAt(%v) = %v, correlation %v", g.name, T, got, want)
+			}
+		}
+	}
+}
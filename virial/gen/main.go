@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type dataset struct {
+	Name    string       `json:"name"`
+	Formula string       `json:"formula"`
+	B       [][2]float64 `json:"b"`
+	C       [][2]float64 `json:"c"`
+}
+
+func main() {
+	input := filepath.Join("../data", "virial.json")
+	out := "table.go"
+
+	b, err := os.ReadFile(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var data []dataset
+	if err := json.Unmarshal(b, &data); err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "// Code generated by go generate; DO NOT EDIT.")
+	fmt.Fprintln(f)
+	fmt.Fprintln(f, "package virial")
+	fmt.Fprintln(f)
+
+	fmt.Println("#------------------------------------------------------#")
+
+	fmt.Fprintln(f, "// builtinData holds the generated datasets, in the order of the data file.")
+	fmt.Fprintln(f, "var builtinData = []*Data{")
+	for _, s := range data {
+		fmt.Printf("Processing %s\n", s.Name)
+		if len(s.B) < 2 {
+			log.Fatalf("%s: at least two B points are required", s.Name)
+		}
+		fmt.Fprintf(f, "\t{\n")
+		fmt.Fprintf(f, "\t\tName: %q,\n", s.Name)
+		fmt.Fprintf(f, "\t\tFormula: %q,\n", s.Formula)
+		writePoints(f, "B", s.Name, s.B)
+		writePoints(f, "C", s.Name, s.C)
+		fmt.Fprintf(f, "\t},\n")
+	}
+	fmt.Fprintf(f, "}\n")
+	fmt.Printf("Processed %d substances(virial)\n", len(data))
+}
+
+// writePoints emits the field name of the points pts, which must be in
+// increasing order of temperature.
+func writePoints(f *os.File, name, sub string, pts [][2]float64) {
+	if len(pts) == 0 {
+		return
+	}
+	if !sort.SliceIsSorted(pts, func(i, j int) bool { return pts[i][0] < pts[j][0] }) {
+		log.Fatalf("%s: %s points are not in increasing order of temperature", sub, name)
+	}
+	fmt.Fprintf(f, "\t\t%s: []Point{\n", name)
+	for _, p := range pts {
+		fmt.Fprintf(f, "\t\t\t{T: %g, V: %g},\n", p[0], p[1])
+	}
+	fmt.Fprintf(f, "\t\t},\n")
+}
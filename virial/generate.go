@@ -0,0 +1,4 @@
+//go:generate go run ./gen
+//go:generate go fmt ./...
+
+package virial
@@ -0,0 +1,138 @@
+package virial
+
+import (
+	"errors"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+)
+
+// HOCComponent holds the pure-component parameters of the Hayden-O'Connell
+// correlation.
+type HOCComponent struct {
+	Tc     float64 // Critical temperature (K)
+	Pc     float64 // Critical pressure (bar)
+	RD     float64 // Mean radius of gyration (Å)
+	Dipole float64 // Dipole moment (debye)
+	// Eta is the association parameter η, which measures the tendency of two
+	// like molecules to form hydrogen-bonded dimers: 0 for nonpolar and weakly
+	// polar fluids, about 1.4 to 1.7 for water and the alcohols and 4.5 for
+	// the carboxylic acids.
+	Eta float64
+}
+
+// HOCB is a second virial coefficient of the Hayden-O'Connell correlation
+// (cm³/mol), split into its contributions.
+type HOCB struct {
+	// Physical is the contribution of the physical forces: the free pairs of
+	// nonpolar and polar molecules and the metastable and bound pairs.
+	Physical float64
+	// Chemical is the contribution of the chemical association (dimerization)
+	// of the molecules, which dominates B of the carboxylic acids.
+	Chemical float64
+}
+
+// Total returns the second virial coefficient B = Physical + Chemical.
+func (b HOCB) Total() float64 { return b.Physical + b.Chemical }
+
+// check validates the parameters of c.
+func (c HOCComponent) check() error {
+	if c.Tc <= 0 || c.Pc <= 0 {
+		return zfactor.ErrCriticalProp
+	}
+	if c.RD <= 0 {
+		return errors.New("mean radius of gyration (RD) must be greater than 0")
+	}
+	if c.Dipole < 0 || c.Eta < 0 {
+		return errors.New("dipole moment and association parameter cannot be less than 0")
+	}
+	return nil
+}
+
+// hocPure holds the derived molecular parameters of a component.
+type hocPure struct {
+	w     float64 // Nonpolar acentric factor
+	eps   float64 // Nonpolar energy parameter ε'/k (K)
+	sigma float64 // Nonpolar size parameter σ' (Å)
+}
+
+// pure derives the nonpolar acentric factor and molecular parameters of c,
+//
+//	ω' = 0.006026 RD + 0.02096 RD² - 0.001366 RD³
+//	ε'/k = Tc [0.748 + 0.91 ω' - 0.4 η/(2 + 20 ω')]
+//	σ' = (2.44 - ω') (1.0133 Tc/Pc)^(1/3)
+func (c HOCComponent) pure() hocPure {
+	rd := c.RD
+	w := 0.006026*rd + 0.02096*rd*rd - 0.001366*rd*rd*rd
+	return hocPure{
+		w:     w,
+		eps:   c.Tc * (0.748 + 0.91*w - 0.4*c.Eta/(2+20*w)),
+		sigma: (2.44 - w) * math.Cbrt(1.0133*c.Tc/c.Pc),
+	}
+}
+
+// hocPair evaluates the correlation at temperature T (K) for a pair with the
+// nonpolar parameters w, eps and sigma, the polar correction xi of ε and σ,
+// the product of the dipole moments mu2 (debye²) and the association or
+// solvation parameter eta.
+func hocPair(T, w, eps, sigma, xi, mu2, eta float64) HOCB {
+	// Polar corrections of the energy and size parameters.
+	c1 := (16 + 400*w) / (10 + 400*w)
+	c2 := 3 / (10 + 400*w)
+	e := eps * (1 - xi*c1*(1-xi*(1+c1/2)))
+	s3 := sigma * sigma * sigma * (1 + xi*c2)
+
+	b0 := 1.26184 * s3 // 2/3 π N σ³ in cm³/mol with σ in Å
+	mu := 7243.8 * mu2 / (e * s3)
+	muF := mu // Reduced dipole moment of the free pairs
+	switch {
+	case mu >= 0.25:
+		muF = mu - 0.25
+	case mu >= 0.04:
+		muF = 0
+	}
+
+	ts := T / e
+	ti := 1/ts - 1.6*w // 1/T*'
+	free := 0.94 - 1.47*ti - 0.85*ti*ti + 1.015*ti*ti*ti
+	free -= muF * (0.74 - 3*ti + 2.1*ti*ti + 2.1*ti*ti*ti)
+	bound := (-0.3 - 0.05*mu) * math.Exp((1.99+0.2*mu*mu)/ts)
+
+	var chem float64
+	if eta != 0 {
+		var E float64
+		if eta < 4.5 {
+			E = math.Exp(eta * (650/(e+300) - 4.27))
+		} else {
+			E = math.Exp(eta * (42800/(e+22400) - 4.27))
+		}
+		chem = b0 * E * (1 - math.Exp(1500*eta/T))
+	}
+	return HOCB{Physical: b0 * (free + bound), Chemical: chem}
+}
+
+// HaydenOConnell calculates the second virial coefficient (cm³/mol) of a pure
+// gas at temperature T (K) from the correlation of Hayden and O'Connell
+// (1975). It treats the molecules as interacting through physical forces,
+// from the critical constants, the mean radius of gyration and the dipole
+// moment, and by chemical association, from the association parameter η, so
+// it holds for strongly associating vapors such as the carboxylic acids,
+// which the Pitzer-type correlations do not describe. The result is usable
+// as Args.B of the two-term virial equation.
+//
+// It returns an error if T <= 0 or a parameter of c is invalid.
+func HaydenOConnell(T float64, c HOCComponent) (HOCB, error) {
+	if T <= 0 {
+		return HOCB{}, zfactor.ErrTemp
+	}
+	if err := c.check(); err != nil {
+		return HOCB{}, err
+	}
+	p := c.pure()
+	var xi float64
+	if c.Dipole >= 1.45 {
+		mu4 := c.Dipole * c.Dipole * c.Dipole * c.Dipole
+		xi = 1.7941e7 * mu4 / ((2.882 - 1.882*p.w/(0.03+p.w)) * c.Tc * math.Pow(p.sigma, 6) * p.eps)
+	}
+	return hocPair(T, p.w, p.eps, p.sigma, xi, c.Dipole*c.Dipole, c.Eta), nil
+}
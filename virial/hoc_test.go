@@ -0,0 +1,45 @@
+package virial
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+)
+
+func TestHaydenOConnell(t *testing.T) {
+	tests := []struct {
+		name string
+		T    float64
+		c    HOCComponent
+		want float64 // experimental, cm³/mol
+	}{
+		{"Methane", 300, HOCComponent{Tc: 190.6, Pc: 46.0, RD: 1.118}, -42},
+		{"Water", 373.15, HOCComponent{Tc: 647.3, Pc: 220.5, RD: 0.615, Dipole: 1.83, Eta: 1.7}, -452},
+		{"Acetone", 300, HOCComponent{Tc: 508.2, Pc: 47.01, RD: 2.740, Dipole: 2.88, Eta: 0.9}, -1970},
+		// Dimerization of acetic acid at its normal boiling point, B ≈ -K RT with
+		// K = 3.58 bar⁻¹ (Ritter and Simons, 1945).
+		{"Acetic acid", 391, HOCComponent{Tc: 594.4, Pc: 57.86, RD: 2.595, Dipole: 1.74, Eta: 4.5}, -3.58 * 83.14 * 391},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HaydenOConnell(tt.T, tt.c)
+			if err != nil {
+				t.Fatalf("HaydenOConnell() unexpected error: %v", err)
+			}
+			if math.Abs(got.Total()-tt.want) > 0.06*math.Abs(tt.want) {
+				t.Errorf("HaydenOConnell() = %v, want %v", got.Total(), tt.want)
+			}
+			if tt.c.Eta == 0 && got.Chemical != 0 {
+				t.Errorf("HaydenOConnell() Chemical = %v, want 0 without association", got.Chemical)
+			}
+		})
+	}
+
+	if _, err := HaydenOConnell(0, HOCComponent{Tc: 190.6, Pc: 46.0, RD: 1.118}); err != zfactor.ErrTemp {
+		t.Errorf("HaydenOConnell() error = %v, want %v", err, zfactor.ErrTemp)
+	}
+	if _, err := HaydenOConnell(300, HOCComponent{Tc: 190.6, Pc: 46.0}); err == nil {
+		t.Error("HaydenOConnell() without RD expected error, got nil")
+	}
+}
@@ -0,0 +1,117 @@
+package virial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/internal/numeric"
+)
+
+// checkMixture validates the mole fractions y and the symmetric matrix Bij of
+// second virial coefficients, with Bij[i][i] the pure-component and Bij[i][j]
+// the cross coefficients.
+func checkMixture(y []float64, Bij [][]float64) error {
+	if len(y) == 0 {
+		return errors.New("mixture requires at least one component")
+	}
+	if len(Bij) != len(y) {
+		return fmt.Errorf("Bij has %d rows, want one per component (%d)", len(Bij), len(y))
+	}
+
+	for i, row := range Bij {
+		if len(row) != len(y) {
+			return fmt.Errorf("Bij row %d has %d columns, want %d", i, len(row), len(y))
+		}
+	}
+	if err := numeric.MoleFractions(y); err != nil {
+		return err
+	}
+
+	for i := range Bij {
+		for j := i + 1; j < len(Bij); j++ {
+			if math.Abs(Bij[i][j]-Bij[j][i]) > 1e-9*math.Max(math.Abs(Bij[i][j]), math.Abs(Bij[j][i])) {
+				return fmt.Errorf("Bij is not symmetric: B%d%d = %g, B%d%d = %g", i+1, j+1, Bij[i][j], j+1, i+1, Bij[j][i])
+			}
+		}
+	}
+	return nil
+}
+
+// MixtureB calculates the second virial coefficient of a gas mixture with the
+// quadratic mixing rule
+//
+//	B = Σi Σj yi yj Bij
+//
+// Where:
+//   - y are the mole fractions of the components.
+//   - Bij is the symmetric matrix of second virial coefficients: Bij[i][i] is the
+//     coefficient of pure i and Bij[i][j] the cross coefficient of i and j.
+//
+// The result takes the units of Bij.
+func MixtureB(y []float64, Bij [][]float64) (float64, error) {
+	if err := checkMixture(y, Bij); err != nil {
+		return 0, err
+	}
+
+	var B float64
+	for i, yi := range y {
+		for j, yj := range y {
+			B += yi * yj * Bij[i][j]
+		}
+	}
+	return B, nil
+}
+
+// MixtureZTwoTerm calculates the compressibility factor of a gas mixture from
+// the 2-term virial equation Z = 1 + BP/RT, with B from MixtureB.
+//
+// The molar volume follows as V = ZRT/P (see zfactor.MolarVolume). As for pure
+// species, pressures above 15 bar are rejected.
+func MixtureZTwoTerm(T, P, R float64, y []float64, Bij [][]float64) (float64, error) {
+	B, err := MixtureB(y, Bij)
+	if err != nil {
+		return 0, err
+	}
+
+	return CompressibilityTwoTerm(zfactor.Args{T: T, P: P, R: R, B: B})
+}
+
+// ComponentPhi calculates the fugacity coefficients of the components of a gas
+// mixture from the 2-term virial equation:
+//
+//	ln φ̂i = (P/RT) (2 Σj yj Bij - B)
+//
+// with B the mixture coefficient from MixtureB. T is in Kelvin, P in bar and Bij
+// in cm³/mol, with R = zfactor.RSI * 10 bar·cm³/(mol·K). As for
+// CompressibilityTwoTerm, pressures above 15 bar are rejected.
+//
+// The returned slice holds φ̂i for each component, in the order of y. These are the
+// vapor-phase corrections of the gamma-phi formulation of VLE at low pressure.
+func ComponentPhi(T, P float64, y []float64, Bij [][]float64) ([]float64, error) {
+	if P <= 0 {
+		return nil, zfactor.ErrPressure
+	}
+	if P > 15 {
+		return nil, zfactor.ErrHighPressureTwoTerm
+	}
+	if T <= 0 {
+		return nil, zfactor.ErrTemp
+	}
+	B, err := MixtureB(y, Bij)
+	if err != nil {
+		return nil, err
+	}
+
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	phi := make([]float64, len(y))
+	for i := range y {
+		var sum float64
+		for j, yj := range y {
+			sum += yj * Bij[i][j]
+		}
+		phi[i] = math.Exp(P / (R * T) * (2*sum - B))
+	}
+	return phi, nil
+}
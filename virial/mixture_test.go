@@ -0,0 +1,130 @@
+package virial
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+)
+
+func TestMixtureZTwoTerm(t *testing.T) {
+	Bij := [][]float64{
+		{-40, -90},
+		{-90, -200},
+	}
+	y := []float64{0.4, 0.6}
+
+	B, err := MixtureB(y, Bij)
+	if err != nil {
+		t.Fatalf("MixtureB() unexpected error: %v", err)
+	}
+	if want := -121.6; math.Abs(B-want) > 1e-9 {
+		t.Errorf("MixtureB() = %v, want %v", B, want)
+	}
+
+	z, err := MixtureZTwoTerm(300, 10, 83.14, y, Bij)
+	if err != nil {
+		t.Fatalf("MixtureZTwoTerm() unexpected error: %v", err)
+	}
+	if want := 1 + B*10/(83.14*300); math.Abs(z-want) > 1e-12 {
+		t.Errorf("MixtureZTwoTerm() = %v, want %v", z, want)
+	}
+
+	// A pure component reduces to CompressibilityTwoTerm.
+	z1, _ := MixtureZTwoTerm(300, 10, 83.14, []float64{1, 0}, Bij)
+	want1, _ := CompressibilityTwoTerm(zfactor.Args{T: 300, P: 10, R: 83.14, B: -40})
+	if math.Abs(z1-want1) > 1e-12 {
+		t.Errorf("MixtureZTwoTerm() pure = %v, want %v", z1, want1)
+	}
+
+	tests := []struct {
+		name string
+		y    []float64
+		Bij  [][]float64
+		P    float64
+	}{
+		{"fractions do not sum to 1", []float64{0.4, 0.5}, Bij, 10},
+		{"negative fraction", []float64{1.2, -0.2}, Bij, 10},
+		{"asymmetric", y, [][]float64{{-40, -90}, {-80, -200}}, 10},
+		{"wrong size", y, [][]float64{{-40}}, 10},
+		{"ragged", y, [][]float64{{-40, -90}, {-90}}, 10},
+		{"high pressure", y, Bij, 20},
+	}
+	for _, tt := range tests {
+		if _, err := MixtureZTwoTerm(300, tt.P, 83.14, tt.y, tt.Bij); err == nil {
+			t.Errorf("MixtureZTwoTerm() %s expected an error", tt.name)
+		}
+	}
+}
+
+func TestComponentPhi(t *testing.T) {
+	Bij := [][]float64{
+		{-40, -90},
+		{-90, -200},
+	}
+	y := []float64{0.4, 0.6}
+	const T, P, R = 300.0, 10.0, zfactor.RSI * 10
+
+	phi, err := ComponentPhi(T, P, y, Bij)
+	if err != nil {
+		t.Fatalf("ComponentPhi() unexpected error: %v", err)
+	}
+
+	// ln φ̂i = (P/RT)(Bii + yj² δij), δij = 2Bij - Bii - Bjj for a binary.
+	d12 := 2*Bij[0][1] - Bij[0][0] - Bij[1][1]
+	want := []float64{
+		math.Exp(P / (R * T) * (Bij[0][0] + y[1]*y[1]*d12)),
+		math.Exp(P / (R * T) * (Bij[1][1] + y[0]*y[0]*d12)),
+	}
+	for i := range want {
+		if math.Abs(phi[i]-want[i]) > 1e-12 {
+			t.Errorf("ComponentPhi()[%d] = %v, want %v", i, phi[i], want[i])
+		}
+	}
+
+	// Σ yi ln φ̂i = ln φ of the mixture = BP/RT.
+	B, _ := MixtureB(y, Bij)
+	if got := y[0]*math.Log(phi[0]) + y[1]*math.Log(phi[1]); math.Abs(got-B*P/(R*T)) > 1e-12 {
+		t.Errorf("Σ yi ln φ̂i = %v, want %v", got, B*P/(R*T))
+	}
+
+	if _, err := ComponentPhi(T, 20, y, Bij); err != zfactor.ErrHighPressureTwoTerm {
+		t.Errorf("ComponentPhi() error = %v, want %v", err, zfactor.ErrHighPressureTwoTerm)
+	}
+	if _, err := ComponentPhi(T, P, []float64{0.5, 0.6}, Bij); err != zfactor.ErrMolFracSum {
+		t.Errorf("ComponentPhi() error = %v, want %v", err, zfactor.ErrMolFracSum)
+	}
+}
+
+func TestCrossCoefficients(t *testing.T) {
+	// Nitrogen(1)/methane(2) at 200 K: Tc12 = 155.1 K, Pc12 = 39.51 bar, ω12 = 0.025.
+	comps := []Component{
+		{Tc: 126.2, Pc: 34.00, Vc: 89.2, Zc: 0.289, Acentric: 0.038},
+		{Tc: 190.6, Pc: 45.99, Vc: 98.6, Zc: 0.286, Acentric: 0.012},
+	}
+	Bij, err := CrossCoefficients(200, comps, nil)
+	if err != nil {
+		t.Fatalf("CrossCoefficients() unexpected error: %v", err)
+	}
+	want := [][]float64{{-35.4, -63.9}, {-63.9, -106.0}}
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(Bij[i][j]-want[i][j]) > 0.2 {
+				t.Errorf("CrossCoefficients() B%d%d = %v, want %v", i+1, j+1, Bij[i][j], want[i][j])
+			}
+		}
+	}
+
+	// kij lowers Tcij and makes B12 less negative.
+	k, _ := CrossCoefficients(200, comps, [][]float64{{0, 0.05}, {0.05, 0}})
+	if k[0][1] <= Bij[0][1] || k[0][0] != Bij[0][0] {
+		t.Errorf("CrossCoefficients() with kij = %v, want B12 > %v and B11 = %v", k, Bij[0][1], Bij[0][0])
+	}
+
+	if _, err := CrossCoefficients(200, comps, [][]float64{{0, 0.05}, {0, 0}}); err == nil {
+		t.Error("CrossCoefficients() with asymmetric kij expected error, got nil")
+	}
+	if _, err := CrossCoefficients(200, []Component{{Tc: 126.2, Pc: 34}}, nil); err == nil {
+		t.Error("CrossCoefficients() without Vc and Zc expected error, got nil")
+	}
+}
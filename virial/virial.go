@@ -2,6 +2,7 @@ package virial
 
 import (
 	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/abbott"
 )
 
 // SolveForVolumeTwoTerm solves the 2-term virial equation for molar volume.
@@ -107,3 +108,93 @@ func CompressibilityThreeTerm(V float64, args zfactor.Args) (float64, error) {
 
 	return 1 + args.B/V + args.C/(V*V), nil
 }
+
+// GeneralizedB calculates the second virial coefficient B and its temperature
+// derivative dB/dT from the Pitzer-Abbott correlation,
+//
+//	B = (R Tc/Pc) (B0 + ω B1)
+//	dB/dT = (R/Pc) (dB0/dTr + ω dB1/dTr)
+//
+// B takes the units of R Tc/Pc, e.g. cm³/mol for R in bar·cm³/(mol·K) and Pc in bar.
+func GeneralizedB(T, Tc, Pc, acentric, R float64) (B, dBdT float64, err error) {
+	if T <= 0 {
+		return 0, 0, zfactor.ErrTemp
+	}
+	if Tc <= 0 || Pc <= 0 {
+		return 0, 0, zfactor.ErrCriticalProp
+	}
+	if R <= 0 {
+		return 0, 0, zfactor.ErrUniversalConst
+	}
+	tr := T / Tc
+	b0, err := abbott.B0(tr)
+	if err != nil {
+		return 0, 0, err
+	}
+	b1, err := abbott.B1(tr)
+	if err != nil {
+		return 0, 0, err
+	}
+	db0, err := abbott.DB0(tr)
+	if err != nil {
+		return 0, 0, err
+	}
+	db1, err := abbott.DB1(tr)
+	if err != nil {
+		return 0, 0, err
+	}
+	return R * Tc / Pc * (b0 + acentric*b1), R / Pc * (db0 + acentric*db1), nil
+}
+
+// ResidualH calculates the residual enthalpy from the 2-term virial equation
+// Z = 1 + BP/RT,
+//
+//	H^R = P (B - T dB/dT)
+//
+// in the units of P·B, e.g. bar·cm³/mol (multiply by 0.1 for J/mol). dBdT is
+// the temperature derivative of B, e.g. from GeneralizedB. As for
+// CompressibilityTwoTerm, pressures above 15 bar are rejected.
+//
+// Required Args:
+//   - T: Temperature
+//   - P: Pressure
+//   - B: Second virial coefficient
+func ResidualH(args zfactor.Args, dBdT float64) (float64, error) {
+	if err := checkResidual(args); err != nil {
+		return 0, err
+	}
+	return args.P * (args.B - args.T*dBdT), nil
+}
+
+// ResidualS calculates the residual entropy from the 2-term virial equation
+// Z = 1 + BP/RT,
+//
+//	S^R = -P dB/dT
+//
+// in the units of P·B/T, e.g. bar·cm³/(mol·K) (multiply by 0.1 for J/(mol·K)).
+// As for CompressibilityTwoTerm, pressures above 15 bar are rejected.
+//
+// Required Args:
+//   - T: Temperature
+//   - P: Pressure
+func ResidualS(args zfactor.Args, dBdT float64) (float64, error) {
+	if err := checkResidual(args); err != nil {
+		return 0, err
+	}
+	return -args.P * dBdT, nil
+}
+
+// checkResidual validates the state of ResidualH and ResidualS. B may be 0, as
+// at the Boyle temperature, where H^R is still finite.
+func checkResidual(args zfactor.Args) error {
+	if args.P <= 0 {
+		return zfactor.ErrPressure
+	}
+	if args.P > 15 {
+		return zfactor.ErrHighPressureTwoTerm
+	}
+	if args.T <= 0 {
+		return zfactor.ErrTemp
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/abbott"
 )
 
 func TestIsopropanolVirial(t *testing.T) {
@@ -84,3 +85,50 @@ func TestIsopropanolVirial(t *testing.T) {
 		}
 	})
 }
+
+func TestResidualHS(t *testing.T) {
+	// n-Butane at 500 K and 10 bar, against the dimensionless Abbott correlations.
+	const (
+		R  = 83.14
+		Tc = 425.1
+		Pc = 37.96
+		w  = 0.200
+		T  = 500.0
+		P  = 10.0
+	)
+	B, dBdT, err := GeneralizedB(T, Tc, Pc, w, R)
+	if err != nil {
+		t.Fatalf("GeneralizedB() unexpected error: %v", err)
+	}
+	args := zfactor.Args{T: T, P: P, R: R, B: B}
+
+	hr, err := ResidualH(args, dBdT)
+	if err != nil {
+		t.Fatalf("ResidualH() unexpected error: %v", err)
+	}
+	want, _ := abbott.ResidualEnthalpy(T/Tc, P/Pc, w)
+	if math.Abs(hr-want*R*Tc) > 1e-9*math.Abs(hr) {
+		t.Errorf("ResidualH() = %v, want %v", hr, want*R*Tc)
+	}
+
+	sr, err := ResidualS(args, dBdT)
+	if err != nil {
+		t.Fatalf("ResidualS() unexpected error: %v", err)
+	}
+	want, _ = abbott.ResidualEntropy(T/Tc, P/Pc, w)
+	if math.Abs(sr-want*R) > 1e-9*math.Abs(sr) {
+		t.Errorf("ResidualS() = %v, want %v", sr, want*R)
+	}
+
+	// dB/dT against a central difference of B, within the rounding of the
+	// constants 0.675 and 0.722 of abbott.DB0 and DB1.
+	lo, _, _ := GeneralizedB(T-0.01, Tc, Pc, w, R)
+	hi, _, _ := GeneralizedB(T+0.01, Tc, Pc, w, R)
+	if fd := (hi - lo) / 0.02; math.Abs(dBdT-fd) > 1e-3*math.Abs(fd) {
+		t.Errorf("GeneralizedB() dB/dT = %v, want %v", dBdT, fd)
+	}
+
+	if _, err := ResidualH(zfactor.Args{T: T, P: 20, B: B}, dBdT); err != zfactor.ErrHighPressureTwoTerm {
+		t.Errorf("ResidualH() error = %v, want %v", err, zfactor.ErrHighPressureTwoTerm)
+	}
+}
@@ -0,0 +1,272 @@
+package flash
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/accel"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// Options configures an EOS flash. The zero value selects the defaults.
+type Options struct {
+	// Kij is the symmetric matrix of binary interaction parameters of the mixing
+	// rules. If nil, all kij are 0.
+	Kij [][]float64
+	// KijT is the matrix of temperature-dependent interaction parameters, used
+	// instead of Kij (see cubic.MixtureCfg).
+	KijT [][]cubic.TempKij
+	// Tolerance bounds Σ(Δ ln Kᵢ)² between successive iterations at convergence.
+	// Defaults to 1e-12.
+	Tolerance float64
+	// MaxIter is the maximum number of successive substitutions. Defaults to 500.
+	MaxIter int
+	// Negative selects the negative flash: the vapor fraction is solved over the
+	// whole range in which both phase compositions are positive (see
+	// NegativeRachfordRice) instead of being clipped to [0, 1]. Feeds just outside
+	// the two-phase region then converge smoothly to their single phase, which is
+	// identified from the side of the tie line they lie on rather than from
+	// their molar volume.
+	Negative bool
+	// TrustRadius, if positive, bounds the change of every ln Kᵢ in one
+	// iteration, which damps the oscillations of the successive substitution
+	// near phase boundaries and critical points.
+	TrustRadius float64
+}
+
+// defaults returns the options with unset fields filled in.
+func (o *Options) defaults() Options {
+	var opts Options
+	if o != nil {
+		opts = *o
+	}
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = 1e-12
+	}
+	if opts.MaxIter <= 0 {
+		opts.MaxIter = 500
+	}
+	return opts
+}
+
+const (
+	// trivialTol is the largest |ln Kᵢ| of a trivial solution, where both phases
+	// have collapsed onto the feed.
+	trivialTol = 1e-4
+	// accelEvery is the number of iterations between accelerated steps of the
+	// negative flash.
+	accelEvery = 5
+)
+
+// ConvergenceError is returned by PTEOS when the successive substitution does
+// not converge, with the state of the last iteration.
+type ConvergenceError struct {
+	Iterations int // Iterations performed
+	// Change is Σ(Δ ln Kᵢ)² of the last iteration, and Tolerance its bound.
+	Change, Tolerance float64
+	VaporFraction     float64   // Vapor fraction of the last iteration
+	K                 []float64 // K-values of the last iteration
+	// MaxLogK is the largest |ln Kᵢ|. Values approaching 0 indicate that the
+	// phases are merging, as near a mixture critical point.
+	MaxLogK float64
+}
+
+func (e *ConvergenceError) Error() string {
+	msg := fmt.Sprintf("successive substitution did not converge in %d iterations (Σ(Δ ln K)² = %.3g, tolerance %.3g, β = %.6g, max |ln K| = %.3g)",
+		e.Iterations, e.Change, e.Tolerance, e.VaporFraction, e.MaxLogK)
+	if e.MaxLogK < 100*trivialTol {
+		msg += "; the phases are nearly identical, as near a mixture critical point"
+	}
+	return msg
+}
+
+// PTEOS flashes a feed of the given species and overall mole fractions z at
+// temperature T (K) and pressure P (bar) with a cubic equation of state and van
+// der Waals mixing rules (see cubic.MixtureCfg). opts may be nil.
+//
+// The K-values are initialized from the Wilson correlation and refined by
+// successive substitution, Kᵢ = φ̂ᵢᴸ/φ̂ᵢⱽ, with the fugacity coefficients of the
+// liquid (smallest) and vapor (largest) roots, until the fugacities of every
+// species are equal in both phases. The Z, residual enthalpy and residual entropy
+// of each phase are set in the result. Options.Negative and Options.TrustRadius
+// make the iteration more robust near phase boundaries; if it still fails, the
+// error is a *ConvergenceError.
+//
+// If the iteration collapses to the trivial solution K = 1, the feed is single
+// phase; it is reported as liquid if its molar volume is below 1.75 times the
+// mixture covolume and as vapor otherwise.
+func PTEOS(eos cubic.EOSType, species []*substance.Substance, z []float64, T, P float64, opts *Options) (*Result, error) {
+	m, err := newMixer(eos, species, z, T, P, opts)
+	if err != nil {
+		return nil, err
+	}
+	o := m.opts
+
+	K := append([]float64(nil), m.K...)
+	res, err := withK(z, K, o.Negative)
+	if err != nil {
+		return nil, err
+	}
+	negative, prev := o.Negative, math.Inf(1)
+	var change, maxLnK float64
+	acc := &accel.Accelerator{Every: accelEvery}
+	for range o.MaxIter {
+		zl, phiL, err := cubic.PhaseLogPhi(m.mix(res.X), phase.Liquid)
+		if err != nil {
+			return nil, fmt.Errorf("liquid: %w", err)
+		}
+		zv, phiV, err := cubic.PhaseLogPhi(m.mix(res.Y), phase.Vapor)
+		if err != nil {
+			return nil, fmt.Errorf("vapor: %w", err)
+		}
+
+		change, maxLnK = 0, 0
+		lnK, next := make([]float64, len(K)), make([]float64, len(K))
+		var maxStep float64
+		for i := range K {
+			lnK[i], next[i] = math.Log(K[i]), phiL[i]-phiV[i]
+			d := next[i] - lnK[i]
+			change += d * d
+			maxLnK = math.Max(maxLnK, math.Abs(next[i]))
+			maxStep = math.Max(maxStep, math.Abs(d))
+		}
+		if maxLnK < trivialTol {
+			res, err := singlePhase(m.mix(z), z)
+			if err != nil {
+				return nil, err
+			}
+			return m.departures(res)
+		}
+		if change < o.Tolerance {
+			if res.VaporFraction <= 0 || res.VaporFraction >= 1 {
+				// A negative flash beyond the two-phase region.
+				return m.boundary(z, K)
+			}
+			res.ZLiquid, res.ZVapor = zl, zv
+			return m.departures(res)
+		}
+
+		// Far outside the two-phase region the negative flash need not converge;
+		// once it diverges there, continue with the vapor fraction in [0, 1].
+		outside := res.VaporFraction < 0 || res.VaporFraction > 1
+		if negative && outside && (change > prev || o.TrustRadius > 0 && maxStep > o.TrustRadius) {
+			negative = false
+		}
+		prev = change
+
+		// Successive substitution converges linearly, with a ratio of successive
+		// steps that tends to 1 near critical points; extrapolate to its limit.
+		if negative {
+			next = acc.Next(lnK, next)
+		}
+		scale := 1.0
+		if o.TrustRadius > 0 {
+			var maxNext float64
+			for i := range K {
+				maxNext = math.Max(maxNext, math.Abs(next[i]-lnK[i]))
+			}
+			if maxNext > o.TrustRadius {
+				scale = o.TrustRadius / maxNext
+			}
+		}
+		for i := range K {
+			K[i] = math.Exp(lnK[i] + scale*(next[i]-lnK[i]))
+		}
+		if res, err = withK(z, K, negative); err != nil {
+			return nil, err
+		}
+	}
+	return nil, &ConvergenceError{
+		Iterations:    o.MaxIter,
+		Change:        change,
+		Tolerance:     o.Tolerance,
+		VaporFraction: res.VaporFraction,
+		K:             K,
+		MaxLogK:       maxLnK,
+	}
+}
+
+// boundary returns the single-phase result of feed z for converged K-values
+// whose negative flash put the feed outside the two-phase region, with the Z of
+// the feed and of its incipient phase.
+func (m *mixer) boundary(z, K []float64) (*Result, error) {
+	res, err := WithK(z, K)
+	if err != nil {
+		return nil, err
+	}
+	if res.ZLiquid, _, err = cubic.PhaseLogPhi(m.mix(res.X), phase.Liquid); err != nil {
+		return nil, fmt.Errorf("liquid: %w", err)
+	}
+	if res.ZVapor, _, err = cubic.PhaseLogPhi(m.mix(res.Y), phase.Vapor); err != nil {
+		return nil, fmt.Errorf("vapor: %w", err)
+	}
+	return m.departures(res)
+}
+
+// departures sets the residual enthalpies and entropies of the phases of res
+// whose compressibility factors are set.
+func (m *mixer) departures(res *Result) (*Result, error) {
+	var err error
+	if res.ZLiquid > 0 {
+		if res.HRLiquid, res.SRLiquid, err = departure(m.mix(res.X), res.ZLiquid); err != nil {
+			return nil, fmt.Errorf("liquid: %w", err)
+		}
+	}
+	if res.ZVapor > 0 {
+		if res.HRVapor, res.SRVapor, err = departure(m.mix(res.Y), res.ZVapor); err != nil {
+			return nil, fmt.Errorf("vapor: %w", err)
+		}
+	}
+	return res, nil
+}
+
+// departure returns the residual enthalpy (J/mol) and entropy (J/(mol·K)) of
+// the phase of cfg with compressibility factor Z.
+func departure(cfg *cubic.MixtureCfg, Z float64) (hr, sr float64, err error) {
+	if hr, err = cubic.ResidualEnthalpy(cfg, Z); err != nil {
+		return 0, 0, err
+	}
+	if sr, err = cubic.ResidualEntropy(cfg, Z); err != nil {
+		return 0, 0, err
+	}
+	toSI := zfactor.RSI / cfg.R
+	return hr * toSI, sr * toSI, nil
+}
+
+// singlePhase returns the result of a feed that does not split.
+func singlePhase(m *cubic.MixtureCfg, z []float64) (*Result, error) {
+	volRes, err := cubic.SolveForVolume(m)
+	if err != nil {
+		return nil, err
+	}
+	roots := volRes.Clean()
+	V := roots[len(roots)-1]
+	res := &Result{
+		X: append([]float64(nil), z...),
+		Y: append([]float64(nil), z...),
+		K: make([]float64, len(z)),
+	}
+	for i := range res.K {
+		res.K[i] = 1
+	}
+	Z := m.P * V / (m.R * m.T)
+	if rootPhase(V, volRes.B) == phase.Liquid {
+		res.ZLiquid = Z
+	} else {
+		res.VaporFraction = 1
+		res.ZVapor = Z
+	}
+	return res, nil
+}
+
+// rootPhase guesses the phase of a single root of the EOS with molar volume V
+// and covolume b: liquid if V is below 1.75 b and vapor otherwise.
+func rootPhase(V, b float64) phase.Phase {
+	if V < 1.75*b {
+		return phase.Liquid
+	}
+	return phase.Vapor
+}
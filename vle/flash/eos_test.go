@@ -0,0 +1,172 @@
+package flash_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/vle/flash"
+)
+
+func TestPTEOS(t *testing.T) {
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+	tests := []struct {
+		name      string
+		T, P      float64
+		wantPhase phase.Phase
+	}{
+		{"two-phase", 300, 30, phase.TwoPhase},
+		{"superheated", 400, 10, phase.Vapor},
+		{"compressed liquid", 250, 200, phase.Liquid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := flash.PTEOS(&cubic.PR{}, species, z, tt.T, tt.P, nil)
+			if err != nil {
+				t.Fatalf("PTEOS() unexpected error: %v", err)
+			}
+			if got := res.Phase(); got != tt.wantPhase {
+				t.Fatalf("PTEOS().Phase() = %v, want %v", got, tt.wantPhase)
+			}
+			b := res.VaporFraction
+			for i := range z {
+				if got := b*res.Y[i] + (1-b)*res.X[i]; math.Abs(got-z[i]) > 1e-9 {
+					t.Errorf("component %d: βy + (1-β)x = %v, want %v", i, got, z[i])
+				}
+			}
+			if res.TwoPhase() && !(res.ZLiquid > 0 && res.ZLiquid < res.ZVapor) {
+				t.Errorf("PTEOS() ZLiquid = %v, ZVapor = %v, want 0 < ZLiquid < ZVapor", res.ZLiquid, res.ZVapor)
+			}
+		})
+	}
+}
+
+func TestPTEOSRefinesWilson(t *testing.T) {
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+	wilson, err := flash.PT(species, z, 300, 30)
+	if err != nil {
+		t.Fatalf("PT() unexpected error: %v", err)
+	}
+	eos, err := flash.PTEOS(&cubic.PR{}, species, z, 300, 30, nil)
+	if err != nil {
+		t.Fatalf("PTEOS() unexpected error: %v", err)
+	}
+	// The ideal-solution Wilson K-values overestimate the vapor fraction here.
+	if d := wilson.VaporFraction - eos.VaporFraction; d < 0.01 || d > 0.1 {
+		t.Errorf("PTEOS() VaporFraction = %v, want a little below Wilson's %v", eos.VaporFraction, wilson.VaporFraction)
+	}
+
+	opts := &flash.Options{MaxIter: 1}
+	if _, err := flash.PTEOS(&cubic.PR{}, species, z, 300, 30, opts); err == nil {
+		t.Error("PTEOS() with one iteration want convergence error")
+	}
+	if _, err := flash.PTEOS(nil, species, z, 300, 30, nil); err == nil {
+		t.Error("PTEOS() with nil EOS want error")
+	}
+}
+
+func TestPTEOSNegative(t *testing.T) {
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+
+	// Inside the two-phase region both formulations find the same split.
+	want, err := flash.PTEOS(&cubic.PR{}, species, z, 300, 30, nil)
+	if err != nil {
+		t.Fatalf("PTEOS() unexpected error: %v", err)
+	}
+	for _, opts := range []*flash.Options{{Negative: true}, {TrustRadius: 0.5}, {Negative: true, TrustRadius: 0.5}} {
+		got, err := flash.PTEOS(&cubic.PR{}, species, z, 300, 30, opts)
+		if err != nil {
+			t.Fatalf("PTEOS(%+v) unexpected error: %v", *opts, err)
+		}
+		if math.Abs(got.VaporFraction-want.VaporFraction) > 1e-5 {
+			t.Errorf("PTEOS(%+v).VaporFraction = %v, want %v", *opts, got.VaporFraction, want.VaporFraction)
+		}
+	}
+
+	// Just beyond the bubble point near the mixture critical region, the clipped
+	// vapor fraction makes the successive substitution stall.
+	const T, P = 370, 72.5
+	_, err = flash.PTEOS(&cubic.PR{}, species, z, T, P, nil)
+	var ce *flash.ConvergenceError
+	if !errors.As(err, &ce) {
+		t.Fatalf("PTEOS() error = %v, want *ConvergenceError", err)
+	}
+	if ce.Iterations != 500 || len(ce.K) != len(z) {
+		t.Errorf("ConvergenceError = %+v, want 500 iterations and %d K-values", *ce, len(z))
+	}
+	res, err := flash.PTEOS(&cubic.PR{}, species, z, T, P, &flash.Options{Negative: true})
+	if err != nil {
+		t.Fatalf("PTEOS() negative flash unexpected error: %v", err)
+	}
+	if got := res.Phase(); got != phase.Liquid {
+		t.Errorf("PTEOS().Phase() = %v, want %v", got, phase.Liquid)
+	}
+}
+
+func TestPTEOSSupercriticalWater(t *testing.T) {
+	// The mixture cubic has two negative roots here and the liquid root of the
+	// feed must not be taken below the covolume.
+	species := []*substance.Substance{substance.Methane, substance.NHexane, substance.Water}
+	opts := &flash.Options{Kij: [][]float64{{0, 0.02, 0.5}, {0.02, 0, 0.5}, {0.5, 0.5, 0}}}
+	for _, T := range []float64{525, 600} {
+		for _, P := range []float64{200, 400} {
+			res, err := flash.PTEOS(&cubic.PR{}, species, []float64{0.8, 0.1, 0.1}, T, P, opts)
+			if err != nil {
+				t.Fatalf("PTEOS(T = %v, P = %v) unexpected error: %v", T, P, err)
+			}
+			if res.VaporFraction != 1 || res.ZVapor <= 0 {
+				t.Errorf("PTEOS(T = %v, P = %v) = β %v, Zv %v, want a single vapor-like phase", T, P, res.VaporFraction, res.ZVapor)
+			}
+		}
+	}
+}
+
+func TestPTEOSDepartures(t *testing.T) {
+	const R = zfactor.RSI
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+	const T, P = 300.0, 30.0
+	res, err := flash.PTEOS(&cubic.PR{}, species, z, T, P, nil)
+	if err != nil {
+		t.Fatalf("PTEOS() unexpected error: %v", err)
+	}
+	if !(res.HRLiquid < res.HRVapor && res.HRVapor < 0) {
+		t.Errorf("PTEOS() HRLiquid = %v, HRVapor = %v, want HRLiquid < HRVapor < 0", res.HRLiquid, res.HRVapor)
+	}
+
+	// The departures must agree with the fugacity coefficients of the mixing
+	// rules: G^R/RT = H^R/RT - S^R/R = Σ xᵢ ln φ̂ᵢ in each phase.
+	comps := make([]cubic.Component, len(species))
+	for i, s := range species {
+		comps[i] = s.CubicComponent()
+	}
+	phases := []struct {
+		name   string
+		x      []float64
+		Z      float64
+		hr, sr float64
+	}{
+		{"liquid", res.X, res.ZLiquid, res.HRLiquid, res.SRLiquid},
+		{"vapor", res.Y, res.ZVapor, res.HRVapor, res.SRVapor},
+	}
+	for _, ph := range phases {
+		lnPhi, err := cubic.ComponentLogPhi(cubic.NewMixtureCfg(&cubic.PR{}, T, P, ph.x, comps, 10*R), ph.Z)
+		if err != nil {
+			t.Fatalf("ComponentLogPhi() unexpected error: %v", err)
+		}
+		var want float64
+		for i, xi := range ph.x {
+			want += xi * lnPhi[i]
+		}
+		if got := ph.hr/(R*T) - ph.sr/R; math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: H^R/RT - S^R/R = %v, want Σ xᵢ ln φ̂ᵢ = %v", ph.name, got, want)
+		}
+	}
+}
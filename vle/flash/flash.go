@@ -0,0 +1,325 @@
+// Package flash implements isothermal (PT) flash calculations, which split a
+// feed of overall composition z at temperature T and pressure P into
+// equilibrium vapor and liquid phases.
+//
+// The phase split for a set of equilibrium ratios Kᵢ = yᵢ/xᵢ is found from the
+// Rachford-Rice equation
+//
+//	Σ zᵢ(Kᵢ - 1) / (1 + β(Kᵢ - 1)) = 0
+//
+// for the vapor fraction β, after which
+//
+//	xᵢ = zᵢ / (1 + β(Kᵢ - 1)),  yᵢ = Kᵢxᵢ
+//
+// PT estimates the K-values of each species from the Wilson correlation, which
+// assumes ideal liquid and vapor phases. PTEOS refines them to phase equilibrium
+// with a cubic equation of state. Near phase boundaries and mixture critical
+// points, where the successive substitution is slow or oscillates, the negative
+// flash (NegativeRachfordRice) and a trust radius on the K-value updates can be
+// selected in Options; a calculation that still fails returns a
+// *ConvergenceError describing its last iteration.
+//
+// Multiphase extends the EOS flash to up to three phases (vapor-liquid-liquid
+// equilibrium), adding phases found unstable by the tangent plane stability
+// test of CheckStability.
+package flash
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+const (
+	fracTol = 1e-4
+	rrTol   = 1e-12
+	rrIter  = 100
+)
+
+// Result is the outcome of a PT flash.
+//
+// If the feed is single phase, VaporFraction is 0 (liquid) or 1 (vapor) and
+// the composition of the missing phase is that of its incipient first
+// drop or bubble.
+type Result struct {
+	VaporFraction float64   // Vapor fraction β = V/F
+	X             []float64 // Liquid mole fractions
+	Y             []float64 // Vapor mole fractions
+	K             []float64 // Equilibrium ratios yᵢ/xᵢ
+	// ZLiquid and ZVapor are the compressibility factors of the phases from the
+	// EOS, set by PTEOS; for a single-phase feed, that of the missing phase is
+	// of its incipient drop or bubble, or 0 if none was found. Both are 0 when
+	// the K-values are not from an EOS.
+	ZLiquid, ZVapor float64
+	// HRLiquid and HRVapor are the residual enthalpies H^R (J/mol), and SRLiquid
+	// and SRVapor the residual entropies S^R (J/(mol·K)), of the phases from the
+	// EOS and its mixing rules. PTEOS sets them wherever it sets the Z of the
+	// phase.
+	HRLiquid, HRVapor float64
+	SRLiquid, SRVapor float64
+}
+
+// TwoPhase reports whether both phases are present.
+func (r *Result) TwoPhase() bool {
+	return r.VaporFraction > 0 && r.VaporFraction < 1
+}
+
+// Phase returns the phase of the flashed feed: phase.TwoPhase if both phases
+// are present, phase.Vapor or phase.Liquid otherwise.
+func (r *Result) Phase() phase.Phase {
+	switch {
+	case r.TwoPhase():
+		return phase.TwoPhase
+	case r.VaporFraction >= 1:
+		return phase.Vapor
+	default:
+		return phase.Liquid
+	}
+}
+
+// WilsonK estimates the equilibrium ratio of a species from the Wilson correlation:
+//
+//	K = (Pc/P) exp[5.373(1 + ω)(1 - Tc/T)]
+//
+// It is cubic.WilsonK for the critical properties of s.
+func WilsonK(s *substance.Substance, T, P float64) (float64, error) {
+	return cubic.WilsonK(s.CubicComponent(), T, P)
+}
+
+// PT flashes a feed of the given species and overall mole fractions z at
+// temperature T (K) and pressure P (bar) using Wilson K-values.
+func PT(species []*substance.Substance, z []float64, T, P float64) (*Result, error) {
+	if len(species) != len(z) {
+		return nil, fmt.Errorf("got %d species and %d mole fractions", len(species), len(z))
+	}
+	K := make([]float64, len(species))
+	for i, s := range species {
+		if s == nil {
+			return nil, errors.New("species cannot be nil")
+		}
+		k, err := WilsonK(s, T, P)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.Name, err)
+		}
+		K[i] = k
+	}
+	return WithK(z, K)
+}
+
+// WithK flashes a feed of overall mole fractions z with fixed equilibrium ratios K.
+func WithK(z, K []float64) (*Result, error) {
+	return withK(z, K, false)
+}
+
+// withK flashes z with fixed K-values. If negative is set, the vapor fraction of
+// the result is that of NegativeRachfordRice and may lie outside [0, 1], with the
+// compositions of both phases on the tie line through z.
+func withK(z, K []float64, negative bool) (*Result, error) {
+	if err := validate(z, K); err != nil {
+		return nil, err
+	}
+
+	beta, err := RachfordRice(z, K)
+	if err != nil {
+		return nil, err
+	}
+	free := false
+	if negative && bracketed(K) {
+		if beta, err = NegativeRachfordRice(z, K); err != nil {
+			return nil, err
+		}
+		free = true
+	}
+
+	n := len(z)
+	res := &Result{
+		VaporFraction: beta,
+		X:             make([]float64, n),
+		Y:             make([]float64, n),
+		K:             append([]float64(nil), K...),
+	}
+
+	switch {
+	case free:
+		for i := range z {
+			res.X[i] = z[i] / (1 + beta*(K[i]-1))
+			res.Y[i] = K[i] * res.X[i]
+		}
+		normalize(res.X)
+		normalize(res.Y)
+	case beta == 0:
+		// Saturated or subcooled liquid; y is the incipient bubble.
+		copy(res.X, z)
+		for i := range z {
+			res.Y[i] = K[i] * z[i]
+		}
+		normalize(res.Y)
+	case beta == 1:
+		// Saturated or superheated vapor; x is the incipient dew.
+		copy(res.Y, z)
+		for i := range z {
+			res.X[i] = z[i] / K[i]
+		}
+		normalize(res.X)
+	default:
+		for i := range z {
+			res.X[i] = z[i] / (1 + beta*(K[i]-1))
+			res.Y[i] = K[i] * res.X[i]
+		}
+		normalize(res.X)
+		normalize(res.Y)
+	}
+
+	return res, nil
+}
+
+// RachfordRice solves the Rachford-Rice equation for the vapor fraction β.
+// It returns 0 if the feed is at or below its bubble point and 1 if it is at or
+// above its dew point.
+func RachfordRice(z, K []float64) (float64, error) {
+	if err := validate(z, K); err != nil {
+		return 0, err
+	}
+
+	f := func(beta float64) (float64, float64) {
+		var g, dg float64
+		for i := range z {
+			d := K[i] - 1
+			den := 1 + beta*d
+			g += z[i] * d / den
+			dg -= z[i] * d * d / (den * den)
+		}
+		return g, dg
+	}
+
+	// g(β) is monotonically decreasing: g(0) <= 0 means no vapor, g(1) >= 0 no liquid.
+	if g0, _ := f(0); g0 <= 0 {
+		return 0, nil
+	}
+	if g1, _ := f(1); g1 >= 0 {
+		return 1, nil
+	}
+
+	// Newton's method safeguarded by bisection on [0, 1].
+	lo, hi := 0.0, 1.0
+	beta := 0.5
+	for range rrIter {
+		g, dg := f(beta)
+		if g > 0 {
+			lo = beta
+		} else {
+			hi = beta
+		}
+		next := beta - g/dg
+		if next <= lo || next >= hi || dg == 0 {
+			next = (lo + hi) / 2
+		}
+		if math.Abs(next-beta) < rrTol {
+			return next, nil
+		}
+		beta = next
+	}
+	return 0, errors.New("rachford-rice iteration did not converge")
+}
+
+// NegativeRachfordRice solves the Rachford-Rice equation for the vapor fraction
+// β without limiting it to [0, 1]. β lies in the window
+//
+//	1/(1 - Kmax) < β < 1/(1 - Kmin)
+//
+// in which every xᵢ and yᵢ is positive; β < 0 or β > 1 means that the feed is
+// a single phase beyond the bubble or dew point of the tie line given by K.
+// At least one K-value must be above 1 and one below it.
+func NegativeRachfordRice(z, K []float64) (float64, error) {
+	if err := validate(z, K); err != nil {
+		return 0, err
+	}
+	if !bracketed(K) {
+		return 0, errors.New("negative flash requires K-values on both sides of 1")
+	}
+	kMin, kMax := K[0], K[0]
+	for _, k := range K {
+		kMin, kMax = math.Min(kMin, k), math.Max(kMax, k)
+	}
+	lo, hi := 1/(1-kMax), 1/(1-kMin)
+
+	// g(β) decreases from +∞ at lo < 0 to -∞ at hi > 1; Newton's method
+	// safeguarded by bisection, which stays off the poles at the ends.
+	beta := 0.5
+	for range rrIter {
+		var g, dg float64
+		for i := range z {
+			d := K[i] - 1
+			den := 1 + beta*d
+			g += z[i] * d / den
+			dg -= z[i] * d * d / (den * den)
+		}
+		if g > 0 {
+			lo = beta
+		} else {
+			hi = beta
+		}
+		next := beta - g/dg
+		if next <= lo || next >= hi || dg == 0 {
+			next = (lo + hi) / 2
+		}
+		if math.Abs(next-beta) < rrTol*math.Max(1, math.Abs(beta)) {
+			return next, nil
+		}
+		beta = next
+	}
+	return 0, errors.New("negative rachford-rice iteration did not converge")
+}
+
+// bracketed reports whether K has values both above and below 1.
+func bracketed(K []float64) bool {
+	above, below := false, false
+	for _, k := range K {
+		above = above || k > 1
+		below = below || k < 1
+	}
+	return above && below
+}
+
+// validate checks the feed composition and K-values.
+func validate(z, K []float64) error {
+	if len(z) == 0 {
+		return errors.New("no components provided")
+	}
+	if len(z) != len(K) {
+		return fmt.Errorf("got %d mole fractions and %d K-values", len(z), len(K))
+	}
+	var sum float64
+	for i, zi := range z {
+		if zi < 0 || zi > 1 {
+			return zfactor.ErrMolFracVal
+		}
+		if K[i] <= 0 {
+			return errors.New("K-values must be greater than 0")
+		}
+		sum += zi
+	}
+	if math.Abs(sum-1) > fracTol {
+		return zfactor.ErrMolFracSum
+	}
+	return nil
+}
+
+// normalize scales v so that its elements sum to 1.
+func normalize(v []float64) {
+	var sum float64
+	for _, x := range v {
+		sum += x
+	}
+	if sum == 0 {
+		return
+	}
+	for i := range v {
+		v[i] /= sum
+	}
+}
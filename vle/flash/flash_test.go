@@ -0,0 +1,88 @@
+package flash_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/vle/flash"
+)
+
+func TestRachfordRice(t *testing.T) {
+	tests := []struct {
+		name      string
+		z, K      []float64
+		want      float64
+		wantPhase phase.Phase
+	}{
+		// Symmetric binary: K = 2 and 1/2 with an equimolar feed splits evenly.
+		{"symmetric", []float64{0.5, 0.5}, []float64{2, 0.5}, 0.5, phase.TwoPhase},
+		{"subcooled", []float64{0.5, 0.5}, []float64{0.8, 0.5}, 0, phase.Liquid},
+		{"superheated", []float64{0.5, 0.5}, []float64{3, 1.5}, 1, phase.Vapor},
+	}
+
+	for _, tt := range tests {
+		got, err := flash.RachfordRice(tt.z, tt.K)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: RachfordRice() = %v, want %v", tt.name, got, tt.want)
+		}
+		res, err := flash.WithK(tt.z, tt.K)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if p := res.Phase(); p != tt.wantPhase {
+			t.Errorf("%s: Phase() = %v, want %v", tt.name, p, tt.wantPhase)
+		}
+	}
+}
+
+func TestWithKMaterialBalance(t *testing.T) {
+	z := []float64{0.2, 0.3, 0.5}
+	K := []float64{4.0, 1.2, 0.3}
+
+	res, err := flash.WithK(z, K)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.TwoPhase() {
+		t.Fatalf("VaporFraction = %v, want two-phase", res.VaporFraction)
+	}
+
+	b := res.VaporFraction
+	for i := range z {
+		if got := b*res.Y[i] + (1-b)*res.X[i]; math.Abs(got-z[i]) > 1e-9 {
+			t.Errorf("component %d: βy + (1-β)x = %v, want %v", i, got, z[i])
+		}
+		if math.Abs(res.Y[i]-K[i]*res.X[i]) > 1e-9 {
+			t.Errorf("component %d: y = %v, want Kx = %v", i, res.Y[i], K[i]*res.X[i])
+		}
+	}
+}
+
+func TestNegativeRachfordRice(t *testing.T) {
+	// For a binary, β = -(z₁d₁ + z₂d₂)/(d₁d₂) with dᵢ = Kᵢ - 1.
+	tests := []struct {
+		name string
+		z, K []float64
+		want float64
+	}{
+		{"two-phase", []float64{0.5, 0.5}, []float64{4, 0.5}, 5.0 / 6},
+		{"beyond dew point", []float64{0.5, 0.5}, []float64{3, 0.9}, 4.75},
+		{"beyond bubble point", []float64{0.5, 0.5}, []float64{1.1, 0.5}, -4},
+	}
+	for _, tt := range tests {
+		got, err := flash.NegativeRachfordRice(tt.z, tt.K)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: NegativeRachfordRice() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := flash.NegativeRachfordRice([]float64{0.5, 0.5}, []float64{3, 1.5}); err == nil {
+		t.Error("NegativeRachfordRice() with every K above 1 want error")
+	}
+}
@@ -0,0 +1,560 @@
+package flash
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rickykimani/zfactor"
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/internal/numeric"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+)
+
+// MaxPhases is the largest number of phases found by Multiphase: a vapor and two
+// liquids (VLLE).
+const MaxPhases = 3
+
+const (
+	// stableTol is the tangent plane distance below which a phase is unstable.
+	stableTol = -1e-8
+	// sameTol is the largest difference of mole fractions of two phases that are
+	// taken to be the same phase.
+	sameTol = 1e-5
+	// absentTol is the phase fraction below which a phase is dropped.
+	absentTol = 1e-10
+	// amountTol bounds the gradient of Q(β) at the solution of the phase amounts.
+	amountTol = 1e-10
+	// maxAmountStep bounds the change of a phase amount in one Newton step.
+	maxAmountStep = 0.5
+)
+
+// Stability is the outcome of a tangent plane stability test.
+type Stability struct {
+	// Stable reports whether the tested phase is stable, i.e. the tangent plane
+	// distance of every trial phase is non-negative.
+	Stable bool
+	// TPD is the smallest modified tangent plane distance tm = 1 - Σ Wᵢ found
+	// among the trial phases.
+	TPD float64
+	// Trial is the composition of the trial phase with the smallest TPD. If the
+	// tested phase is unstable, it is an estimate of the phase that splits off.
+	Trial []float64
+}
+
+// PhaseState is one phase of a multiphase flash.
+type PhaseState struct {
+	Kind     phase.Phase // phase.Vapor or phase.Liquid, from the root of the EOS
+	Fraction float64     // Fraction of the feed in the phase (mol/mol)
+	X        []float64   // Mole fractions
+	Z        float64     // Compressibility factor
+}
+
+// MultiphaseResult is the outcome of a multiphase flash.
+type MultiphaseResult struct {
+	// Phases are the phases present, in order of decreasing Z: the vapor, if
+	// any, first and the densest liquid last.
+	Phases []PhaseState
+}
+
+// Count returns the number of phases present.
+func (r *MultiphaseResult) Count() int {
+	return len(r.Phases)
+}
+
+// CheckStability performs Michelsen's tangent plane stability test on a phase of
+// the given species and mole fractions x at temperature T (K) and pressure P
+// (bar) with a cubic equation of state. opts may be nil.
+//
+// With dᵢ = ln xᵢ + ln φ̂ᵢ(x), trial phases are iterated to the stationary
+// points of the tangent plane distance,
+//
+//	ln Wᵢ = dᵢ - ln φ̂ᵢ(w),  w = W / Σ Wⱼ
+//
+// starting from Wilson vapor-like and liquid-like estimates and from each nearly
+// pure component. The phase is unstable if any trial has Σ Wᵢ > 1.
+func CheckStability(eos cubic.EOSType, species []*substance.Substance, x []float64, T, P float64, opts *Options) (*Stability, error) {
+	m, err := newMixer(eos, species, x, T, P, opts)
+	if err != nil {
+		return nil, err
+	}
+	return m.stability(x)
+}
+
+// Multiphase flashes a feed of the given species and overall mole fractions z
+// at temperature T (K) and pressure P (bar) into up to MaxPhases phases with a
+// cubic equation of state and van der Waals mixing rules, such as vapor,
+// hydrocarbon liquid and aqueous liquid for water-hydrocarbon systems. opts may
+// be nil.
+//
+// Phases are added one at a time: whenever the stability test (CheckStability)
+// of the current solution finds an unstable trial phase, the trial is added and
+// the phase amounts and compositions are solved again. For fixed fugacity
+// coefficients, the amounts βⱼ minimize Michelsen's convex function
+//
+//	Q(β) = Σⱼ βⱼ - Σᵢ zᵢ ln Eᵢ,  Eᵢ = Σⱼ βⱼ/φ̂ᵢⱼ
+//
+// subject to βⱼ >= 0, and the compositions are xᵢⱼ = zᵢ/(Eᵢ φ̂ᵢⱼ); the
+// fugacity coefficients are then updated by successive substitution until they
+// converge. Phases whose amount vanishes are removed.
+func Multiphase(eos cubic.EOSType, species []*substance.Substance, z []float64, T, P float64, opts *Options) (*MultiphaseResult, error) {
+	m, err := newMixer(eos, species, z, T, P, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := m.state(z)
+	if err != nil {
+		return nil, err
+	}
+	phases := []*trialPhase{st}
+	for iter := 0; ; iter++ {
+		if iter == m.opts.MaxIter {
+			return nil, fmt.Errorf("multiphase flash did not settle on a set of phases in %d stability tests", m.opts.MaxIter)
+		}
+		stab, err := m.stability(phases[0].x)
+		if err != nil {
+			return nil, err
+		}
+		if stab.Stable || len(phases) == MaxPhases || present(phases, stab.Trial) {
+			break
+		}
+		next, err := m.state(stab.Trial)
+		if err != nil {
+			return nil, err
+		}
+		if phases, err = m.split(z, append(phases, next)); err != nil {
+			return nil, err
+		}
+		if len(phases) == 1 {
+			// The trial phase vanished again; the test is not conclusive.
+			break
+		}
+	}
+
+	res := &MultiphaseResult{Phases: make([]PhaseState, len(phases))}
+	for j, p := range phases {
+		res.Phases[j] = PhaseState{Kind: p.kind, Fraction: p.beta, X: p.x, Z: p.z}
+	}
+	if len(phases) == 1 {
+		res.Phases[0].Fraction = 1
+	}
+	sort.SliceStable(res.Phases, func(i, j int) bool { return res.Phases[i].Z > res.Phases[j].Z })
+	return res, nil
+}
+
+// mixer evaluates the fugacity coefficients of phases of a fixed set of species.
+type mixer struct {
+	mix  func(y []float64) *cubic.MixtureCfg
+	K    []float64 // Wilson K-values
+	opts Options
+}
+
+// newMixer validates the inputs of a fugacity-based calculation.
+func newMixer(eos cubic.EOSType, species []*substance.Substance, z []float64, T, P float64, opts *Options) (*mixer, error) {
+	const R = zfactor.RSI * 10 // bar*cm^3/(mol*K)
+	if eos == nil {
+		return nil, errors.New("EOS cannot be nil")
+	}
+	wilson, err := PT(species, z, T, P)
+	if err != nil {
+		return nil, err
+	}
+	o := opts.defaults()
+	comps := make([]cubic.Component, len(species))
+	for i, s := range species {
+		comps[i] = s.CubicComponent()
+	}
+	return &mixer{
+		mix: func(y []float64) *cubic.MixtureCfg {
+			m := cubic.NewMixtureCfg(eos, T, P, y, comps, R)
+			m.Kij, m.KijT = o.Kij, o.KijT
+			return m
+		},
+		K:    wilson.K,
+		opts: o,
+	}, nil
+}
+
+// trialPhase is a phase with its fugacity coefficients.
+type trialPhase struct {
+	x     []float64 // Mole fractions
+	lnPhi []float64 // ln φ̂ᵢ
+	z     float64   // Compressibility factor
+	kind  phase.Phase
+	beta  float64 // Phase fraction
+}
+
+// state evaluates the phase with mole fractions x on the root of the EOS with
+// the lowest Gibbs energy.
+func (m *mixer) state(x []float64) (*trialPhase, error) {
+	cfg := m.mix(x)
+	volRes, err := cubic.SolveForVolume(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var best *trialPhase
+	g := math.Inf(1)
+	for _, V := range volRes.Clean() {
+		if V <= volRes.B {
+			continue
+		}
+		Z := cfg.P * V / (cfg.R * cfg.T)
+		lnPhi, err := cubic.ComponentLogPhi(cfg, Z)
+		if err != nil {
+			return nil, err
+		}
+		var gi float64 // Σ xᵢ ln φ̂ᵢ = ln φ of the mixture
+		for i, xi := range x {
+			gi += xi * lnPhi[i]
+		}
+		if gi < g {
+			g = gi
+			best = &trialPhase{x: append([]float64(nil), x...), lnPhi: lnPhi, z: Z, kind: rootPhase(V, volRes.B)}
+		}
+	}
+	if best == nil {
+		return nil, errors.New("no volume root above the covolume")
+	}
+	return best, nil
+}
+
+// stability runs the tangent plane stability test on the phase x.
+func (m *mixer) stability(x []float64) (*Stability, error) {
+	ref, err := m.state(x)
+	if err != nil {
+		return nil, err
+	}
+	n := len(x)
+	d := make([]float64, n)
+	for i := range x {
+		d[i] = math.Inf(-1)
+		if x[i] > 0 {
+			d[i] = math.Log(x[i]) + ref.lnPhi[i]
+		}
+	}
+
+	trials := [][]float64{make([]float64, n), make([]float64, n)}
+	for i := range x {
+		trials[0][i] = x[i] * m.K[i]
+		trials[1][i] = x[i] / m.K[i]
+	}
+	if n > 1 {
+		for k := range n {
+			w := make([]float64, n)
+			for i := range w {
+				w[i] = 0.01 / float64(n-1)
+			}
+			w[k] = 0.99
+			trials = append(trials, w)
+		}
+	}
+
+	res := &Stability{Stable: true, TPD: math.Inf(1)}
+	for _, W := range trials {
+		tm, w, err := m.tangentPlane(x, d, W)
+		if err != nil {
+			continue
+		}
+		if tm < res.TPD {
+			res.TPD, res.Trial = tm, w
+		}
+	}
+	if res.Trial == nil {
+		return nil, errors.New("stability test failed for every trial phase")
+	}
+	res.Stable = res.TPD >= stableTol
+	return res, nil
+}
+
+// tangentPlane iterates the trial phase W to a stationary point of the tangent
+// plane distance of the phase x, returning tm = 1 - Σ Wᵢ and the normalized
+// trial composition. A trial that converges onto x gives tm = 0.
+func (m *mixer) tangentPlane(x, d, W []float64) (float64, []float64, error) {
+	n := len(W)
+	w := make([]float64, n)
+	for range m.opts.MaxIter {
+		sum := 0.0
+		for _, Wi := range W {
+			sum += Wi
+		}
+		for i := range W {
+			w[i] = W[i] / sum
+		}
+		st, err := m.state(w)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		var change float64
+		for i := range W {
+			next := 0.0
+			if !math.IsInf(d[i], -1) {
+				next = math.Exp(d[i] - st.lnPhi[i])
+			}
+			if W[i] > 0 && next > 0 {
+				dl := math.Log(next) - math.Log(W[i])
+				change += dl * dl
+			}
+			W[i] = next
+		}
+		if change < m.opts.Tolerance {
+			sum = 0
+			for i, Wi := range W {
+				sum += Wi
+				w[i] = Wi
+			}
+			normalize(w)
+			if same(w, x) {
+				return 0, w, nil
+			}
+			return 1 - sum, w, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("stability test did not converge in %d iterations", m.opts.MaxIter)
+}
+
+// split solves the amounts and compositions of the phases of feed z, starting
+// from the given phases, and returns the phases present.
+func (m *mixer) split(z []float64, phases []*trialPhase) ([]*trialPhase, error) {
+	beta := seedAmounts(z, phases)
+	for range m.opts.MaxIter {
+		var err error
+		if beta, err = phaseAmounts(z, phases, beta); err != nil {
+			return nil, err
+		}
+
+		var change float64
+		next := make([]*trialPhase, 0, len(phases))
+		nextBeta := make([]float64, 0, len(phases))
+		for j, p := range phases {
+			if beta[j] < absentTol {
+				continue
+			}
+			x := make([]float64, len(z))
+			for i := range z {
+				x[i] = z[i] * math.Exp(-p.lnPhi[i]) / eValue(phases, beta, i)
+			}
+			normalize(x)
+			st, err := m.state(x)
+			if err != nil {
+				return nil, err
+			}
+			for i := range z {
+				d := st.lnPhi[i] - p.lnPhi[i]
+				change += d * d
+			}
+			st.beta = beta[j]
+			next = append(next, st)
+			nextBeta = append(nextBeta, beta[j])
+		}
+		phases, beta = merge(next, nextBeta)
+		if change < m.opts.Tolerance {
+			return phases, nil
+		}
+	}
+	return nil, fmt.Errorf("multiphase flash did not converge in %d iterations", m.opts.MaxIter)
+}
+
+// seedAmounts returns the initial phase amounts of split. Two phases start from
+// the Rachford-Rice split with Kᵢ = φ̂ᵢ₀/φ̂ᵢ₁, the ratio of their mole fractions
+// at equal fugacities, as PTEOS does; a phase added to a split keeps the amounts
+// of the others in proportion and starts with 1/n of the feed. Splits that
+// Rachford-Rice cannot place start evenly.
+func seedAmounts(z []float64, phases []*trialPhase) []float64 {
+	n := len(phases)
+	beta := make([]float64, n)
+	for j := range beta {
+		beta[j] = 1 / float64(n)
+	}
+	switch {
+	case n == 2:
+		K := make([]float64, len(z))
+		for i := range z {
+			K[i] = math.Exp(phases[0].lnPhi[i] - phases[1].lnPhi[i])
+		}
+		if b, err := RachfordRice(z, K); err == nil && b > 0 && b < 1 {
+			beta[0], beta[1] = 1-b, b
+		}
+	case n > 2:
+		var sum float64
+		for _, p := range phases[:n-1] {
+			sum += p.beta
+		}
+		if sum > 0 {
+			for j, p := range phases[:n-1] {
+				beta[j] = p.beta / sum * float64(n-1) / float64(n)
+			}
+		}
+	}
+	return beta
+}
+
+// eValue returns Eᵢ = Σⱼ βⱼ/φ̂ᵢⱼ.
+func eValue(phases []*trialPhase, beta []float64, i int) float64 {
+	var e float64
+	for j, p := range phases {
+		e += beta[j] * math.Exp(-p.lnPhi[i])
+	}
+	return e
+}
+
+// merge combines phases with the same composition and Z.
+func merge(phases []*trialPhase, beta []float64) ([]*trialPhase, []float64) {
+	var out []*trialPhase
+	var outBeta []float64
+	for j, p := range phases {
+		dup := false
+		for k, q := range out {
+			if same(p.x, q.x) && math.Abs(p.z-q.z) < sameTol {
+				outBeta[k] += beta[j]
+				q.beta = outBeta[k]
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			out = append(out, p)
+			outBeta = append(outBeta, beta[j])
+		}
+	}
+	return out, outBeta
+}
+
+// present reports whether one of phases has the composition x.
+func present(phases []*trialPhase, x []float64) bool {
+	for _, p := range phases {
+		if same(p.x, x) {
+			return true
+		}
+	}
+	return false
+}
+
+// same reports whether two compositions are the same within sameTol.
+func same(a, b []float64) bool {
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > sameTol {
+			return false
+		}
+	}
+	return true
+}
+
+// phaseAmounts minimizes Q(β) for the fixed fugacity coefficients of phases by
+// Newton's method with the bounds βⱼ >= 0, starting from beta.
+func phaseAmounts(z []float64, phases []*trialPhase, beta []float64) ([]float64, error) {
+	np := len(phases)
+	beta = append([]float64(nil), beta...)
+	inv := make([][]float64, np) // 1/φ̂ᵢⱼ
+	for j, p := range phases {
+		inv[j] = make([]float64, len(z))
+		for i := range z {
+			inv[j][i] = math.Exp(-p.lnPhi[i])
+		}
+	}
+	q := func(beta []float64) float64 {
+		v := 0.0
+		for _, b := range beta {
+			v += b
+		}
+		for i, zi := range z {
+			if zi > 0 {
+				v -= zi * math.Log(eValue(phases, beta, i))
+			}
+		}
+		return v
+	}
+
+	for range rrIter {
+		g := make([]float64, np)
+		H := make([][]float64, np)
+		for j := range H {
+			H[j] = make([]float64, np)
+			g[j] = 1
+		}
+		for i, zi := range z {
+			e := eValue(phases, beta, i)
+			for j := range np {
+				g[j] -= zi * inv[j][i] / e
+				for k := range np {
+					H[j][k] += zi * inv[j][i] * inv[k][i] / (e * e)
+				}
+			}
+		}
+
+		// Phases at the bound with a positive gradient stay absent.
+		var free []int
+		for j := range np {
+			if beta[j] > 0 || g[j] < 0 {
+				free = append(free, j)
+			}
+		}
+		converged := true
+		for _, j := range free {
+			if math.Abs(g[j]) > amountTol {
+				converged = false
+			}
+		}
+		if converged {
+			return beta, nil
+		}
+
+		step, err := newtonStep(H, g, free)
+		if err != nil {
+			return nil, err
+		}
+		// Limit the step to the bounds of the phases present and to a change of
+		// maxAmountStep in every amount; phases at the bound are clipped to it.
+		// Then halve the step until Q decreases.
+		alpha := 1.0
+		for k, j := range free {
+			if beta[j] > 0 && beta[j]+step[k] < 0 {
+				alpha = math.Min(alpha, -beta[j]/step[k])
+			}
+			if d := math.Abs(step[k]); alpha*d > maxAmountStep {
+				alpha = maxAmountStep / d
+			}
+		}
+		q0 := q(beta)
+		trial := make([]float64, np)
+		decreased := false
+		for range 50 {
+			copy(trial, beta)
+			for k, j := range free {
+				trial[j] = math.Max(beta[j]+alpha*step[k], 0)
+			}
+			if q(trial) < q0 {
+				decreased = true
+				break
+			}
+			alpha /= 2
+		}
+		if !decreased {
+			// Q is at its minimum within rounding, short of amountTol.
+			return beta, nil
+		}
+		beta = trial
+	}
+	return nil, errors.New("phase amounts did not converge")
+}
+
+// newtonStep solves H[free][free] d = -g[free].
+func newtonStep(H [][]float64, g []float64, free []int) ([]float64, error) {
+	a := make([][]float64, len(free))
+	b := make([]float64, len(free))
+	for r, j := range free {
+		a[r] = make([]float64, len(free))
+		for c, k := range free {
+			a[r][c] = H[j][k]
+		}
+		b[r] = -g[j]
+	}
+	d, ok := numeric.SolveLinear(a, b)
+	if !ok {
+		return nil, errors.New("singular phase amount Hessian")
+	}
+	return d, nil
+}
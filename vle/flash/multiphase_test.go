@@ -0,0 +1,153 @@
+package flash_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rickykimani/zfactor/cubic"
+	"github.com/rickykimani/zfactor/phase"
+	"github.com/rickykimani/zfactor/substance"
+	"github.com/rickykimani/zfactor/vle/flash"
+)
+
+// waterSystem is methane, n-hexane and water with the large water-hydrocarbon
+// interaction parameters that make the liquids immiscible.
+var (
+	waterSystem = []*substance.Substance{substance.Methane, substance.NHexane, substance.Water}
+	waterKij    = &flash.Options{Kij: [][]float64{{0, 0.02, 0.5}, {0.02, 0, 0.5}, {0.5, 0.5, 0}}}
+)
+
+func TestMultiphase(t *testing.T) {
+	tests := []struct {
+		name      string
+		z         []float64
+		T, P      float64
+		wantKinds []phase.Phase
+	}{
+		{"VLLE", []float64{0.3, 0.3, 0.4}, 310, 20, []phase.Phase{phase.Vapor, phase.Liquid, phase.Liquid}},
+		{"LLE", []float64{0, 0.5, 0.5}, 310, 20, []phase.Phase{phase.Liquid, phase.Liquid}},
+		{"vapor", []float64{0.3, 0.4, 0.3}, 350, 1, []phase.Phase{phase.Vapor}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := flash.Multiphase(&cubic.PR{}, waterSystem, tt.z, tt.T, tt.P, waterKij)
+			if err != nil {
+				t.Fatalf("Multiphase() unexpected error: %v", err)
+			}
+			if res.Count() != len(tt.wantKinds) {
+				t.Fatalf("Multiphase().Count() = %d, want %d", res.Count(), len(tt.wantKinds))
+			}
+			var total float64
+			balance := make([]float64, len(tt.z))
+			for j, p := range res.Phases {
+				if p.Kind != tt.wantKinds[j] {
+					t.Errorf("Multiphase().Phases[%d].Kind = %v, want %v", j, p.Kind, tt.wantKinds[j])
+				}
+				total += p.Fraction
+				for i, x := range p.X {
+					balance[i] += p.Fraction * x
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("Σ phase fractions = %v, want 1", total)
+			}
+			for i := range tt.z {
+				if math.Abs(balance[i]-tt.z[i]) > 1e-8 {
+					t.Errorf("component %d: Σ βx = %v, want %v", i, balance[i], tt.z[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMultiphaseInvariantCompositions(t *testing.T) {
+	// With three components and three phases at fixed T and P, the phase rule
+	// leaves no degrees of freedom: feeds in the three-phase region differ only in
+	// the amounts of the phases.
+	a, err := flash.Multiphase(&cubic.PR{}, waterSystem, []float64{0.3, 0.3, 0.4}, 310, 20, waterKij)
+	if err != nil {
+		t.Fatalf("Multiphase() unexpected error: %v", err)
+	}
+	b, err := flash.Multiphase(&cubic.PR{}, waterSystem, []float64{0.9, 0.05, 0.05}, 310, 20, waterKij)
+	if err != nil {
+		t.Fatalf("Multiphase() unexpected error: %v", err)
+	}
+	if a.Count() != 3 || b.Count() != 3 {
+		t.Fatalf("Multiphase().Count() = %d, %d, want 3", a.Count(), b.Count())
+	}
+	for j := range a.Phases {
+		for i := range a.Phases[j].X {
+			if d := a.Phases[j].X[i] - b.Phases[j].X[i]; math.Abs(d) > 1e-6 {
+				t.Errorf("Phases[%d].X[%d] = %v and %v, want equal", j, i, a.Phases[j].X[i], b.Phases[j].X[i])
+			}
+		}
+	}
+}
+
+func TestMultiphaseVLE(t *testing.T) {
+	// A plain two-phase feed agrees with the two-phase flash PTEOS.
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+	for _, T := range []float64{300, 250} {
+		want, err := flash.PTEOS(&cubic.PR{}, species, z, T, 10, nil)
+		if err != nil {
+			t.Fatalf("PTEOS(T = %v) unexpected error: %v", T, err)
+		}
+		got, err := flash.Multiphase(&cubic.PR{}, species, z, T, 10, nil)
+		if err != nil {
+			t.Fatalf("Multiphase(T = %v) unexpected error: %v", T, err)
+		}
+		if got.Count() != 2 {
+			t.Fatalf("Multiphase(T = %v).Count() = %d, want 2", T, got.Count())
+		}
+		vap, liq := got.Phases[0], got.Phases[1]
+		if vap.Kind != phase.Vapor || liq.Kind != phase.Liquid {
+			t.Errorf("Multiphase(T = %v) kinds = %v, %v, want vapor, liquid", T, vap.Kind, liq.Kind)
+		}
+		if math.Abs(vap.Fraction-want.VaporFraction) > 1e-6 {
+			t.Errorf("Multiphase(T = %v) vapor fraction = %v, want %v", T, vap.Fraction, want.VaporFraction)
+		}
+		for i := range z {
+			if math.Abs(vap.X[i]-want.Y[i]) > 1e-6 || math.Abs(liq.X[i]-want.X[i]) > 1e-6 {
+				t.Errorf("Multiphase(T = %v) component %d: y = %v, x = %v, want %v, %v", T, i, vap.X[i], liq.X[i], want.Y[i], want.X[i])
+			}
+		}
+	}
+}
+
+func TestCheckStability(t *testing.T) {
+	species := []*substance.Substance{substance.Methane, substance.Propane, substance.NButane}
+	z := []float64{0.3, 0.4, 0.3}
+	tests := []struct {
+		name       string
+		T, P       float64
+		wantStable bool
+	}{
+		{"two-phase", 300, 30, false},
+		{"superheated", 400, 10, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := flash.CheckStability(&cubic.PR{}, species, z, tt.T, tt.P, nil)
+			if err != nil {
+				t.Fatalf("CheckStability() unexpected error: %v", err)
+			}
+			if got.Stable != tt.wantStable {
+				t.Errorf("CheckStability().Stable = %v (TPD %v), want %v", got.Stable, got.TPD, tt.wantStable)
+			}
+		})
+	}
+
+	// A converged phase of a flash is stable.
+	res, err := flash.PTEOS(&cubic.PR{}, species, z, 300, 30, nil)
+	if err != nil {
+		t.Fatalf("PTEOS() unexpected error: %v", err)
+	}
+	got, err := flash.CheckStability(&cubic.PR{}, species, res.X, 300, 30, nil)
+	if err != nil {
+		t.Fatalf("CheckStability() unexpected error: %v", err)
+	}
+	if !got.Stable {
+		t.Errorf("CheckStability(liquid of PTEOS).Stable = false (TPD %v), want true", got.TPD)
+	}
+}
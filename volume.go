@@ -0,0 +1,37 @@
+package zfactor
+
+// MolarVolume returns the molar volume from the definition of the
+// compressibility factor, V = ZRT/P.
+//
+// V takes the units of R/P per mole: with R = RSI * 10 bar·cm³/(mol·K) and P in
+// bar, V is in cm³/mol.
+func MolarVolume(Z, P, T, R float64) (float64, error) {
+	if Z <= 0 {
+		return 0, ErrCompressibility
+	}
+	if P <= 0 {
+		return 0, ErrPressure
+	}
+	if T <= 0 {
+		return 0, ErrTemp
+	}
+	if R <= 0 {
+		return 0, ErrUniversalConst
+	}
+	return Z * R * T / P, nil
+}
+
+// Density returns the mass density MW/V = MW·P/(ZRT), where MW is the molar mass.
+//
+// The result is in units of MW per volume of R: with R = RSI * 10
+// bar·cm³/(mol·K), P in bar and MW in g/mol it is in g/cm³ (multiply by 1000 for kg/m³).
+func Density(Z, P, T, R, MW float64) (float64, error) {
+	if MW <= 0 {
+		return 0, ErrMolarMass
+	}
+	v, err := MolarVolume(Z, P, T, R)
+	if err != nil {
+		return 0, err
+	}
+	return MW / v, nil
+}
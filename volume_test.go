@@ -0,0 +1,48 @@
+package zfactor
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMolarVolume(t *testing.T) {
+	tests := []struct {
+		name       string
+		Z, P, T, R float64
+		want       float64
+		wantErr    error
+	}{
+		{"ideal gas", 1, 1, 273.15, RSI * 10, 22709.69, nil},
+		{"ethane", 0.7, 32, 299, RSI * 10, 543.788, nil},
+		{"zero Z", 0, 1, 300, RSI * 10, 0, ErrCompressibility},
+		{"zero P", 1, 0, 300, RSI * 10, 0, ErrPressure},
+		{"zero T", 1, 1, 0, RSI * 10, 0, ErrTemp},
+		{"zero R", 1, 1, 300, 0, 0, ErrUniversalConst},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MolarVolume(tt.Z, tt.P, tt.T, tt.R)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MolarVolume() error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-2 {
+				t.Errorf("MolarVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDensity(t *testing.T) {
+	// Methane as an ideal gas at 0 °C and 1 bar: 16.043 / 22709.69 g/cm³
+	got, err := Density(1, 1, 273.15, RSI*10, 16.043)
+	if err != nil {
+		t.Fatalf("Density() unexpected error: %v", err)
+	}
+	if want := 7.0644e-4; math.Abs(got-want) > 1e-7 {
+		t.Errorf("Density() = %v, want %v", got, want)
+	}
+	if _, err := Density(1, 1, 273.15, RSI*10, 0); !errors.Is(err, ErrMolarMass) {
+		t.Errorf("Density() error = %v, want %v", err, ErrMolarMass)
+	}
+}